@@ -0,0 +1,15 @@
+package accesslog
+
+import "errors"
+
+// ErrUnknownSink signals that an unknown access log sink has been provided
+var ErrUnknownSink = errors.New("unknown access log sink")
+
+// ErrEmptyFilePath signals that an empty access log file path has been provided
+var ErrEmptyFilePath = errors.New("empty access log file path")
+
+// ErrInvalidMaxFileSize signals that an invalid maximum access log file size has been provided
+var ErrInvalidMaxFileSize = errors.New("invalid maximum access log file size")
+
+// ErrInvalidMaxBackups signals that an invalid number of rotated access log files has been provided
+var ErrInvalidMaxBackups = errors.New("invalid number of rotated access log files")
@@ -0,0 +1,153 @@
+package accesslog
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const bytesInMB = 1024 * 1024
+
+type rotatingFileWriter struct {
+	mut         sync.Mutex
+	path        string
+	maxFileSize int64
+	maxBackups  int
+	file        *os.File
+	fileSize    int64
+}
+
+// NewRotatingFileWriter returns a writer appending to the file at the provided path. When a write would take the file
+// above the maximum size, the file is renamed to path.1, the older rotated files are shifted to path.2, path.3 and so
+// on, the ones above the maximum number of backups being removed, and a new file is started
+func NewRotatingFileWriter(path string, maxFileSizeInMB int, maxBackups int) (*rotatingFileWriter, error) {
+	if len(path) == 0 {
+		return nil, ErrEmptyFilePath
+	}
+	if maxFileSizeInMB <= 0 {
+		return nil, fmt.Errorf("%w %d, it should be greater than zero", ErrInvalidMaxFileSize, maxFileSizeInMB)
+	}
+	if maxBackups < 0 {
+		return nil, fmt.Errorf("%w %d, it should not be negative", ErrInvalidMaxBackups, maxBackups)
+	}
+
+	rfw := &rotatingFileWriter{
+		path:        path,
+		maxFileSize: int64(maxFileSizeInMB) * bytesInMB,
+		maxBackups:  maxBackups,
+	}
+
+	err := rfw.openFile()
+	if err != nil {
+		return nil, err
+	}
+
+	return rfw, nil
+}
+
+// Write appends the provided bytes to the file, rotating it first if it would grow above the maximum size
+func (rfw *rotatingFileWriter) Write(p []byte) (int, error) {
+	rfw.mut.Lock()
+	defer rfw.mut.Unlock()
+
+	if rfw.file == nil {
+		return 0, os.ErrClosed
+	}
+
+	// a file is never left empty, even if the bytes are larger than the maximum size
+	shouldRotate := rfw.fileSize > 0 && rfw.fileSize+int64(len(p)) > rfw.maxFileSize
+	if shouldRotate {
+		err := rfw.rotate()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := rfw.file.Write(p)
+	rfw.fileSize += int64(n)
+
+	return n, err
+}
+
+func (rfw *rotatingFileWriter) rotate() error {
+	err := rfw.file.Close()
+	if err != nil {
+		return err
+	}
+	rfw.file = nil
+
+	if rfw.maxBackups == 0 {
+		err = os.Remove(rfw.path)
+	} else {
+		err = rfw.shiftBackups()
+	}
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return rfw.openFile()
+}
+
+func (rfw *rotatingFileWriter) shiftBackups() error {
+	err := os.Remove(rfw.backupPath(rfw.maxBackups))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	for idx := rfw.maxBackups - 1; idx > 0; idx-- {
+		err = os.Rename(rfw.backupPath(idx), rfw.backupPath(idx+1))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return os.Rename(rfw.path, rfw.backupPath(1))
+}
+
+func (rfw *rotatingFileWriter) backupPath(index int) string {
+	return fmt.Sprintf("%s.%d", rfw.path, index)
+}
+
+func (rfw *rotatingFileWriter) openFile() error {
+	err := os.MkdirAll(filepath.Dir(rfw.path), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(rfw.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	rfw.file = file
+	rfw.fileSize = fileInfo.Size()
+
+	return nil
+}
+
+// Close closes the current file
+func (rfw *rotatingFileWriter) Close() error {
+	rfw.mut.Lock()
+	defer rfw.mut.Unlock()
+
+	if rfw.file == nil {
+		return nil
+	}
+
+	err := rfw.file.Close()
+	rfw.file = nil
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rfw *rotatingFileWriter) IsInterfaceNil() bool {
+	return rfw == nil
+}
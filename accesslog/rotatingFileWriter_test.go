@@ -0,0 +1,101 @@
+package accesslog
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readFile(t *testing.T, path string) string {
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	return string(content)
+}
+
+func TestNewRotatingFileWriter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty path should error", func(t *testing.T) {
+		t.Parallel()
+
+		rfw, err := NewRotatingFileWriter("", 1, 1)
+		require.Nil(t, rfw)
+		require.Equal(t, ErrEmptyFilePath, err)
+	})
+	t.Run("invalid max file size should error", func(t *testing.T) {
+		t.Parallel()
+
+		rfw, err := NewRotatingFileWriter(filepath.Join(t.TempDir(), "access.log"), 0, 1)
+		require.Nil(t, rfw)
+		require.True(t, errors.Is(err, ErrInvalidMaxFileSize))
+	})
+	t.Run("invalid max backups should error", func(t *testing.T) {
+		t.Parallel()
+
+		rfw, err := NewRotatingFileWriter(filepath.Join(t.TempDir(), "access.log"), 1, -1)
+		require.Nil(t, rfw)
+		require.True(t, errors.Is(err, ErrInvalidMaxBackups))
+	})
+	t.Run("should create the directories and append to an existing file", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "logs", "access.log")
+		rfw, err := NewRotatingFileWriter(path, 1, 1)
+		require.NoError(t, err)
+		_, _ = rfw.Write([]byte("line 1\n"))
+		require.NoError(t, rfw.Close())
+
+		rfw, err = NewRotatingFileWriter(path, 1, 1)
+		require.NoError(t, err)
+		require.False(t, rfw.IsInterfaceNil())
+		_, _ = rfw.Write([]byte("line 2\n"))
+		require.NoError(t, rfw.Close())
+
+		require.Equal(t, "line 1\nline 2\n", readFile(t, path))
+	})
+}
+
+func TestRotatingFileWriter_WriteShouldRotate(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "access.log")
+	rfw, err := NewRotatingFileWriter(path, 1, 2)
+	require.NoError(t, err)
+	rfw.maxFileSize = 10
+
+	for _, line := range []string{"line 1\n", "line 2\n", "line 3\n", "line 4\n"} {
+		n, errWrite := rfw.Write([]byte(line))
+		require.NoError(t, errWrite)
+		require.Equal(t, len(line), n)
+	}
+	require.NoError(t, rfw.Close())
+
+	require.Equal(t, "line 4\n", readFile(t, path))
+	require.Equal(t, "line 3\n", readFile(t, path+".1"))
+	require.Equal(t, "line 2\n", readFile(t, path+".2"))
+	require.NoFileExists(t, path+".3")
+
+	_, err = rfw.Write([]byte("line 5\n"))
+	require.Equal(t, os.ErrClosed, err)
+}
+
+func TestRotatingFileWriter_WriteWithoutBackupsShouldTruncate(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "access.log")
+	rfw, err := NewRotatingFileWriter(path, 1, 0)
+	require.NoError(t, err)
+	rfw.maxFileSize = 10
+
+	_, _ = rfw.Write([]byte("line 1\n"))
+	_, _ = rfw.Write([]byte("a line larger than the maximum size\n"))
+	_, _ = rfw.Write([]byte("line 3\n"))
+	require.NoError(t, rfw.Close())
+
+	require.Equal(t, "line 3\n", readFile(t, path))
+	require.NoFileExists(t, path+".1")
+}
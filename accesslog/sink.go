@@ -0,0 +1,53 @@
+package accesslog
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+)
+
+const (
+	// FileSink is the sink writing the access log lines to a file, rotated by size
+	FileSink = "file"
+	// StdoutSink is the sink writing the access log lines to the standard output
+	StdoutSink = "stdout"
+)
+
+// NewSink returns the access log sink as configured
+func NewSink(cfg config.AccessLogConfig) (io.WriteCloser, error) {
+	switch cfg.Sink {
+	case FileSink:
+		rotatingWriter, err := NewRotatingFileWriter(cfg.FilePath, cfg.MaxFileSizeInMB, cfg.MaxBackups)
+		if err != nil {
+			return nil, err
+		}
+
+		return rotatingWriter, nil
+	case StdoutSink:
+		return &stdoutSink{writer: os.Stdout}, nil
+	default:
+		return nil, fmt.Errorf("%w %s", ErrUnknownSink, cfg.Sink)
+	}
+}
+
+// stdoutSink does not close the standard output, which is still used by the logger
+type stdoutSink struct {
+	writer io.Writer
+}
+
+// Write writes the provided bytes to the standard output
+func (sink *stdoutSink) Write(p []byte) (int, error) {
+	return sink.writer.Write(p)
+}
+
+// Close does nothing
+func (sink *stdoutSink) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sink *stdoutSink) IsInterfaceNil() bool {
+	return sink == nil
+}
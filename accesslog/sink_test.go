@@ -0,0 +1,50 @@
+package accesslog
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSink(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown sink should error", func(t *testing.T) {
+		t.Parallel()
+
+		sink, err := NewSink(config.AccessLogConfig{Sink: "unknown"})
+		require.Nil(t, sink)
+		require.True(t, errors.Is(err, ErrUnknownSink))
+	})
+	t.Run("invalid file sink should error", func(t *testing.T) {
+		t.Parallel()
+
+		sink, err := NewSink(config.AccessLogConfig{Sink: FileSink})
+		require.Nil(t, sink)
+		require.Equal(t, ErrEmptyFilePath, err)
+	})
+	t.Run("file sink should work", func(t *testing.T) {
+		t.Parallel()
+
+		sink, err := NewSink(config.AccessLogConfig{
+			Sink:            FileSink,
+			FilePath:        filepath.Join(t.TempDir(), "access.log"),
+			MaxFileSizeInMB: 1,
+			MaxBackups:      1,
+		})
+		require.NoError(t, err)
+		require.IsType(t, &rotatingFileWriter{}, sink)
+		require.NoError(t, sink.Close())
+	})
+	t.Run("stdout sink should work", func(t *testing.T) {
+		t.Parallel()
+
+		sink, err := NewSink(config.AccessLogConfig{Sink: StdoutSink})
+		require.NoError(t, err)
+		require.IsType(t, &stdoutSink{}, sink)
+		require.NoError(t, sink.Close())
+	})
+}
@@ -1,65 +1,74 @@
 package api
 
 import (
-	"encoding/hex"
 	"fmt"
+	"io"
 	"net/http"
+	"path"
 	"reflect"
 	"time"
 
-	"github.com/gin-contrib/cors"
 	"github.com/gin-contrib/pprof"
 	"github.com/gin-contrib/static"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
-	"github.com/multiversx/mx-chain-core-go/hashing"
-	"github.com/multiversx/mx-chain-core-go/hashing/factory"
-	"github.com/multiversx/mx-chain-core-go/hashing/sha256"
-	logger "github.com/multiversx/mx-chain-logger-go"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/middleware"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
 	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"go.opentelemetry.io/otel"
 	"gopkg.in/go-playground/validator.v8"
 )
 
-var log = logger.GetOrCreate("api")
-
 type validatorInput struct {
 	Name      string
 	Validator validator.Func
 }
 
-// CreateServer creates a HTTP server
+// CreateServer creates a HTTP server. Its routes are served by the returned routes handler, which rebuilds them when
+// the routes settings of the versions are updated
 func CreateServer(
 	versionsRegistry data.VersionsRegistryHandler,
 	port int,
 	apiLoggingConfig config.ApiLoggingConfig,
-	credentialsConfig config.CredentialsConfig,
+	credentialsChecker *shared.CredentialsChecker,
 	statusMetricsExtractor middleware.StatusMetricsExtractor,
 	rateLimitTimeWindowInSeconds int,
+	rateLimiterConfig config.RateLimiterConfig,
+	trustedProxies []string,
+	accessLogSink io.Writer,
 	isProfileModeActivated bool,
 	shouldStartSwaggerUI bool,
-) (*http.Server, error) {
-	ws := gin.New()
-	ws.Use(gin.Recovery())
-	ws.Use(cors.Default())
-
+) (*http.Server, *routesHandler, error) {
 	err := registerValidators()
 	if err != nil {
-		return nil, err
-	}
-
-	err = registerRoutes(ws, versionsRegistry, apiLoggingConfig, credentialsConfig, statusMetricsExtractor, rateLimitTimeWindowInSeconds, isProfileModeActivated, shouldStartSwaggerUI)
+		return nil, nil, err
+	}
+
+	handler, err := newRoutesHandler(argsRoutesHandler{
+		versionsRegistry:             versionsRegistry,
+		apiLoggingConfig:             apiLoggingConfig,
+		credentialsChecker:           credentialsChecker,
+		statusMetricsExtractor:       statusMetricsExtractor,
+		rateLimitTimeWindowInSeconds: rateLimitTimeWindowInSeconds,
+		rateLimiterConfig:            rateLimiterConfig,
+		trustedProxies:               trustedProxies,
+		accessLogSink:                accessLogSink,
+		isProfileModeActivated:       isProfileModeActivated,
+		shouldStartSwaggerUI:         shouldStartSwaggerUI,
+	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	httpServer := &http.Server{
 		Addr:    fmt.Sprintf(":%d", port),
-		Handler: ws,
+		Handler: handler,
 	}
 
-	return httpServer, nil
+	return httpServer, handler, nil
 }
 
 func registerValidators() error {
@@ -79,129 +88,149 @@ func registerValidators() error {
 
 func registerRoutes(
 	ws *gin.Engine,
-	versionsRegistry data.VersionsRegistryHandler,
-	apiLoggingConfig config.ApiLoggingConfig,
-	credentialsConfig config.CredentialsConfig,
-	statusMetricsExtractor middleware.StatusMetricsExtractor,
-	rateLimitTimeWindowInSeconds int,
-	isProfileModeActivated bool,
-	shouldStartSwaggerUI bool,
+	versionsMap map[string]*data.VersionData,
+	args argsRoutesHandler,
+	rateLimitStore middleware.RateLimitStore,
 ) error {
-	versionsMap, err := versionsRegistry.GetAllVersions()
-	if err != nil {
-		return err
+	// the access log is disabled if there is no sink. Its middleware is registered before the request ID one, so it can
+	// tell the internal requests of the JSON-RPC and batch routes apart
+	if args.accessLogSink != nil {
+		accessLogMiddleware, err := middleware.NewAccessLogMiddleware(args.accessLogSink, args.rateLimiterConfig.ApiKeyHeader)
+		if err != nil {
+			return err
+		}
+		ws.Use(accessLogMiddleware.MiddlewareHandlerFunc())
 	}
 
-	if shouldStartSwaggerUI {
+	requestIDMiddleware := middleware.NewRequestIDMiddleware()
+	ws.Use(requestIDMiddleware.MiddlewareHandlerFunc())
+
+	if args.shouldStartSwaggerUI {
 		ws.Use(static.ServeRoot("/", "config/swagger"))
 	}
 
-	if apiLoggingConfig.LoggingEnabled {
-		responseLoggerMiddleware := middleware.NewResponseLoggerMiddleware(time.Duration(apiLoggingConfig.ThresholdInMicroSeconds) * time.Microsecond)
+	if args.apiLoggingConfig.LoggingEnabled {
+		responseLoggerMiddleware := middleware.NewResponseLoggerMiddleware(time.Duration(args.apiLoggingConfig.ThresholdInMicroSeconds) * time.Microsecond)
 		ws.Use(responseLoggerMiddleware.MiddlewareHandlerFunc())
 	}
 
+	// the global tracer provider does not record anything unless the tracing is enabled
+	tracingMiddleware, err := middleware.NewTracingMiddleware(otel.GetTracerProvider(), otel.GetTextMapPropagator())
+	if err != nil {
+		return err
+	}
+	ws.Use(tracingMiddleware.MiddlewareHandlerFunc())
+
 	// TODO: maybe add a flag when starting proxy if metrics should be exposed or not
-	metricsMiddleware, err := middleware.NewMetricsMiddleware(statusMetricsExtractor)
+	metricsMiddleware, err := middleware.NewMetricsMiddleware(args.statusMetricsExtractor)
 	if err != nil {
 		return err
 	}
 
 	for version, versionData := range versionsMap {
-		limitsMap := getLimitsMapForVersion(versionData)
-		rateLimitTimeWindowDuration := time.Duration(rateLimitTimeWindowInSeconds) * time.Second
-		rateLimiter, err := middleware.NewRateLimiter(limitsMap, rateLimitTimeWindowDuration)
+		rateLimiter, err := middleware.NewRateLimiter(middleware.ArgsRateLimiter{
+			Limits: getLimitsMapForVersion(version, versionData),
+			Window: time.Duration(args.rateLimitTimeWindowInSeconds) * time.Second,
+			Config: args.rateLimiterConfig,
+			Store:  rateLimitStore,
+		})
 		if err != nil {
 			return err
 		}
-		startRateLimiterReset(rateLimitTimeWindowInSeconds, rateLimiter, version)
 		versionGroup := ws.Group(version)
 		for path, group := range versionData.ApiHandler.GetAllGroups() {
 			subGroup := versionGroup.Group(path)
 			group.RegisterRoutes(
 				subGroup,
 				versionData.ApiConfig,
-				getAuthenticationFunc(credentialsConfig),
+				getAuthenticationFunc(args.credentialsChecker),
 				rateLimiter.MiddlewareHandlerFunc(),
 				metricsMiddleware.MiddlewareHandlerFunc(),
 			)
 		}
+
+		// the JSON-RPC methods and the batch paths are served by the REST routes of the version, so they need the whole
+		// web server
+		jsonRpcGroup, err := groups.NewJsonRpcGroup(ws, version)
+		if err != nil {
+			return err
+		}
+		jsonRpcGroup.RegisterRoutes(
+			versionGroup.Group("/rpc"),
+			versionData.ApiConfig,
+			getAuthenticationFunc(args.credentialsChecker),
+			rateLimiter.MiddlewareHandlerFunc(),
+			metricsMiddleware.MiddlewareHandlerFunc(),
+		)
+
+		batchGroup, err := groups.NewBatchGroup(ws, version)
+		if err != nil {
+			return err
+		}
+		batchGroup.RegisterRoutes(
+			versionGroup.Group("/batch"),
+			versionData.ApiConfig,
+			getAuthenticationFunc(args.credentialsChecker),
+			rateLimiter.MiddlewareHandlerFunc(),
+			metricsMiddleware.MiddlewareHandlerFunc(),
+		)
 	}
 
-	if isProfileModeActivated {
+	if args.isProfileModeActivated {
 		pprof.Register(ws)
 	}
 
 	return nil
 }
 
-func getAuthenticationFunc(credentialsConfig config.CredentialsConfig) gin.HandlerFunc {
-	if len(credentialsConfig.Credentials) == 0 {
-		return func(c *gin.Context) {
+// getAuthenticationFunc returns the middleware checking the Basic Authentication of the secured endpoints. The
+// credentials are read for each request, as they can be reloaded
+func getAuthenticationFunc(credentialsChecker *shared.CredentialsChecker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !credentialsChecker.HasCredentials() {
 			c.AbortWithStatusJSON(
 				http.StatusInternalServerError,
 				data.GenericAPIResponse{
-					Data:  nil,
-					Error: "no credentials found on server",
-					Code:  data.ReturnCodeInternalError,
+					Data:      nil,
+					Error:     apiErrors.ErrNoCredentialsFound.Error(),
+					Code:      data.ReturnCodeInternalError,
+					RequestID: shared.GetRequestID(c),
 				},
 			)
-		}
-	}
-
-	var hasher hashing.Hasher
-	var err error
-	hasher, err = factory.NewHasher(credentialsConfig.Hasher.Type)
-	if err != nil {
-		log.Warn("cannot create hasher from config. Will use Sha256 as default", "error", err)
-		hasher = sha256.NewSha256() // fallback in case the hasher creation failed
-	}
-
-	accounts := gin.Accounts{}
-	for _, pair := range credentialsConfig.Credentials {
-		accounts[pair.Username] = pair.Password
-	}
-
-	authenticationFunction := func(c *gin.Context) {
-		user, pass, ok := c.Request.BasicAuth()
-		if !ok {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, data.GenericAPIResponse{
-				Data:  nil,
-				Error: "this endpoint requires Basic Authentication",
-				Code:  data.ReturnCodeRequestError,
-			})
 			return
 		}
 
-		userPassword, ok := accounts[user]
+		user, pass, ok := c.Request.BasicAuth()
 		if !ok {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, data.GenericAPIResponse{
-				Data:  nil,
-				Error: "username does not exist",
-				Code:  data.ReturnCodeRequestError,
+				Data:      nil,
+				Error:     apiErrors.ErrBasicAuthenticationRequired.Error(),
+				Code:      data.ReturnCodeRequestError,
+				RequestID: shared.GetRequestID(c),
 			})
 			return
 		}
 
-		if userPassword != hex.EncodeToString(hasher.Compute(pass)) {
+		err := credentialsChecker.Check(user, pass)
+		if err != nil {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, data.GenericAPIResponse{
-				Data:  nil,
-				Error: "invalid password",
-				Code:  data.ReturnCodeRequestError,
+				Data:      nil,
+				Error:     err.Error(),
+				Code:      data.ReturnCodeRequestError,
+				RequestID: shared.GetRequestID(c),
 			})
 			return
 		}
 	}
-
-	return authenticationFunction
 }
 
-func getLimitsMapForVersion(versionData *data.VersionData) map[string]uint64 {
+// getLimitsMapForVersion returns the rate limits of the version's endpoints, mapped by their full path
+func getLimitsMapForVersion(version string, versionData *data.VersionData) map[string]uint64 {
 	limitsMap := make(map[string]uint64)
 	for packageName, packageConfig := range versionData.ApiConfig.APIPackages {
 		for _, routeConfig := range packageConfig.Routes {
 			if routeConfig.RateLimit > 0 {
-				mapKey := fmt.Sprintf("/%s%s", packageName, routeConfig.Name)
+				mapKey := path.Join("/", version, packageName) + routeConfig.Name
 				limitsMap[mapKey] = routeConfig.RateLimit
 			}
 		}
@@ -210,15 +239,6 @@ func getLimitsMapForVersion(versionData *data.VersionData) map[string]uint64 {
 	return limitsMap
 }
 
-func startRateLimiterReset(rateLimiterDuration int, rl middleware.RateLimiterHandler, version string) {
-	go func() {
-		for {
-			time.Sleep(time.Duration(rateLimiterDuration) * time.Second)
-			rl.ResetMap(version)
-		}
-	}()
-}
-
 // skValidator validates a secret key from user input for correctness
 func skValidator(
 	_ *validator.Validate,
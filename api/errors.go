@@ -13,3 +13,12 @@ var ErrGroupDoesNotExist = errors.New("group does not exist")
 
 // ErrNilFacade signals that a nil facade has been provided
 var ErrNilFacade = errors.New("nil facade")
+
+// ErrNilCredentialsChecker signals that a nil credentials checker has been provided
+var ErrNilCredentialsChecker = errors.New("nil credentials checker")
+
+// ErrMissingApiRoutesConfig signals that the routes settings of a registered version have not been provided
+var ErrMissingApiRoutesConfig = errors.New("missing API routes config")
+
+// ErrNilVersionsRegistry signals that a nil versions registry has been provided
+var ErrNilVersionsRegistry = errors.New("nil versions registry")
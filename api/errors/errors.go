@@ -3,6 +3,8 @@ package errors
 import (
 	"errors"
 	"fmt"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // ErrGetAccount signals an error in fetching an account
@@ -26,6 +28,15 @@ var ErrComputeShardForAddress = errors.New("compute shard ID for address error")
 // ErrGetESDTTokenData signals an error in fetching an ESDT token data
 var ErrGetESDTTokenData = errors.New("cannot get ESDT token data")
 
+// ErrGetTransactionsForAddress signals an error in fetching the transactions of an address from the database
+var ErrGetTransactionsForAddress = errors.New("cannot get transactions for address")
+
+// ErrGetBlockFromDatabase signals an error in fetching a block from the database
+var ErrGetBlockFromDatabase = errors.New("cannot get block from database")
+
+// ErrGetTransactionsByMiniblockHash signals an error in fetching the transactions of a miniblock from the database
+var ErrGetTransactionsByMiniblockHash = errors.New("cannot get transactions by miniblock hash")
+
 // ErrGetGuardianData signals an error in fetching an address guardian data
 var ErrGetGuardianData = errors.New("cannot get guardian data")
 
@@ -110,6 +121,12 @@ var ErrTransactionHashMissing = errors.New("transaction hash missing")
 // ErrFaucetNotEnabled signals that the faucet mechanism is not enabled
 var ErrFaucetNotEnabled = errors.New("faucet not enabled")
 
+// ErrRelayerNotEnabled signals that the relayer mechanism is not enabled
+var ErrRelayerNotEnabled = errors.New("relayer not enabled")
+
+// ErrRelayerAddress signals that the relayer address could not be provided
+var ErrRelayerAddress = errors.New("cannot get the relayer address")
+
 // ErrInvalidBlockNonceParam signals that an invalid block's nonce parameter has been provided
 var ErrInvalidBlockNonceParam = errors.New("invalid block nonce parameter")
 
@@ -146,6 +163,27 @@ var ErrOperationNotAllowed = errors.New("operation not allowed")
 // ErrIsDataTrieMigrated signals that an error occurred while trying to verify the migration status of the data trie
 var ErrIsDataTrieMigrated = errors.New("could not verify the migration status of the data trie")
 
+// ErrNoCredentialsFound signals that an authenticated request was received while no credentials are configured
+var ErrNoCredentialsFound = errors.New("no credentials found on server")
+
+// ErrBasicAuthenticationRequired signals that a request without Basic Authentication was received on a secured endpoint
+var ErrBasicAuthenticationRequired = errors.New("this endpoint requires Basic Authentication")
+
+// ErrUsernameDoesNotExist signals that the provided username is not among the configured credentials
+var ErrUsernameDoesNotExist = errors.New("username does not exist")
+
+// ErrInvalidPassword signals that the provided password does not match the configured one
+var ErrInvalidPassword = errors.New("invalid password")
+
+// ErrEmptyUsername signals that the credentials config contains an empty username
+var ErrEmptyUsername = errors.New("empty username")
+
+// ErrDuplicatedUsername signals that the credentials config contains the same username more times
+var ErrDuplicatedUsername = errors.New("duplicated username")
+
+// ErrTooManyTransactionWatchers signals that the maximum number of transaction status watchers has been reached
+var ErrTooManyTransactionWatchers = errors.New("too many transaction status watchers")
+
 // ErrInvalidTxFields signals that one or more field of a transaction are invalid
 type ErrInvalidTxFields struct {
 	Message string
@@ -156,3 +194,24 @@ type ErrInvalidTxFields struct {
 func (eitx *ErrInvalidTxFields) Error() string {
 	return fmt.Sprintf("%s : %s", eitx.Message, eitx.Reason)
 }
+
+// ErrTxPreflightCheckFailed signals that a transaction did not pass the local pre-flight checks
+type ErrTxPreflightCheckFailed struct {
+	Code   data.ReturnCode
+	Reason string
+}
+
+// Error returns the string message of the ErrTxPreflightCheckFailed custom error struct
+func (etpc *ErrTxPreflightCheckFailed) Error() string {
+	return fmt.Sprintf("transaction pre-flight check failed (%s): %s", etpc.Code, etpc.Reason)
+}
+
+// ErrFaucetLimitReached signals that a faucet request was refused because of a cooldown or of a daily budget
+type ErrFaucetLimitReached struct {
+	Reason string
+}
+
+// Error returns the string message of the ErrFaucetLimitReached custom error struct
+func (eflr *ErrFaucetLimitReached) Error() string {
+	return fmt.Sprintf("faucet limit reached: %s", eflr.Reason)
+}
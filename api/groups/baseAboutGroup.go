@@ -44,7 +44,7 @@ func (ag *aboutGroup) getAboutInfo(c *gin.Context) {
 }
 
 func (ag *aboutGroup) getNodesVersions(c *gin.Context) {
-	nodesVersions, err := ag.facade.GetNodesVersions()
+	nodesVersions, err := ag.facade.GetNodesVersions(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
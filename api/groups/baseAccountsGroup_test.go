@@ -2,6 +2,7 @@ package groups_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
@@ -198,7 +200,7 @@ func TestGetAccount_FailWhenFacadeGetAccountFails(t *testing.T) {
 
 	returnedError := "i am an error"
 	facade := &mock.FacadeStub{
-		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+		GetAccountHandler: func(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
 			return nil, errors.New(returnedError)
 		},
 	}
@@ -222,7 +224,7 @@ func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 
 	facade := &mock.FacadeStub{
-		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+		GetAccountHandler: func(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
 			return &data.AccountModel{
 				Account: data.Account{
 					Address: address,
@@ -278,7 +280,7 @@ func TestGetAccount_FailWhenFacadeGetAccountsFails(t *testing.T) {
 
 	returnedError := "i am an error"
 	facade := &mock.FacadeStub{
-		GetAccountsHandler: func(addresses []string, _ common.AccountQueryOptions) (*data.AccountsModel, error) {
+		GetAccountsHandler: func(_ context.Context, addresses []string, _ common.AccountQueryOptions) (*data.AccountsModel, error) {
 			return nil, errors.New(returnedError)
 		},
 	}
@@ -314,7 +316,7 @@ func TestGetAccounts_ReturnsSuccessfully(t *testing.T) {
 		},
 	}
 	facade := &mock.FacadeStub{
-		GetAccountsHandler: func(addresses []string, _ common.AccountQueryOptions) (*data.AccountsModel, error) {
+		GetAccountsHandler: func(_ context.Context, addresses []string, _ common.AccountQueryOptions) (*data.AccountsModel, error) {
 			return &data.AccountsModel{
 				Accounts: accounts,
 			}, nil
@@ -345,7 +347,7 @@ func TestGetBalance_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 
 	facade := &mock.FacadeStub{
-		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+		GetAccountHandler: func(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
 			return &data.AccountModel{
 				Account: data.Account{
 					Address: address,
@@ -379,7 +381,7 @@ func TestGetUsername_ReturnsSuccessfully(t *testing.T) {
 
 	expectedUsername := "testUser"
 	facade := &mock.FacadeStub{
-		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+		GetAccountHandler: func(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
 			return &data.AccountModel{
 				Account: data.Account{
 					Address:  address,
@@ -413,7 +415,7 @@ func TestGetNonce_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 
 	facade := &mock.FacadeStub{
-		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+		GetAccountHandler: func(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
 			return &data.AccountModel{
 				Account: data.Account{
 					Address: address,
@@ -1083,3 +1085,113 @@ func TestAccountsGroup_IsDataTrieMigrated(t *testing.T) {
 		assert.Empty(t, actualResponse.Error)
 	})
 }
+
+func TestGetTransactions_FailWhenFacadeErrors(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("database error")
+	facade := &mock.FacadeStub{
+		GetTransactionsHandler: func(address string, options common.TransactionsHistoryOptions) (*data.DatabaseTransactionsPage, error) {
+			return nil, expectedErr
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest("GET", "/address/test/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := data.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, fmt.Sprintf("%s: %s", apiErrors.ErrGetTransactionsForAddress.Error(), expectedErr.Error()), response.Error)
+}
+
+func TestGetTransactions_FailWhenInvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetTransactionsHandler: func(address string, options common.TransactionsHistoryOptions) (*data.DatabaseTransactionsPage, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	testInvalidOptions := func(query string, expectedErr error) {
+		req, _ := http.NewRequest("GET", "/address/test/transactions?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsForAddress.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	}
+
+	testInvalidOptions("from-timestamp=20&to-timestamp=10", groups.ErrInvalidTimestampRange)
+	testInvalidOptions("from-nonce=20&to-nonce=10", groups.ErrInvalidNonceRange)
+	testInvalidOptions("size=0", groups.ErrInvalidPageSize)
+	testInvalidOptions(fmt.Sprintf("size=%d", common.MaxTransactionsHistoryPageSize+1), groups.ErrInvalidPageSize)
+	testInvalidOptions("cursor=invalid!", common.ErrInvalidCursor)
+	testInvalidOptions("from-nonce=abc", errors.New("invalid syntax"))
+}
+
+func TestGetTransactions_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	cursor, _ := common.EncodeCursor([]interface{}{15, 2, "erd1sender"})
+
+	facade := &mock.FacadeStub{
+		GetTransactionsHandler: func(address string, options common.TransactionsHistoryOptions) (*data.DatabaseTransactionsPage, error) {
+			expectedOptions := common.TransactionsHistoryOptions{
+				Sender:        "erd1sender",
+				Receiver:      "erd1receiver",
+				Token:         "TKN-abcdef",
+				FromTimestamp: core.OptionalUint64{Value: 10, HasValue: true},
+				ToTimestamp:   core.OptionalUint64{Value: 20, HasValue: true},
+				FromNonce:     core.OptionalUint64{Value: 1, HasValue: true},
+				Cursor:        cursor,
+				Size:          5,
+			}
+			assert.Equal(t, "test", address)
+			assert.Equal(t, expectedOptions, options)
+
+			return &data.DatabaseTransactionsPage{
+				Transactions: []data.DatabaseTransaction{{Hash: "hash0"}},
+				NextCursor:   "next",
+			}, nil
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	query := "sender=erd1sender&receiver=erd1receiver&token=TKN-abcdef&from-timestamp=10&to-timestamp=20&from-nonce=1&size=5&cursor=" + cursor
+	req, _ := http.NewRequest("GET", "/address/test/transactions?"+query, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	type transactionsResponse struct {
+		Data struct {
+			Transactions []data.DatabaseTransaction `json:"transactions"`
+			NextCursor   string                     `json:"nextCursor"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}
+	response := transactionsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	require.Len(t, response.Data.Transactions, 1)
+	assert.Equal(t, "hash0", response.Data.Transactions[0].Hash)
+	assert.Equal(t, "next", response.Data.NextCursor)
+}
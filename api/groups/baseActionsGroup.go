@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
@@ -28,6 +29,14 @@ func NewActionsGroup(facadeHandler data.FacadeHandler) (*actionsGroup, error) {
 	baseRoutesHandlers := []*data.EndpointHandlerData{
 		{Path: "/reload-observers", Handler: ng.updateObservers, Method: http.MethodPost},
 		{Path: "/reload-full-history-observers", Handler: ng.updateFullHistoryObservers, Method: http.MethodPost},
+		{Path: "/observers/add", Handler: ng.addNode(data.Observer), Method: http.MethodPost},
+		{Path: "/observers/remove", Handler: ng.removeNode(data.Observer), Method: http.MethodPost},
+		{Path: "/observers/drain", Handler: ng.drainNode(data.Observer), Method: http.MethodPost},
+		{Path: "/observers/undrain", Handler: ng.undrainNode(data.Observer), Method: http.MethodPost},
+		{Path: "/full-history-nodes/add", Handler: ng.addNode(data.FullHistoryNode), Method: http.MethodPost},
+		{Path: "/full-history-nodes/remove", Handler: ng.removeNode(data.FullHistoryNode), Method: http.MethodPost},
+		{Path: "/full-history-nodes/drain", Handler: ng.drainNode(data.FullHistoryNode), Method: http.MethodPost},
+		{Path: "/full-history-nodes/undrain", Handler: ng.undrainNode(data.FullHistoryNode), Method: http.MethodPost},
 	}
 	ng.baseGroup.endpoints = baseRoutesHandlers
 
@@ -44,6 +53,73 @@ func (group *actionsGroup) updateFullHistoryObservers(c *gin.Context) {
 	group.handleUpdateResponding(result, c)
 }
 
+// addNode returns the handler which adds the observer or the full history node from the request body
+func (group *actionsGroup) addNode(nodesType data.NodeType) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		request, ok := getNodeAdminRequest(c)
+		if !ok {
+			return
+		}
+
+		result := group.facade.AddNode(nodesType, request)
+		group.handleUpdateResponding(result, c)
+	}
+}
+
+// removeNode returns the handler which removes the nodes with the address from the request body
+func (group *actionsGroup) removeNode(nodesType data.NodeType) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		request, ok := getNodeAdminRequest(c)
+		if !ok {
+			return
+		}
+
+		result := group.facade.RemoveNode(nodesType, request.Address)
+		group.handleUpdateResponding(result, c)
+	}
+}
+
+// drainNode returns the handler which stops sending new requests to the nodes with the address from the request body
+func (group *actionsGroup) drainNode(nodesType data.NodeType) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		request, ok := getNodeAdminRequest(c)
+		if !ok {
+			return
+		}
+
+		result := group.facade.DrainNode(nodesType, request.Address)
+		group.handleUpdateResponding(result, c)
+	}
+}
+
+// undrainNode returns the handler which resumes sending new requests to the nodes with the address from the request body
+func (group *actionsGroup) undrainNode(nodesType data.NodeType) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		request, ok := getNodeAdminRequest(c)
+		if !ok {
+			return
+		}
+
+		result := group.facade.UndrainNode(nodesType, request.Address)
+		group.handleUpdateResponding(result, c)
+	}
+}
+
+func getNodeAdminRequest(c *gin.Context) (*data.NodeAdminRequest, bool) {
+	request := &data.NodeAdminRequest{}
+	err := c.ShouldBindJSON(request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return nil, false
+	}
+	if len(request.Address) == 0 {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrEmptyAddress)
+		return nil, false
+	}
+
+	return request, true
+}
+
 func (group *actionsGroup) handleUpdateResponding(result data.NodesReloadResponse, c *gin.Context) {
 	if result.Error != "" {
 		httpCode := http.StatusInternalServerError
@@ -1,10 +1,12 @@
 package groups_test
 
 import (
+	"bytes"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
 	"github.com/multiversx/mx-chain-proxy-go/data"
@@ -205,3 +207,102 @@ func TestActions_ReloadFullHistoryObserversShouldWork(t *testing.T) {
 	assert.Equal(t, description, response.Data.(string))
 	assert.Equal(t, "", response.Error)
 }
+
+func TestActions_NodesChanges(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		actionsGroup, err := groups.NewActionsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		ws := startProxyServer(actionsGroup, actionsPath)
+
+		for _, body := range []string{"invalid", `{"shardId":0}`} {
+			req, _ := http.NewRequest("POST", "/actions/observers/add", bytes.NewBufferString(body))
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			response := &data.GenericAPIResponse{}
+			loadResponse(resp.Body, response)
+			assert.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+		}
+	})
+	t.Run("node not changed should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			DrainNodeCalled: func(nodesType data.NodeType, address string) data.NodesReloadResponse {
+				return data.NodesReloadResponse{
+					OkRequest:   false,
+					Description: "observer not drained",
+					Error:       "node not found",
+				}
+			},
+		}
+		actionsGroup, err := groups.NewActionsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(actionsGroup, actionsPath)
+
+		req, _ := http.NewRequest("POST", "/actions/observers/drain", bytes.NewBufferString(`{"address":"http://observer:8080"}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		response := &data.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, "observer not drained", response.Data.(string))
+		assert.Equal(t, "node not found", response.Error)
+	})
+	t.Run("should call the facade with the nodes type", func(t *testing.T) {
+		t.Parallel()
+
+		calls := make(map[string]string)
+		var addedNode *data.NodeAdminRequest
+		facade := &mock.FacadeStub{
+			AddNodeCalled: func(nodesType data.NodeType, request *data.NodeAdminRequest) data.NodesReloadResponse {
+				calls["add"] = string(nodesType)
+				addedNode = request
+				return data.NodesReloadResponse{OkRequest: true, Description: "added"}
+			},
+			RemoveNodeCalled: func(nodesType data.NodeType, address string) data.NodesReloadResponse {
+				calls["remove"] = string(nodesType) + " " + address
+				return data.NodesReloadResponse{OkRequest: true, Description: "removed"}
+			},
+			DrainNodeCalled: func(nodesType data.NodeType, address string) data.NodesReloadResponse {
+				calls["drain"] = string(nodesType) + " " + address
+				return data.NodesReloadResponse{OkRequest: true, Description: "drained"}
+			},
+			UndrainNodeCalled: func(nodesType data.NodeType, address string) data.NodesReloadResponse {
+				calls["undrain"] = string(nodesType) + " " + address
+				return data.NodesReloadResponse{OkRequest: true, Description: "undrained"}
+			},
+		}
+		actionsGroup, err := groups.NewActionsGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(actionsGroup, actionsPath)
+
+		requests := map[string]string{
+			"/actions/full-history-nodes/add":   `{"shardId":1,"address":"http://node:8080","isFallback":true}`,
+			"/actions/observers/remove":         `{"address":"http://observer:8080"}`,
+			"/actions/full-history-nodes/drain": `{"address":"http://node:8080"}`,
+			"/actions/observers/undrain":        `{"address":"http://observer:8080"}`,
+		}
+		for path, body := range requests {
+			req, _ := http.NewRequest("POST", path, bytes.NewBufferString(body))
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			assert.Equal(t, http.StatusOK, resp.Code)
+		}
+
+		require.Equal(t, map[string]string{
+			"add":     "full history",
+			"remove":  "observer http://observer:8080",
+			"drain":   "full history http://node:8080",
+			"undrain": "observer http://observer:8080",
+		}, calls)
+		require.Equal(t, &data.NodeAdminRequest{ShardId: 1, Address: "http://node:8080", IsFallback: true}, addedNode)
+	})
+}
@@ -0,0 +1,128 @@
+package groups
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const maxBatchPaths = 50
+
+type batchGroup struct {
+	dispatcher  http.Handler
+	versionPath string
+	*baseGroup
+}
+
+// NewBatchGroup returns a new instance of batchGroup, fetching the GET routes of the provided API version, which are
+// handled by the provided dispatcher
+func NewBatchGroup(dispatcher http.Handler, version string) (*batchGroup, error) {
+	if dispatcher == nil {
+		return nil, ErrNilHttpHandler
+	}
+
+	bg := &batchGroup{
+		dispatcher:  dispatcher,
+		versionPath: strings.TrimSuffix(path.Join("/", version), "/"),
+		baseGroup:   &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "", Handler: bg.handleBatch, Method: http.MethodPost},
+	}
+	bg.baseGroup.endpoints = baseRoutesHandlers
+
+	return bg, nil
+}
+
+// handleBatch fetches the requested paths concurrently and responds with their statuses and bodies, in the order of the
+// request. Each path is served by its GET route, whose Open, Secured and RateLimit settings apply as well
+func (group *batchGroup) handleBatch(c *gin.Context) {
+	request := &data.BatchRequest{}
+	err := c.ShouldBindJSON(request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	err = checkBatchPaths(request.Paths)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	responses := make([]*data.BatchResponseItem, len(request.Paths))
+	wg := &sync.WaitGroup{}
+	wg.Add(len(request.Paths))
+	for idx, batchPath := range request.Paths {
+		go func(idx int, batchPath string) {
+			responses[idx] = group.fetchPath(c, batchPath)
+			wg.Done()
+		}(idx, batchPath)
+	}
+	wg.Wait()
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"responses": responses}, "", data.ReturnCodeSuccess)
+}
+
+func checkBatchPaths(paths []string) error {
+	if len(paths) == 0 {
+		return ErrEmptyBatch
+	}
+	if len(paths) > maxBatchPaths {
+		return fmt.Errorf("%w: at most %d paths are allowed", ErrBatchTooLarge, maxBatchPaths)
+	}
+
+	for _, batchPath := range paths {
+		parsedPath, err := url.Parse(batchPath)
+		if err != nil {
+			return fmt.Errorf("%w %s: %s", ErrInvalidBatchPath, batchPath, err.Error())
+		}
+
+		isRelativePath := len(parsedPath.Scheme) == 0 && len(parsedPath.Host) == 0 &&
+			strings.HasPrefix(parsedPath.Path, "/") && path.Clean(parsedPath.Path) == parsedPath.Path
+		if !isRelativePath {
+			return fmt.Errorf("%w %s", ErrInvalidBatchPath, batchPath)
+		}
+		if isStreamingRoute(parsedPath.Path) {
+			return fmt.Errorf("%w %s: the streaming routes are not supported", ErrInvalidBatchPath, batchPath)
+		}
+	}
+
+	return nil
+}
+
+func (group *batchGroup) fetchPath(c *gin.Context, batchPath string) *data.BatchResponseItem {
+	request, err := createInternalRequest(c, http.MethodGet, group.versionPath+batchPath, http.NoBody)
+	if err != nil {
+		return newBatchResponseItem(batchPath, http.StatusBadRequest, []byte(err.Error()))
+	}
+
+	recorder := newResponseRecorder()
+	group.dispatcher.ServeHTTP(recorder, request)
+
+	return newBatchResponseItem(batchPath, recorder.statusCode, recorder.body.Bytes())
+}
+
+// newBatchResponseItem keeps the JSON bodies as they are, while the other bodies, such as the plain text of a not found
+// route, are sent as JSON strings
+func newBatchResponseItem(batchPath string, status int, body []byte) *data.BatchResponseItem {
+	if !json.Valid(body) {
+		body, _ = json.Marshal(string(body))
+	}
+
+	return &data.BatchResponseItem{
+		Path:   batchPath,
+		Status: status,
+		Body:   body,
+	}
+}
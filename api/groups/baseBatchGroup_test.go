@@ -0,0 +1,187 @@
+package groups_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+const batchTestVersion = "v1.0"
+
+type batchTestResponse struct {
+	Data struct {
+		Responses []*data.BatchResponseItem `json:"responses"`
+	} `json:"data"`
+	Error string          `json:"error"`
+	Code  data.ReturnCode `json:"code"`
+}
+
+// startBatchServer starts a server with the accounts and hyperblock routes of a version along with the batch route.
+// The account balance route is secured, the account nonce route is rate limited and the hyperblock by hash route is
+// closed
+func startBatchServer(t *testing.T, facade *mock.FacadeStub) *gin.Engine {
+	apiConfig := data.ApiRoutesConfig{
+		APIPackages: map[string]data.APIPackageConfig{
+			"address": {Routes: []data.RouteConfig{
+				{Name: "/:address", Open: true},
+				{Name: "/:address/balance", Open: true, Secured: true},
+				{Name: "/:address/nonce", Open: true, RateLimit: 1},
+			}},
+			"hyperblock": {Routes: []data.RouteConfig{
+				{Name: "/by-nonce/:nonce", Open: true},
+				{Name: "/by-hash/:hash", Open: false},
+			}},
+			"batch": {Routes: []data.RouteConfig{
+				{Name: "", Open: true},
+			}},
+		},
+	}
+	authenticationFunc := func(c *gin.Context) {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, data.GenericAPIResponse{Error: "unauthorized", Code: data.ReturnCodeRequestError})
+	}
+	rateLimiter := func(c *gin.Context) {
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, data.GenericAPIResponse{Error: "rate limited", Code: data.ReturnCodeRequestError})
+	}
+
+	ws := gin.New()
+	versionGroup := ws.Group(batchTestVersion)
+
+	accountsGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	accountsGroup.RegisterRoutes(versionGroup.Group("/address"), apiConfig, authenticationFunc, rateLimiter, emptyGinHandler)
+
+	hyperblockGroup, err := groups.NewHyperBlockGroup(facade)
+	require.NoError(t, err)
+	hyperblockGroup.RegisterRoutes(versionGroup.Group("/hyperblock"), apiConfig, authenticationFunc, rateLimiter, emptyGinHandler)
+
+	batchGroup, err := groups.NewBatchGroup(ws, batchTestVersion)
+	require.NoError(t, err)
+	batchGroup.RegisterRoutes(versionGroup.Group("/batch"), apiConfig, authenticationFunc, rateLimiter, emptyGinHandler)
+
+	return ws
+}
+
+func doBatchRequest(ws *gin.Engine, body string) (*httptest.ResponseRecorder, *batchTestResponse) {
+	req, _ := http.NewRequest(http.MethodPost, "/"+batchTestVersion+"/batch", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &batchTestResponse{}
+	_ = json.Unmarshal(resp.Body.Bytes(), response)
+
+	return resp, response
+}
+
+func TestNewBatchGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil dispatcher should error", func(t *testing.T) {
+		t.Parallel()
+
+		bg, err := groups.NewBatchGroup(nil, batchTestVersion)
+		require.Nil(t, bg)
+		require.Equal(t, groups.ErrNilHttpHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		bg, err := groups.NewBatchGroup(gin.New(), batchTestVersion)
+		require.NoError(t, err)
+		require.NotNil(t, bg)
+	})
+}
+
+func TestBatchGroup_InvalidRequests(t *testing.T) {
+	t.Parallel()
+
+	ws := startBatchServer(t, &mock.FacadeStub{})
+
+	tooManyPaths := make([]string, 51)
+	for idx := range tooManyPaths {
+		tooManyPaths[idx] = `"/address/erd1"`
+	}
+
+	testCases := map[string]string{
+		"invalid JSON":   `{"paths":`,
+		"empty batch":    `{"paths":[]}`,
+		"too many":       fmt.Sprintf(`{"paths":[%s]}`, strings.Join(tooManyPaths, ",")),
+		"absolute URL":   `{"paths":["http://example.com/address/erd1"]}`,
+		"no leading /":   `{"paths":["address/erd1"]}`,
+		"unclean path":   `{"paths":["/../v1.0/address/erd1"]}`,
+		"invalid escape": `{"paths":["/address/erd1%zz"]}`,
+		"stream":         `{"paths":["/hyperblock/stream"]}`,
+		"web socket":     `{"paths":["/hyperblock/ws?from-nonce=1"]}`,
+		"watch":          `{"paths":["/transaction/abcd/watch"]}`,
+	}
+	for name, body := range testCases {
+		resp, response := doBatchRequest(ws, body)
+		require.Equal(t, http.StatusBadRequest, resp.Code, name)
+		require.Equal(t, data.ReturnCodeRequestError, response.Code, name)
+	}
+}
+
+func TestBatchGroup_ShouldRespondInOrder(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetAccountHandler: func(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+			return &data.AccountModel{Account: data.Account{Address: address, Nonce: 7}}, nil
+		},
+	}
+	ws := startBatchServer(t, facade)
+
+	paths := make([]string, 0)
+	for idx := 0; idx < 20; idx++ {
+		paths = append(paths, fmt.Sprintf(`"/address/erd%d?onFinalBlock=true"`, idx))
+	}
+	resp, response := doBatchRequest(ws, fmt.Sprintf(`{"paths":[%s]}`, strings.Join(paths, ",")))
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Len(t, response.Data.Responses, 20)
+
+	for idx, item := range response.Data.Responses {
+		require.Equal(t, fmt.Sprintf("/address/erd%d?onFinalBlock=true", idx), item.Path)
+		require.Equal(t, http.StatusOK, item.Status)
+
+		accountResponse := &data.GenericAPIResponse{}
+		require.NoError(t, json.Unmarshal(item.Body, accountResponse))
+		require.Equal(t, fmt.Sprintf("erd%d", idx), accountResponse.Data.(map[string]interface{})["account"].(map[string]interface{})["address"])
+	}
+}
+
+func TestBatchGroup_ShouldApplyTheRoutesConfig(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetAccountHandler: func(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+			return &data.AccountModel{Account: data.Account{Address: address}}, nil
+		},
+	}
+	ws := startBatchServer(t, facade)
+
+	body := `{"paths":["/address/erd1","/address/erd1/balance","/address/erd1/nonce","/hyperblock/by-hash/aa","/unknown"]}`
+	resp, response := doBatchRequest(ws, body)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Len(t, response.Data.Responses, 5)
+
+	require.Equal(t, http.StatusOK, response.Data.Responses[0].Status)
+	require.Equal(t, http.StatusUnauthorized, response.Data.Responses[1].Status)
+	require.Equal(t, http.StatusTooManyRequests, response.Data.Responses[2].Status)
+
+	// the closed and the unknown routes are not registered at all
+	require.Equal(t, http.StatusNotFound, response.Data.Responses[3].Status)
+	require.Equal(t, `"404 page not found"`, string(response.Data.Responses[3].Body))
+	require.Equal(t, http.StatusNotFound, response.Data.Responses[4].Status)
+}
@@ -31,6 +31,8 @@ func NewBlockGroup(facadeHandler data.FacadeHandler) (*blockGroup, error) {
 		{Path: "/:shard/by-hash/:hash", Handler: bg.byHashHandler, Method: http.MethodGet},
 		{Path: "/:shard/altered-accounts/by-nonce/:nonce", Handler: bg.alteredAccountsByNonceHandler, Method: http.MethodGet},
 		{Path: "/:shard/altered-accounts/by-hash/:hash", Handler: bg.alteredAccountsByHashHandler, Method: http.MethodGet},
+		{Path: "/:shard/database/by-nonce/:nonce", Handler: bg.databaseBlockByNonceHandler, Method: http.MethodGet},
+		{Path: "/miniblock/:hash/transactions", Handler: bg.transactionsByMiniblockHashHandler, Method: http.MethodGet},
 	}
 	bg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -69,7 +71,7 @@ func (group *blockGroup) byHashHandler(c *gin.Context) {
 		return
 	}
 
-	blockByHashResponse, err := group.facade.GetBlockByHash(shardID, hash, options)
+	blockByHashResponse, err := group.facade.GetBlockByHash(c.Request.Context(), shardID, hash, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -110,7 +112,7 @@ func (group *blockGroup) byNonceHandler(c *gin.Context) {
 		return
 	}
 
-	blockByNonceResponse, err := group.facade.GetBlockByNonce(shardID, nonce, options)
+	blockByNonceResponse, err := group.facade.GetBlockByNonce(c.Request.Context(), shardID, nonce, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -138,7 +140,7 @@ func (group *blockGroup) alteredAccountsByNonceHandler(c *gin.Context) {
 		return
 	}
 
-	blockByNonceResponse, err := group.facade.GetAlteredAccountsByNonce(shardID, nonce, options)
+	blockByNonceResponse, err := group.facade.GetAlteredAccountsByNonce(c.Request.Context(), shardID, nonce, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -166,7 +168,7 @@ func (group *blockGroup) alteredAccountsByHashHandler(c *gin.Context) {
 		return
 	}
 
-	blockByHashResponse, err := group.facade.GetAlteredAccountsByHash(shardID, hash, options)
+	blockByHashResponse, err := group.facade.GetAlteredAccountsByHash(c.Request.Context(), shardID, hash, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -174,3 +176,43 @@ func (group *blockGroup) alteredAccountsByHashHandler(c *gin.Context) {
 
 	c.JSON(http.StatusOK, blockByHashResponse)
 }
+
+// databaseBlockByNonceHandler will handle the fetching and returning a block, along with its transactions, from the database
+func (group *blockGroup) databaseBlockByNonceHandler(c *gin.Context) {
+	shardID, err := shared.FetchShardIDFromRequest(c)
+	if err != nil {
+		shared.RespondWithBadRequest(c, apiErrors.ErrCannotParseShardID.Error())
+		return
+	}
+
+	nonce, err := shared.FetchNonceFromRequest(c)
+	if err != nil {
+		shared.RespondWithBadRequest(c, apiErrors.ErrCannotParseNonce.Error())
+		return
+	}
+
+	block, err := group.facade.GetDatabaseBlockByNonce(c.Request.Context(), shardID, nonce)
+	if err != nil {
+		shared.RespondWithInternalError(c, apiErrors.ErrGetBlockFromDatabase, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"block": block}, "", data.ReturnCodeSuccess)
+}
+
+// transactionsByMiniblockHashHandler will handle the fetching and returning the transactions of a miniblock from the database
+func (group *blockGroup) transactionsByMiniblockHashHandler(c *gin.Context) {
+	hash, err := shared.FetchHashFromRequest(c)
+	if err != nil {
+		shared.RespondWithBadRequest(c, err.Error())
+		return
+	}
+
+	transactions, err := group.facade.GetTransactionsByMiniblockHash(c.Request.Context(), hash)
+	if err != nil {
+		shared.RespondWithInternalError(c, apiErrors.ErrGetTransactionsByMiniblockHash, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"transactions": transactions}, "", data.ReturnCodeSuccess)
+}
@@ -470,3 +470,107 @@ func TestGetAlteredAccountsByHash(t *testing.T) {
 		require.Equal(t, expectedApiResponse, apiResp)
 	})
 }
+
+func TestGetDatabaseBlockByNonce_FailWhenNonceParamIsInvalid(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{}
+	blockGroup, err := groups.NewBlockGroup(facade)
+	require.NoError(t, err)
+
+	ws := startProxyServer(blockGroup, blockPath)
+
+	req, _ := http.NewRequest("GET", "/block/0/database/by-nonce/invalid_nonce", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	apiResp := data.GenericAPIResponse{}
+	loadResponse(resp.Body, &apiResp)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, apiErrors.ErrCannotParseNonce.Error(), apiResp.Error)
+}
+
+func TestGetDatabaseBlockByNonce_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetDatabaseBlockByNonceCalled: func(shardID uint32, nonce uint64) (*data.DatabaseBlock, error) {
+			require.Equal(t, uint32(1), shardID)
+			require.Equal(t, uint64(37), nonce)
+
+			block := &data.DatabaseBlock{Hash: "hash"}
+			block.Nonce = nonce
+			return block, nil
+		},
+	}
+	blockGroup, err := groups.NewBlockGroup(facade)
+	require.NoError(t, err)
+
+	ws := startProxyServer(blockGroup, blockPath)
+
+	req, _ := http.NewRequest("GET", "/block/1/database/by-nonce/37", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	type databaseBlockResponse struct {
+		Data struct {
+			Block data.DatabaseBlock `json:"block"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}
+	apiResp := databaseBlockResponse{}
+	loadResponse(resp.Body, &apiResp)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "hash", apiResp.Data.Block.Hash)
+	assert.Equal(t, uint64(37), apiResp.Data.Block.Nonce)
+}
+
+func TestGetTransactionsByMiniblockHash_FailWhenFacadeErrors(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("database error")
+	facade := &mock.FacadeStub{
+		GetTransactionsByMiniblockHashCalled: func(hash string) ([]data.DatabaseTransaction, error) {
+			return nil, expectedErr
+		},
+	}
+	blockGroup, err := groups.NewBlockGroup(facade)
+	require.NoError(t, err)
+
+	ws := startProxyServer(blockGroup, blockPath)
+
+	req, _ := http.NewRequest("GET", "/block/miniblock/aabb/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	apiResp := data.GenericAPIResponse{}
+	loadResponse(resp.Body, &apiResp)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, apiResp.Error, apiErrors.ErrGetTransactionsByMiniblockHash.Error())
+	assert.Contains(t, apiResp.Error, expectedErr.Error())
+}
+
+func TestGetTransactionsByMiniblockHash_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetTransactionsByMiniblockHashCalled: func(hash string) ([]data.DatabaseTransaction, error) {
+			require.Equal(t, "aabb", hash)
+			return []data.DatabaseTransaction{{Hash: "tx0"}}, nil
+		},
+	}
+	blockGroup, err := groups.NewBlockGroup(facade)
+	require.NoError(t, err)
+
+	ws := startProxyServer(blockGroup, blockPath)
+
+	req, _ := http.NewRequest("GET", "/block/miniblock/aabb/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), "tx0")
+}
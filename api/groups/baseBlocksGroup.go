@@ -45,7 +45,7 @@ func (bbp *blocksGroup) byRoundHandler(c *gin.Context) {
 		return
 	}
 
-	blockByRoundResponse, err := bbp.facade.GetBlocksByRound(round, options)
+	blockByRoundResponse, err := bbp.facade.GetBlocksByRound(c.Request.Context(), round, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -1,8 +1,10 @@
 package groups
 
 import (
+	"context"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -21,6 +23,7 @@ type endpointProperties struct {
 	isSecured        bool
 	isFoundInConfig  bool
 	rateLimiterPerIP uint64
+	timeout          time.Duration
 }
 
 // AddEndpoint will add the handler data for the given path inside the map
@@ -112,12 +115,27 @@ func (bg *baseGroup) RegisterRoutes(
 		}
 
 		middlewares = append(middlewares, statusMetricsExtractor)
+		if properties.timeout > 0 {
+			middlewares = append(middlewares, createRequestTimeoutHandlerFunc(properties.timeout))
+		}
 		middlewares = append(middlewares, handlerData.Handler)
 
 		ws.Handle(handlerData.Method, handlerData.Path, middlewares...)
 	}
 }
 
+// createRequestTimeoutHandlerFunc returns the gin middleware that sets the provided deadline on the request's context,
+// so that the requests towards the observers are aborted once the deadline is reached
+func createRequestTimeoutHandlerFunc(timeout time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
 func getEndpointProperties(ws *gin.RouterGroup, path string, apiConfig data.ApiRoutesConfig) endpointProperties {
 	basePath := ws.BasePath()
 
@@ -139,6 +157,7 @@ func getEndpointProperties(ws *gin.RouterGroup, path string, apiConfig data.ApiR
 				isSecured:        route.Secured,
 				isFoundInConfig:  true,
 				rateLimiterPerIP: route.RateLimit,
+				timeout:          time.Duration(route.TimeoutSec) * time.Second,
 			}
 		}
 	}
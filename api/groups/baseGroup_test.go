@@ -1,11 +1,15 @@
 package groups
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCrudOperationsBaseGroup(t *testing.T) {
@@ -75,3 +79,51 @@ func TestCrudOperationsBaseGroup(t *testing.T) {
 	assert.Equal(t, hd1.Path, bg.endpoints[1].Path)
 	assert.Equal(t, hd4.Path, bg.endpoints[2].Path)
 }
+
+func TestBaseGroup_RegisterRoutesShouldApplyTheRouteTimeout(t *testing.T) {
+	t.Parallel()
+
+	type requestDeadline struct {
+		deadline    time.Time
+		hasDeadline bool
+	}
+	deadlines := make(map[string]requestDeadline)
+	ginHandler := func(c *gin.Context) {
+		deadline, hasDeadline := c.Request.Context().Deadline()
+		deadlines[c.FullPath()] = requestDeadline{deadline: deadline, hasDeadline: hasDeadline}
+		c.Status(http.StatusOK)
+	}
+	bg := &baseGroup{
+		endpoints: []*data.EndpointHandlerData{
+			{Path: "/with-timeout", Handler: ginHandler, Method: http.MethodGet},
+			{Path: "/without-timeout", Handler: ginHandler, Method: http.MethodGet},
+		},
+	}
+	apiConfig := data.ApiRoutesConfig{
+		APIPackages: map[string]data.APIPackageConfig{
+			"group": {
+				Routes: []data.RouteConfig{
+					{Name: "/with-timeout", Open: true, TimeoutSec: 60},
+					{Name: "/without-timeout", Open: true},
+				},
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+	ws := gin.New()
+	emptyHandler := func(c *gin.Context) {}
+	bg.RegisterRoutes(ws.Group("/group"), apiConfig, emptyHandler, emptyHandler, emptyHandler)
+
+	startTime := time.Now()
+	for _, path := range []string{"/group/with-timeout", "/group/without-timeout"} {
+		req, _ := http.NewRequest(http.MethodGet, path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Code)
+	}
+
+	require.True(t, deadlines["/group/with-timeout"].hasDeadline)
+	require.WithinDuration(t, startTime.Add(time.Minute), deadlines["/group/with-timeout"].deadline, time.Second)
+	require.False(t, deadlines["/group/without-timeout"].hasDeadline)
+}
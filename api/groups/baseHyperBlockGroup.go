@@ -1,8 +1,11 @@
 package groups
 
 import (
+	"context"
 	"encoding/hex"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
@@ -10,8 +13,11 @@ import (
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
+const hyperblockEventName = "hyperblock"
+
 type hyperBlockGroup struct {
-	facade HyperBlockFacadeHandler
+	facade                  HyperBlockFacadeHandler
+	streamKeepAliveInterval time.Duration
 	*baseGroup
 }
 
@@ -23,13 +29,16 @@ func NewHyperBlockGroup(facadeHandler data.FacadeHandler) (*hyperBlockGroup, err
 	}
 
 	hbg := &hyperBlockGroup{
-		facade:    facade,
-		baseGroup: &baseGroup{},
+		facade:                  facade,
+		streamKeepAliveInterval: defaultStreamKeepAliveInterval,
+		baseGroup:               &baseGroup{},
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
 		{Path: "/by-hash/:hash", Handler: hbg.hyperBlockByHashHandler, Method: http.MethodGet},
 		{Path: "/by-nonce/:nonce", Handler: hbg.hyperBlockByNonceHandler, Method: http.MethodGet},
+		{Path: "/stream", Handler: hbg.hyperBlockStreamHandler, Method: http.MethodGet},
+		{Path: "/ws", Handler: hbg.hyperBlockWebSocketHandler, Method: http.MethodGet},
 	}
 	hbg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -51,7 +60,7 @@ func (group *hyperBlockGroup) hyperBlockByHashHandler(c *gin.Context) {
 		return
 	}
 
-	blockByHashResponse, err := group.facade.GetHyperBlockByHash(hash, options)
+	blockByHashResponse, err := group.facade.GetHyperBlockByHash(c.Request.Context(), hash, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -74,7 +83,7 @@ func (group *hyperBlockGroup) hyperBlockByNonceHandler(c *gin.Context) {
 		return
 	}
 
-	blockByNonceResponse, err := group.facade.GetHyperBlockByNonce(nonce, options)
+	blockByNonceResponse, err := group.facade.GetHyperBlockByNonce(c.Request.Context(), nonce, options)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -82,3 +91,58 @@ func (group *hyperBlockGroup) hyperBlockByNonceHandler(c *gin.Context) {
 
 	c.JSON(http.StatusOK, blockByNonceResponse)
 }
+
+// hyperBlockStreamHandler streams the new hyperblocks as Server-Sent Events. The ID of each event is the nonce of the
+// hyperblock, so a reconnecting client resumes the stream automatically, through the Last-Event-ID header
+func (group *hyperBlockGroup) hyperBlockStreamHandler(c *gin.Context) {
+	subscription, ok := group.subscribeToHyperblocks(c)
+	if !ok {
+		return
+	}
+	defer subscription.Close()
+
+	streamServerSentEvents(c, group.streamKeepAliveInterval, createHyperblockMessageProducer(subscription))
+}
+
+// hyperBlockWebSocketHandler streams the new hyperblocks over a WebSocket connection, one JSON message per hyperblock.
+// A reconnecting client resumes the stream by providing the from-nonce URL parameter
+func (group *hyperBlockGroup) hyperBlockWebSocketHandler(c *gin.Context) {
+	subscription, ok := group.subscribeToHyperblocks(c)
+	if !ok {
+		return
+	}
+	defer subscription.Close()
+
+	streamOverWebSocket(c, group.streamKeepAliveInterval, createHyperblockMessageProducer(subscription))
+}
+
+func (group *hyperBlockGroup) subscribeToHyperblocks(c *gin.Context) (data.HyperblockSubscriptionHandler, bool) {
+	fromNonce, err := parseHyperblockStreamFromNonce(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrBadUrlParams, err)
+		return nil, false
+	}
+
+	subscription, err := group.facade.SubscribeToHyperblocks(fromNonce)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return nil, false
+	}
+
+	return subscription, true
+}
+
+func createHyperblockMessageProducer(subscription data.HyperblockSubscriptionHandler) streamMessageProducer {
+	return func(ctx context.Context) (*streamMessage, error) {
+		response, err := subscription.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return &streamMessage{
+			id:      fmt.Sprintf("%d", response.Data.Hyperblock.Nonce),
+			event:   hyperblockEventName,
+			payload: response,
+		}, nil
+	}
+}
@@ -1,17 +1,24 @@
 package groups_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
 )
 
 const hyperBlockPath = "/hyperblock"
@@ -108,3 +115,127 @@ func doGet(t *testing.T, facade interface{}, url string, response interface{}) i
 	loadResponse(responseRecorder.Body, &response)
 	return responseRecorder.Code
 }
+
+// createHyperblocksSubscription returns a subscription stub that streams the hyperblocks starting with the provided
+// nonce, until the last nonce, after which it fails
+func createHyperblocksSubscription(fromNonce uint64, lastNonce uint64, numClosed *uint32) *mock.HyperblockSubscriptionStub {
+	nextNonce := fromNonce
+	return &mock.HyperblockSubscriptionStub{
+		NextCalled: func(ctx context.Context) (*data.HyperblockApiResponse, error) {
+			if nextNonce > lastNonce {
+				return nil, errors.New("stream closed")
+			}
+
+			nextNonce++
+			return data.NewHyperblockApiResponse(api.Hyperblock{Nonce: nextNonce - 1}), nil
+		},
+		CloseCalled: func() {
+			atomic.AddUint32(numClosed, 1)
+		},
+	}
+}
+
+func TestHyperblockStream(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid from nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		response := data.GenericAPIResponse{}
+		statusCode := doGet(t, &mock.FacadeStub{}, "/hyperblock/stream?from-nonce=abc", &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Equal(t, data.ReturnCodeRequestError, response.Code)
+	})
+	t.Run("subscribe error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SubscribeToHyperblocksCalled: func(fromNonce core.OptionalUint64) (data.HyperblockSubscriptionHandler, error) {
+				return nil, errors.New("stream not ready")
+			},
+		}
+
+		response := data.GenericAPIResponse{}
+		statusCode := doGet(t, facade, "/hyperblock/stream", &response)
+		require.Equal(t, http.StatusInternalServerError, statusCode)
+		require.Equal(t, "stream not ready", response.Error)
+	})
+	t.Run("should stream the hyperblocks as events", func(t *testing.T) {
+		t.Parallel()
+
+		numClosed := uint32(0)
+		facade := &mock.FacadeStub{
+			SubscribeToHyperblocksCalled: func(fromNonce core.OptionalUint64) (data.HyperblockSubscriptionHandler, error) {
+				require.Equal(t, core.OptionalUint64{Value: 7, HasValue: true}, fromNonce)
+				return createHyperblocksSubscription(fromNonce.Value, 8, &numClosed), nil
+			},
+		}
+
+		hyperBlockGroup, _ := groups.NewHyperBlockGroup(facade)
+		server := startProxyServer(hyperBlockGroup, hyperBlockPath)
+		httpRequest, _ := http.NewRequest("GET", "/hyperblock/stream", nil)
+		httpRequest.Header.Set("Last-Event-ID", "6")
+
+		responseRecorder := httptest.NewRecorder()
+		server.ServeHTTP(responseRecorder, httpRequest)
+
+		require.Equal(t, http.StatusOK, responseRecorder.Code)
+		require.Equal(t, "text/event-stream", responseRecorder.Header().Get("Content-Type"))
+		events := strings.Split(strings.TrimSpace(responseRecorder.Body.String()), "\n\n")
+		require.Equal(t, 3, len(events))
+		require.True(t, strings.HasPrefix(events[0], "id: 7\nevent: hyperblock\ndata: {\"data\":{\"hyperblock\":{"))
+		require.Contains(t, events[0], "\"nonce\":7,")
+		require.True(t, strings.HasPrefix(events[1], "id: 8\nevent: hyperblock\n"))
+		require.Equal(t, "event: error\ndata: {\"data\":null,\"error\":\"stream closed\",\"code\":\"internal_issue\"}", events[2])
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numClosed))
+	})
+}
+
+func TestHyperblockWebSocket(t *testing.T) {
+	t.Parallel()
+
+	numClosed := uint32(0)
+	facade := &mock.FacadeStub{
+		SubscribeToHyperblocksCalled: func(fromNonce core.OptionalUint64) (data.HyperblockSubscriptionHandler, error) {
+			if !fromNonce.HasValue {
+				return nil, errors.New("missing nonce")
+			}
+
+			return createHyperblocksSubscription(fromNonce.Value, fromNonce.Value+1, &numClosed), nil
+		},
+	}
+	hyperBlockGroup, _ := groups.NewHyperBlockGroup(facade)
+	httpServer := httptest.NewServer(startProxyServer(hyperBlockGroup, hyperBlockPath))
+	t.Cleanup(httpServer.Close)
+	webSocketURL := "ws" + strings.TrimPrefix(httpServer.URL, "http") + "/hyperblock/ws"
+
+	t.Run("subscribe error should not upgrade the connection", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := websocket.Dial(webSocketURL, "", httpServer.URL)
+		require.Error(t, err)
+	})
+	t.Run("should stream the hyperblocks as messages", func(t *testing.T) {
+		t.Parallel()
+
+		conn, err := websocket.Dial(webSocketURL+"?from-nonce=5", "", httpServer.URL)
+		require.NoError(t, err)
+		defer func() {
+			_ = conn.Close()
+		}()
+
+		for _, expectedNonce := range []uint64{5, 6} {
+			response := data.HyperblockApiResponse{}
+			require.NoError(t, websocket.JSON.Receive(conn, &response))
+			require.Equal(t, expectedNonce, response.Data.Hyperblock.Nonce)
+		}
+
+		errorResponse := data.GenericAPIResponse{}
+		require.NoError(t, websocket.JSON.Receive(conn, &errorResponse))
+		require.Equal(t, "stream closed", errorResponse.Error)
+
+		require.Eventually(t, func() bool {
+			return atomic.LoadUint32(&numClosed) == 1
+		}, time.Second, time.Millisecond)
+	})
+}
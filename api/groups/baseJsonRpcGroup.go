@@ -0,0 +1,399 @@
+package groups
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	paramPrefix               = ":"
+	maxJsonRpcBatchSize       = 50
+	maxJsonRpcBodySizeInBytes = 10 * 1024 * 1024
+)
+
+// jsonRpcMethod holds the REST route a JSON-RPC method is served by. The route handler calls the corresponding facade
+// method, while the route configuration (Open, Secured, RateLimit, TimeoutSec) applies to the JSON-RPC method as well
+type jsonRpcMethod struct {
+	httpMethod string
+	route      string
+}
+
+// jsonRpcMethods holds the supported JSON-RPC methods. The placeholders of the GET routes are filled in from the
+// parameters of the same name, or in order for positional parameters, the rest of the named parameters being sent as
+// query parameters. The parameters of the POST methods are sent as request body
+var jsonRpcMethods = map[string]jsonRpcMethod{
+	"getAccount":              {httpMethod: http.MethodGet, route: "/address/:address"},
+	"getBalance":              {httpMethod: http.MethodGet, route: "/address/:address/balance"},
+	"getNonce":                {httpMethod: http.MethodGet, route: "/address/:address/nonce"},
+	"getESDTTokens":           {httpMethod: http.MethodGet, route: "/address/:address/esdt"},
+	"getTransaction":          {httpMethod: http.MethodGet, route: "/transaction/:txhash"},
+	"getTransactionStatus":    {httpMethod: http.MethodGet, route: "/transaction/:txhash/status"},
+	"sendTransaction":         {httpMethod: http.MethodPost, route: "/transaction/send"},
+	"simulateTransaction":     {httpMethod: http.MethodPost, route: "/transaction/simulate"},
+	"estimateTransactionCost": {httpMethod: http.MethodPost, route: "/transaction/cost"},
+	"getHyperblockByNonce":    {httpMethod: http.MethodGet, route: "/hyperblock/by-nonce/:nonce"},
+	"getHyperblockByHash":     {httpMethod: http.MethodGet, route: "/hyperblock/by-hash/:hash"},
+	"getBlockByNonce":         {httpMethod: http.MethodGet, route: "/block/:shard/by-nonce/:nonce"},
+	"getBlockByHash":          {httpMethod: http.MethodGet, route: "/block/:shard/by-hash/:hash"},
+	"getNetworkConfig":        {httpMethod: http.MethodGet, route: "/network/config"},
+	"getNetworkStatus":        {httpMethod: http.MethodGet, route: "/network/status/:shard"},
+	"queryContract":           {httpMethod: http.MethodPost, route: "/vm-values/query"},
+}
+
+type jsonRpcGroup struct {
+	dispatcher  http.Handler
+	versionPath string
+	*baseGroup
+}
+
+// NewJsonRpcGroup returns a new instance of jsonRpcGroup, serving the JSON-RPC 2.0 methods through the REST routes of
+// the provided API version, which are handled by the provided dispatcher
+func NewJsonRpcGroup(dispatcher http.Handler, version string) (*jsonRpcGroup, error) {
+	if dispatcher == nil {
+		return nil, ErrNilHttpHandler
+	}
+
+	jrg := &jsonRpcGroup{
+		dispatcher:  dispatcher,
+		versionPath: strings.TrimSuffix(path.Join("/", version), "/"),
+		baseGroup:   &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "", Handler: jrg.handleJsonRpc, Method: http.MethodPost},
+	}
+	jrg.baseGroup.endpoints = baseRoutesHandlers
+
+	return jrg, nil
+}
+
+// handleJsonRpc executes a single JSON-RPC request or a batch of them. The notifications do not get a response
+func (group *jsonRpcGroup) handleJsonRpc(c *gin.Context) {
+	body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxJsonRpcBodySizeInBytes))
+	if err != nil {
+		errorCode := data.JsonRpcParseErrorCode
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			errorCode = data.JsonRpcInvalidRequestCode
+		}
+
+		c.JSON(http.StatusOK, newJsonRpcErrorResponse(nil, errorCode, err.Error()))
+		return
+	}
+
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		group.handleJsonRpcBatch(c, body)
+		return
+	}
+
+	request := &data.JsonRpcRequest{}
+	err = json.Unmarshal(body, request)
+	if err != nil {
+		c.JSON(http.StatusOK, newJsonRpcErrorResponse(nil, data.JsonRpcParseErrorCode, err.Error()))
+		return
+	}
+
+	response := group.executeJsonRpcRequest(c, request)
+	if response == nil {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// handleJsonRpcBatch executes the requests of a batch in order, so the transactions of a sender can be sent together
+func (group *jsonRpcGroup) handleJsonRpcBatch(c *gin.Context, body []byte) {
+	var rawRequests []json.RawMessage
+	err := json.Unmarshal(body, &rawRequests)
+	if err != nil {
+		c.JSON(http.StatusOK, newJsonRpcErrorResponse(nil, data.JsonRpcParseErrorCode, err.Error()))
+		return
+	}
+	if len(rawRequests) == 0 {
+		c.JSON(http.StatusOK, newJsonRpcErrorResponse(nil, data.JsonRpcInvalidRequestCode, "empty batch"))
+		return
+	}
+	if len(rawRequests) > maxJsonRpcBatchSize {
+		message := fmt.Sprintf("batch too large: at most %d requests are allowed", maxJsonRpcBatchSize)
+		c.JSON(http.StatusOK, newJsonRpcErrorResponse(nil, data.JsonRpcInvalidRequestCode, message))
+		return
+	}
+
+	responses := make([]*data.JsonRpcResponse, 0, len(rawRequests))
+	for _, rawRequest := range rawRequests {
+		request := &data.JsonRpcRequest{}
+		errUnmarshal := json.Unmarshal(rawRequest, request)
+		if errUnmarshal != nil {
+			responses = append(responses, newJsonRpcErrorResponse(nil, data.JsonRpcInvalidRequestCode, errUnmarshal.Error()))
+			continue
+		}
+
+		response := group.executeJsonRpcRequest(c, request)
+		if response != nil {
+			responses = append(responses, response)
+		}
+	}
+
+	if len(responses) == 0 {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// executeJsonRpcRequest serves the request through the REST route of its method. It returns nil for notifications
+func (group *jsonRpcGroup) executeJsonRpcRequest(c *gin.Context, request *data.JsonRpcRequest) *data.JsonRpcResponse {
+	response := group.doExecuteJsonRpcRequest(c, request)
+	isNotification := request.ID == nil
+	if isNotification {
+		return nil
+	}
+
+	return response
+}
+
+func (group *jsonRpcGroup) doExecuteJsonRpcRequest(c *gin.Context, request *data.JsonRpcRequest) *data.JsonRpcResponse {
+	if request.JsonRpc != data.JsonRpcVersion || len(request.Method) == 0 {
+		return newJsonRpcErrorResponse(request.ID, data.JsonRpcInvalidRequestCode, "invalid JSON-RPC 2.0 request")
+	}
+
+	method, found := jsonRpcMethods[request.Method]
+	if !found {
+		return newJsonRpcErrorResponse(request.ID, data.JsonRpcMethodNotFoundCode, fmt.Sprintf("method %s not found", request.Method))
+	}
+
+	restRequest, err := group.createRestRequest(c, method, request.Params)
+	if err != nil {
+		return newJsonRpcErrorResponse(request.ID, data.JsonRpcInvalidParamsCode, err.Error())
+	}
+
+	recorder := newResponseRecorder()
+	group.dispatcher.ServeHTTP(recorder, restRequest)
+
+	return createJsonRpcResponse(request, recorder)
+}
+
+// createRestRequest builds the request for the REST route of the method
+func (group *jsonRpcGroup) createRestRequest(c *gin.Context, method jsonRpcMethod, params json.RawMessage) (*http.Request, error) {
+	restPath := method.route
+	var body io.Reader = http.NoBody
+	var err error
+	if method.httpMethod == http.MethodGet {
+		restPath, err = buildGetRestPath(method.route, params)
+	} else {
+		body, err = buildPostRestBody(params)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	routePath, _, _ := strings.Cut(restPath, "?")
+	if isStreamingRoute(routePath) {
+		return nil, fmt.Errorf("the streaming routes are not supported")
+	}
+
+	return createInternalRequest(c, method.httpMethod, group.versionPath+restPath, body)
+}
+
+// buildGetRestPath fills in the placeholders of the route with the parameters, the remaining named parameters being
+// appended as query parameters
+func buildGetRestPath(route string, params json.RawMessage) (string, error) {
+	namedParams, err := getNamedParams(route, params)
+	if err != nil {
+		return "", err
+	}
+
+	pathSegments := strings.Split(route, "/")
+	for idx, segment := range pathSegments {
+		if !strings.HasPrefix(segment, paramPrefix) {
+			continue
+		}
+
+		paramName := strings.TrimPrefix(segment, paramPrefix)
+		value, found := namedParams[paramName]
+		if !found {
+			return "", fmt.Errorf("missing parameter %s", paramName)
+		}
+
+		pathSegments[idx] = url.PathEscape(value)
+		delete(namedParams, paramName)
+	}
+
+	restPath := strings.Join(pathSegments, "/")
+	if len(namedParams) == 0 {
+		return restPath, nil
+	}
+
+	query := url.Values{}
+	for name, value := range namedParams {
+		query.Set(name, value)
+	}
+
+	return restPath + "?" + query.Encode(), nil
+}
+
+// getNamedParams returns the parameters of a GET method by name. The positional parameters are named after the
+// placeholders of the route, in order
+func getNamedParams(route string, params json.RawMessage) (map[string]string, error) {
+	rawParams := make(map[string]interface{})
+	if len(params) > 0 && params[0] == '[' {
+		var positionalParams []interface{}
+		err := unmarshalJsonRpcParams(params, &positionalParams)
+		if err != nil {
+			return nil, err
+		}
+
+		placeholders := getRoutePlaceholders(route)
+		if len(positionalParams) > len(placeholders) {
+			return nil, fmt.Errorf("expected at most %d positional parameters", len(placeholders))
+		}
+		for idx, value := range positionalParams {
+			rawParams[placeholders[idx]] = value
+		}
+	} else if len(params) > 0 {
+		err := unmarshalJsonRpcParams(params, &rawParams)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	namedParams := make(map[string]string, len(rawParams))
+	for name, rawValue := range rawParams {
+		switch value := rawValue.(type) {
+		case string:
+			namedParams[name] = value
+		case json.Number:
+			namedParams[name] = value.String()
+		case bool:
+			namedParams[name] = fmt.Sprintf("%t", value)
+		default:
+			return nil, fmt.Errorf("parameter %s should be a string, a number or a boolean", name)
+		}
+	}
+
+	return namedParams, nil
+}
+
+func unmarshalJsonRpcParams(params json.RawMessage, destination interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(params))
+	decoder.UseNumber()
+
+	err := decoder.Decode(destination)
+	if err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+
+	return nil
+}
+
+func getRoutePlaceholders(route string) []string {
+	placeholders := make([]string, 0)
+	for _, segment := range strings.Split(route, "/") {
+		if strings.HasPrefix(segment, paramPrefix) {
+			placeholders = append(placeholders, strings.TrimPrefix(segment, paramPrefix))
+		}
+	}
+
+	return placeholders
+}
+
+// buildPostRestBody returns the request body of a POST method: the named parameters object or the single positional
+// parameter
+func buildPostRestBody(params json.RawMessage) (io.Reader, error) {
+	if len(params) > 0 && params[0] == '[' {
+		var positionalParams []json.RawMessage
+		err := json.Unmarshal(params, &positionalParams)
+		if err != nil {
+			return nil, fmt.Errorf("invalid params: %w", err)
+		}
+		if len(positionalParams) != 1 {
+			return nil, fmt.Errorf("expected a single positional parameter")
+		}
+
+		params = positionalParams[0]
+	}
+	if len(params) == 0 || params[0] != '{' {
+		return nil, fmt.Errorf("params should be an object")
+	}
+
+	return bytes.NewReader(params), nil
+}
+
+// createJsonRpcResponse converts the response of the REST route into a JSON-RPC response
+func createJsonRpcResponse(request *data.JsonRpcRequest, recorder *responseRecorder) *data.JsonRpcResponse {
+	restResponse := struct {
+		Data  json.RawMessage `json:"data"`
+		Error string          `json:"error"`
+		Code  data.ReturnCode `json:"code"`
+	}{}
+	errUnmarshal := json.Unmarshal(recorder.body.Bytes(), &restResponse)
+
+	if recorder.statusCode == http.StatusOK && errUnmarshal == nil {
+		result := restResponse.Data
+		if len(result) == 0 {
+			result = json.RawMessage("null")
+		}
+
+		return &data.JsonRpcResponse{
+			JsonRpc: data.JsonRpcVersion,
+			Result:  result,
+			ID:      request.ID,
+		}
+	}
+
+	// a closed route is not registered at all, so the router responds with a plain text 404
+	if recorder.statusCode == http.StatusNotFound && errUnmarshal != nil {
+		return newJsonRpcErrorResponse(request.ID, data.JsonRpcMethodNotFoundCode, fmt.Sprintf("method %s not found", request.Method))
+	}
+
+	message := restResponse.Error
+	if len(message) == 0 {
+		message = http.StatusText(recorder.statusCode)
+	}
+
+	response := newJsonRpcErrorResponse(request.ID, getJsonRpcErrorCode(recorder.statusCode), message)
+	response.Error.Data = &data.JsonRpcErrorDetails{
+		HttpStatus:    recorder.statusCode,
+		ReturnCode:    restResponse.Code,
+		RetryAfterSec: recorder.header.Get("Retry-After"),
+	}
+
+	return response
+}
+
+func getJsonRpcErrorCode(statusCode int) int {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return data.JsonRpcInvalidParamsCode
+	case http.StatusUnauthorized:
+		return data.JsonRpcUnauthorizedCode
+	case http.StatusTooManyRequests:
+		return data.JsonRpcRateLimitedCode
+	default:
+		return data.JsonRpcServerErrorCode
+	}
+}
+
+func newJsonRpcErrorResponse(id json.RawMessage, code int, message string) *data.JsonRpcResponse {
+	return &data.JsonRpcResponse{
+		JsonRpc: data.JsonRpcVersion,
+		Error: &data.JsonRpcError{
+			Code:    code,
+			Message: message,
+		},
+		ID: id,
+	}
+}
@@ -0,0 +1,312 @@
+package groups_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/api/groups"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+const jsonRpcTestVersion = "v1.0"
+
+type jsonRpcTestResponse struct {
+	JsonRpc string             `json:"jsonrpc"`
+	Result  json.RawMessage    `json:"result"`
+	Error   *data.JsonRpcError `json:"error"`
+	ID      json.RawMessage    `json:"id"`
+}
+
+// startJsonRpcServer starts a server with the accounts, hyperblock and transaction routes of a version along with the
+// JSON-RPC route. The account balance route is secured, the account nonce route is rate limited and the hyperblock by
+// hash route is closed
+func startJsonRpcServer(t *testing.T, facade *mock.FacadeStub) *gin.Engine {
+	apiConfig := data.ApiRoutesConfig{
+		APIPackages: map[string]data.APIPackageConfig{
+			"address": {Routes: []data.RouteConfig{
+				{Name: "/:address", Open: true},
+				{Name: "/:address/balance", Open: true, Secured: true},
+				{Name: "/:address/nonce", Open: true, RateLimit: 1},
+			}},
+			"hyperblock": {Routes: []data.RouteConfig{
+				{Name: "/by-nonce/:nonce", Open: true},
+				{Name: "/by-hash/:hash", Open: false},
+			}},
+			"transaction": {Routes: []data.RouteConfig{
+				{Name: "/send", Open: true},
+			}},
+			"rpc": {Routes: []data.RouteConfig{
+				{Name: "", Open: true},
+			}},
+		},
+	}
+	authenticationFunc := func(c *gin.Context) {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, data.GenericAPIResponse{Error: "unauthorized", Code: data.ReturnCodeRequestError})
+	}
+	rateLimiter := func(c *gin.Context) {
+		c.Header("Retry-After", "7")
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, data.GenericAPIResponse{Error: "rate limited", Code: data.ReturnCodeRequestError})
+	}
+
+	ws := gin.New()
+	versionGroup := ws.Group(jsonRpcTestVersion)
+
+	accountsGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	accountsGroup.RegisterRoutes(versionGroup.Group("/address"), apiConfig, authenticationFunc, rateLimiter, emptyGinHandler)
+
+	hyperblockGroup, err := groups.NewHyperBlockGroup(facade)
+	require.NoError(t, err)
+	hyperblockGroup.RegisterRoutes(versionGroup.Group("/hyperblock"), apiConfig, authenticationFunc, rateLimiter, emptyGinHandler)
+
+	transactionGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	transactionGroup.RegisterRoutes(versionGroup.Group("/transaction"), apiConfig, authenticationFunc, rateLimiter, emptyGinHandler)
+
+	jsonRpcGroup, err := groups.NewJsonRpcGroup(ws, jsonRpcTestVersion)
+	require.NoError(t, err)
+	jsonRpcGroup.RegisterRoutes(versionGroup.Group("/rpc"), apiConfig, authenticationFunc, rateLimiter, emptyGinHandler)
+
+	return ws
+}
+
+func createJsonRpcTestFacade() *mock.FacadeStub {
+	return &mock.FacadeStub{
+		GetAccountHandler: func(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+			if address == "missing" {
+				return nil, errors.New("account not found")
+			}
+			return &data.AccountModel{Account: data.Account{Address: address, Nonce: 7, Balance: "100"}}, nil
+		},
+		GetHyperBlockByNonceCalled: func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+			return data.NewHyperblockApiResponse(api.Hyperblock{Nonce: nonce}), nil
+		},
+		SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+			return http.StatusOK, "hash-" + tx.Sender, nil
+		},
+	}
+}
+
+func doJsonRpcRequest(ws *gin.Engine, body string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodPost, "/"+jsonRpcTestVersion+"/rpc", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func doSingleJsonRpcRequest(t *testing.T, ws *gin.Engine, body string) *jsonRpcTestResponse {
+	resp := doJsonRpcRequest(ws, body)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	response := &jsonRpcTestResponse{}
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), response))
+	require.Equal(t, data.JsonRpcVersion, response.JsonRpc)
+
+	return response
+}
+
+func requireJsonRpcError(t *testing.T, response *jsonRpcTestResponse, expectedCode int) {
+	require.NotNil(t, response.Error)
+	require.Equal(t, expectedCode, response.Error.Code)
+	require.Nil(t, response.Result)
+}
+
+func TestNewJsonRpcGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil dispatcher should error", func(t *testing.T) {
+		t.Parallel()
+
+		jrg, err := groups.NewJsonRpcGroup(nil, jsonRpcTestVersion)
+		require.Nil(t, jrg)
+		require.Equal(t, groups.ErrNilHttpHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		jrg, err := groups.NewJsonRpcGroup(gin.New(), jsonRpcTestVersion)
+		require.NoError(t, err)
+		require.False(t, jrg.IsInterfaceNil())
+	})
+}
+
+func TestJsonRpcGroup_SingleRequests(t *testing.T) {
+	t.Parallel()
+
+	ws := startJsonRpcServer(t, createJsonRpcTestFacade())
+
+	t.Run("named params should fill in the route", func(t *testing.T) {
+		t.Parallel()
+
+		response := doSingleJsonRpcRequest(t, ws, `{"jsonrpc":"2.0","method":"getAccount","params":{"address":"erd1alice"},"id":1}`)
+		require.Nil(t, response.Error)
+		require.Equal(t, "1", string(response.ID))
+
+		result := struct {
+			Account data.Account `json:"account"`
+		}{}
+		require.NoError(t, json.Unmarshal(response.Result, &result))
+		require.Equal(t, "erd1alice", result.Account.Address)
+		require.Equal(t, uint64(7), result.Account.Nonce)
+	})
+	t.Run("positional params should fill in the route in order", func(t *testing.T) {
+		t.Parallel()
+
+		response := doSingleJsonRpcRequest(t, ws, `{"jsonrpc":"2.0","method":"getHyperblockByNonce","params":[42],"id":"abc"}`)
+		require.Nil(t, response.Error)
+		require.Equal(t, `"abc"`, string(response.ID))
+
+		result := data.HyperblockApiResponsePayload{}
+		require.NoError(t, json.Unmarshal(response.Result, &result))
+		require.Equal(t, uint64(42), result.Hyperblock.Nonce)
+	})
+	t.Run("params of a POST method should be sent as request body", func(t *testing.T) {
+		t.Parallel()
+
+		response := doSingleJsonRpcRequest(t, ws, `{"jsonrpc":"2.0","method":"sendTransaction","params":{"sender":"erd1bob","receiver":"erd1alice","value":"1"},"id":2}`)
+		require.Nil(t, response.Error)
+		require.JSONEq(t, `{"txHash":"hash-erd1bob"}`, string(response.Result))
+	})
+	t.Run("unknown method should return method not found", func(t *testing.T) {
+		t.Parallel()
+
+		response := doSingleJsonRpcRequest(t, ws, `{"jsonrpc":"2.0","method":"getEverything","id":3}`)
+		requireJsonRpcError(t, response, data.JsonRpcMethodNotFoundCode)
+		require.Equal(t, "3", string(response.ID))
+	})
+	t.Run("method of a closed route should return method not found", func(t *testing.T) {
+		t.Parallel()
+
+		response := doSingleJsonRpcRequest(t, ws, `{"jsonrpc":"2.0","method":"getHyperblockByHash","params":["aa"],"id":4}`)
+		requireJsonRpcError(t, response, data.JsonRpcMethodNotFoundCode)
+	})
+	t.Run("method of a secured route should require authentication", func(t *testing.T) {
+		t.Parallel()
+
+		response := doSingleJsonRpcRequest(t, ws, `{"jsonrpc":"2.0","method":"getBalance","params":["erd1alice"],"id":5}`)
+		requireJsonRpcError(t, response, data.JsonRpcUnauthorizedCode)
+		require.Equal(t, http.StatusUnauthorized, response.Error.Data.HttpStatus)
+	})
+	t.Run("method of a rate limited route should be rate limited", func(t *testing.T) {
+		t.Parallel()
+
+		response := doSingleJsonRpcRequest(t, ws, `{"jsonrpc":"2.0","method":"getNonce","params":["erd1alice"],"id":6}`)
+		requireJsonRpcError(t, response, data.JsonRpcRateLimitedCode)
+		require.Equal(t, "rate limited", response.Error.Message)
+		require.Equal(t, "7", response.Error.Data.RetryAfterSec)
+	})
+	t.Run("missing route parameter should return invalid params", func(t *testing.T) {
+		t.Parallel()
+
+		response := doSingleJsonRpcRequest(t, ws, `{"jsonrpc":"2.0","method":"getAccount","params":{},"id":7}`)
+		requireJsonRpcError(t, response, data.JsonRpcInvalidParamsCode)
+	})
+	t.Run("POST method without an object should return invalid params", func(t *testing.T) {
+		t.Parallel()
+
+		response := doSingleJsonRpcRequest(t, ws, `{"jsonrpc":"2.0","method":"sendTransaction","params":[1,2],"id":8}`)
+		requireJsonRpcError(t, response, data.JsonRpcInvalidParamsCode)
+	})
+	t.Run("facade error should return a server error", func(t *testing.T) {
+		t.Parallel()
+
+		response := doSingleJsonRpcRequest(t, ws, `{"jsonrpc":"2.0","method":"getAccount","params":["missing"],"id":9}`)
+		requireJsonRpcError(t, response, data.JsonRpcServerErrorCode)
+		require.Contains(t, response.Error.Message, "account not found")
+		require.Equal(t, http.StatusInternalServerError, response.Error.Data.HttpStatus)
+		require.Equal(t, data.ReturnCodeInternalError, response.Error.Data.ReturnCode)
+	})
+	t.Run("invalid JSON should return a parse error", func(t *testing.T) {
+		t.Parallel()
+
+		response := doSingleJsonRpcRequest(t, ws, `{"jsonrpc":"2.0","method"`)
+		requireJsonRpcError(t, response, data.JsonRpcParseErrorCode)
+		require.Equal(t, "null", string(response.ID))
+	})
+	t.Run("wrong protocol version should return invalid request", func(t *testing.T) {
+		t.Parallel()
+
+		response := doSingleJsonRpcRequest(t, ws, `{"jsonrpc":"1.0","method":"getAccount","params":["erd1alice"],"id":10}`)
+		requireJsonRpcError(t, response, data.JsonRpcInvalidRequestCode)
+	})
+	t.Run("notification should not get a response", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doJsonRpcRequest(ws, `{"jsonrpc":"2.0","method":"getAccount","params":["erd1alice"]}`)
+		require.Equal(t, http.StatusNoContent, resp.Code)
+		require.Empty(t, resp.Body.Bytes())
+	})
+}
+
+func TestJsonRpcGroup_BatchRequests(t *testing.T) {
+	t.Parallel()
+
+	ws := startJsonRpcServer(t, createJsonRpcTestFacade())
+
+	t.Run("should respond to each request, except the notifications", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doJsonRpcRequest(ws, `[
+			{"jsonrpc":"2.0","method":"getAccount","params":["erd1alice"],"id":1},
+			{"jsonrpc":"2.0","method":"getAccount","params":["erd1bob"]},
+			{"jsonrpc":"2.0","method":"unknown","id":2},
+			42
+		]`)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		var responses []*jsonRpcTestResponse
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &responses))
+		require.Equal(t, 3, len(responses))
+
+		require.Equal(t, "1", string(responses[0].ID))
+		require.Nil(t, responses[0].Error)
+		require.Equal(t, "2", string(responses[1].ID))
+		requireJsonRpcError(t, responses[1], data.JsonRpcMethodNotFoundCode)
+		require.Equal(t, "null", string(responses[2].ID))
+		requireJsonRpcError(t, responses[2], data.JsonRpcInvalidRequestCode)
+	})
+	t.Run("empty batch should return invalid request", func(t *testing.T) {
+		t.Parallel()
+
+		response := doSingleJsonRpcRequest(t, ws, `[]`)
+		requireJsonRpcError(t, response, data.JsonRpcInvalidRequestCode)
+	})
+	t.Run("too large batch should return invalid request", func(t *testing.T) {
+		t.Parallel()
+
+		requests := make([]string, 51)
+		for i := range requests {
+			requests[i] = fmt.Sprintf(`{"jsonrpc":"2.0","method":"getAccount","params":["erd1alice"],"id":%d}`, i)
+		}
+
+		response := doSingleJsonRpcRequest(t, ws, "["+strings.Join(requests, ",")+"]")
+		requireJsonRpcError(t, response, data.JsonRpcInvalidRequestCode)
+	})
+	t.Run("too large body should return invalid request", func(t *testing.T) {
+		t.Parallel()
+
+		body := `{"jsonrpc":"2.0","method":"getAccount","params":["` + strings.Repeat("a", 10*1024*1024) + `"],"id":1}`
+		response := doSingleJsonRpcRequest(t, ws, body)
+		requireJsonRpcError(t, response, data.JsonRpcInvalidRequestCode)
+	})
+	t.Run("batch of notifications should not get a response", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doJsonRpcRequest(ws, `[{"jsonrpc":"2.0","method":"getAccount","params":["erd1alice"]}]`)
+		require.Equal(t, http.StatusNoContent, resp.Code)
+	})
+}
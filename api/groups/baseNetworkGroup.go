@@ -58,7 +58,7 @@ func (group *networkGroup) getNetworkStatusData(c *gin.Context) {
 		return
 	}
 
-	networkStatusResults, err := group.facade.GetNetworkStatusMetrics(shardIDUint)
+	networkStatusResults, err := group.facade.GetNetworkStatusMetrics(c.Request.Context(), shardIDUint)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -69,7 +69,7 @@ func (group *networkGroup) getNetworkStatusData(c *gin.Context) {
 
 // getNetworkConfigData will expose the node network metrics for the given shard
 func (group *networkGroup) getNetworkConfigData(c *gin.Context) {
-	networkConfigResults, err := group.facade.GetNetworkConfigMetrics()
+	networkConfigResults, err := group.facade.GetNetworkConfigMetrics(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -91,7 +91,7 @@ func (group *networkGroup) getEconomicsData(c *gin.Context) {
 
 func (group *networkGroup) getEsdtHandlerFunc(tokenType string) func(c *gin.Context) {
 	return func(c *gin.Context) {
-		tokens, err := group.facade.GetAllIssuedESDTs(tokenType)
+		tokens, err := group.facade.GetAllIssuedESDTs(c.Request.Context(), tokenType)
 		if err != nil {
 			shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 			return
@@ -103,7 +103,7 @@ func (group *networkGroup) getEsdtHandlerFunc(tokenType string) func(c *gin.Cont
 
 // getDirectStakedInfo will expose the direct staked values from a metachain observer in json format
 func (group *networkGroup) getDirectStakedInfo(c *gin.Context) {
-	directStakedInfo, err := group.facade.GetDirectStakedInfo()
+	directStakedInfo, err := group.facade.GetDirectStakedInfo(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -114,7 +114,7 @@ func (group *networkGroup) getDirectStakedInfo(c *gin.Context) {
 
 // getDelegatedInfo will expose the delegated info values from a metachain observer in json format
 func (group *networkGroup) getDelegatedInfo(c *gin.Context) {
-	delegatedInfo, err := group.facade.GetDelegatedInfo()
+	delegatedInfo, err := group.facade.GetDelegatedInfo(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -125,7 +125,7 @@ func (group *networkGroup) getDelegatedInfo(c *gin.Context) {
 
 // getEsdts will expose all the issued ESDTs
 func (group *networkGroup) getEsdts(c *gin.Context) {
-	allIssuedESDTs, err := group.facade.GetAllIssuedESDTs("")
+	allIssuedESDTs, err := group.facade.GetAllIssuedESDTs(c.Request.Context(), "")
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -135,7 +135,7 @@ func (group *networkGroup) getEsdts(c *gin.Context) {
 }
 
 func (group *networkGroup) getEnableEpochs(c *gin.Context) {
-	enableEpochsMetrics, err := group.facade.GetEnableEpochsMetrics()
+	enableEpochsMetrics, err := group.facade.GetEnableEpochsMetrics(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -157,7 +157,7 @@ func (group *networkGroup) getESDTSupply(c *gin.Context) {
 		return
 	}
 
-	esdtSupply, err := group.facade.GetESDTSupply(tokenIdentifier)
+	esdtSupply, err := group.facade.GetESDTSupply(c.Request.Context(), tokenIdentifier)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -168,7 +168,7 @@ func (group *networkGroup) getESDTSupply(c *gin.Context) {
 
 // getRatingsConfig will expose the ratings configuration
 func (group *networkGroup) getRatingsConfig(c *gin.Context) {
-	networkConfigResults, err := group.facade.GetRatingsConfig()
+	networkConfigResults, err := group.facade.GetRatingsConfig(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -179,7 +179,7 @@ func (group *networkGroup) getRatingsConfig(c *gin.Context) {
 
 // getGenesisNodes will expose genesis nodes public keys
 func (group *networkGroup) getGenesisNodes(c *gin.Context) {
-	genesisNodes, err := group.facade.GetGenesisNodesPubKeys()
+	genesisNodes, err := group.facade.GetGenesisNodesPubKeys(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -190,7 +190,7 @@ func (group *networkGroup) getGenesisNodes(c *gin.Context) {
 
 // getGasConfigs will expose gas configs
 func (group *networkGroup) getGasConfigs(c *gin.Context) {
-	gasConfigs, err := group.facade.GetGasConfigs()
+	gasConfigs, err := group.facade.GetGasConfigs(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -207,7 +207,7 @@ func (group *networkGroup) getTrieStatistics(c *gin.Context) {
 		return
 	}
 
-	trieStatistics, err := group.facade.GetTriesStatistics(shardID)
+	trieStatistics, err := group.facade.GetTriesStatistics(c.Request.Context(), shardID)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -230,7 +230,7 @@ func (group *networkGroup) getEpochStartData(c *gin.Context) {
 		return
 	}
 
-	epochStartData, err := group.facade.GetEpochStartData(epoch, shardID)
+	epochStartData, err := group.facade.GetEpochStartData(c.Request.Context(), epoch, shardID)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
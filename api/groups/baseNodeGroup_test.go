@@ -1,6 +1,7 @@
 package groups_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -27,7 +28,7 @@ func TestHeartbeat_GetHeartbeatDataReturnsStatusOk(t *testing.T) {
 	t.Parallel()
 
 	facade := &mock.FacadeStub{
-		GetHeartbeatDataHandler: func() (*data.HeartbeatResponse, error) {
+		GetHeartbeatDataHandler: func(_ context.Context) (*data.HeartbeatResponse, error) {
 			return &data.HeartbeatResponse{Heartbeats: []data.PubKeyHeartbeat{}}, nil
 		},
 	}
@@ -50,7 +51,7 @@ func TestHeartbeat_GetHeartbeatDataReturnsOkResults(t *testing.T) {
 	name2, identity2 := "name2", "identity2"
 
 	facade := &mock.FacadeStub{
-		GetHeartbeatDataHandler: func() (*data.HeartbeatResponse, error) {
+		GetHeartbeatDataHandler: func(_ context.Context) (*data.HeartbeatResponse, error) {
 			return &data.HeartbeatResponse{
 				Heartbeats: []data.PubKeyHeartbeat{
 					{
@@ -86,7 +87,7 @@ func TestHeartbeat_GetHeartbeatBadRequestShouldErr(t *testing.T) {
 	t.Parallel()
 
 	facade := &mock.FacadeStub{
-		GetHeartbeatDataHandler: func() (*data.HeartbeatResponse, error) {
+		GetHeartbeatDataHandler: func(_ context.Context) (*data.HeartbeatResponse, error) {
 			return nil, errors.New("bad request")
 		},
 	}
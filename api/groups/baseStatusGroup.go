@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-proxy-go/api/shared"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/prometheus/common/expfmt"
 )
 
 type statusGroup struct {
@@ -28,6 +29,8 @@ func NewStatusGroup(facadeHandler data.FacadeHandler) (*statusGroup, error) {
 	baseRoutesHandlers := []*data.EndpointHandlerData{
 		{Path: "/metrics", Handler: ng.getMetrics, Method: http.MethodGet},
 		{Path: "/prometheus-metrics", Handler: ng.getPrometheusMetrics, Method: http.MethodGet},
+		{Path: "/observers", Handler: ng.getObservers, Method: http.MethodGet},
+		{Path: "/connection-pool", Handler: ng.getConnectionPool, Method: http.MethodGet},
 	}
 	ng.baseGroup.endpoints = baseRoutesHandlers
 
@@ -41,9 +44,23 @@ func (group *statusGroup) getMetrics(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"metrics": metricsResults}, "", data.ReturnCodeSuccess)
 }
 
-// getPrometheusMetrics will expose proxy metrics in prometheus format
+// getPrometheusMetrics will expose proxy metrics in the prometheus text exposition format
 func (group *statusGroup) getPrometheusMetrics(c *gin.Context) {
 	metricsResults := group.facade.GetMetricsForPrometheus()
 
-	c.String(http.StatusOK, metricsResults)
+	c.Data(http.StatusOK, string(expfmt.FmtText), []byte(metricsResults))
+}
+
+// getObservers will expose the circuit breaker state of each observer and full history node
+func (group *statusGroup) getObservers(c *gin.Context) {
+	nodesStatus := group.facade.GetNodesCircuitBreakerStatus()
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"observers": nodesStatus.Observers, "fullHistoryNodes": nodesStatus.FullHistoryNodes}, "", data.ReturnCodeSuccess)
+}
+
+// getConnectionPool will expose the metrics of the connections opened towards the observers and full history nodes
+func (group *statusGroup) getConnectionPool(c *gin.Context) {
+	poolMetrics := group.facade.GetConnectionPoolMetrics()
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"connectionPool": poolMetrics}, "", data.ReturnCodeSuccess)
 }
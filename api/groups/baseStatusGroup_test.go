@@ -86,5 +86,93 @@ func TestGetPrometheusMetrics_ShouldWork(t *testing.T) {
 	require.NoError(t, err)
 
 	require.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, "text/plain; version=0.0.4; charset=utf-8", resp.Header().Get("Content-Type"))
 	require.Equal(t, expectedMetrics, string(bodyBytes))
 }
+
+type statusObserversResponse struct {
+	Data struct {
+		Observers        []*data.NodeCircuitBreakerStatus `json:"observers"`
+		FullHistoryNodes []*data.NodeCircuitBreakerStatus `json:"fullHistoryNodes"`
+	}
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestGetObservers_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedStatus := &data.NodesCircuitBreakerStatus{
+		Observers: []*data.NodeCircuitBreakerStatus{
+			{Address: "observer0", ShardId: 1, IsSynced: true, State: data.CircuitBreakerOpen, ConsecutiveFailures: 3},
+		},
+		FullHistoryNodes: []*data.NodeCircuitBreakerStatus{
+			{Address: "fullHistory0", ShardId: 0, IsSynced: true, State: data.CircuitBreakerHalfOpen, ConsecutiveFailures: 5},
+		},
+	}
+	facade := &mock.FacadeStub{
+		GetNodesCircuitBreakerStatusCalled: func() *data.NodesCircuitBreakerStatus {
+			return expectedStatus
+		},
+	}
+
+	statusGroup, err := groups.NewStatusGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(statusGroup, statusPath)
+
+	req, _ := http.NewRequest("GET", "/status/observers", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	var apiResp statusObserversResponse
+	loadResponse(resp.Body, &apiResp)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	require.Equal(t, expectedStatus.Observers, apiResp.Data.Observers)
+	require.Equal(t, expectedStatus.FullHistoryNodes, apiResp.Data.FullHistoryNodes)
+}
+
+type statusConnectionPoolResponse struct {
+	Data struct {
+		ConnectionPool *data.ConnectionPoolMetrics `json:"connectionPool"`
+	}
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestGetConnectionPool_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	hostMetrics := &data.HostConnectionsMetrics{
+		OpenConnections:   2,
+		DialedConnections: 3,
+		DialErrors:        1,
+		ReusedConnections: 10,
+		InFlightRequests:  1,
+	}
+	expectedMetrics := &data.ConnectionPoolMetrics{
+		Total: *hostMetrics,
+		Hosts: map[string]*data.HostConnectionsMetrics{
+			"observer0:8080": hostMetrics,
+		},
+	}
+	facade := &mock.FacadeStub{
+		GetConnectionPoolMetricsCalled: func() *data.ConnectionPoolMetrics {
+			return expectedMetrics
+		},
+	}
+
+	statusGroup, err := groups.NewStatusGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(statusGroup, statusPath)
+
+	req, _ := http.NewRequest("GET", "/status/connection-pool", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	var apiResp statusConnectionPoolResponse
+	loadResponse(resp.Body, &apiResp)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	require.Equal(t, expectedMetrics, apiResp.Data.ConnectionPool)
+}
@@ -1,9 +1,11 @@
 package groups
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-proxy-go/api/errors"
@@ -12,8 +14,11 @@ import (
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
+const transactionStatusEventName = "status"
+
 type transactionGroup struct {
-	facade TransactionFacadeHandler
+	facade                  TransactionFacadeHandler
+	streamKeepAliveInterval time.Duration
 	*baseGroup
 }
 
@@ -25,18 +30,23 @@ func NewTransactionGroup(facadeHandler data.FacadeHandler) (*transactionGroup, e
 	}
 
 	tg := &transactionGroup{
-		facade:    facade,
-		baseGroup: &baseGroup{},
+		facade:                  facade,
+		streamKeepAliveInterval: defaultStreamKeepAliveInterval,
+		baseGroup:               &baseGroup{},
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
 		{Path: "/send", Handler: tg.sendTransaction, Method: http.MethodPost},
+		{Path: "/send-and-wait", Handler: tg.sendTransactionAndWait, Method: http.MethodPost},
 		{Path: "/simulate", Handler: tg.simulateTransaction, Method: http.MethodPost},
 		{Path: "/send-multiple", Handler: tg.sendMultipleTransactions, Method: http.MethodPost},
 		{Path: "/send-user-funds", Handler: tg.sendUserFunds, Method: http.MethodPost},
+		{Path: "/relay", Handler: tg.relayTransaction, Method: http.MethodPost},
+		{Path: "/relayer/:sender", Handler: tg.getRelayerAddress, Method: http.MethodGet},
 		{Path: "/cost", Handler: tg.requestTransactionCost, Method: http.MethodPost},
 		{Path: "/:txhash/status", Handler: tg.getTransactionStatus, Method: http.MethodGet},
 		{Path: "/:txhash/process-status", Handler: tg.getProcessedTransactionStatus, Method: http.MethodGet},
+		{Path: "/:txhash/watch", Handler: tg.watchTransactionStatus, Method: http.MethodGet},
 		{Path: "/:txhash", Handler: tg.getTransaction, Method: http.MethodGet},
 		{Path: "/pool", Handler: tg.getTransactionsPool, Method: http.MethodGet},
 	}
@@ -60,15 +70,103 @@ func (group *transactionGroup) sendTransaction(c *gin.Context) {
 		return
 	}
 
-	statusCode, txHash, err := group.facade.SendTransaction(&tx)
+	statusCode, txHash, err := group.facade.SendTransaction(c.Request.Context(), &tx)
 	if err != nil {
-		shared.RespondWith(c, statusCode, nil, err.Error(), data.ReturnCodeInternalError)
+		shared.RespondWith(c, statusCode, nil, err.Error(), getSendTransactionErrorCode(err))
 		return
 	}
 
 	shared.RespondWith(c, http.StatusOK, gin.H{"txHash": txHash}, "", data.ReturnCodeSuccess)
 }
 
+// getSendTransactionErrorCode returns the precise code of a transaction rejected by the pre-flight checks, or the
+// internal error code otherwise
+func getSendTransactionErrorCode(err error) data.ReturnCode {
+	preflightErr, ok := err.(*errors.ErrTxPreflightCheckFailed)
+	if ok {
+		return preflightErr.Code
+	}
+
+	return data.ReturnCodeInternalError
+}
+
+// sendTransactionAndWait will receive a transaction from the client, propagate it for processing and wait for its
+// final status. If the final status is not reached in time, the last known status is returned, with 202 status code
+func (group *transactionGroup) sendTransactionAndWait(c *gin.Context) {
+	var tx = data.Transaction{}
+	err := c.ShouldBindJSON(&tx)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	statusCode, statusEvent, err := group.facade.SendTransactionAndWait(c.Request.Context(), &tx)
+	if err != nil {
+		shared.RespondWith(c, statusCode, nil, err.Error(), getSendTransactionErrorCode(err))
+		return
+	}
+
+	responseCode := http.StatusOK
+	if !statusEvent.IsFinal {
+		responseCode = http.StatusAccepted
+	}
+
+	shared.RespondWith(
+		c,
+		responseCode,
+		gin.H{"txHash": statusEvent.TxHash, "status": statusEvent.Status, "reason": statusEvent.Reason, "isFinal": statusEvent.IsFinal},
+		"",
+		data.ReturnCodeSuccess,
+	)
+}
+
+// watchTransactionStatus streams each status transition of a transaction, until its final status is reached. The
+// transitions are sent over WebSocket if the client asks for a protocol upgrade, or as Server-Sent Events otherwise
+func (group *transactionGroup) watchTransactionStatus(c *gin.Context) {
+	txHash := c.Param("txhash")
+	if txHash == "" {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrTransactionHashMissing.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	subscription, err := group.facade.WatchTransactionStatus(txHash)
+	if err == errors.ErrTooManyTransactionWatchers {
+		shared.RespondWith(c, http.StatusServiceUnavailable, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+	defer subscription.Close()
+
+	produceMessage := func(ctx context.Context) (*streamMessage, error) {
+		statusEvent, err := subscription.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return &streamMessage{
+			event:   transactionStatusEventName,
+			payload: statusEvent,
+			isLast:  statusEvent.IsFinal,
+		}, nil
+	}
+
+	if isWebSocketRequest(c) {
+		streamOverWebSocket(c, group.streamKeepAliveInterval, produceMessage)
+		return
+	}
+
+	streamServerSentEvents(c, group.streamKeepAliveInterval, produceMessage)
+}
+
 // sendUserFunds will receive an address from the client and propagate a transaction for sending some ERD to that address
 func (group *transactionGroup) sendUserFunds(c *gin.Context) {
 	if !group.facade.IsFaucetEnabled() {
@@ -95,8 +193,14 @@ func (group *transactionGroup) sendUserFunds(c *gin.Context) {
 		return
 	}
 
-	err = group.facade.SendUserFunds(gtx.Receiver, gtx.Value)
+	txHashes, err := group.facade.SendUserFunds(c.Request.Context(), gtx.Receiver, gtx.Value, c.ClientIP())
 	if err != nil {
+		_, isLimitReached := err.(*errors.ErrFaucetLimitReached)
+		if isLimitReached {
+			shared.RespondWith(c, http.StatusTooManyRequests, nil, err.Error(), data.ReturnCodeRequestError)
+			return
+		}
+
 		shared.RespondWith(
 			c,
 			http.StatusInternalServerError,
@@ -107,7 +211,64 @@ func (group *transactionGroup) sendUserFunds(c *gin.Context) {
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"message": "ok"}, "", data.ReturnCodeSuccess)
+	shared.RespondWith(c, http.StatusOK, gin.H{"message": "ok", "txHashes": txHashes}, "", data.ReturnCodeSuccess)
+}
+
+// relayTransaction will receive a relayed transaction signed by its sender, add the relayer signature and propagate
+// it for processing
+func (group *transactionGroup) relayTransaction(c *gin.Context) {
+	if !group.facade.IsRelayerEnabled() {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrRelayerNotEnabled.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	var tx = data.Transaction{}
+	err := c.ShouldBindJSON(&tx)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	statusCode, txHash, err := group.facade.RelayTransaction(c.Request.Context(), &tx)
+	if err != nil {
+		internalCode := getSendTransactionErrorCode(err)
+		if statusCode != http.StatusInternalServerError && internalCode == data.ReturnCodeInternalError {
+			internalCode = data.ReturnCodeRequestError
+		}
+		shared.RespondWith(c, statusCode, nil, err.Error(), internalCode)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"txHash": txHash}, "", data.ReturnCodeSuccess)
+}
+
+// getRelayerAddress returns the relayer address to be set by the provided sender in its relayed transactions
+func (group *transactionGroup) getRelayerAddress(c *gin.Context) {
+	if !group.facade.IsRelayerEnabled() {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrRelayerNotEnabled.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	sender := c.Param("sender")
+	relayerAddress, err := group.facade.GetRelayerAddress(sender)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrRelayerAddress.Error(), err.Error()),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"relayer": relayerAddress}, "", data.ReturnCodeSuccess)
 }
 
 // sendMultipleTransactions will send multiple transactions at once
@@ -125,7 +286,7 @@ func (group *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 		return
 	}
 
-	response, err := group.facade.SendMultipleTransactions(txs)
+	response, err := group.facade.SendMultipleTransactions(c.Request.Context(), txs)
 	if err != nil {
 		shared.RespondWith(
 			c,
@@ -143,6 +304,7 @@ func (group *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 		gin.H{
 			"numOfSentTxs": response.NumOfTxs,
 			"txsHashes":    response.TxsHashes,
+			"txsResults":   response.TxsResults,
 		},
 		"",
 		data.ReturnCodeSuccess,
@@ -170,7 +332,7 @@ func (group *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
-	simulationResponse, err := group.facade.SimulateTransaction(&tx, options.CheckSignature)
+	simulationResponse, err := group.facade.SimulateTransaction(c.Request.Context(), &tx, options.CheckSignature)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -197,7 +359,7 @@ func (group *transactionGroup) requestTransactionCost(c *gin.Context) {
 		return
 	}
 
-	cost, err := group.facade.TransactionCostRequest(&tx)
+	cost, err := group.facade.TransactionCostRequest(c.Request.Context(), &tx)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -210,7 +372,7 @@ func (group *transactionGroup) requestTransactionCost(c *gin.Context) {
 func (group *transactionGroup) getTransactionStatus(c *gin.Context) {
 	txHash := c.Param("txhash")
 	sender := c.Request.URL.Query().Get("sender")
-	txStatus, err := group.facade.GetTransactionStatus(txHash, sender)
+	txStatus, err := group.facade.GetTransactionStatus(c.Request.Context(), txHash, sender)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -239,7 +401,7 @@ func (group *transactionGroup) getTransaction(c *gin.Context) {
 		return
 	}
 
-	tx, err := group.facade.GetTransaction(txHash, options.WithResults)
+	tx, err := group.facade.GetTransaction(c.Request.Context(), txHash, options.WithResults)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -255,7 +417,7 @@ func (group *transactionGroup) getProcessedTransactionStatus(c *gin.Context) {
 		return
 	}
 
-	status, err := group.facade.GetProcessedTransactionStatus(txHash)
+	status, err := group.facade.GetProcessedTransactionStatus(c.Request.Context(), txHash)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -265,7 +427,7 @@ func (group *transactionGroup) getProcessedTransactionStatus(c *gin.Context) {
 }
 
 func getTransactionByHashAndSenderAddress(c *gin.Context, ef TransactionFacadeHandler, txHash string, sndAddr string, withEvents bool) {
-	tx, statusCode, err := ef.GetTransactionByHashAndSenderAddress(txHash, sndAddr, withEvents)
+	tx, statusCode, err := ef.GetTransactionByHashAndSenderAddress(c.Request.Context(), txHash, sndAddr, withEvents)
 	if err != nil {
 		internalCode := data.ReturnCodeInternalError
 		if statusCode == http.StatusBadRequest {
@@ -365,7 +527,7 @@ func validateFields(fields string) error {
 }
 
 func getTxPool(c *gin.Context, ef TransactionFacadeHandler, fields string) {
-	txPool, err := ef.GetTransactionsPool(fields)
+	txPool, err := ef.GetTransactionsPool(c.Request.Context(), fields)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -375,7 +537,7 @@ func getTxPool(c *gin.Context, ef TransactionFacadeHandler, fields string) {
 }
 
 func getTxPoolForShard(c *gin.Context, ef TransactionFacadeHandler, shardID uint32, fields string) {
-	txPool, err := ef.GetTransactionsPoolForShard(shardID, fields)
+	txPool, err := ef.GetTransactionsPoolForShard(c.Request.Context(), shardID, fields)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -385,7 +547,7 @@ func getTxPoolForShard(c *gin.Context, ef TransactionFacadeHandler, shardID uint
 }
 
 func getLastTxPoolNonceForSender(c *gin.Context, ef TransactionFacadeHandler, sender string) {
-	lastNonce, err := ef.GetLastPoolNonceForSender(sender)
+	lastNonce, err := ef.GetLastPoolNonceForSender(c.Request.Context(), sender)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -395,7 +557,7 @@ func getLastTxPoolNonceForSender(c *gin.Context, ef TransactionFacadeHandler, se
 }
 
 func getTxPoolNonceGapsForSender(c *gin.Context, ef TransactionFacadeHandler, sender string) {
-	nonceGaps, err := ef.GetTransactionsPoolNonceGapsForSender(sender)
+	nonceGaps, err := ef.GetTransactionsPoolNonceGapsForSender(c.Request.Context(), sender)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -405,7 +567,7 @@ func getTxPoolNonceGapsForSender(c *gin.Context, ef TransactionFacadeHandler, se
 }
 
 func getTxPoolForSender(c *gin.Context, ef TransactionFacadeHandler, sender, fields string) {
-	txPool, err := ef.GetTransactionsPoolForSender(sender, fields)
+	txPool, err := ef.GetTransactionsPoolForSender(c.Request.Context(), sender, fields)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -2,12 +2,15 @@ package groups_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
@@ -15,6 +18,7 @@ import (
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
 )
 
 const transactionsPath = "/transaction"
@@ -30,7 +34,8 @@ type TxHashResponse struct {
 }
 
 type numOfSentTxsResponseData struct {
-	Num uint64 `json:"numOfSentTxs"`
+	Num        uint64                        `json:"numOfSentTxs"`
+	TxsResults []*data.TransactionSendResult `json:"txsResults"`
 }
 
 // MultiTxsResponse structure
@@ -155,6 +160,34 @@ func TestSendTransaction_ErrorWhenFacadeSendTransactionError(t *testing.T) {
 
 	assert.Equal(t, http.StatusInternalServerError, resp.Code)
 	assert.Contains(t, response.Error, errorString)
+	assert.Equal(t, string(data.ReturnCodeInternalError), response.Code)
+}
+
+func TestSendTransaction_PreflightCheckFailureShouldReturnItsCode(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+			return http.StatusBadRequest, "", &apiErrors.ErrTxPreflightCheckFailed{
+				Code:   data.ReturnCodeNonceTooLow,
+				Reason: "nonce 4 is lower than the sender's account nonce 5",
+			}
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("POST", "/transaction/send", bytes.NewBuffer([]byte(`{"nonce":4}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, string(data.ReturnCodeNonceTooLow), response.Code)
+	assert.Contains(t, response.Error, "lower than the sender's account nonce")
 }
 
 func TestSendTransaction_ReturnsSuccessfully(t *testing.T) {
@@ -370,6 +403,10 @@ func TestSendMultipleTransactions_ReturnsSuccessfully(t *testing.T) {
 			return data.MultipleTransactionsResponseData{
 				NumOfTxs:  10,
 				TxsHashes: nil,
+				TxsResults: []*data.TransactionSendResult{
+					{Index: 0, TxHash: txHash},
+					{Index: 1, Error: &data.TransactionSendError{Type: data.TxSendErrorValidation, Message: "no chainID"}},
+				},
 			}, nil
 		},
 	}
@@ -398,6 +435,8 @@ func TestSendMultipleTransactions_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.Code)
 	assert.Empty(t, response.Error)
 	assert.Equal(t, uint64(10), response.Data.Num)
+	assert.Equal(t, txHash, response.Data.TxsResults[0].TxHash)
+	assert.Equal(t, data.TxSendErrorValidation, response.Data.TxsResults[1].Error.Type)
 }
 
 func TestSendUserFunds_ErrorWhenFacadeSendUserFundsError(t *testing.T) {
@@ -407,8 +446,8 @@ func TestSendUserFunds_ErrorWhenFacadeSendUserFundsError(t *testing.T) {
 	errorString := "send user funds error"
 
 	facade := &mock.FacadeStub{
-		SendUserFundsCalled: func(receiver string, value *big.Int) error {
-			return errors.New(errorString)
+		SendUserFundsCalled: func(receiver string, value *big.Int, clientIP string) ([]string, error) {
+			return nil, errors.New(errorString)
 		},
 	}
 
@@ -437,8 +476,8 @@ func TestSendUserFunds_ReturnsSuccessfully(t *testing.T) {
 	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
 
 	facade := &mock.FacadeStub{
-		SendUserFundsCalled: func(receiver string, value *big.Int) error {
-			return nil
+		SendUserFundsCalled: func(receiver string, value *big.Int, clientIP string) ([]string, error) {
+			return []string{"hash"}, nil
 		},
 	}
 
@@ -461,6 +500,38 @@ func TestSendUserFunds_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, response.Error, "")
 }
 
+func TestSendUserFunds_FaucetLimitReachedShouldReturnTooManyRequests(t *testing.T) {
+	t.Parallel()
+
+	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+
+	var callClientIP string
+	facade := &mock.FacadeStub{
+		SendUserFundsCalled: func(receiver string, value *big.Int, clientIP string) ([]string, error) {
+			callClientIP = clientIP
+			return nil, &apiErrors.ErrFaucetLimitReached{Reason: "cooldown"}
+		},
+	}
+
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	jsonStr := fmt.Sprintf(`{"receiver":"%s"}`, receiver)
+	req, _ := http.NewRequest("POST", "/transaction/send-user-funds", bytes.NewBuffer([]byte(jsonStr)))
+	req.RemoteAddr = "10.0.0.1:1234"
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Equal(t, "faucet limit reached: cooldown", response.Error)
+	assert.Equal(t, "10.0.0.1", callClientIP)
+}
+
 func TestSendUserFunds_NilValue(t *testing.T) {
 	t.Parallel()
 
@@ -468,9 +539,9 @@ func TestSendUserFunds_NilValue(t *testing.T) {
 
 	var callValue *big.Int
 	facade := &mock.FacadeStub{
-		SendUserFundsCalled: func(receiver string, value *big.Int) error {
+		SendUserFundsCalled: func(receiver string, value *big.Int, clientIP string) ([]string, error) {
 			callValue = value
-			return nil
+			return nil, nil
 		},
 	}
 
@@ -499,9 +570,9 @@ func TestSendUserFunds_CorrectValue(t *testing.T) {
 
 	var callValue *big.Int
 	facade := &mock.FacadeStub{
-		SendUserFundsCalled: func(receiver string, value *big.Int) error {
+		SendUserFundsCalled: func(receiver string, value *big.Int, clientIP string) ([]string, error) {
 			callValue = value
-			return nil
+			return nil, nil
 		},
 	}
 	transactionsGroup, err := groups.NewTransactionGroup(facade)
@@ -778,7 +849,7 @@ func TestTransactionGroup_getProcessedTransactionStatus(t *testing.T) {
 		t.Parallel()
 
 		facade := &mock.FacadeStub{
-			GetProcessedTransactionStatusHandler: func(txHash string) (*data.ProcessStatusResponse, error) {
+			GetProcessedTransactionStatusHandler: func(_ context.Context, txHash string) (*data.ProcessStatusResponse, error) {
 				assert.Equal(t, hash, txHash)
 				return &data.ProcessStatusResponse{}, expectedErr
 			},
@@ -806,7 +877,7 @@ func TestTransactionGroup_getProcessedTransactionStatus(t *testing.T) {
 			Reason: "some error",
 		}
 		facade := &mock.FacadeStub{
-			GetProcessedTransactionStatusHandler: func(txHash string) (*data.ProcessStatusResponse, error) {
+			GetProcessedTransactionStatusHandler: func(_ context.Context, txHash string) (*data.ProcessStatusResponse, error) {
 				assert.Equal(t, hash, txHash)
 				return status, nil
 			},
@@ -829,3 +900,326 @@ func TestTransactionGroup_getProcessedTransactionStatus(t *testing.T) {
 		assert.Equal(t, status.Reason, response.Data.Reason)
 	})
 }
+
+type sendAndWaitResponse struct {
+	GeneralResponse
+	Data data.TransactionStatusEvent `json:"data"`
+}
+
+func sendTransactionAndWait(t *testing.T, facade *mock.FacadeStub, body string) (int, sendAndWaitResponse) {
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("POST", "/transaction/send-and-wait", bytes.NewBuffer([]byte(body)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := sendAndWaitResponse{}
+	loadResponse(resp.Body, &response)
+
+	return resp.Code, response
+}
+
+func TestSendTransactionAndWait(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		statusCode, response := sendTransactionAndWait(t, &mock.FacadeStub{}, `{"nonce": "not a number"}`)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+	})
+	t.Run("send error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SendTransactionAndWaitHandler: func(_ context.Context, tx *data.Transaction) (int, *data.TransactionStatusEvent, error) {
+				return http.StatusBadRequest, nil, errors.New("invalid signature")
+			},
+		}
+
+		statusCode, response := sendTransactionAndWait(t, facade, `{"nonce": 1}`)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Equal(t, "invalid signature", response.Error)
+	})
+	t.Run("final status should return 200", func(t *testing.T) {
+		t.Parallel()
+
+		expectedEvent := data.TransactionStatusEvent{TxHash: "hash", Status: "fail", Reason: "out of gas", IsFinal: true}
+		facade := &mock.FacadeStub{
+			SendTransactionAndWaitHandler: func(_ context.Context, tx *data.Transaction) (int, *data.TransactionStatusEvent, error) {
+				require.Equal(t, uint64(1), tx.Nonce)
+				return http.StatusOK, &expectedEvent, nil
+			},
+		}
+
+		statusCode, response := sendTransactionAndWait(t, facade, `{"nonce": 1}`)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, string(data.ReturnCodeSuccess), response.Code)
+		require.Equal(t, expectedEvent, response.Data)
+	})
+	t.Run("status not final should return 202", func(t *testing.T) {
+		t.Parallel()
+
+		expectedEvent := data.TransactionStatusEvent{TxHash: "hash", Status: "partially-executed"}
+		facade := &mock.FacadeStub{
+			SendTransactionAndWaitHandler: func(_ context.Context, tx *data.Transaction) (int, *data.TransactionStatusEvent, error) {
+				return http.StatusOK, &expectedEvent, nil
+			},
+		}
+
+		statusCode, response := sendTransactionAndWait(t, facade, `{"nonce": 1}`)
+		require.Equal(t, http.StatusAccepted, statusCode)
+		require.Equal(t, expectedEvent, response.Data)
+	})
+}
+
+// createTransactionStatusSubscription returns a subscription stub that emits the provided statuses, the last one
+// being final
+func createTransactionStatusSubscription(txHash string, statuses ...string) *mock.TransactionStatusSubscriptionStub {
+	index := 0
+	return &mock.TransactionStatusSubscriptionStub{
+		NextCalled: func(ctx context.Context) (*data.TransactionStatusEvent, error) {
+			if index >= len(statuses) {
+				return nil, errors.New("watch finished")
+			}
+
+			index++
+			return &data.TransactionStatusEvent{
+				TxHash:  txHash,
+				Status:  statuses[index-1],
+				IsFinal: index == len(statuses),
+			}, nil
+		},
+	}
+}
+
+func TestWatchTransactionStatus(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		WatchTransactionStatusHandler: func(txHash string) (data.TransactionStatusSubscriptionHandler, error) {
+			switch txHash {
+			case "expired":
+				return &mock.TransactionStatusSubscriptionStub{
+					NextCalled: func(ctx context.Context) (*data.TransactionStatusEvent, error) {
+						return nil, errors.New("transaction status watch expired")
+					},
+				}, nil
+			case "busy":
+				return nil, apiErrors.ErrTooManyTransactionWatchers
+			default:
+				return createTransactionStatusSubscription(txHash, "pending", "partially-executed", "success"), nil
+			}
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(startProxyServer(transactionsGroup, transactionsPath))
+	t.Cleanup(httpServer.Close)
+
+	t.Run("should stream the status transitions as events", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := http.Get(httpServer.URL + "/transaction/hash/watch")
+		require.NoError(t, err)
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		body := new(bytes.Buffer)
+		_, _ = body.ReadFrom(resp.Body)
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+		events := strings.Split(strings.TrimSpace(body.String()), "\n\n")
+		require.Equal(t, []string{
+			`event: status` + "\n" + `data: {"txHash":"hash","status":"pending","isFinal":false}`,
+			`event: status` + "\n" + `data: {"txHash":"hash","status":"partially-executed","isFinal":false}`,
+			`event: status` + "\n" + `data: {"txHash":"hash","status":"success","isFinal":true}`,
+		}, events)
+	})
+	t.Run("watch error should be sent as an error event", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := http.Get(httpServer.URL + "/transaction/expired/watch")
+		require.NoError(t, err)
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		body := new(bytes.Buffer)
+		_, _ = body.ReadFrom(resp.Body)
+
+		require.Equal(t, `event: error`+"\n"+`data: {"data":null,"error":"transaction status watch expired","code":"internal_issue"}`,
+			strings.TrimSpace(body.String()))
+	})
+	t.Run("too many watchers should return 503", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := http.Get(httpServer.URL + "/transaction/busy/watch")
+		require.NoError(t, err)
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+
+		response := data.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		require.Equal(t, apiErrors.ErrTooManyTransactionWatchers.Error(), response.Error)
+	})
+	t.Run("should stream the status transitions over WebSocket", func(t *testing.T) {
+		t.Parallel()
+
+		webSocketURL := "ws" + strings.TrimPrefix(httpServer.URL, "http") + "/transaction/hash/watch"
+		conn, err := websocket.Dial(webSocketURL, "", httpServer.URL)
+		require.NoError(t, err)
+		defer func() {
+			_ = conn.Close()
+		}()
+
+		for _, expectedStatus := range []string{"pending", "partially-executed", "success"} {
+			event := data.TransactionStatusEvent{}
+			require.NoError(t, websocket.JSON.Receive(conn, &event))
+			require.Equal(t, expectedStatus, event.Status)
+		}
+
+		// the connection is closed by the server after the final status
+		_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+		event := data.TransactionStatusEvent{}
+		require.Error(t, websocket.JSON.Receive(conn, &event))
+	})
+}
+
+type relayerAddressResponse struct {
+	Data struct {
+		Relayer string `json:"relayer"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type relayTransactionResponse struct {
+	Data struct {
+		TxHash string `json:"txHash"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func relayTransaction(t *testing.T, facade *mock.FacadeStub, body string) (int, relayTransactionResponse) {
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("POST", "/transaction/relay", bytes.NewBuffer([]byte(body)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := relayTransactionResponse{}
+	loadResponse(resp.Body, &response)
+
+	return resp.Code, response
+}
+
+func TestRelayTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("relayer not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			IsRelayerEnabledHandler: func() bool {
+				return false
+			},
+		}
+
+		statusCode, response := relayTransaction(t, facade, `{"nonce": 1}`)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Equal(t, apiErrors.ErrRelayerNotEnabled.Error(), response.Error)
+	})
+	t.Run("invalid transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		statusCode, response := relayTransaction(t, &mock.FacadeStub{}, `{"nonce": "not a number"}`)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, apiErrors.ErrValidation.Error())
+	})
+	t.Run("rejected transaction should return the status code of the relayer", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			RelayTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+				return http.StatusTooManyRequests, "", errors.New("gas budget exceeded")
+			},
+		}
+
+		statusCode, response := relayTransaction(t, facade, `{"nonce": 1}`)
+		require.Equal(t, http.StatusTooManyRequests, statusCode)
+		require.Equal(t, "gas budget exceeded", response.Error)
+		require.Equal(t, string(data.ReturnCodeRequestError), response.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			RelayTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+				require.Equal(t, "relayer", tx.RelayerAddr)
+				return http.StatusOK, "hash", nil
+			},
+		}
+
+		statusCode, response := relayTransaction(t, facade, `{"nonce": 1, "relayer": "relayer"}`)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, "hash", response.Data.TxHash)
+	})
+}
+
+func TestGetRelayerAddress(t *testing.T) {
+	t.Parallel()
+
+	getRelayerAddress := func(facade *mock.FacadeStub, sender string) (int, relayerAddressResponse) {
+		transactionsGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+		ws := startProxyServer(transactionsGroup, transactionsPath)
+
+		req, _ := http.NewRequest("GET", "/transaction/relayer/"+sender, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := relayerAddressResponse{}
+		loadResponse(resp.Body, &response)
+
+		return resp.Code, response
+	}
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetRelayerAddressHandler: func(sender string) (string, error) {
+				return "", errors.New("no relayer account")
+			},
+		}
+
+		statusCode, response := getRelayerAddress(facade, "sender")
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, apiErrors.ErrRelayerAddress.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetRelayerAddressHandler: func(sender string) (string, error) {
+				require.Equal(t, "sender", sender)
+				return "relayer", nil
+			},
+		}
+
+		statusCode, response := getRelayerAddress(facade, "sender")
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, "relayer", response.Data.Relayer)
+	})
+}
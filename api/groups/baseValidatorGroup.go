@@ -36,7 +36,7 @@ func NewValidatorGroup(facadeHandler data.FacadeHandler) (*validatorGroup, error
 
 // statistics returns the validator statistics
 func (group *validatorGroup) statistics(c *gin.Context) {
-	validatorStatistics, err := group.facade.ValidatorStatistics()
+	validatorStatistics, err := group.facade.ValidatorStatistics(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
 		return
@@ -46,7 +46,7 @@ func (group *validatorGroup) statistics(c *gin.Context) {
 }
 
 func (group *validatorGroup) auctionList(c *gin.Context) {
-	auctionList, err := group.facade.AuctionList()
+	auctionList, err := group.facade.AuctionList(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
 		return
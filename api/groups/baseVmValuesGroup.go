@@ -113,7 +113,7 @@ func (group *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputAp
 		return nil, data.BlockInfo{}, err
 	}
 
-	vmOutput, blockInfo, err := group.facade.ExecuteSCQuery(command)
+	vmOutput, blockInfo, err := group.facade.ExecuteSCQuery(context.Request.Context(), command)
 	if err != nil {
 		return nil, data.BlockInfo{}, err
 	}
@@ -2,6 +2,7 @@ package groups_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -55,7 +56,7 @@ func TestGetHex_ShouldWork(t *testing.T) {
 	valueBuff, _ := hex.DecodeString("DEADBEEF")
 
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+		ExecuteSCQueryHandler: func(_ context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{valueBuff},
 			}, data.BlockInfo{}, nil
@@ -82,7 +83,7 @@ func TestGetString_ShouldWork(t *testing.T) {
 	valueBuff := "DEADBEEF"
 
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+		ExecuteSCQueryHandler: func(_ context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{[]byte(valueBuff)},
 			}, data.BlockInfo{}, nil
@@ -109,7 +110,7 @@ func TestGetInt_ShouldWork(t *testing.T) {
 	value := "1234567"
 
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+		ExecuteSCQueryHandler: func(_ context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
 			returnData := big.NewInt(0)
 			returnData.SetString(value, 10)
 			return &vm.VMOutputApi{
@@ -136,7 +137,7 @@ func TestQuery_ShouldWork(t *testing.T) {
 	t.Parallel()
 
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+		ExecuteSCQueryHandler: func(_ context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
 
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{big.NewInt(42).Bytes()},
@@ -168,7 +169,7 @@ func TestQuery_ShouldWorkWithCoordinates(t *testing.T) {
 		RootHash: "block rootHash",
 	}
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+		ExecuteSCQueryHandler: func(_ context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
 			require.Equal(t, providedNonce, query.BlockNonce.Value)
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{big.NewInt(42).Bytes()},
@@ -208,7 +209,7 @@ func TestAllRoutes_FacadeErrorsShouldErr(t *testing.T) {
 
 	errExpected := errors.New("some random error")
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+		ExecuteSCQueryHandler: func(_ context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
 			return nil, data.BlockInfo{}, errExpected
 		},
 	}
@@ -227,7 +228,7 @@ func TestAllRoutes_WhenBadArgumentsShouldErr(t *testing.T) {
 
 	errExpected := errors.New("not a valid hex string")
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+		ExecuteSCQueryHandler: func(_ context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
 			return &vm.VMOutputApi{}, data.BlockInfo{}, nil
 		},
 	}
@@ -246,7 +247,7 @@ func TestAllRoutes_WhenNoVMReturnDataShouldErr(t *testing.T) {
 
 	errExpected := errors.New("no return data")
 	facade := mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+		ExecuteSCQueryHandler: func(_ context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
 			return &vm.VMOutputApi{}, data.BlockInfo{}, nil
 		},
 	}
@@ -264,7 +265,7 @@ func TestAllRoutes_WhenBadJsonShouldErr(t *testing.T) {
 	t.Parallel()
 
 	facade := mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+		ExecuteSCQueryHandler: func(_ context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
 			return &vm.VMOutputApi{}, data.BlockInfo{}, nil
 		},
 	}
@@ -276,7 +277,7 @@ func TestAllRoutes_WithSameScStateAndShouldBySyncedFilled(t *testing.T) {
 	t.Parallel()
 
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
+		ExecuteSCQueryHandler: func(_ context.Context, query *data.SCQuery) (vmOutput *vm.VMOutputApi, blockInfo data.BlockInfo, e error) {
 			require.True(t, query.ShouldBeSynced)
 			require.True(t, query.SameScState)
 			return &vm.VMOutputApi{}, data.BlockInfo{}, nil
@@ -16,3 +16,24 @@ var ErrWrongTypeAssertion = errors.New("wrong type assertion")
 
 // ErrForcedShardIDCannotBeProvided signals that the forced shard id cannot be provided for a different address other than the system account address
 var ErrForcedShardIDCannotBeProvided = errors.New("forced shard id parameter can only be provided for system accounts")
+
+// ErrInvalidTimestampRange signals that the provided timestamp range is invalid
+var ErrInvalidTimestampRange = errors.New("invalid timestamp range")
+
+// ErrInvalidNonceRange signals that the provided nonce range is invalid
+var ErrInvalidNonceRange = errors.New("invalid nonce range")
+
+// ErrInvalidPageSize signals that the provided page size is invalid
+var ErrInvalidPageSize = errors.New("invalid page size")
+
+// ErrNilHttpHandler signals that a nil http handler has been provided
+var ErrNilHttpHandler = errors.New("nil http handler")
+
+// ErrEmptyBatch signals that a batch request without paths has been received
+var ErrEmptyBatch = errors.New("empty batch")
+
+// ErrBatchTooLarge signals that a batch request holds too many paths
+var ErrBatchTooLarge = errors.New("batch too large")
+
+// ErrInvalidBatchPath signals that a path of a batch request is not a relative API path
+var ErrInvalidBatchPath = errors.New("invalid batch path")
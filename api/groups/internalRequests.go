@@ -0,0 +1,75 @@
+package groups
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/gin-gonic/gin"
+)
+
+// streamingRoutes holds the routes streaming their responses, which cannot be served internally, as the responseRecorder
+// can neither flush nor hijack the connection
+var streamingRoutes = []string{
+	"/hyperblock/stream",
+	"/hyperblock/ws",
+	"/transaction/*/watch",
+}
+
+// isStreamingRoute returns true if the provided API path, without the version, is served by a streaming route
+func isStreamingRoute(apiPath string) bool {
+	for _, route := range streamingRoutes {
+		isMatch, _ := path.Match(route, apiPath)
+		if isMatch {
+			return true
+		}
+	}
+
+	return false
+}
+
+// createInternalRequest builds a request to be served by the REST routes within the same process. It carries the
+// headers and the remote address of the original request, so the REST route authenticates and rate limits the same
+// client
+func createInternalRequest(c *gin.Context, httpMethod string, target string, body io.Reader) (*http.Request, error) {
+	request, err := http.NewRequestWithContext(c.Request.Context(), httpMethod, target, body)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header = c.Request.Header.Clone()
+	request.Header.Del("Content-Length")
+	request.RemoteAddr = c.Request.RemoteAddr
+
+	return request, nil
+}
+
+// responseRecorder is an in-memory http.ResponseWriter collecting the response of a REST route
+type responseRecorder struct {
+	header     http.Header
+	body       bytes.Buffer
+	statusCode int
+}
+
+func newResponseRecorder() *responseRecorder {
+	return &responseRecorder{
+		header:     make(http.Header),
+		statusCode: http.StatusOK,
+	}
+}
+
+// Header returns the headers of the response
+func (rr *responseRecorder) Header() http.Header {
+	return rr.header
+}
+
+// Write appends the provided bytes to the response body
+func (rr *responseRecorder) Write(buff []byte) (int, error) {
+	return rr.body.Write(buff)
+}
+
+// WriteHeader records the status code of the response
+func (rr *responseRecorder) WriteHeader(statusCode int) {
+	rr.statusCode = statusCode
+}
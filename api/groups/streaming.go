@@ -0,0 +1,173 @@
+package groups
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	headerLastEventID              = "Last-Event-ID"
+	errorEventName                 = "error"
+	defaultStreamKeepAliveInterval = 15 * time.Second
+)
+
+// streamMessage holds a message to be sent to a streaming client
+type streamMessage struct {
+	id      string
+	event   string
+	payload interface{}
+	isLast  bool
+}
+
+// streamMessageProducer waits for the next message to be streamed. The waiting is interrupted when the context is done
+type streamMessageProducer func(ctx context.Context) (*streamMessage, error)
+
+// streamServerSentEvents sends the produced messages as Server-Sent Events, until the client disconnects, the last
+// message is sent or an error occurs. The error is sent as an error event
+func streamServerSentEvents(c *gin.Context, keepAliveInterval time.Duration, produceMessage streamMessageProducer) {
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	keepAlive := func() error {
+		_, errWrite := c.Writer.WriteString(": keep-alive\n\n")
+		c.Writer.Flush()
+		return errWrite
+	}
+
+	ctx := c.Request.Context()
+	for {
+		message, err := waitForStreamMessage(ctx, keepAliveInterval, produceMessage, keepAlive)
+		if err != nil {
+			if ctx.Err() == nil {
+				_ = writeServerSentEvent(c, "", errorEventName, createStreamErrorResponse(err))
+			}
+			return
+		}
+
+		err = writeServerSentEvent(c, message.id, message.event, message.payload)
+		if err != nil || message.isLast {
+			return
+		}
+	}
+}
+
+// streamOverWebSocket sends the produced messages over a WebSocket connection, one JSON message each, until the client
+// disconnects, the last message is sent or an error occurs. The error is sent as a JSON error response
+func streamOverWebSocket(c *gin.Context, keepAliveInterval time.Duration, produceMessage streamMessageProducer) {
+	// the server does not check the origin of the request, as the API is public
+	server := websocket.Server{
+		Handler: func(conn *websocket.Conn) {
+			sendWebSocketMessages(c.Request.Context(), conn, keepAliveInterval, produceMessage)
+		},
+	}
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+func sendWebSocketMessages(
+	ctx context.Context,
+	conn *websocket.Conn,
+	keepAliveInterval time.Duration,
+	produceMessage streamMessageProducer,
+) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// the hijacked connection is not monitored by the http server anymore, so the stream is stopped when reading
+	// from the connection fails. The messages sent by the client are ignored
+	go func() {
+		defer cancel()
+
+		var message []byte
+		for websocket.Message.Receive(conn, &message) == nil {
+		}
+	}()
+
+	// the messages are sent through the JSON codec, which sets its own payload type, so the default payload type
+	// of the connection is only used by the keep-alive pings
+	conn.PayloadType = websocket.PingFrame
+	keepAlive := func() error {
+		_, errWrite := conn.Write(nil)
+		return errWrite
+	}
+
+	for {
+		message, err := waitForStreamMessage(ctx, keepAliveInterval, produceMessage, keepAlive)
+		if err != nil {
+			if ctx.Err() == nil {
+				_ = websocket.JSON.Send(conn, createStreamErrorResponse(err))
+			}
+			return
+		}
+
+		err = websocket.JSON.Send(conn, message.payload)
+		if err != nil || message.isLast {
+			return
+		}
+	}
+}
+
+// waitForStreamMessage waits for the next message to be streamed, while keeping the connection alive
+func waitForStreamMessage(
+	ctx context.Context,
+	keepAliveInterval time.Duration,
+	produceMessage streamMessageProducer,
+	keepAlive func() error,
+) (*streamMessage, error) {
+	for {
+		waitCtx, cancel := context.WithTimeout(ctx, keepAliveInterval)
+		message, err := produceMessage(waitCtx)
+		cancel()
+
+		if !errors.Is(err, context.DeadlineExceeded) || ctx.Err() != nil {
+			return message, err
+		}
+
+		err = keepAlive()
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// isWebSocketRequest returns true if the client asked for the connection to be upgraded to the WebSocket protocol
+func isWebSocketRequest(c *gin.Context) bool {
+	return strings.EqualFold(c.GetHeader("Upgrade"), "websocket")
+}
+
+func writeServerSentEvent(c *gin.Context, id string, event string, payload interface{}) error {
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("event: %s\ndata: %s\n\n", event, payloadBytes)
+	if len(id) > 0 {
+		message = fmt.Sprintf("id: %s\n%s", id, message)
+	}
+
+	_, err = c.Writer.WriteString(message)
+	c.Writer.Flush()
+
+	return err
+}
+
+func createStreamErrorResponse(err error) data.GenericAPIResponse {
+	return data.GenericAPIResponse{
+		Data:  nil,
+		Error: err.Error(),
+		Code:  data.ReturnCodeInternalError,
+	}
+}
@@ -64,6 +64,30 @@ func parseHyperblockQueryOptions(c *gin.Context) (common.HyperblockQueryOptions,
 	}, nil
 }
 
+// parseHyperblockStreamFromNonce returns the nonce the hyperblock stream should start from. A reconnecting SSE client
+// sends the ID of the last received event, which is the nonce of the last received hyperblock
+func parseHyperblockStreamFromNonce(c *gin.Context) (core.OptionalUint64, error) {
+	fromNonce, err := parseUint64UrlParam(c, common.UrlParameterFromNonce)
+	if err != nil || fromNonce.HasValue {
+		return fromNonce, err
+	}
+
+	lastEventID := c.GetHeader(headerLastEventID)
+	if lastEventID == "" {
+		return core.OptionalUint64{}, nil
+	}
+
+	lastNonce, err := strconv.ParseUint(lastEventID, 10, 64)
+	if err != nil {
+		return core.OptionalUint64{}, err
+	}
+
+	return core.OptionalUint64{
+		Value:    lastNonce + 1,
+		HasValue: true,
+	}, nil
+}
+
 func parseAccountQueryOptions(c *gin.Context, address string) (common.AccountQueryOptions, error) {
 	onFinalBlock, err := parseBoolUrlParam(c, common.UrlParameterOnFinalBlock)
 	if err != nil {
@@ -143,6 +167,65 @@ func parseTransactionSimulationOptions(c *gin.Context) (common.TransactionSimula
 	return options, nil
 }
 
+func parseTransactionsHistoryOptions(c *gin.Context) (common.TransactionsHistoryOptions, error) {
+	fromTimestamp, err := parseUint64UrlParam(c, common.UrlParameterFromTimestamp)
+	if err != nil {
+		return common.TransactionsHistoryOptions{}, err
+	}
+
+	toTimestamp, err := parseUint64UrlParam(c, common.UrlParameterToTimestamp)
+	if err != nil {
+		return common.TransactionsHistoryOptions{}, err
+	}
+
+	if fromTimestamp.HasValue && toTimestamp.HasValue && fromTimestamp.Value > toTimestamp.Value {
+		return common.TransactionsHistoryOptions{}, ErrInvalidTimestampRange
+	}
+
+	fromNonce, err := parseUint64UrlParam(c, common.UrlParameterFromNonce)
+	if err != nil {
+		return common.TransactionsHistoryOptions{}, err
+	}
+
+	toNonce, err := parseUint64UrlParam(c, common.UrlParameterToNonce)
+	if err != nil {
+		return common.TransactionsHistoryOptions{}, err
+	}
+
+	if fromNonce.HasValue && toNonce.HasValue && fromNonce.Value > toNonce.Value {
+		return common.TransactionsHistoryOptions{}, ErrInvalidNonceRange
+	}
+
+	size, err := parseUint32UrlParam(c, common.UrlParameterSize)
+	if err != nil {
+		return common.TransactionsHistoryOptions{}, err
+	}
+
+	if size.HasValue && (size.Value == 0 || size.Value > common.MaxTransactionsHistoryPageSize) {
+		return common.TransactionsHistoryOptions{}, ErrInvalidPageSize
+	}
+
+	cursor := parseStringUrlParam(c, common.UrlParameterCursor)
+	if len(cursor) > 0 {
+		_, err = common.DecodeCursor(cursor)
+		if err != nil {
+			return common.TransactionsHistoryOptions{}, err
+		}
+	}
+
+	return common.TransactionsHistoryOptions{
+		Sender:        parseStringUrlParam(c, common.UrlParameterFilterSender),
+		Receiver:      parseStringUrlParam(c, common.UrlParameterFilterReceiver),
+		Token:         parseStringUrlParam(c, common.UrlParameterToken),
+		FromTimestamp: fromTimestamp,
+		ToTimestamp:   toTimestamp,
+		FromNonce:     fromNonce,
+		ToNonce:       toNonce,
+		Cursor:        cursor,
+		Size:          size.Value,
+	}, nil
+}
+
 func parseBoolUrlParam(c *gin.Context, name string) (bool, error) {
 	return parseBoolUrlParamWithDefault(c, name, false)
 }
@@ -0,0 +1,71 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/grpcapi/proxypb"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"google.golang.org/grpc/codes"
+)
+
+type accountService struct {
+	proxypb.UnimplementedAccountServiceServer
+	facade AccountFacadeHandler
+}
+
+// GetAccount returns the state of an account
+func (service *accountService) GetAccount(ctx context.Context, request *proxypb.GetAccountRequest) (*proxypb.GetAccountResponse, error) {
+	if len(request.GetAddress()) == 0 {
+		return nil, newStatusError(codes.InvalidArgument, apiErrors.ErrGetAccount, apiErrors.ErrEmptyAddress)
+	}
+
+	accountModel, err := service.facade.GetAccount(ctx, request.GetAddress(), getAccountQueryOptions(request))
+	if err != nil {
+		return nil, newStatusError(codes.Internal, apiErrors.ErrGetAccount, err)
+	}
+
+	account := accountModel.Account
+	return &proxypb.GetAccountResponse{
+		Account: &proxypb.Account{
+			Address:         account.Address,
+			Nonce:           account.Nonce,
+			Balance:         account.Balance,
+			Username:        account.Username,
+			Code:            account.Code,
+			CodeHash:        account.CodeHash,
+			RootHash:        account.RootHash,
+			CodeMetadata:    account.CodeMetadata,
+			DeveloperReward: account.DeveloperReward,
+			OwnerAddress:    account.OwnerAddress,
+		},
+		BlockInfo: newBlockInfo(accountModel.BlockInfo),
+	}, nil
+}
+
+// GetESDTTokens returns all the ESDT tokens of an account
+func (service *accountService) GetESDTTokens(ctx context.Context, request *proxypb.GetAccountRequest) (*proxypb.JsonResponse, error) {
+	if len(request.GetAddress()) == 0 {
+		return nil, newStatusError(codes.InvalidArgument, apiErrors.ErrGetESDTTokenData, apiErrors.ErrEmptyAddress)
+	}
+
+	tokens, err := service.facade.GetAllESDTTokens(ctx, request.GetAddress(), getAccountQueryOptions(request))
+	if err != nil {
+		return nil, newStatusError(codes.Internal, apiErrors.ErrGetESDTTokenData, err)
+	}
+
+	return newJsonResponse(tokens)
+}
+
+func getAccountQueryOptions(request *proxypb.GetAccountRequest) common.AccountQueryOptions {
+	options := common.AccountQueryOptions{
+		OnFinalBlock: request.GetOnFinalBlock(),
+		BlockHash:    request.GetBlockHash(),
+	}
+	if request.BlockNonce != nil {
+		options.BlockNonce = core.OptionalUint64{Value: request.GetBlockNonce(), HasValue: true}
+	}
+
+	return options
+}
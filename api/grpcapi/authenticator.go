@@ -0,0 +1,91 @@
+package grpcapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	authorizationMetadataKey = "authorization"
+	reflectionMethodsPrefix  = "/grpc.reflection."
+)
+
+// authenticator checks the Basic Authentication credentials sent in the metadata of the gRPC calls, against the
+// same credentials as the secured REST endpoints
+type authenticator struct {
+	credentialsChecker *shared.CredentialsChecker
+}
+
+func (auth *authenticator) unaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	err := auth.checkCredentials(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (auth *authenticator) streamInterceptor(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	err := auth.checkCredentials(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, stream)
+}
+
+func (auth *authenticator) checkCredentials(ctx context.Context, fullMethod string) error {
+	// the reflection service only describes the services, so the clients can discover them without credentials
+	if strings.HasPrefix(fullMethod, reflectionMethodsPrefix) {
+		return nil
+	}
+
+	if !auth.credentialsChecker.HasCredentials() {
+		return newStatusError(codes.Internal, nil, apiErrors.ErrNoCredentialsFound)
+	}
+
+	username, password, ok := getBasicAuth(ctx)
+	if !ok {
+		return newStatusError(codes.Unauthenticated, nil, apiErrors.ErrBasicAuthenticationRequired)
+	}
+
+	err := auth.credentialsChecker.Check(username, password)
+	if err != nil {
+		return newStatusError(codes.Unauthenticated, nil, err)
+	}
+
+	return nil
+}
+
+// getBasicAuth parses the authorization metadata the same way as the Authorization header of an HTTP request
+func getBasicAuth(ctx context.Context) (string, string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", "", false
+	}
+
+	request := &http.Request{
+		Header: http.Header{
+			"Authorization": md.Get(authorizationMetadataKey),
+		},
+	}
+
+	return request.BasicAuth()
+}
@@ -0,0 +1,175 @@
+package grpcapi
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/grpcapi/proxypb"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"google.golang.org/grpc/codes"
+)
+
+type blockService struct {
+	proxypb.UnimplementedBlockServiceServer
+	facade BlockFacadeHandler
+}
+
+// GetBlockByNonce returns the block of a shard with the provided nonce
+func (service *blockService) GetBlockByNonce(ctx context.Context, request *proxypb.GetBlockByNonceRequest) (*proxypb.BlockResponse, error) {
+	response, err := service.facade.GetBlockByNonce(ctx, request.GetShardId(), request.GetNonce(), getBlockQueryOptions(request.GetOptions()))
+	if err != nil {
+		return nil, newStatusError(codes.Internal, nil, err)
+	}
+
+	return newBlockResponse(&response.Data.Block)
+}
+
+// GetBlockByHash returns the block of a shard with the provided hash
+func (service *blockService) GetBlockByHash(ctx context.Context, request *proxypb.GetBlockByHashRequest) (*proxypb.BlockResponse, error) {
+	err := checkHash(request.GetHash())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := service.facade.GetBlockByHash(ctx, request.GetShardId(), request.GetHash(), getBlockQueryOptions(request.GetOptions()))
+	if err != nil {
+		return nil, newStatusError(codes.Internal, nil, err)
+	}
+
+	return newBlockResponse(&response.Data.Block)
+}
+
+// GetHyperblockByNonce returns the hyperblock with the provided nonce
+func (service *blockService) GetHyperblockByNonce(ctx context.Context, request *proxypb.GetHyperblockByNonceRequest) (*proxypb.HyperblockResponse, error) {
+	return service.getHyperblockByNonce(ctx, request.GetNonce(), getHyperblockQueryOptions(request.GetOptions()))
+}
+
+// GetHyperblockByHash returns the hyperblock with the provided hash
+func (service *blockService) GetHyperblockByHash(ctx context.Context, request *proxypb.GetHyperblockByHashRequest) (*proxypb.HyperblockResponse, error) {
+	err := checkHash(request.GetHash())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := service.facade.GetHyperBlockByHash(ctx, request.GetHash(), getHyperblockQueryOptions(request.GetOptions()))
+	if err != nil {
+		return nil, newStatusError(codes.Internal, nil, err)
+	}
+
+	return newHyperblockResponse(&response.Data.Hyperblock)
+}
+
+// StreamHyperblocksByNonceRange sends, in order, the hyperblocks with the nonces in the requested range. The stream
+// ends with an error as soon as a hyperblock cannot be fetched, for example when it was not produced yet
+func (service *blockService) StreamHyperblocksByNonceRange(
+	request *proxypb.StreamHyperblocksByNonceRangeRequest,
+	stream proxypb.BlockService_StreamHyperblocksByNonceRangeServer,
+) error {
+	if request.GetToNonce() < request.GetFromNonce() {
+		return newStatusError(
+			codes.InvalidArgument,
+			ErrInvalidNonceRange,
+			fmt.Errorf("to nonce %d is lower than from nonce %d", request.GetToNonce(), request.GetFromNonce()),
+		)
+	}
+
+	options := getHyperblockQueryOptions(request.GetOptions())
+	for nonce := request.GetFromNonce(); ; nonce++ {
+		err := stream.Context().Err()
+		if err != nil {
+			return newStatusError(codes.Canceled, nil, err)
+		}
+
+		hyperblock, err := service.getHyperblockByNonce(stream.Context(), nonce, options)
+		if err != nil {
+			return err
+		}
+
+		err = stream.Send(hyperblock)
+		if err != nil {
+			return err
+		}
+
+		// the loop is ended here, so the nonce does not overflow when the range ends with the maximum uint64 value
+		if nonce == request.GetToNonce() {
+			return nil
+		}
+	}
+}
+
+func (service *blockService) getHyperblockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*proxypb.HyperblockResponse, error) {
+	response, err := service.facade.GetHyperBlockByNonce(ctx, nonce, options)
+	if err != nil {
+		return nil, newStatusError(codes.Internal, nil, err)
+	}
+
+	return newHyperblockResponse(&response.Data.Hyperblock)
+}
+
+func checkHash(hash string) error {
+	_, err := hex.DecodeString(hash)
+	if err != nil || len(hash) == 0 {
+		return newStatusError(codes.InvalidArgument, nil, fmt.Errorf("%w:%s", apiErrors.ErrInvalidBlockHashParam, hash))
+	}
+
+	return nil
+}
+
+func getBlockQueryOptions(options *proxypb.BlockQueryOptions) common.BlockQueryOptions {
+	return common.BlockQueryOptions{
+		WithTransactions: options.GetWithTransactions(),
+		WithLogs:         options.GetWithLogs(),
+	}
+}
+
+func getHyperblockQueryOptions(options *proxypb.HyperblockQueryOptions) common.HyperblockQueryOptions {
+	return common.HyperblockQueryOptions{
+		WithLogs:            options.GetWithLogs(),
+		NotarizedAtSource:   options.GetNotarizedAtSource(),
+		WithAlteredAccounts: options.GetWithAlteredAccounts(),
+	}
+}
+
+func newBlockResponse(block *api.Block) (*proxypb.BlockResponse, error) {
+	jsonBytes, err := marshalJson(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proxypb.BlockResponse{
+		Nonce:         block.Nonce,
+		Round:         block.Round,
+		Epoch:         block.Epoch,
+		Shard:         block.Shard,
+		Hash:          block.Hash,
+		PrevBlockHash: block.PrevBlockHash,
+		StateRootHash: block.StateRootHash,
+		NumTxs:        block.NumTxs,
+		Timestamp:     int64(block.Timestamp),
+		Status:        block.Status,
+		Json:          jsonBytes,
+	}, nil
+}
+
+func newHyperblockResponse(hyperblock *api.Hyperblock) (*proxypb.HyperblockResponse, error) {
+	jsonBytes, err := marshalJson(hyperblock)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proxypb.HyperblockResponse{
+		Nonce:         hyperblock.Nonce,
+		Round:         hyperblock.Round,
+		Epoch:         hyperblock.Epoch,
+		Hash:          hyperblock.Hash,
+		PrevBlockHash: hyperblock.PrevBlockHash,
+		StateRootHash: hyperblock.StateRootHash,
+		NumTxs:        hyperblock.NumTxs,
+		Timestamp:     int64(hyperblock.Timestamp),
+		Status:        hyperblock.Status,
+		Json:          jsonBytes,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package grpcapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-proxy-go/api/grpcapi/proxypb"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newStatusError wraps the provided error in a gRPC status error, keeping the same message format as the REST API
+func newStatusError(code codes.Code, scope error, err error) error {
+	if scope == nil {
+		return status.Error(code, err.Error())
+	}
+
+	return status.Error(code, fmt.Sprintf("%s: %s", scope.Error(), err.Error()))
+}
+
+// getCodeForHttpStatus returns the gRPC code equivalent to the HTTP status code returned by the facade
+func getCodeForHttpStatus(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
+
+func marshalJson(value interface{}) ([]byte, error) {
+	jsonBytes, err := json.Marshal(value)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return jsonBytes, nil
+}
+
+func newJsonResponse(response *data.GenericAPIResponse) (*proxypb.JsonResponse, error) {
+	jsonBytes, err := marshalJson(response.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proxypb.JsonResponse{Json: jsonBytes}, nil
+}
+
+func newBlockInfo(blockInfo data.BlockInfo) *proxypb.BlockInfo {
+	return &proxypb.BlockInfo{
+		Nonce:    blockInfo.Nonce,
+		Hash:     blockInfo.Hash,
+		RootHash: blockInfo.RootHash,
+	}
+}
@@ -0,0 +1,15 @@
+package grpcapi
+
+import "errors"
+
+// ErrWrongTypeAssertion signals that the provided facade does not implement the actions needed by the gRPC services
+var ErrWrongTypeAssertion = errors.New("wrong type assertion")
+
+// ErrInvalidNonceRange signals that the end of a nonce range is lower than its start
+var ErrInvalidNonceRange = errors.New("invalid nonce range")
+
+// ErrNilTransaction signals that a request without a transaction has been received
+var ErrNilTransaction = errors.New("nil transaction")
+
+// ErrNilCredentialsChecker signals that a nil credentials checker has been provided
+var ErrNilCredentialsChecker = errors.New("nil credentials checker")
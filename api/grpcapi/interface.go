@@ -0,0 +1,54 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// AccountFacadeHandler defines the actions needed by the account service
+type AccountFacadeHandler interface {
+	GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+	GetAllESDTTokens(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+}
+
+// TransactionFacadeHandler defines the actions needed by the transaction service
+type TransactionFacadeHandler interface {
+	SendTransaction(ctx context.Context, tx *data.Transaction) (int, string, error)
+	SimulateTransaction(ctx context.Context, tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
+	TransactionCostRequest(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error)
+	GetTransaction(ctx context.Context, txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionByHashAndSenderAddress(ctx context.Context, txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error)
+	GetTransactionStatus(ctx context.Context, txHash string, sender string) (string, error)
+}
+
+// BlockFacadeHandler defines the actions needed by the block service
+type BlockFacadeHandler interface {
+	GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetHyperBlockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByHash(ctx context.Context, hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+}
+
+// VmQueryFacadeHandler defines the actions needed by the VM query service
+type VmQueryFacadeHandler interface {
+	ExecuteSCQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+}
+
+// NetworkFacadeHandler defines the actions needed by the network service
+type NetworkFacadeHandler interface {
+	GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error)
+}
+
+// FacadeHandler defines all the actions needed by the gRPC services
+type FacadeHandler interface {
+	AccountFacadeHandler
+	TransactionFacadeHandler
+	BlockFacadeHandler
+	VmQueryFacadeHandler
+	NetworkFacadeHandler
+}
@@ -0,0 +1,33 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/api/grpcapi/proxypb"
+	"google.golang.org/grpc/codes"
+)
+
+type networkService struct {
+	proxypb.UnimplementedNetworkServiceServer
+	facade NetworkFacadeHandler
+}
+
+// GetNetworkConfig returns the configuration metrics of the network
+func (service *networkService) GetNetworkConfig(ctx context.Context, _ *proxypb.GetNetworkConfigRequest) (*proxypb.JsonResponse, error) {
+	response, err := service.facade.GetNetworkConfigMetrics(ctx)
+	if err != nil {
+		return nil, newStatusError(codes.Internal, nil, err)
+	}
+
+	return newJsonResponse(response)
+}
+
+// GetNetworkStatus returns the status metrics of a shard
+func (service *networkService) GetNetworkStatus(ctx context.Context, request *proxypb.GetNetworkStatusRequest) (*proxypb.JsonResponse, error) {
+	response, err := service.facade.GetNetworkStatusMetrics(ctx, request.GetShardId())
+	if err != nil {
+		return nil, newStatusError(codes.Internal, nil, err)
+	}
+
+	return newJsonResponse(response)
+}
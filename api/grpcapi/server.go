@@ -0,0 +1,94 @@
+package grpcapi
+
+import (
+	"net"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/api/grpcapi/proxypb"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+const gracefulStopTimeout = time.Second
+
+var log = logger.GetOrCreate("api/grpcapi")
+
+// ArgsServer holds the arguments needed to create a new gRPC server
+type ArgsServer struct {
+	Facade             data.FacadeHandler
+	CredentialsChecker *shared.CredentialsChecker
+	Secured            bool
+}
+
+type server struct {
+	grpcServer *grpc.Server
+}
+
+// NewServer returns a new gRPC server exposing the account, transaction, block, VM query and network services. If
+// secured, all the calls, except the reflection ones, require Basic Authentication, checked against the credentials
+// shared with the REST API
+func NewServer(args ArgsServer) (*server, error) {
+	facade, ok := args.Facade.(FacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	if args.CredentialsChecker == nil {
+		return nil, ErrNilCredentialsChecker
+	}
+
+	options := make([]grpc.ServerOption, 0)
+	if args.Secured {
+		auth := &authenticator{
+			credentialsChecker: args.CredentialsChecker,
+		}
+		options = append(options,
+			grpc.UnaryInterceptor(auth.unaryInterceptor),
+			grpc.StreamInterceptor(auth.streamInterceptor),
+		)
+	}
+
+	grpcServer := grpc.NewServer(options...)
+	proxypb.RegisterAccountServiceServer(grpcServer, &accountService{facade: facade})
+	proxypb.RegisterTransactionServiceServer(grpcServer, &transactionService{facade: facade})
+	proxypb.RegisterBlockServiceServer(grpcServer, &blockService{facade: facade})
+	proxypb.RegisterVmQueryServiceServer(grpcServer, &vmQueryService{facade: facade})
+	proxypb.RegisterNetworkServiceServer(grpcServer, &networkService{facade: facade})
+	reflection.Register(grpcServer)
+
+	return &server{
+		grpcServer: grpcServer,
+	}, nil
+}
+
+// Serve accepts the gRPC connections on the provided listener. It returns when the server is closed
+func (s *server) Serve(listener net.Listener) error {
+	log.Info("gRPC server started", "address", listener.Addr().String())
+
+	return s.grpcServer.Serve(listener)
+}
+
+// Close stops the server, waiting a short while for the pending calls to finish
+func (s *server) Close() error {
+	stopped := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(gracefulStopTimeout):
+		s.grpcServer.Stop()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *server) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,471 @@
+package grpcapi
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-proxy-go/api/grpcapi/proxypb"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufferSize = 1024 * 1024
+
+var expectedErr = errors.New("expected error")
+
+func createTestClientConnection(t *testing.T, args ArgsServer) *grpc.ClientConn {
+	if args.CredentialsChecker == nil {
+		args.CredentialsChecker = shared.NewCredentialsChecker(config.CredentialsConfig{})
+	}
+	srv, err := NewServer(args)
+	require.Nil(t, err)
+
+	listener := bufconn.Listen(bufferSize)
+	go func() {
+		_ = srv.Serve(listener)
+	}()
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.Nil(t, err)
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		_ = srv.Close()
+	})
+
+	return conn
+}
+
+func createCredentialsConfig() config.CredentialsConfig {
+	return config.CredentialsConfig{
+		Credentials: []data.Credential{
+			{
+				Username: "user",
+				// sha256 of "user"
+				Password: "04f8996da763b7a969b1028ee3007569eaf3a635486ddab211d512c85b9df8fb",
+			},
+		},
+		Hasher: config.TypeConfig{Type: "sha256"},
+	}
+}
+
+func withBasicAuth(ctx context.Context, username string, password string) context.Context {
+	encodedCredentials := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+	return metadata.AppendToOutgoingContext(ctx, authorizationMetadataKey, "Basic "+encodedCredentials)
+}
+
+func TestNewServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("wrong facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		srv, err := NewServer(ArgsServer{Facade: struct{}{}})
+		require.Nil(t, srv)
+		require.Equal(t, ErrWrongTypeAssertion, err)
+	})
+	t.Run("nil credentials checker should error", func(t *testing.T) {
+		t.Parallel()
+
+		srv, err := NewServer(ArgsServer{Facade: &mock.FacadeStub{}})
+		require.Nil(t, srv)
+		require.Equal(t, ErrNilCredentialsChecker, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		srv, err := NewServer(ArgsServer{Facade: &mock.FacadeStub{}, CredentialsChecker: shared.NewCredentialsChecker(config.CredentialsConfig{})})
+		require.Nil(t, err)
+		require.False(t, srv.IsInterfaceNil())
+		require.Nil(t, srv.Close())
+	})
+}
+
+func TestServer_AccountService(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		conn := createTestClientConnection(t, ArgsServer{Facade: &mock.FacadeStub{}})
+		client := proxypb.NewAccountServiceClient(conn)
+
+		response, err := client.GetAccount(context.Background(), &proxypb.GetAccountRequest{})
+		require.Nil(t, response)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountHandler: func(_ context.Context, _ string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+				return nil, expectedErr
+			},
+		}
+		conn := createTestClientConnection(t, ArgsServer{Facade: facade})
+		client := proxypb.NewAccountServiceClient(conn)
+
+		response, err := client.GetAccount(context.Background(), &proxypb.GetAccountRequest{Address: "erd1address"})
+		require.Nil(t, response)
+		require.Equal(t, codes.Internal, status.Code(err))
+		require.Contains(t, err.Error(), expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		blockNonce := uint64(37)
+		facade := &mock.FacadeStub{
+			GetAccountHandler: func(_ context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+				require.Equal(t, "erd1address", address)
+				require.True(t, options.OnFinalBlock)
+				require.True(t, options.BlockNonce.HasValue)
+				require.Equal(t, blockNonce, options.BlockNonce.Value)
+
+				return &data.AccountModel{
+					Account: data.Account{
+						Address: address,
+						Nonce:   5,
+						Balance: "1000",
+					},
+					BlockInfo: data.BlockInfo{Nonce: blockNonce, Hash: "hash"},
+				}, nil
+			},
+		}
+		conn := createTestClientConnection(t, ArgsServer{Facade: facade})
+		client := proxypb.NewAccountServiceClient(conn)
+
+		response, err := client.GetAccount(context.Background(), &proxypb.GetAccountRequest{
+			Address:      "erd1address",
+			OnFinalBlock: true,
+			BlockNonce:   &blockNonce,
+		})
+		require.Nil(t, err)
+		require.Equal(t, "erd1address", response.GetAccount().GetAddress())
+		require.Equal(t, uint64(5), response.GetAccount().GetNonce())
+		require.Equal(t, "1000", response.GetAccount().GetBalance())
+		require.Equal(t, blockNonce, response.GetBlockInfo().GetNonce())
+		require.Equal(t, "hash", response.GetBlockInfo().GetHash())
+	})
+}
+
+func TestServer_TransactionService(t *testing.T) {
+	t.Parallel()
+
+	t.Run("send transaction error should keep the status code", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SendTransactionHandler: func(_ *data.Transaction) (int, string, error) {
+				return http.StatusBadRequest, "", expectedErr
+			},
+		}
+		conn := createTestClientConnection(t, ArgsServer{Facade: facade})
+		client := proxypb.NewTransactionServiceClient(conn)
+
+		response, err := client.SendTransaction(context.Background(), &proxypb.Transaction{})
+		require.Nil(t, response)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("send transaction should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+				require.Equal(t, &data.Transaction{
+					Nonce:     7,
+					Value:     "10",
+					Receiver:  "erd1receiver",
+					Sender:    "erd1sender",
+					GasPrice:  1000000000,
+					GasLimit:  50000,
+					Data:      []byte("data"),
+					Signature: "signature",
+					ChainID:   "T",
+					Version:   2,
+				}, tx)
+
+				return http.StatusOK, "txHash", nil
+			},
+		}
+		conn := createTestClientConnection(t, ArgsServer{Facade: facade})
+		client := proxypb.NewTransactionServiceClient(conn)
+
+		response, err := client.SendTransaction(context.Background(), &proxypb.Transaction{
+			Nonce:     7,
+			Value:     "10",
+			Receiver:  "erd1receiver",
+			Sender:    "erd1sender",
+			GasPrice:  1000000000,
+			GasLimit:  50000,
+			Data:      []byte("data"),
+			Signature: "signature",
+			ChainId:   "T",
+			Version:   2,
+		})
+		require.Nil(t, err)
+		require.Equal(t, "txHash", response.GetTxHash())
+	})
+	t.Run("simulate without transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		conn := createTestClientConnection(t, ArgsServer{Facade: &mock.FacadeStub{}})
+		client := proxypb.NewTransactionServiceClient(conn)
+
+		response, err := client.SimulateTransaction(context.Background(), &proxypb.SimulateTransactionRequest{})
+		require.Nil(t, response)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("get transaction status should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionStatusHandler: func(_ context.Context, txHash string, sender string) (string, error) {
+				require.Equal(t, "txHash", txHash)
+				require.Equal(t, "erd1sender", sender)
+				return "success", nil
+			},
+		}
+		conn := createTestClientConnection(t, ArgsServer{Facade: facade})
+		client := proxypb.NewTransactionServiceClient(conn)
+
+		response, err := client.GetTransactionStatus(context.Background(), &proxypb.GetTransactionStatusRequest{
+			TxHash: "txHash",
+			Sender: "erd1sender",
+		})
+		require.Nil(t, err)
+		require.Equal(t, "success", response.GetStatus())
+	})
+}
+
+func TestServer_StreamHyperblocksByNonceRange(t *testing.T) {
+	t.Parallel()
+
+	createFacade := func(lastNonce uint64) *mock.FacadeStub {
+		return &mock.FacadeStub{
+			GetHyperBlockByNonceCalled: func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+				require.True(t, options.WithLogs)
+				if nonce > lastNonce {
+					return nil, expectedErr
+				}
+
+				return data.NewHyperblockApiResponse(api.Hyperblock{Nonce: nonce, Hash: "hash"}), nil
+			},
+		}
+	}
+	receiveAll := func(stream proxypb.BlockService_StreamHyperblocksByNonceRangeClient) ([]uint64, error) {
+		nonces := make([]uint64, 0)
+		for {
+			hyperblock, err := stream.Recv()
+			if err == io.EOF {
+				return nonces, nil
+			}
+			if err != nil {
+				return nonces, err
+			}
+
+			nonces = append(nonces, hyperblock.GetNonce())
+		}
+	}
+
+	t.Run("invalid range should error", func(t *testing.T) {
+		t.Parallel()
+
+		conn := createTestClientConnection(t, ArgsServer{Facade: createFacade(100)})
+		client := proxypb.NewBlockServiceClient(conn)
+
+		stream, err := client.StreamHyperblocksByNonceRange(context.Background(), &proxypb.StreamHyperblocksByNonceRangeRequest{
+			FromNonce: 10,
+			ToNonce:   9,
+		})
+		require.Nil(t, err)
+
+		nonces, err := receiveAll(stream)
+		require.Empty(t, nonces)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("should stream the hyperblocks in order", func(t *testing.T) {
+		t.Parallel()
+
+		conn := createTestClientConnection(t, ArgsServer{Facade: createFacade(100)})
+		client := proxypb.NewBlockServiceClient(conn)
+
+		stream, err := client.StreamHyperblocksByNonceRange(context.Background(), &proxypb.StreamHyperblocksByNonceRangeRequest{
+			FromNonce: 10,
+			ToNonce:   13,
+			Options:   &proxypb.HyperblockQueryOptions{WithLogs: true},
+		})
+		require.Nil(t, err)
+
+		nonces, err := receiveAll(stream)
+		require.Nil(t, err)
+		require.Equal(t, []uint64{10, 11, 12, 13}, nonces)
+	})
+	t.Run("missing hyperblock should end the stream with error", func(t *testing.T) {
+		t.Parallel()
+
+		conn := createTestClientConnection(t, ArgsServer{Facade: createFacade(11)})
+		client := proxypb.NewBlockServiceClient(conn)
+
+		stream, err := client.StreamHyperblocksByNonceRange(context.Background(), &proxypb.StreamHyperblocksByNonceRangeRequest{
+			FromNonce: 10,
+			ToNonce:   13,
+			Options:   &proxypb.HyperblockQueryOptions{WithLogs: true},
+		})
+		require.Nil(t, err)
+
+		nonces, err := receiveAll(stream)
+		require.Equal(t, []uint64{10, 11}, nonces)
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
+func TestServer_VmQueryService(t *testing.T) {
+	t.Parallel()
+
+	blockNonce := uint64(37)
+	facade := &mock.FacadeStub{
+		ExecuteSCQueryHandler: func(_ context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+			require.Equal(t, "erd1contract", query.ScAddress)
+			require.Equal(t, "getSum", query.FuncName)
+			require.Equal(t, [][]byte{{1}, {2}}, query.Arguments)
+			require.True(t, query.BlockNonce.HasValue)
+			require.Equal(t, blockNonce, query.BlockNonce.Value)
+
+			return &vm.VMOutputApi{
+				ReturnCode: "ok",
+				ReturnData: [][]byte{{3}},
+			}, data.BlockInfo{Nonce: blockNonce}, nil
+		},
+	}
+	conn := createTestClientConnection(t, ArgsServer{Facade: facade})
+	client := proxypb.NewVmQueryServiceClient(conn)
+
+	response, err := client.Query(context.Background(), &proxypb.VmQueryRequest{
+		ScAddress:  "erd1contract",
+		FuncName:   "getSum",
+		Args:       [][]byte{{1}, {2}},
+		BlockNonce: &blockNonce,
+	})
+	require.Nil(t, err)
+	require.Equal(t, "ok", response.GetReturnCode())
+	require.Equal(t, [][]byte{{3}}, response.GetReturnData())
+	require.Equal(t, blockNonce, response.GetBlockInfo().GetNonce())
+}
+
+func TestServer_NetworkService(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetConfigMetricsHandler: func() (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{"erd_chain_id": "T"},
+			}, nil
+		},
+	}
+	conn := createTestClientConnection(t, ArgsServer{Facade: facade})
+	client := proxypb.NewNetworkServiceClient(conn)
+
+	response, err := client.GetNetworkConfig(context.Background(), &proxypb.GetNetworkConfigRequest{})
+	require.Nil(t, err)
+	require.JSONEq(t, `{"erd_chain_id":"T"}`, string(response.GetJson()))
+}
+
+func TestServer_Authentication(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetConfigMetricsHandler: func() (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{}, nil
+		},
+	}
+
+	t.Run("no credentials on server should error", func(t *testing.T) {
+		t.Parallel()
+
+		conn := createTestClientConnection(t, ArgsServer{Facade: facade, Secured: true})
+		client := proxypb.NewNetworkServiceClient(conn)
+
+		ctx := withBasicAuth(context.Background(), "user", "user")
+		_, err := client.GetNetworkConfig(ctx, &proxypb.GetNetworkConfigRequest{})
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+	t.Run("missing credentials should error", func(t *testing.T) {
+		t.Parallel()
+
+		conn := createTestClientConnection(t, ArgsServer{Facade: facade, CredentialsChecker: shared.NewCredentialsChecker(createCredentialsConfig()), Secured: true})
+		client := proxypb.NewNetworkServiceClient(conn)
+
+		_, err := client.GetNetworkConfig(context.Background(), &proxypb.GetNetworkConfigRequest{})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+	t.Run("invalid credentials should error", func(t *testing.T) {
+		t.Parallel()
+
+		conn := createTestClientConnection(t, ArgsServer{Facade: facade, CredentialsChecker: shared.NewCredentialsChecker(createCredentialsConfig()), Secured: true})
+		client := proxypb.NewNetworkServiceClient(conn)
+
+		ctx := withBasicAuth(context.Background(), "user", "invalid")
+		_, err := client.GetNetworkConfig(ctx, &proxypb.GetNetworkConfigRequest{})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+	t.Run("valid credentials should work", func(t *testing.T) {
+		t.Parallel()
+
+		conn := createTestClientConnection(t, ArgsServer{Facade: facade, CredentialsChecker: shared.NewCredentialsChecker(createCredentialsConfig()), Secured: true})
+		client := proxypb.NewNetworkServiceClient(conn)
+
+		ctx := withBasicAuth(context.Background(), "user", "user")
+		_, err := client.GetNetworkConfig(ctx, &proxypb.GetNetworkConfigRequest{})
+		require.Nil(t, err)
+	})
+	t.Run("reflection should not require credentials", func(t *testing.T) {
+		t.Parallel()
+
+		conn := createTestClientConnection(t, ArgsServer{Facade: facade, CredentialsChecker: shared.NewCredentialsChecker(createCredentialsConfig()), Secured: true})
+		client := grpc_reflection_v1alpha.NewServerReflectionClient(conn)
+
+		stream, err := client.ServerReflectionInfo(context.Background())
+		require.Nil(t, err)
+		err = stream.Send(&grpc_reflection_v1alpha.ServerReflectionRequest{
+			MessageRequest: &grpc_reflection_v1alpha.ServerReflectionRequest_ListServices{},
+		})
+		require.Nil(t, err)
+
+		response, err := stream.Recv()
+		require.Nil(t, err)
+
+		services := make([]string, 0)
+		for _, service := range response.GetListServicesResponse().GetService() {
+			services = append(services, service.GetName())
+		}
+		require.Contains(t, services, "proxy.v1.AccountService")
+		require.Contains(t, services, "proxy.v1.BlockService")
+		require.Contains(t, services, "proxy.v1.NetworkService")
+		require.Contains(t, services, "proxy.v1.TransactionService")
+		require.Contains(t, services, "proxy.v1.VmQueryService")
+	})
+}
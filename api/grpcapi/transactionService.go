@@ -0,0 +1,117 @@
+package grpcapi
+
+import (
+	"context"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/api/grpcapi/proxypb"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"google.golang.org/grpc/codes"
+)
+
+type transactionService struct {
+	proxypb.UnimplementedTransactionServiceServer
+	facade TransactionFacadeHandler
+}
+
+// SendTransaction sends the transaction to the observers and returns its hash
+func (service *transactionService) SendTransaction(ctx context.Context, tx *proxypb.Transaction) (*proxypb.SendTransactionResponse, error) {
+	statusCode, txHash, err := service.facade.SendTransaction(ctx, newTransaction(tx))
+	if err != nil {
+		return nil, newStatusError(getCodeForHttpStatus(statusCode), nil, err)
+	}
+
+	return &proxypb.SendTransactionResponse{TxHash: txHash}, nil
+}
+
+// SimulateTransaction simulates the execution of the transaction, without propagating it
+func (service *transactionService) SimulateTransaction(ctx context.Context, request *proxypb.SimulateTransactionRequest) (*proxypb.JsonResponse, error) {
+	if request.GetTransaction() == nil {
+		return nil, newStatusError(codes.InvalidArgument, apiErrors.ErrValidation, ErrNilTransaction)
+	}
+
+	response, err := service.facade.SimulateTransaction(ctx, newTransaction(request.GetTransaction()), request.GetCheckSignature())
+	if err != nil {
+		return nil, newStatusError(codes.Internal, nil, err)
+	}
+
+	return newJsonResponse(response)
+}
+
+// EstimateTransactionCost returns the gas units needed by the transaction
+func (service *transactionService) EstimateTransactionCost(ctx context.Context, tx *proxypb.Transaction) (*proxypb.EstimateTransactionCostResponse, error) {
+	cost, err := service.facade.TransactionCostRequest(ctx, newTransaction(tx))
+	if err != nil {
+		return nil, newStatusError(codes.Internal, nil, err)
+	}
+
+	jsonBytes, err := marshalJson(cost)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proxypb.EstimateTransactionCostResponse{
+		TxGasUnits:    cost.TxCost,
+		ReturnMessage: cost.RetMessage,
+		Json:          jsonBytes,
+	}, nil
+}
+
+// GetTransaction returns the transaction with the provided hash
+func (service *transactionService) GetTransaction(ctx context.Context, request *proxypb.GetTransactionRequest) (*proxypb.JsonResponse, error) {
+	if len(request.GetTxHash()) == 0 {
+		return nil, newStatusError(codes.InvalidArgument, nil, apiErrors.ErrTransactionHashMissing)
+	}
+
+	if len(request.GetSender()) == 0 {
+		tx, err := service.facade.GetTransaction(ctx, request.GetTxHash(), request.GetWithResults())
+		if err != nil {
+			return nil, newStatusError(codes.Internal, nil, err)
+		}
+
+		return newJsonResponse(&data.GenericAPIResponse{Data: tx})
+	}
+
+	tx, statusCode, err := service.facade.GetTransactionByHashAndSenderAddress(ctx, request.GetTxHash(), request.GetSender(), request.GetWithResults())
+	if err != nil {
+		return nil, newStatusError(getCodeForHttpStatus(statusCode), nil, err)
+	}
+
+	return newJsonResponse(&data.GenericAPIResponse{Data: tx})
+}
+
+// GetTransactionStatus returns the status of the transaction with the provided hash
+func (service *transactionService) GetTransactionStatus(ctx context.Context, request *proxypb.GetTransactionStatusRequest) (*proxypb.GetTransactionStatusResponse, error) {
+	if len(request.GetTxHash()) == 0 {
+		return nil, newStatusError(codes.InvalidArgument, nil, apiErrors.ErrTransactionHashMissing)
+	}
+
+	txStatus, err := service.facade.GetTransactionStatus(ctx, request.GetTxHash(), request.GetSender())
+	if err != nil {
+		return nil, newStatusError(codes.Internal, nil, err)
+	}
+
+	return &proxypb.GetTransactionStatusResponse{Status: txStatus}, nil
+}
+
+func newTransaction(tx *proxypb.Transaction) *data.Transaction {
+	return &data.Transaction{
+		Nonce:             tx.GetNonce(),
+		Value:             tx.GetValue(),
+		Receiver:          tx.GetReceiver(),
+		Sender:            tx.GetSender(),
+		SenderUsername:    tx.GetSenderUsername(),
+		ReceiverUsername:  tx.GetReceiverUsername(),
+		GasPrice:          tx.GetGasPrice(),
+		GasLimit:          tx.GetGasLimit(),
+		Data:              tx.GetData(),
+		Signature:         tx.GetSignature(),
+		ChainID:           tx.GetChainId(),
+		Version:           tx.GetVersion(),
+		Options:           tx.GetOptions(),
+		GuardianAddr:      tx.GetGuardian(),
+		GuardianSignature: tx.GetGuardianSignature(),
+		RelayerAddr:       tx.GetRelayer(),
+		RelayerSignature:  tx.GetRelayerSignature(),
+	}
+}
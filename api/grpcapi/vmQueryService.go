@@ -0,0 +1,51 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/api/grpcapi/proxypb"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"google.golang.org/grpc/codes"
+)
+
+type vmQueryService struct {
+	proxypb.UnimplementedVmQueryServiceServer
+	facade VmQueryFacadeHandler
+}
+
+// Query executes a smart contract view function
+func (service *vmQueryService) Query(ctx context.Context, request *proxypb.VmQueryRequest) (*proxypb.VmQueryResponse, error) {
+	query := &data.SCQuery{
+		ScAddress:      request.GetScAddress(),
+		FuncName:       request.GetFuncName(),
+		CallerAddr:     request.GetCaller(),
+		CallValue:      request.GetValue(),
+		SameScState:    request.GetSameScState(),
+		ShouldBeSynced: request.GetShouldBeSynced(),
+		Arguments:      request.GetArgs(),
+		BlockHash:      request.GetBlockHash(),
+	}
+	if request.BlockNonce != nil {
+		query.BlockNonce = core.OptionalUint64{Value: request.GetBlockNonce(), HasValue: true}
+	}
+
+	vmOutput, blockInfo, err := service.facade.ExecuteSCQuery(ctx, query)
+	if err != nil {
+		return nil, newStatusError(codes.InvalidArgument, nil, err)
+	}
+
+	jsonBytes, err := marshalJson(vmOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proxypb.VmQueryResponse{
+		ReturnCode:    vmOutput.ReturnCode,
+		ReturnMessage: vmOutput.ReturnMessage,
+		ReturnData:    vmOutput.ReturnData,
+		GasRemaining:  vmOutput.GasRemaining,
+		BlockInfo:     newBlockInfo(blockInfo),
+		Json:          jsonBytes,
+	}, nil
+}
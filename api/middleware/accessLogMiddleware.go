@@ -0,0 +1,207 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+)
+
+const (
+	maxAccessLogErrorBodyLength = 4096
+	maxAccessLogErrorLength     = 400
+	visibleApiKeyPrefixLength   = 4
+	maskedApiKeySuffix          = "***"
+)
+
+// accessLogEntry is the JSON line written to the access log for each request
+type accessLogEntry struct {
+	Time       string   `json:"time"`
+	RequestID  string   `json:"requestId"`
+	Method     string   `json:"method"`
+	Route      string   `json:"route"`
+	Path       string   `json:"path"`
+	Status     int      `json:"status"`
+	DurationMs float64  `json:"durationMs"`
+	ClientIP   string   `json:"clientIp"`
+	ApiKey     string   `json:"apiKey,omitempty"`
+	BytesIn    int64    `json:"bytesIn"`
+	BytesOut   int      `json:"bytesOut"`
+	Observers  []string `json:"observers"`
+	Error      string   `json:"error,omitempty"`
+}
+
+type accessLogMiddleware struct {
+	mutSink      sync.Mutex
+	sink         io.Writer
+	apiKeyHeader string
+}
+
+// NewAccessLogMiddleware returns a new instance of accessLogMiddleware, writing the access log lines to the provided
+// sink. The API keys are read from the provided header
+func NewAccessLogMiddleware(sink io.Writer, apiKeyHeader string) (*accessLogMiddleware, error) {
+	if sink == nil {
+		return nil, ErrNilAccessLogSink
+	}
+
+	return &accessLogMiddleware{
+		sink:         sink,
+		apiKeyHeader: apiKeyHeader,
+	}, nil
+}
+
+// MiddlewareHandlerFunc writes a JSON line for each request once it is served. It has to be registered before the
+// request ID middleware, so the internal requests of the JSON-RPC and batch routes, which already carry the request info
+// of the outer request, are not logged separately
+func (alm *accessLogMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if common.RequestInfoFromContext(c.Request.Context()) != nil {
+			c.Next()
+			return
+		}
+
+		startTime := time.Now()
+		writer := &errorBodyWriter{ResponseWriter: c.Writer, body: &bytes.Buffer{}}
+		c.Writer = writer
+
+		c.Next()
+
+		entry := &accessLogEntry{
+			Time:       startTime.UTC().Format(time.RFC3339Nano),
+			Method:     c.Request.Method,
+			Route:      c.FullPath(),
+			Path:       c.Request.URL.Path,
+			Status:     writer.Status(),
+			DurationMs: float64(time.Since(startTime).Microseconds()) / 1000,
+			ClientIP:   c.ClientIP(),
+			ApiKey:     maskApiKey(c.GetHeader(alm.apiKeyHeader)),
+			BytesIn:    getRequestSize(c.Request),
+			BytesOut:   getResponseSize(writer),
+			Observers:  make([]string, 0),
+			Error:      extractError(writer.Status(), writer.body.Bytes()),
+		}
+
+		// the request ID middleware replaces the request of the context with one carrying the request info
+		requestInfo := common.RequestInfoFromContext(c.Request.Context())
+		if requestInfo != nil {
+			entry.RequestID = requestInfo.ID
+			entry.Observers = requestInfo.GetObservers()
+		}
+
+		alm.write(entry)
+	}
+}
+
+func (alm *accessLogMiddleware) write(entry *accessLogEntry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Warn("cannot marshal access log entry", "error", err)
+		return
+	}
+
+	alm.mutSink.Lock()
+	_, err = alm.sink.Write(append(line, '\n'))
+	alm.mutSink.Unlock()
+	if err != nil {
+		log.Warn("cannot write access log entry", "error", err)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (alm *accessLogMiddleware) IsInterfaceNil() bool {
+	return alm == nil
+}
+
+// maskApiKey keeps only the first characters of the API key, enough to tell the clients apart without leaking the keys
+func maskApiKey(apiKey string) string {
+	if len(apiKey) == 0 {
+		return ""
+	}
+	if len(apiKey) <= visibleApiKeyPrefixLength {
+		return maskedApiKeySuffix
+	}
+
+	return apiKey[:visibleApiKeyPrefixLength] + maskedApiKeySuffix
+}
+
+func getRequestSize(request *http.Request) int64 {
+	if request.ContentLength < 0 {
+		return 0
+	}
+
+	return request.ContentLength
+}
+
+func getResponseSize(writer gin.ResponseWriter) int {
+	if writer.Size() < 0 {
+		return 0
+	}
+
+	return writer.Size()
+}
+
+// extractError returns the error field of a JSON error response, or the beginning of the response otherwise. The
+// responses written by gin after the middlewares, such as the ones of the unknown routes, are described by their status
+func extractError(status int, body []byte) string {
+	if status < http.StatusBadRequest {
+		return ""
+	}
+	if len(body) == 0 {
+		return http.StatusText(status)
+	}
+
+	response := struct {
+		Error string `json:"error"`
+	}{}
+	err := json.Unmarshal(body, &response)
+	if err == nil && len(response.Error) > 0 {
+		return response.Error
+	}
+
+	errorMessage := strings.TrimSpace(string(body))
+	if len(errorMessage) > maxAccessLogErrorLength {
+		return errorMessage[:maxAccessLogErrorLength] + "..."
+	}
+
+	return errorMessage
+}
+
+// errorBodyWriter keeps the beginning of the error responses, so the error can be written to the access log
+type errorBodyWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+// Write writes the provided bytes to the response, keeping them if the response is an error
+func (w *errorBodyWriter) Write(b []byte) (int, error) {
+	w.keepErrorBody(b)
+	return w.ResponseWriter.Write(b)
+}
+
+// WriteString writes the provided string to the response, keeping it if the response is an error
+func (w *errorBodyWriter) WriteString(s string) (int, error) {
+	w.keepErrorBody([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *errorBodyWriter) keepErrorBody(b []byte) {
+	if w.Status() < http.StatusBadRequest {
+		return
+	}
+
+	remainingLength := maxAccessLogErrorBodyLength - w.body.Len()
+	if remainingLength <= 0 {
+		return
+	}
+	if len(b) > remainingLength {
+		b = b[:remainingLength]
+	}
+
+	w.body.Write(b)
+}
@@ -0,0 +1,168 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/stretchr/testify/require"
+)
+
+func startApiServerAccessLog(t *testing.T, sink *bytes.Buffer, handler gin.HandlerFunc) *gin.Engine {
+	alm, err := NewAccessLogMiddleware(sink, testApiKeyHeader)
+	require.NoError(t, err)
+
+	ws := gin.New()
+	ws.Use(alm.MiddlewareHandlerFunc())
+	ws.Use(NewRequestIDMiddleware().MiddlewareHandlerFunc())
+	ws.POST("/transaction/send", handler)
+
+	return ws
+}
+
+func readAccessLogEntries(t *testing.T, sink *bytes.Buffer) []*accessLogEntry {
+	entries := make([]*accessLogEntry, 0)
+	for _, line := range strings.Split(strings.TrimSuffix(sink.String(), "\n"), "\n") {
+		if len(line) == 0 {
+			continue
+		}
+
+		entry := &accessLogEntry{}
+		require.NoError(t, json.Unmarshal([]byte(line), entry))
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func TestNewAccessLogMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil sink should err", func(t *testing.T) {
+		t.Parallel()
+
+		alm, err := NewAccessLogMiddleware(nil, testApiKeyHeader)
+		require.Nil(t, alm)
+		require.Equal(t, ErrNilAccessLogSink, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		alm, err := NewAccessLogMiddleware(&bytes.Buffer{}, testApiKeyHeader)
+		require.NoError(t, err)
+		require.False(t, alm.IsInterfaceNil())
+	})
+}
+
+func TestAccessLogMiddleware_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should write a line for a successful request", func(t *testing.T) {
+		t.Parallel()
+
+		sink := &bytes.Buffer{}
+		ws := startApiServerAccessLog(t, sink, func(c *gin.Context) {
+			requestInfo := common.RequestInfoFromContext(c.Request.Context())
+			requestInfo.AddObserver("http://observer-0")
+			requestInfo.AddObserver("http://observer-1")
+			c.JSON(http.StatusOK, gin.H{"data": "ok"})
+		})
+
+		req, _ := http.NewRequest(http.MethodPost, "/transaction/send?checkSignature=true", bytes.NewBufferString(`{"nonce":1}`))
+		req.Header.Set(common.RequestIDHeader, "request-id")
+		req.Header.Set(testApiKeyHeader, "secret-api-key")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		entries := readAccessLogEntries(t, sink)
+		require.Len(t, entries, 1)
+		entry := entries[0]
+		require.NotEmpty(t, entry.Time)
+		require.Equal(t, "request-id", entry.RequestID)
+		require.Equal(t, http.MethodPost, entry.Method)
+		require.Equal(t, "/transaction/send", entry.Route)
+		require.Equal(t, "/transaction/send", entry.Path)
+		require.Equal(t, http.StatusOK, entry.Status)
+		require.Equal(t, "secr***", entry.ApiKey)
+		require.Equal(t, int64(len(`{"nonce":1}`)), entry.BytesIn)
+		require.Equal(t, resp.Body.Len(), entry.BytesOut)
+		require.Equal(t, []string{"http://observer-0", "http://observer-1"}, entry.Observers)
+		require.Empty(t, entry.Error)
+	})
+	t.Run("should write the error of a failed request", func(t *testing.T) {
+		t.Parallel()
+
+		sink := &bytes.Buffer{}
+		ws := startApiServerAccessLog(t, sink, func(c *gin.Context) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "validation error", "code": "bad_request"})
+		})
+
+		req, _ := http.NewRequest(http.MethodPost, "/transaction/send", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		entries := readAccessLogEntries(t, sink)
+		require.Len(t, entries, 1)
+		require.Equal(t, resp.Header().Get(common.RequestIDHeader), entries[0].RequestID)
+		require.Equal(t, http.StatusBadRequest, entries[0].Status)
+		require.Equal(t, "validation error", entries[0].Error)
+		require.Empty(t, entries[0].ApiKey)
+		require.Empty(t, entries[0].Observers)
+	})
+	t.Run("should write the status text of a failed request without body", func(t *testing.T) {
+		t.Parallel()
+
+		sink := &bytes.Buffer{}
+		ws := startApiServerAccessLog(t, sink, func(c *gin.Context) {})
+
+		req, _ := http.NewRequest(http.MethodGet, "/unknown", nil)
+		ws.ServeHTTP(httptest.NewRecorder(), req)
+
+		entries := readAccessLogEntries(t, sink)
+		require.Len(t, entries, 1)
+		require.Empty(t, entries[0].Route)
+		require.Equal(t, "/unknown", entries[0].Path)
+		require.Equal(t, http.StatusNotFound, entries[0].Status)
+		require.Equal(t, "Not Found", entries[0].Error)
+	})
+	t.Run("internal request should not be written", func(t *testing.T) {
+		t.Parallel()
+
+		sink := &bytes.Buffer{}
+		ws := startApiServerAccessLog(t, sink, func(c *gin.Context) {
+			c.JSON(http.StatusOK, gin.H{})
+		})
+
+		ctx := common.ContextWithRequestInfo(context.Background(), common.NewRequestInfo("outer-id"))
+		req, _ := http.NewRequestWithContext(ctx, http.MethodPost, "/transaction/send", nil)
+		ws.ServeHTTP(httptest.NewRecorder(), req)
+
+		require.Empty(t, sink.String())
+	})
+}
+
+func TestMaskApiKey(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "", maskApiKey(""))
+	require.Equal(t, "***", maskApiKey("abcd"))
+	require.Equal(t, "abcd***", maskApiKey("abcde"))
+}
+
+func TestExtractError(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "", extractError(http.StatusOK, []byte(`{"error":""}`)))
+	require.Equal(t, "Not Found", extractError(http.StatusNotFound, nil))
+	require.Equal(t, "not found", extractError(http.StatusNotFound, []byte(`{"data":null,"error":"not found","code":"bad_request"}`)))
+	require.Equal(t, "plain text", extractError(http.StatusInternalServerError, []byte(" plain text\n")))
+
+	longText := strings.Repeat("a", maxAccessLogErrorLength+1)
+	require.Equal(t, longText[:maxAccessLogErrorLength]+"...", extractError(http.StatusInternalServerError, []byte(longText)))
+}
@@ -7,3 +7,27 @@ var ErrNilLimitsMapForEndpoints = errors.New("nil limits map")
 
 // ErrNilStatusMetricsExtractor signals that a nil status metrics extractor has been provided
 var ErrNilStatusMetricsExtractor = errors.New("nil status metrics extractor")
+
+// ErrInvalidRateLimitWindow signals that an invalid rate limit window has been provided
+var ErrInvalidRateLimitWindow = errors.New("invalid rate limit window")
+
+// ErrNilRateLimitStore signals that a nil rate limit store has been provided
+var ErrNilRateLimitStore = errors.New("nil rate limit store")
+
+// ErrUnknownRateLimitAlgorithm signals that an unknown rate limit algorithm has been provided
+var ErrUnknownRateLimitAlgorithm = errors.New("unknown rate limit algorithm")
+
+// ErrUnknownRateLimitTier signals that an API key refers an unknown rate limit tier
+var ErrUnknownRateLimitTier = errors.New("unknown rate limit tier")
+
+// ErrEmptyApiKey signals that an empty API key has been provided
+var ErrEmptyApiKey = errors.New("empty API key")
+
+// ErrNilTracerProvider signals that a nil tracer provider has been provided
+var ErrNilTracerProvider = errors.New("nil tracer provider")
+
+// ErrNilTextMapPropagator signals that a nil text map propagator has been provided
+var ErrNilTextMapPropagator = errors.New("nil text map propagator")
+
+// ErrNilAccessLogSink signals that a nil access log sink has been provided
+var ErrNilAccessLogSink = errors.New("nil access log sink")
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const sweepIntervalForExpiredStates = time.Minute
+
+type storedRateLimitState struct {
+	state     data.RateLimitState
+	expiresAt time.Time
+}
+
+// inMemoryRateLimitStore holds the rate limit states in memory, so it can only be used by a single proxy instance.
+// The expired states are removed periodically, while handling the updates
+type inMemoryRateLimitStore struct {
+	mutStates      sync.Mutex
+	states         map[string]*storedRateLimitState
+	lastSweep      time.Time
+	getTimeHandler func() time.Time
+}
+
+// NewInMemoryRateLimitStore returns a new instance of inMemoryRateLimitStore
+func NewInMemoryRateLimitStore() *inMemoryRateLimitStore {
+	return &inMemoryRateLimitStore{
+		states:         make(map[string]*storedRateLimitState),
+		lastSweep:      time.Now(),
+		getTimeHandler: time.Now,
+	}
+}
+
+// Update applies the handler on the state stored for the provided key, under lock. A missing or expired state is
+// replaced by an empty one, while the updated state expires after the provided duration
+func (store *inMemoryRateLimitStore) Update(key string, expiry time.Duration, handler func(state *data.RateLimitState)) error {
+	store.mutStates.Lock()
+	defer store.mutStates.Unlock()
+
+	now := store.getTimeHandler()
+	if now.Sub(store.lastSweep) >= sweepIntervalForExpiredStates {
+		store.removeExpiredStates(now)
+	}
+
+	stored, found := store.states[key]
+	if !found || now.After(stored.expiresAt) {
+		stored = &storedRateLimitState{}
+		store.states[key] = stored
+	}
+
+	handler(&stored.state)
+	stored.expiresAt = now.Add(expiry)
+
+	return nil
+}
+
+func (store *inMemoryRateLimitStore) removeExpiredStates(now time.Time) {
+	for key, stored := range store.states {
+		if now.After(stored.expiresAt) {
+			delete(store.states, key)
+		}
+	}
+
+	store.lastSweep = now
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (store *inMemoryRateLimitStore) IsInterfaceNil() bool {
+	return store == nil
+}
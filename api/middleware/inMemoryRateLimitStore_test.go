@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInMemoryRateLimitStore(t *testing.T) {
+	t.Parallel()
+
+	store := NewInMemoryRateLimitStore()
+	assert.False(t, check.IfNil(store))
+}
+
+func TestInMemoryRateLimitStore_Update(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Now()
+	store := NewInMemoryRateLimitStore()
+	store.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+
+	increment := func(state *data.RateLimitState) {
+		state.CurrentCount++
+	}
+	_ = store.Update("key", time.Second, increment)
+	_ = store.Update("key", time.Second, increment)
+	assert.Equal(t, uint64(2), store.states["key"].state.CurrentCount)
+
+	currentTime = currentTime.Add(2 * time.Second)
+	_ = store.Update("key", time.Second, increment)
+	assert.Equal(t, uint64(1), store.states["key"].state.CurrentCount, "expired state should have been reset")
+
+	_ = store.Update("other key", time.Hour, increment)
+	currentTime = currentTime.Add(sweepIntervalForExpiredStates)
+	_ = store.Update("another key", time.Second, increment)
+	assert.Equal(t, 2, len(store.states), "expired states should have been removed")
+	assert.NotNil(t, store.states["other key"])
+	assert.NotNil(t, store.states["another key"])
+}
+
+func TestInMemoryRateLimitStore_ConcurrentUpdates(t *testing.T) {
+	t.Parallel()
+
+	store := NewInMemoryRateLimitStore()
+	numUpdates := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numUpdates)
+	for i := 0; i < numUpdates; i++ {
+		go func() {
+			_ = store.Update("key", time.Minute, func(state *data.RateLimitState) {
+				state.CurrentCount++
+			})
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, uint64(numUpdates), store.states["key"].state.CurrentCount)
+}
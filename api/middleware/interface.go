@@ -4,17 +4,26 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // RateLimiterHandler defines the actions that an implementation of rate limiter handler should do
 type RateLimiterHandler interface {
 	MiddlewareProcessor
-	ResetMap(version string)
+}
+
+// RateLimitStore defines what a storage of the rate limit states should do. The update has to be atomic for a key, so
+// that an external store shared by more proxy instances counts all their requests
+type RateLimitStore interface {
+	Update(key string, expiry time.Duration, handler func(state *data.RateLimitState)) error
+	IsInterfaceNil() bool
 }
 
 // StatusMetricsExtractor defines what a status metrics extractor should do
 type StatusMetricsExtractor interface {
-	AddRequestData(path string, withError bool, duration time.Duration)
+	AddRequestData(path string, statusCode int, duration time.Duration)
+	IncrementInFlightRequests(path string)
+	DecrementInFlightRequests(path string)
 	IsInterfaceNil() bool
 }
 
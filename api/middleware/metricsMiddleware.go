@@ -1,8 +1,6 @@
 package middleware
 
 import (
-	"bytes"
-	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -29,19 +27,19 @@ func NewMetricsMiddleware(statusMetricsExtractor StatusMetricsExtractor) (*metri
 // MiddlewareHandlerFunc logs updated data in regards to endpoints' durations statistics
 func (mm *metricsMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		t := time.Now()
+		// the route is matched before the handlers chain is started, so the full path is already known
+		fullPath := c.FullPath()
+		mm.statusMetricsExtractor.IncrementInFlightRequests(fullPath)
+		defer mm.statusMetricsExtractor.DecrementInFlightRequests(fullPath)
 
-		bw := &bodyWriter{body: bytes.NewBufferString(""), ResponseWriter: c.Writer}
-		c.Writer = bw
+		t := time.Now()
 
+		// the response body is not buffered, as only the status is needed and the streaming routes never end their body
 		c.Next()
 
 		duration := time.Since(t)
-		status := c.Writer.Status()
-
-		withError := status != http.StatusOK
 
-		mm.statusMetricsExtractor.AddRequestData(c.FullPath(), withError, duration)
+		mm.statusMetricsExtractor.AddRequestData(fullPath, c.Writer.Status(), duration)
 	}
 }
 
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -52,24 +53,35 @@ func TestMetricsMiddleware_MiddlewareHandlerFunc(t *testing.T) {
 	t.Parallel()
 
 	type receivedRequestData struct {
-		path      string
-		withError bool
-		duration  time.Duration
+		path       string
+		statusCode int
+		duration   time.Duration
 	}
 	receivedData := make([]*receivedRequestData, 0)
+	inFlightRequests := make(map[string]int)
+	maxInFlightRequests := 0
 	mm, err := NewMetricsMiddleware(&apiMock.StatusMetricsExporterStub{
-		AddRequestDataCalled: func(path string, withError bool, duration time.Duration) {
+		AddRequestDataCalled: func(path string, statusCode int, duration time.Duration) {
 			receivedData = append(receivedData, &receivedRequestData{
-				path:      path,
-				withError: withError,
-				duration:  duration,
+				path:       path,
+				statusCode: statusCode,
+				duration:   duration,
 			})
 		},
+		IncrementInFlightRequestsCalled: func(path string) {
+			inFlightRequests[path]++
+			if inFlightRequests[path] > maxInFlightRequests {
+				maxInFlightRequests = inFlightRequests[path]
+			}
+		},
+		DecrementInFlightRequestsCalled: func(path string) {
+			inFlightRequests[path]--
+		},
 	})
 	require.NoError(t, err)
 
 	facade := &apiMock.FacadeStub{
-		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+		GetAccountHandler: func(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
 			return &data.AccountModel{
 				Account: data.Account{
 					Address: address,
@@ -89,5 +101,7 @@ func TestMetricsMiddleware_MiddlewareHandlerFunc(t *testing.T) {
 
 	require.Len(t, receivedData, 1)
 	require.Equal(t, "/address/:address", receivedData[0].path)
-	require.False(t, receivedData[0].withError)
+	require.Equal(t, http.StatusOK, receivedData[0].statusCode)
+	require.Equal(t, 1, maxInFlightRequests)
+	require.Equal(t, 0, inFlightRequests["/address/:address"])
 }
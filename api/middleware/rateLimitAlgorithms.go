@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"math"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// TokenBucketAlgorithm allows bursts up to the limit, while the tokens are refilled continuously over the window
+	TokenBucketAlgorithm = "token-bucket"
+
+	// SlidingWindowAlgorithm weights the requests of the previous window by the time left to slide out of it
+	SlidingWindowAlgorithm = "sliding-window"
+)
+
+type rateLimitDecision struct {
+	isAllowed  bool
+	remaining  uint64
+	resetAfter time.Duration
+	retryAfter time.Duration
+}
+
+type rateLimitAlgorithm func(state *data.RateLimitState, limit uint64, window time.Duration, now time.Time) rateLimitDecision
+
+func getRateLimitAlgorithm(name string) (rateLimitAlgorithm, error) {
+	switch name {
+	case TokenBucketAlgorithm:
+		return applyTokenBucket, nil
+	case SlidingWindowAlgorithm:
+		return applySlidingWindow, nil
+	default:
+		return nil, ErrUnknownRateLimitAlgorithm
+	}
+}
+
+// applyTokenBucket uses a bucket holding at most limit tokens, refilled at a rate of limit tokens per window. Each
+// request consumes a token. The reset duration is the time needed for the bucket to be full again
+func applyTokenBucket(state *data.RateLimitState, limit uint64, window time.Duration, now time.Time) rateLimitDecision {
+	capacity := float64(limit)
+	tokensPerNano := capacity / float64(window)
+	nowNano := now.UnixNano()
+
+	if state.LastUpdateNano == 0 {
+		state.Tokens = capacity
+	} else if nowNano > state.LastUpdateNano {
+		refilledTokens := float64(nowNano-state.LastUpdateNano) * tokensPerNano
+		state.Tokens = math.Min(capacity, state.Tokens+refilledTokens)
+	}
+	state.LastUpdateNano = nowNano
+
+	decision := rateLimitDecision{
+		isAllowed: state.Tokens >= 1,
+	}
+	if decision.isAllowed {
+		state.Tokens--
+	} else {
+		decision.retryAfter = ceilDuration((1 - state.Tokens) / tokensPerNano)
+	}
+
+	decision.remaining = uint64(math.Floor(state.Tokens))
+	decision.resetAfter = ceilDuration((capacity - state.Tokens) / tokensPerNano)
+
+	return decision
+}
+
+// applySlidingWindow counts the requests in fixed windows and estimates the number of requests in the last window
+// duration as the current count plus the previous count weighted by the part of the previous window still covered.
+// The reset duration is the time left until the end of the current window
+func applySlidingWindow(state *data.RateLimitState, limit uint64, window time.Duration, now time.Time) rateLimitDecision {
+	windowNano := int64(window)
+	nowNano := now.UnixNano()
+	currentWindowStart := nowNano - nowNano%windowNano
+
+	if state.WindowStartNano != currentWindowStart {
+		if currentWindowStart-state.WindowStartNano == windowNano {
+			state.PreviousCount = state.CurrentCount
+		} else {
+			state.PreviousCount = 0
+		}
+		state.CurrentCount = 0
+		state.WindowStartNano = currentWindowStart
+	}
+
+	elapsedNano := nowNano - currentWindowStart
+	elapsedRatio := float64(elapsedNano) / float64(windowNano)
+	estimatedCount := float64(state.PreviousCount)*(1-elapsedRatio) + float64(state.CurrentCount)
+
+	decision := rateLimitDecision{
+		isAllowed:  estimatedCount+1 <= float64(limit),
+		resetAfter: time.Duration(windowNano - elapsedNano),
+	}
+	if decision.isAllowed {
+		state.CurrentCount++
+		estimatedCount++
+	} else {
+		decision.retryAfter = computeSlidingWindowRetryAfter(state, limit, window, elapsedNano)
+	}
+
+	decision.remaining = uint64(math.Max(0, float64(limit)-math.Ceil(estimatedCount)))
+
+	return decision
+}
+
+// computeSlidingWindowRetryAfter returns the time left until the estimated count drops enough to allow a new request
+func computeSlidingWindowRetryAfter(state *data.RateLimitState, limit uint64, window time.Duration, elapsedNano int64) time.Duration {
+	allowedCount := float64(limit) - 1
+	if float64(state.CurrentCount) <= allowedCount && state.PreviousCount > 0 {
+		requiredRatio := 1 - (allowedCount-float64(state.CurrentCount))/float64(state.PreviousCount)
+		return ceilDuration(requiredRatio*float64(window)) - time.Duration(elapsedNano)
+	}
+
+	// the current window is full, so the next window has to be waited, until the current requests slide out enough
+	requiredRatio := 1 - allowedCount/float64(state.CurrentCount)
+	return window - time.Duration(elapsedNano) + ceilDuration(requiredRatio*float64(window))
+}
+
+// ceilDuration converts the provided number of nanoseconds to a duration, rounding up the floating point errors
+func ceilDuration(nanoseconds float64) time.Duration {
+	return time.Duration(math.Ceil(nanoseconds))
+}
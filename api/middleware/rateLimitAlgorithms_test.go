@@ -0,0 +1,120 @@
+package middleware
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRateLimitAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	algorithm, err := getRateLimitAlgorithm(TokenBucketAlgorithm)
+	assert.Nil(t, err)
+	assert.NotNil(t, algorithm)
+
+	algorithm, err = getRateLimitAlgorithm(SlidingWindowAlgorithm)
+	assert.Nil(t, err)
+	assert.NotNil(t, algorithm)
+
+	algorithm, err = getRateLimitAlgorithm("")
+	assert.True(t, errors.Is(err, ErrUnknownRateLimitAlgorithm))
+	assert.Nil(t, algorithm)
+}
+
+func TestApplyTokenBucket(t *testing.T) {
+	t.Parallel()
+
+	window := 10 * time.Second
+	limit := uint64(5)
+	state := &data.RateLimitState{}
+	now := time.Unix(1000, 0)
+
+	t.Run("should allow a burst up to the limit", func(t *testing.T) {
+		for i := uint64(0); i < limit; i++ {
+			decision := applyTokenBucket(state, limit, window, now)
+			require.True(t, decision.isAllowed)
+			require.Equal(t, limit-i-1, decision.remaining)
+		}
+
+		decision := applyTokenBucket(state, limit, window, now)
+		assert.False(t, decision.isAllowed)
+		assert.Equal(t, uint64(0), decision.remaining)
+		assert.Equal(t, 2*time.Second, decision.retryAfter)
+		assert.Equal(t, window, decision.resetAfter)
+	})
+	t.Run("should refill the tokens over time", func(t *testing.T) {
+		now = now.Add(4 * time.Second)
+
+		decision := applyTokenBucket(state, limit, window, now)
+		assert.True(t, decision.isAllowed)
+		assert.Equal(t, uint64(1), decision.remaining)
+
+		decision = applyTokenBucket(state, limit, window, now)
+		assert.True(t, decision.isAllowed)
+		assert.Equal(t, uint64(0), decision.remaining)
+
+		decision = applyTokenBucket(state, limit, window, now)
+		assert.False(t, decision.isAllowed)
+	})
+	t.Run("should not refill over the limit", func(t *testing.T) {
+		now = now.Add(time.Hour)
+
+		decision := applyTokenBucket(state, limit, window, now)
+		assert.True(t, decision.isAllowed)
+		assert.Equal(t, limit-1, decision.remaining)
+		assert.Equal(t, 2*time.Second, decision.resetAfter)
+	})
+}
+
+func TestApplySlidingWindow(t *testing.T) {
+	t.Parallel()
+
+	window := 10 * time.Second
+	limit := uint64(4)
+	state := &data.RateLimitState{}
+	windowStart := time.Unix(1000, 0)
+
+	t.Run("should allow up to the limit in a window", func(t *testing.T) {
+		now := windowStart.Add(8 * time.Second)
+		for i := uint64(0); i < limit; i++ {
+			decision := applySlidingWindow(state, limit, window, now)
+			require.True(t, decision.isAllowed)
+			require.Equal(t, limit-i-1, decision.remaining)
+			require.Equal(t, 2*time.Second, decision.resetAfter)
+		}
+
+		decision := applySlidingWindow(state, limit, window, now)
+		assert.False(t, decision.isAllowed)
+		// the next window has to start and a quarter of it to pass, until the previous count weights less than 3
+		assert.Equal(t, 2*time.Second+window/4, decision.retryAfter)
+	})
+	t.Run("window edge should not double the limit", func(t *testing.T) {
+		// right after the window edge, the previous requests still count almost entirely
+		now := windowStart.Add(window + time.Second)
+
+		decision := applySlidingWindow(state, limit, window, now)
+		assert.False(t, decision.isAllowed)
+		assert.Equal(t, 1500*time.Millisecond, decision.retryAfter)
+
+		// at the middle of the window, only half of the previous requests count
+		now = windowStart.Add(window + window/2)
+		decision = applySlidingWindow(state, limit, window, now)
+		assert.True(t, decision.isAllowed)
+		decision = applySlidingWindow(state, limit, window, now)
+		assert.True(t, decision.isAllowed)
+		decision = applySlidingWindow(state, limit, window, now)
+		assert.False(t, decision.isAllowed)
+	})
+	t.Run("should forget the requests older than a window", func(t *testing.T) {
+		now := windowStart.Add(5 * window)
+
+		decision := applySlidingWindow(state, limit, window, now)
+		assert.True(t, decision.isAllowed)
+		assert.Equal(t, limit-1, decision.remaining)
+	})
+}
@@ -2,36 +2,103 @@ package middleware
 
 import (
 	"fmt"
+	"math"
 	"net/http"
-	"sync"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // ReturnCodeRequestError defines a request which hasn't been executed successfully due to a bad request received
 const ReturnCodeRequestError string = "bad_request"
 
+const (
+	headerRateLimitLimit     = "X-RateLimit-Limit"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+	headerRetryAfter         = "Retry-After"
+)
+
+// ArgsRateLimiter holds the arguments needed to create a new rateLimiter
+type ArgsRateLimiter struct {
+	Limits map[string]uint64
+	Window time.Duration
+	Config config.RateLimiterConfig
+	Store  RateLimitStore
+}
+
 type rateLimiter struct {
-	requestsMap    map[string]uint64
-	mutRequestsMap sync.RWMutex
 	limits         map[string]uint64
-	countDuration  time.Duration
+	window         time.Duration
+	algorithm      rateLimitAlgorithm
+	apiKeyHeader   string
+	apiKeysTiers   map[string]uint64
+	store          RateLimitStore
+	getTimeHandler func() time.Time
 }
 
-// NewRateLimiter returns a new instance of rateLimiter
-func NewRateLimiter(limits map[string]uint64, countDuration time.Duration) (*rateLimiter, error) {
-	if limits == nil {
+// NewRateLimiter returns a new instance of rateLimiter. The clients are identified by the API key provided in the
+// configured header, if any, or by their IP otherwise. The limit of each endpoint is multiplied by the limit
+// multiplier of the tier the API key belongs to
+func NewRateLimiter(args ArgsRateLimiter) (*rateLimiter, error) {
+	if args.Limits == nil {
 		return nil, ErrNilLimitsMapForEndpoints
 	}
+	if args.Window <= 0 {
+		return nil, ErrInvalidRateLimitWindow
+	}
+	if check.IfNil(args.Store) {
+		return nil, ErrNilRateLimitStore
+	}
+	algorithm, err := getRateLimitAlgorithm(args.Config.Algorithm)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, args.Config.Algorithm)
+	}
+	apiKeysTiers, err := createApiKeysTiers(args.Config)
+	if err != nil {
+		return nil, err
+	}
+
 	return &rateLimiter{
-		requestsMap:   make(map[string]uint64),
-		limits:        limits,
-		countDuration: countDuration,
+		limits:         args.Limits,
+		window:         args.Window,
+		algorithm:      algorithm,
+		apiKeyHeader:   args.Config.ApiKeyHeader,
+		apiKeysTiers:   apiKeysTiers,
+		store:          args.Store,
+		getTimeHandler: time.Now,
 	}, nil
 }
 
+// createApiKeysTiers returns the limit multiplier of each API key
+func createApiKeysTiers(cfg config.RateLimiterConfig) (map[string]uint64, error) {
+	tiers := make(map[string]uint64, len(cfg.Tiers))
+	for _, tier := range cfg.Tiers {
+		tiers[tier.Name] = tier.LimitMultiplier
+	}
+
+	apiKeysTiers := make(map[string]uint64, len(cfg.ApiKeys))
+	for _, apiKey := range cfg.ApiKeys {
+		if len(apiKey.Key) == 0 {
+			return nil, ErrEmptyApiKey
+		}
+
+		limitMultiplier, found := tiers[apiKey.Tier]
+		if !found {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownRateLimitTier, apiKey.Tier)
+		}
+
+		apiKeysTiers[apiKey.Key] = limitMultiplier
+	}
+
+	return apiKeysTiers, nil
+}
+
 // MiddlewareHandlerFunc returns the gin middleware for limiting the number of requests for a given endpoint
 func (rl *rateLimiter) MiddlewareHandlerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -42,44 +109,72 @@ func (rl *rateLimiter) MiddlewareHandlerFunc() gin.HandlerFunc {
 			return
 		}
 
-		clientIP := c.ClientIP()
-		key := fmt.Sprintf("%s_%s", endpoint, clientIP)
-
-		numRequests := rl.addInRequestsMap(key)
-		if numRequests >= limitForEndpoint {
-			printMessage := fmt.Sprintf("your IP exceeded the limit of %d requests in %v for this endpoint", limitForEndpoint, rl.countDuration)
-			c.AbortWithStatusJSON(http.StatusTooManyRequests, data.GenericAPIResponse{
-				Data:  nil,
-				Error: printMessage,
-				Code:  data.ReturnCode(ReturnCodeRequestError),
-			})
+		clientKey, limitMultiplier, isApiKey := rl.identifyClient(c)
+		if limitMultiplier == 0 {
+			// unlimited tier
+			return
+		}
+
+		limit := limitForEndpoint * limitMultiplier
+		decision, err := rl.applyLimit(fmt.Sprintf("%s_%s", endpoint, clientKey), limit)
+		if err != nil {
+			log.Warn("rate limiter: cannot update the state, the request will be allowed", "endpoint", endpoint, "error", err)
+			return
 		}
+
+		c.Header(headerRateLimitLimit, strconv.FormatUint(limit, 10))
+		c.Header(headerRateLimitRemaining, strconv.FormatUint(decision.remaining, 10))
+		c.Header(headerRateLimitReset, formatSeconds(decision.resetAfter))
+		if decision.isAllowed {
+			return
+		}
+
+		client := "IP"
+		if isApiKey {
+			client = "API key"
+		}
+		printMessage := fmt.Sprintf("your %s exceeded the limit of %d requests in %v for this endpoint", client, limit, rl.window)
+		c.Header(headerRetryAfter, formatSeconds(decision.retryAfter))
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, data.GenericAPIResponse{
+			Data:      nil,
+			Error:     printMessage,
+			Code:      data.ReturnCode(ReturnCodeRequestError),
+			RequestID: shared.GetRequestID(c),
+		})
 	}
 }
 
-func (rl *rateLimiter) addInRequestsMap(key string) uint64 {
-	rl.mutRequestsMap.Lock()
-	defer rl.mutRequestsMap.Unlock()
+// identifyClient returns the key of the client along with the limit multiplier of its tier. The requests without a
+// known API key are identified by the client IP and use the endpoint limits as they are
+func (rl *rateLimiter) identifyClient(c *gin.Context) (string, uint64, bool) {
+	if len(rl.apiKeyHeader) == 0 {
+		return "ip:" + c.ClientIP(), 1, false
+	}
 
-	_, ok := rl.requestsMap[key]
-	if !ok {
-		rl.requestsMap[key] = 1
-		return 1
+	apiKey := c.GetHeader(rl.apiKeyHeader)
+	limitMultiplier, found := rl.apiKeysTiers[apiKey]
+	if len(apiKey) == 0 || !found {
+		return "ip:" + c.ClientIP(), 1, false
 	}
 
-	rl.requestsMap[key]++
+	return "key:" + apiKey, limitMultiplier, true
+}
+
+func (rl *rateLimiter) applyLimit(key string, limit uint64) (rateLimitDecision, error) {
+	var decision rateLimitDecision
+	// the state is kept for two windows, as the sliding window algorithm still uses the counter of the previous window
+	err := rl.store.Update(key, 2*rl.window, func(state *data.RateLimitState) {
+		decision = rl.algorithm(state, limit, rl.window, rl.getTimeHandler())
+	})
 
-	return rl.requestsMap[key]
+	return decision, err
 }
 
-// ResetMap has to be called from outside at a given interval so the requests map will be cleaned and older restrictions
-// would be erased
-func (rl *rateLimiter) ResetMap(version string) {
-	rl.mutRequestsMap.Lock()
-	rl.requestsMap = make(map[string]uint64)
-	rl.mutRequestsMap.Unlock()
+// formatSeconds returns the provided duration as a number of seconds, rounded up
+func formatSeconds(duration time.Duration) string {
+	seconds := math.Ceil(duration.Seconds())
 
-	log.Info("rate limiter map has been reset", "version", version, "time", time.Now())
+	return strconv.FormatInt(int64(math.Max(0, seconds)), 10)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -12,35 +14,46 @@ import (
 	"github.com/multiversx/mx-chain-proxy-go/api/groups"
 	"github.com/multiversx/mx-chain-proxy-go/api/mock"
 	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func TestNewRateLimiter_NilLimitsMapShouldErr(t *testing.T) {
-	t.Parallel()
+const testApiKeyHeader = "X-Api-Key"
 
-	rl, err := NewRateLimiter(nil, time.Millisecond)
-	require.Equal(t, ErrNilLimitsMapForEndpoints, err)
-	require.True(t, check.IfNil(rl))
+func createRateLimiterArgs(limits map[string]uint64) ArgsRateLimiter {
+	return ArgsRateLimiter{
+		Limits: limits,
+		Window: time.Minute,
+		Config: config.RateLimiterConfig{
+			Algorithm:    TokenBucketAlgorithm,
+			ApiKeyHeader: testApiKeyHeader,
+			Tiers: []config.RateLimitTierConfig{
+				{Name: "premium", LimitMultiplier: 10},
+				{Name: "internal", LimitMultiplier: 0},
+			},
+			ApiKeys: []config.RateLimitApiKeyConfig{
+				{Key: "premium-key", Tier: "premium"},
+				{Key: "internal-key", Tier: "internal"},
+			},
+		},
+		Store: NewInMemoryRateLimitStore(),
+	}
 }
 
-func TestNewRateLimiter_ShouldWork(t *testing.T) {
-	t.Parallel()
+func createRateLimiterWithTime(args ArgsRateLimiter, currentTime *time.Time) *rateLimiter {
+	rl, _ := NewRateLimiter(args)
+	rl.getTimeHandler = func() time.Time {
+		return *currentTime
+	}
 
-	rl, err := NewRateLimiter(map[string]uint64{"abc": 5}, time.Millisecond)
-	require.NoError(t, err)
-	require.False(t, check.IfNil(rl))
+	return rl
 }
 
-func TestRateLimiter_IpRestrictionRaisedAndErased(t *testing.T) {
-	t.Parallel()
-
-	rl, err := NewRateLimiter(map[string]uint64{"/address/:address": 2}, time.Millisecond)
-	require.NoError(t, err)
-
-	facade := &mock.FacadeStub{
-		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+func createAccountsFacade() *mock.FacadeStub {
+	return &mock.FacadeStub{
+		GetAccountHandler: func(_ context.Context, address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
 			return &data.AccountModel{
 				Account: data.Account{
 					Address: address,
@@ -50,70 +63,196 @@ func TestRateLimiter_IpRestrictionRaisedAndErased(t *testing.T) {
 			}, nil
 		},
 	}
-	addressGroup, err := groups.NewAccountsGroup(facade)
-	require.NoError(t, err)
-	ws := startProxyServer(addressGroup, rl, 2, "/address")
+}
 
+func sendAccountRequest(ws *gin.Engine, apiKey string) *httptest.ResponseRecorder {
 	resp := httptest.NewRecorder()
-	context, _ := gin.CreateTestContext(resp)
-	req, _ := http.NewRequestWithContext(context, "GET", "/address/test", nil)
+	req, _ := http.NewRequest("GET", "/address/test", nil)
+	if len(apiKey) > 0 {
+		req.Header.Set(testApiKeyHeader, apiKey)
+	}
 	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil limits map should error", func(t *testing.T) {
+		t.Parallel()
+
+		rl, err := NewRateLimiter(createRateLimiterArgs(nil))
+		require.Equal(t, ErrNilLimitsMapForEndpoints, err)
+		require.True(t, check.IfNil(rl))
+	})
+	t.Run("invalid window should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRateLimiterArgs(map[string]uint64{"abc": 5})
+		args.Window = 0
+		rl, err := NewRateLimiter(args)
+		require.Equal(t, ErrInvalidRateLimitWindow, err)
+		require.True(t, check.IfNil(rl))
+	})
+	t.Run("nil store should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRateLimiterArgs(map[string]uint64{"abc": 5})
+		args.Store = nil
+		rl, err := NewRateLimiter(args)
+		require.Equal(t, ErrNilRateLimitStore, err)
+		require.True(t, check.IfNil(rl))
+	})
+	t.Run("unknown algorithm should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRateLimiterArgs(map[string]uint64{"abc": 5})
+		args.Config.Algorithm = "fixed-window"
+		rl, err := NewRateLimiter(args)
+		require.True(t, errors.Is(err, ErrUnknownRateLimitAlgorithm))
+		require.True(t, check.IfNil(rl))
+	})
+	t.Run("empty API key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRateLimiterArgs(map[string]uint64{"abc": 5})
+		args.Config.ApiKeys = append(args.Config.ApiKeys, config.RateLimitApiKeyConfig{Tier: "premium"})
+		rl, err := NewRateLimiter(args)
+		require.Equal(t, ErrEmptyApiKey, err)
+		require.True(t, check.IfNil(rl))
+	})
+	t.Run("unknown tier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRateLimiterArgs(map[string]uint64{"abc": 5})
+		args.Config.ApiKeys = append(args.Config.ApiKeys, config.RateLimitApiKeyConfig{Key: "key", Tier: "gold"})
+		rl, err := NewRateLimiter(args)
+		require.True(t, errors.Is(err, ErrUnknownRateLimitTier))
+		require.True(t, check.IfNil(rl))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rl, err := NewRateLimiter(createRateLimiterArgs(map[string]uint64{"abc": 5}))
+		require.NoError(t, err)
+		require.False(t, check.IfNil(rl))
+	})
+}
+
+func TestRateLimiter_IpRestrictionRaisedAndErased(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Now()
+	rl := createRateLimiterWithTime(createRateLimiterArgs(map[string]uint64{"/address/:address": 2}), &currentTime)
+
+	addressGroup, err := groups.NewAccountsGroup(createAccountsFacade())
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, rl, 2, "/address")
+
+	resp := sendAccountRequest(ws, "")
 	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "2", resp.Header().Get(headerRateLimitLimit))
+	assert.Equal(t, "1", resp.Header().Get(headerRateLimitRemaining))
+	assert.Equal(t, "30", resp.Header().Get(headerRateLimitReset))
 
-	req, _ = http.NewRequestWithContext(context, "GET", "/address/test", nil)
-	resp = httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
-	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	resp = sendAccountRequest(ws, "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "0", resp.Header().Get(headerRateLimitRemaining))
 
-	req, _ = http.NewRequestWithContext(context, "GET", "/address/test", nil)
-	resp = httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
+	resp = sendAccountRequest(ws, "")
 	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Equal(t, "0", resp.Header().Get(headerRateLimitRemaining))
+	assert.Equal(t, "30", resp.Header().Get(headerRetryAfter))
 
-	rl.ResetMap("")
+	// half of the window refills one token
+	currentTime = currentTime.Add(30 * time.Second)
 
-	req, _ = http.NewRequestWithContext(context, "GET", "/address/test", nil)
-	resp = httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
+	resp = sendAccountRequest(ws, "")
 	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp = sendAccountRequest(ws, "")
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
 }
 
-func TestRateLimiter_EndpointNotLimitedShouldNotRaiseRestrictions(t *testing.T) {
+func TestRateLimiter_ApiKeyTiers(t *testing.T) {
 	t.Parallel()
 
-	rl, err := NewRateLimiter(map[string]uint64{"/address/:address/nonce": 1}, time.Millisecond)
+	currentTime := time.Now()
+	rl := createRateLimiterWithTime(createRateLimiterArgs(map[string]uint64{"/address/:address": 1}), &currentTime)
+
+	addressGroup, err := groups.NewAccountsGroup(createAccountsFacade())
 	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, rl, 1, "/address")
 
-	facade := &mock.FacadeStub{
-		GetAccountHandler: func(address string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
-			return &data.AccountModel{
-				Account: data.Account{
-					Address: address,
-					Nonce:   1,
-					Balance: "100",
-				},
-			}, nil
+	t.Run("premium API key should get the multiplied limit", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			resp := sendAccountRequest(ws, "premium-key")
+			require.Equal(t, http.StatusOK, resp.Code)
+			require.Equal(t, "10", resp.Header().Get(headerRateLimitLimit))
+		}
+
+		resp := sendAccountRequest(ws, "premium-key")
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	})
+	t.Run("requests without API key should be limited by IP, separately", func(t *testing.T) {
+		resp := sendAccountRequest(ws, "")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "1", resp.Header().Get(headerRateLimitLimit))
+
+		resp = sendAccountRequest(ws, "")
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	})
+	t.Run("unknown API key should be limited by IP, as the requests without API key", func(t *testing.T) {
+		resp := sendAccountRequest(ws, "unknown-key")
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+		assert.Equal(t, "1", resp.Header().Get(headerRateLimitLimit))
+	})
+	t.Run("unlimited tier should not be limited", func(t *testing.T) {
+		for i := 0; i < 20; i++ {
+			resp := sendAccountRequest(ws, "internal-key")
+			require.Equal(t, http.StatusOK, resp.Code)
+			require.Empty(t, resp.Header().Get(headerRateLimitLimit))
+		}
+	})
+}
+
+func TestRateLimiter_StoreErrorShouldAllowTheRequest(t *testing.T) {
+	t.Parallel()
+
+	args := createRateLimiterArgs(map[string]uint64{"/address/:address": 1})
+	args.Store = &mock.RateLimitStoreStub{
+		UpdateCalled: func(key string, expiry time.Duration, handler func(state *data.RateLimitState)) error {
+			return errors.New("store unavailable")
 		},
 	}
-	addressGroup, err := groups.NewAccountsGroup(facade)
+	rl, _ := NewRateLimiter(args)
+
+	addressGroup, err := groups.NewAccountsGroup(createAccountsFacade())
 	require.NoError(t, err)
 	ws := startProxyServer(addressGroup, rl, 1, "/address")
 
-	resp := httptest.NewRecorder()
-	context, _ := gin.CreateTestContext(resp)
-	req, _ := http.NewRequestWithContext(context, "GET", "/address/test", nil)
-	ws.ServeHTTP(resp, req)
-	assert.Equal(t, http.StatusOK, resp.Code)
+	for i := 0; i < 3; i++ {
+		resp := sendAccountRequest(ws, "")
+		assert.Equal(t, http.StatusOK, resp.Code)
+	}
+}
 
-	req, _ = http.NewRequestWithContext(context, "GET", "/address/test", nil)
-	resp = httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
-	assert.Equal(t, http.StatusOK, resp.Code)
+func TestRateLimiter_EndpointNotLimitedShouldNotRaiseRestrictions(t *testing.T) {
+	t.Parallel()
 
-	req, _ = http.NewRequestWithContext(context, "GET", "/address/test", nil)
-	resp = httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
-	assert.Equal(t, http.StatusOK, resp.Code)
+	rl, err := NewRateLimiter(createRateLimiterArgs(map[string]uint64{"/address/:address/nonce": 1}))
+	require.NoError(t, err)
+
+	addressGroup, err := groups.NewAccountsGroup(createAccountsFacade())
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, rl, 1, "/address")
+
+	for i := 0; i < 3; i++ {
+		resp := sendAccountRequest(ws, "")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, resp.Header().Get(headerRateLimitLimit))
+	}
 }
 
 func startProxyServer(group data.GroupHandler, rateLimiter RateLimiterHandler, rateLimit uint64, path string) *gin.Engine {
@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+)
+
+const maxRequestIDLength = 128
+
+type requestIDMiddleware struct {
+}
+
+// NewRequestIDMiddleware returns a new instance of requestIDMiddleware
+func NewRequestIDMiddleware() *requestIDMiddleware {
+	return &requestIDMiddleware{}
+}
+
+// MiddlewareHandlerFunc identifies each request by the ID received in its header, or by a newly generated one if there
+// is none or it is not valid. The ID is returned in the response header and carried by the context of the request, so
+// it can be forwarded to the observers and returned in the error responses
+func (rim *requestIDMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		// the internal requests of the JSON-RPC and batch routes already carry the request info of the outer request
+		if common.RequestInfoFromContext(ctx) != nil {
+			c.Next()
+			return
+		}
+
+		requestID := c.GetHeader(common.RequestIDHeader)
+		if !isValidRequestID(requestID) {
+			requestID = newRequestID()
+		}
+
+		c.Header(common.RequestIDHeader, requestID)
+		c.Request = c.Request.WithContext(common.ContextWithRequestInfo(ctx, common.NewRequestInfo(requestID)))
+
+		c.Next()
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rim *requestIDMiddleware) IsInterfaceNil() bool {
+	return rim == nil
+}
+
+// isValidRequestID accepts only the characters commonly used by the request IDs, so the received values can be safely
+// written to the logs and forwarded to the observers
+func isValidRequestID(requestID string) bool {
+	if len(requestID) == 0 || len(requestID) > maxRequestIDLength {
+		return false
+	}
+
+	for _, ch := range requestID {
+		isAlphanumeric := (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || (ch >= '0' && ch <= '9')
+		isSeparator := ch == '-' || ch == '_' || ch == '.' || ch == ':'
+		if !isAlphanumeric && !isSeparator {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newRequestID generates a random UUID (version 4)
+func newRequestID() string {
+	uuid := make([]byte, 16)
+	_, _ = rand.Read(uuid)
+	uuid[6] = (uuid[6] & 0x0f) | 0x40
+	uuid[8] = (uuid[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%s-%s-%s-%s-%s",
+		hex.EncodeToString(uuid[0:4]),
+		hex.EncodeToString(uuid[4:6]),
+		hex.EncodeToString(uuid[6:8]),
+		hex.EncodeToString(uuid[8:10]),
+		hex.EncodeToString(uuid[10:16]),
+	)
+}
@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/stretchr/testify/require"
+)
+
+var uuidRegexp = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func startApiServerRequestID(handler gin.HandlerFunc) *gin.Engine {
+	ws := gin.New()
+	ws.Use(NewRequestIDMiddleware().MiddlewareHandlerFunc())
+	ws.GET("/address/:address", handler)
+
+	return ws
+}
+
+func TestNewRequestIDMiddleware(t *testing.T) {
+	t.Parallel()
+
+	rim := NewRequestIDMiddleware()
+	require.False(t, rim.IsInterfaceNil())
+}
+
+func TestRequestIDMiddleware_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should generate the request ID if missing", func(t *testing.T) {
+		t.Parallel()
+
+		var requestInfo *common.RequestInfo
+		ws := startApiServerRequestID(func(c *gin.Context) {
+			requestInfo = common.RequestInfoFromContext(c.Request.Context())
+			c.JSON(http.StatusOK, gin.H{})
+		})
+
+		req, _ := http.NewRequest(http.MethodGet, "/address/erd1", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		requestID := resp.Header().Get(common.RequestIDHeader)
+		require.True(t, uuidRegexp.MatchString(requestID), requestID)
+		require.NotNil(t, requestInfo)
+		require.Equal(t, requestID, requestInfo.ID)
+	})
+	t.Run("should propagate a valid request ID", func(t *testing.T) {
+		t.Parallel()
+
+		var requestInfo *common.RequestInfo
+		ws := startApiServerRequestID(func(c *gin.Context) {
+			requestInfo = common.RequestInfoFromContext(c.Request.Context())
+			c.JSON(http.StatusOK, gin.H{})
+		})
+
+		req, _ := http.NewRequest(http.MethodGet, "/address/erd1", nil)
+		req.Header.Set(common.RequestIDHeader, "client-id_1.2:3")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, "client-id_1.2:3", resp.Header().Get(common.RequestIDHeader))
+		require.Equal(t, "client-id_1.2:3", requestInfo.ID)
+	})
+	t.Run("should replace an invalid request ID", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startApiServerRequestID(func(c *gin.Context) {
+			c.JSON(http.StatusOK, gin.H{})
+		})
+
+		for _, invalidRequestID := range []string{"id with spaces", "id\"quoted", strings.Repeat("a", maxRequestIDLength+1)} {
+			req, _ := http.NewRequest(http.MethodGet, "/address/erd1", nil)
+			req.Header.Set(common.RequestIDHeader, invalidRequestID)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			require.True(t, uuidRegexp.MatchString(resp.Header().Get(common.RequestIDHeader)), invalidRequestID)
+		}
+	})
+	t.Run("internal request should keep the request info of the outer request", func(t *testing.T) {
+		t.Parallel()
+
+		outerRequestInfo := common.NewRequestInfo("outer-id")
+		var requestInfo *common.RequestInfo
+		ws := startApiServerRequestID(func(c *gin.Context) {
+			requestInfo = common.RequestInfoFromContext(c.Request.Context())
+			c.JSON(http.StatusOK, gin.H{})
+		})
+
+		ctx := common.ContextWithRequestInfo(context.Background(), outerRequestInfo)
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/address/erd1", nil)
+		req.Header.Set(common.RequestIDHeader, "other-id")
+		ws.ServeHTTP(httptest.NewRecorder(), req)
+
+		require.True(t, outerRequestInfo == requestInfo)
+	})
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -54,7 +55,7 @@ func TestResponseLoggerMiddleware_DurationExceedsTimeout(t *testing.T) {
 	thresholdDuration := 10 * time.Millisecond
 	addr := "testAddress"
 	facade := mock.FacadeStub{
-		GetAccountHandler: func(s string, _ common.AccountQueryOptions) (i *data.AccountModel, e error) {
+		GetAccountHandler: func(_ context.Context, s string, _ common.AccountQueryOptions) (i *data.AccountModel, e error) {
 			time.Sleep(thresholdDuration + 1*time.Millisecond)
 			return &data.AccountModel{
 				Account: data.Account{
@@ -98,7 +99,7 @@ func TestResponseLoggerMiddleware_InternalError(t *testing.T) {
 	expectedErr := errors.New("internal err")
 	thresholdDuration := 10000 * time.Millisecond
 	facade := mock.FacadeStub{
-		GetAccountHandler: func(_ string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
+		GetAccountHandler: func(_ context.Context, _ string, _ common.AccountQueryOptions) (*data.AccountModel, error) {
 			return nil, expectedErr
 		},
 	}
@@ -136,7 +137,7 @@ func TestResponseLoggerMiddleware_ShouldNotCallHandler(t *testing.T) {
 
 	thresholdDuration := 10000 * time.Millisecond
 	facade := mock.FacadeStub{
-		GetAccountHandler: func(s string, _ common.AccountQueryOptions) (i *data.AccountModel, e error) {
+		GetAccountHandler: func(_ context.Context, s string, _ common.AccountQueryOptions) (i *data.AccountModel, e error) {
 			return &data.AccountModel{
 				Account: data.Account{
 					Balance: "5555",
@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "api/middleware"
+
+type tracingMiddleware struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+// NewTracingMiddleware returns a new instance of tracingMiddleware
+func NewTracingMiddleware(tracerProvider trace.TracerProvider, propagator propagation.TextMapPropagator) (*tracingMiddleware, error) {
+	if tracerProvider == nil {
+		return nil, ErrNilTracerProvider
+	}
+	if propagator == nil {
+		return nil, ErrNilTextMapPropagator
+	}
+
+	return &tracingMiddleware{
+		tracer:     tracerProvider.Tracer(tracerName),
+		propagator: propagator,
+	}, nil
+}
+
+// MiddlewareHandlerFunc starts a span for each request, continuing the trace from the request headers if any. The span
+// is carried by the context of the request, so the spans started while serving it become its children
+func (tm *tracingMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		// the internal requests of the JSON-RPC and batch routes already carry the span of the outer request
+		if !trace.SpanContextFromContext(ctx).IsValid() {
+			ctx = tm.propagator.Extract(ctx, propagation.HeaderCarrier(c.Request.Header))
+		}
+
+		route := c.FullPath()
+		spanName := fmt.Sprintf("%s %s", c.Request.Method, route)
+		if len(route) == 0 {
+			spanName = fmt.Sprintf("%s unknown route", c.Request.Method)
+		}
+
+		ctx, span := tm.tracer.Start(
+			ctx,
+			spanName,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPMethod(c.Request.Method),
+				semconv.HTTPRoute(route),
+				semconv.HTTPTarget(c.Request.URL.RequestURI()),
+			),
+		)
+		defer span.End()
+
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttributes(semconv.HTTPStatusCode(status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tm *tracingMiddleware) IsInterfaceNil() bool {
+	return tm == nil
+}
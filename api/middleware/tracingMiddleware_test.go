@@ -0,0 +1,120 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func startApiServerTracing(t *testing.T, spanRecorder *tracetest.SpanRecorder, handler gin.HandlerFunc) *gin.Engine {
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder))
+	tm, err := NewTracingMiddleware(tracerProvider, propagation.TraceContext{})
+	require.NoError(t, err)
+
+	ws := gin.New()
+	ws.Use(tm.MiddlewareHandlerFunc())
+	ws.GET("/transaction/:txhash", handler)
+
+	return ws
+}
+
+func TestNewTracingMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil tracer provider should err", func(t *testing.T) {
+		t.Parallel()
+
+		tm, err := NewTracingMiddleware(nil, propagation.TraceContext{})
+		require.Nil(t, tm)
+		require.Equal(t, ErrNilTracerProvider, err)
+	})
+	t.Run("nil propagator should err", func(t *testing.T) {
+		t.Parallel()
+
+		tm, err := NewTracingMiddleware(sdktrace.NewTracerProvider(), nil)
+		require.Nil(t, tm)
+		require.Equal(t, ErrNilTextMapPropagator, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tm, err := NewTracingMiddleware(sdktrace.NewTracerProvider(), propagation.TraceContext{})
+		require.NoError(t, err)
+		require.False(t, tm.IsInterfaceNil())
+	})
+}
+
+func TestTracingMiddleware_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should start a span carried by the request context", func(t *testing.T) {
+		t.Parallel()
+
+		spanRecorder := tracetest.NewSpanRecorder()
+		var handlerSpanContext trace.SpanContext
+		ws := startApiServerTracing(t, spanRecorder, func(c *gin.Context) {
+			handlerSpanContext = trace.SpanContextFromContext(c.Request.Context())
+			c.JSON(http.StatusOK, gin.H{})
+		})
+
+		req, _ := http.NewRequest(http.MethodGet, "/transaction/aabb?withResults=true", nil)
+		ws.ServeHTTP(httptest.NewRecorder(), req)
+
+		spans := spanRecorder.Ended()
+		require.Len(t, spans, 1)
+		require.Equal(t, "GET /transaction/:txhash", spans[0].Name())
+		require.Equal(t, trace.SpanKindServer, spans[0].SpanKind())
+		require.False(t, spans[0].Parent().IsValid())
+		require.Equal(t, spans[0].SpanContext(), handlerSpanContext)
+
+		attributes := attribute.NewSet(spans[0].Attributes()...)
+		route, _ := attributes.Value("http.route")
+		require.Equal(t, "/transaction/:txhash", route.AsString())
+		target, _ := attributes.Value("http.target")
+		require.Equal(t, "/transaction/aabb?withResults=true", target.AsString())
+		statusCode, _ := attributes.Value("http.status_code")
+		require.Equal(t, int64(http.StatusOK), statusCode.AsInt64())
+		require.Equal(t, codes.Unset, spans[0].Status().Code)
+	})
+	t.Run("should continue the trace of the traceparent header", func(t *testing.T) {
+		t.Parallel()
+
+		spanRecorder := tracetest.NewSpanRecorder()
+		ws := startApiServerTracing(t, spanRecorder, func(c *gin.Context) {
+			c.JSON(http.StatusInternalServerError, gin.H{})
+		})
+
+		req, _ := http.NewRequest(http.MethodGet, "/transaction/aabb", nil)
+		req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+		ws.ServeHTTP(httptest.NewRecorder(), req)
+
+		spans := spanRecorder.Ended()
+		require.Len(t, spans, 1)
+		require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spans[0].SpanContext().TraceID().String())
+		require.Equal(t, "00f067aa0ba902b7", spans[0].Parent().SpanID().String())
+		require.True(t, spans[0].Parent().IsRemote())
+		require.Equal(t, codes.Error, spans[0].Status().Code)
+	})
+	t.Run("unknown route", func(t *testing.T) {
+		t.Parallel()
+
+		spanRecorder := tracetest.NewSpanRecorder()
+		ws := startApiServerTracing(t, spanRecorder, func(c *gin.Context) {})
+
+		req, _ := http.NewRequest(http.MethodGet, "/unknown", nil)
+		ws.ServeHTTP(httptest.NewRecorder(), req)
+
+		spans := spanRecorder.Ended()
+		require.Len(t, spans, 1)
+		require.Equal(t, "GET unknown route", spans[0].Name())
+	})
+}
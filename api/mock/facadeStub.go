@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -13,8 +14,8 @@ import (
 // FacadeStub is the mock implementation of a node's router handler
 type FacadeStub struct {
 	IsFaucetEnabledHandler                       func() bool
-	GetAccountHandler                            func(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
-	GetAccountsHandler                           func(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
+	GetAccountHandler                            func(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+	GetAccountsHandler                           func(ctx context.Context, addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
 	GetShardIDForAddressHandler                  func(address string) (uint32, error)
 	GetValueForKeyHandler                        func(address string, key string, options common.AccountQueryOptions) (string, error)
 	GetKeyValuePairsHandler                      func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
@@ -23,24 +24,29 @@ type FacadeStub struct {
 	GetESDTsWithRoleCalled                       func(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetNFTTokenIDsRegisteredByAddressCalled      func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetAllESDTTokensCalled                       func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetTransactionsHandler                       func(address string) ([]data.DatabaseTransaction, error)
-	GetTransactionHandler                        func(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionsHandler                       func(address string, options common.TransactionsHistoryOptions) (*data.DatabaseTransactionsPage, error)
+	GetTransactionHandler                        func(ctx context.Context, txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetTransactionsPoolHandler                   func(fields string) (*data.TransactionsPool, error)
 	GetTransactionsPoolForShardHandler           func(shardID uint32, fields string) (*data.TransactionsPool, error)
 	GetTransactionsPoolForSenderHandler          func(sender, fields string) (*data.TransactionsPoolForSender, error)
 	GetLastPoolNonceForSenderHandler             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderHandler func(sender string) (*data.TransactionsPoolNonceGaps, error)
 	SendTransactionHandler                       func(tx *data.Transaction) (int, string, error)
+	SendTransactionAndWaitHandler                func(ctx context.Context, tx *data.Transaction) (int, *data.TransactionStatusEvent, error)
+	WatchTransactionStatusHandler                func(txHash string) (data.TransactionStatusSubscriptionHandler, error)
+	IsRelayerEnabledHandler                      func() bool
+	GetRelayerAddressHandler                     func(sender string) (string, error)
+	RelayTransactionHandler                      func(tx *data.Transaction) (int, string, error)
 	SendMultipleTransactionsHandler              func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
 	SimulateTransactionHandler                   func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
-	SendUserFundsCalled                          func(receiver string, value *big.Int) error
-	ExecuteSCQueryHandler                        func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
-	GetHeartbeatDataHandler                      func() (*data.HeartbeatResponse, error)
+	SendUserFundsCalled                          func(receiver string, value *big.Int, clientIP string) ([]string, error)
+	ExecuteSCQueryHandler                        func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	GetHeartbeatDataHandler                      func(ctx context.Context) (*data.HeartbeatResponse, error)
 	ValidatorStatisticsHandler                   func() (map[string]*data.ValidatorApiResponse, error)
 	AuctionListHandler                           func() ([]*data.AuctionListValidatorAPIResponse, error)
 	TransactionCostRequestHandler                func(tx *data.Transaction) (*data.TxCostResponseData, error)
-	GetTransactionStatusHandler                  func(txHash string, sender string) (string, error)
-	GetProcessedTransactionStatusHandler         func(txHash string) (*data.ProcessStatusResponse, error)
+	GetTransactionStatusHandler                  func(ctx context.Context, txHash string, sender string) (string, error)
+	GetProcessedTransactionStatusHandler         func(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error)
 	GetConfigMetricsHandler                      func() (*data.GenericAPIResponse, error)
 	GetNetworkMetricsHandler                     func(shardID uint32) (*data.GenericAPIResponse, error)
 	GetAllIssuedESDTsHandler                     func(tokenType string) (*data.GenericAPIResponse, error)
@@ -49,9 +55,11 @@ type FacadeStub struct {
 	GetDirectStakedInfoCalled                    func() (*data.GenericAPIResponse, error)
 	GetDelegatedInfoCalled                       func() (*data.GenericAPIResponse, error)
 	GetRatingsConfigCalled                       func() (*data.GenericAPIResponse, error)
-	GetTransactionByHashAndSenderAddressHandler  func(txHash string, sndAddr string, withResults bool) (*transaction.ApiTransactionResult, int, error)
+	GetTransactionByHashAndSenderAddressHandler  func(ctx context.Context, txHash string, sndAddr string, withResults bool) (*transaction.ApiTransactionResult, int, error)
 	GetBlockByHashCalled                         func(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
 	GetBlockByNonceCalled                        func(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetDatabaseBlockByNonceCalled                func(shardID uint32, nonce uint64) (*data.DatabaseBlock, error)
+	GetTransactionsByMiniblockHashCalled         func(hash string) ([]data.DatabaseTransaction, error)
 	GetBlocksByRoundCalled                       func(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
 	GetInternalBlockByHashCalled                 func(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
 	GetInternalBlockByNonceCalled                func(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
@@ -60,8 +68,13 @@ type FacadeStub struct {
 	GetInternalStartOfEpochValidatorsInfoCalled  func(epoch uint32) (*data.ValidatorsInfoApiResponse, error)
 	GetHyperBlockByHashCalled                    func(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
 	GetHyperBlockByNonceCalled                   func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	SubscribeToHyperblocksCalled                 func(fromNonce core.OptionalUint64) (data.HyperblockSubscriptionHandler, error)
 	ReloadObserversCalled                        func() data.NodesReloadResponse
 	ReloadFullHistoryObserversCalled             func() data.NodesReloadResponse
+	AddNodeCalled                                func(nodesType data.NodeType, request *data.NodeAdminRequest) data.NodesReloadResponse
+	RemoveNodeCalled                             func(nodesType data.NodeType, address string) data.NodesReloadResponse
+	DrainNodeCalled                              func(nodesType data.NodeType, address string) data.NodesReloadResponse
+	UndrainNodeCalled                            func(nodesType data.NodeType, address string) data.NodesReloadResponse
 	GetProofCalled                               func(string, string) (*data.GenericAPIResponse, error)
 	GetProofDataTrieCalled                       func(string, string, string) (*data.GenericAPIResponse, error)
 	GetProofCurrentRootHashCalled                func(string) (*data.GenericAPIResponse, error)
@@ -70,6 +83,8 @@ type FacadeStub struct {
 	GetESDTSupplyCalled                          func(token string) (*data.ESDTSupplyResponse, error)
 	GetMetricsCalled                             func() map[string]*data.EndpointMetrics
 	GetPrometheusMetricsCalled                   func() string
+	GetNodesCircuitBreakerStatusCalled           func() *data.NodesCircuitBreakerStatus
+	GetConnectionPoolMetricsCalled               func() *data.ConnectionPoolMetrics
 	GetGenesisNodesPubKeysCalled                 func() (*data.GenericAPIResponse, error)
 	GetGasConfigsCalled                          func() (*data.GenericAPIResponse, error)
 	IsOldStorageForTokenCalled                   func(tokenID string, nonce uint64) (bool, error)
@@ -86,7 +101,7 @@ type FacadeStub struct {
 }
 
 // GetProof -
-func (f *FacadeStub) GetProof(rootHash string, address string) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetProof(_ context.Context, rootHash string, address string) (*data.GenericAPIResponse, error) {
 	if f.GetProofCalled != nil {
 		return f.GetProofCalled(rootHash, address)
 	}
@@ -95,7 +110,7 @@ func (f *FacadeStub) GetProof(rootHash string, address string) (*data.GenericAPI
 }
 
 // GetProofDataTrie -
-func (f *FacadeStub) GetProofDataTrie(rootHash string, address string, key string) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetProofDataTrie(_ context.Context, rootHash string, address string, key string) (*data.GenericAPIResponse, error) {
 	if f.GetProofDataTrieCalled != nil {
 		return f.GetProofDataTrieCalled(rootHash, address, key)
 	}
@@ -104,7 +119,7 @@ func (f *FacadeStub) GetProofDataTrie(rootHash string, address string, key strin
 }
 
 // GetProofCurrentRootHash -
-func (f *FacadeStub) GetProofCurrentRootHash(address string) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetProofCurrentRootHash(_ context.Context, address string) (*data.GenericAPIResponse, error) {
 	if f.GetProofCurrentRootHashCalled != nil {
 		return f.GetProofCurrentRootHashCalled(address)
 	}
@@ -113,7 +128,7 @@ func (f *FacadeStub) GetProofCurrentRootHash(address string) (*data.GenericAPIRe
 }
 
 // VerifyProof -
-func (f *FacadeStub) VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) VerifyProof(_ context.Context, rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
 	if f.VerifyProofCalled != nil {
 		return f.VerifyProofCalled(rootHash, address, proof)
 	}
@@ -148,8 +163,44 @@ func (f *FacadeStub) ReloadFullHistoryObservers() data.NodesReloadResponse {
 	return data.NodesReloadResponse{}
 }
 
+// AddNode -
+func (f *FacadeStub) AddNode(nodesType data.NodeType, request *data.NodeAdminRequest) data.NodesReloadResponse {
+	if f.AddNodeCalled != nil {
+		return f.AddNodeCalled(nodesType, request)
+	}
+
+	return data.NodesReloadResponse{}
+}
+
+// RemoveNode -
+func (f *FacadeStub) RemoveNode(nodesType data.NodeType, address string) data.NodesReloadResponse {
+	if f.RemoveNodeCalled != nil {
+		return f.RemoveNodeCalled(nodesType, address)
+	}
+
+	return data.NodesReloadResponse{}
+}
+
+// DrainNode -
+func (f *FacadeStub) DrainNode(nodesType data.NodeType, address string) data.NodesReloadResponse {
+	if f.DrainNodeCalled != nil {
+		return f.DrainNodeCalled(nodesType, address)
+	}
+
+	return data.NodesReloadResponse{}
+}
+
+// UndrainNode -
+func (f *FacadeStub) UndrainNode(nodesType data.NodeType, address string) data.NodesReloadResponse {
+	if f.UndrainNodeCalled != nil {
+		return f.UndrainNodeCalled(nodesType, address)
+	}
+
+	return data.NodesReloadResponse{}
+}
+
 // GetNetworkStatusMetrics -
-func (f *FacadeStub) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetNetworkStatusMetrics(_ context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
 	if f.GetNetworkMetricsHandler != nil {
 		return f.GetNetworkMetricsHandler(shardID)
 	}
@@ -158,7 +209,7 @@ func (f *FacadeStub) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIRe
 }
 
 // GetNetworkConfigMetrics -
-func (f *FacadeStub) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetNetworkConfigMetrics(_ context.Context) (*data.GenericAPIResponse, error) {
 	if f.GetConfigMetricsHandler != nil {
 		return f.GetConfigMetricsHandler()
 	}
@@ -176,7 +227,7 @@ func (f *FacadeStub) GetEconomicsDataMetrics() (*data.GenericAPIResponse, error)
 }
 
 // GetAllIssuedESDTs -
-func (f *FacadeStub) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetAllIssuedESDTs(_ context.Context, tokenType string) (*data.GenericAPIResponse, error) {
 	if f.GetAllIssuedESDTsHandler != nil {
 		return f.GetAllIssuedESDTsHandler(tokenType)
 	}
@@ -185,7 +236,7 @@ func (f *FacadeStub) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIRespon
 }
 
 // GetESDTsWithRole -
-func (f *FacadeStub) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetESDTsWithRole(_ context.Context, address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetESDTsWithRoleCalled != nil {
 		return f.GetESDTsWithRoleCalled(address, role, options)
 	}
@@ -194,7 +245,7 @@ func (f *FacadeStub) GetESDTsWithRole(address string, role string, options commo
 }
 
 // GetESDTsRoles -
-func (f *FacadeStub) GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetESDTsRoles(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetESDTsRolesCalled != nil {
 		return f.GetESDTsRolesCalled(address, options)
 	}
@@ -203,7 +254,7 @@ func (f *FacadeStub) GetESDTsRoles(address string, options common.AccountQueryOp
 }
 
 // GetNFTTokenIDsRegisteredByAddress -
-func (f *FacadeStub) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetNFTTokenIDsRegisteredByAddress(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetNFTTokenIDsRegisteredByAddressCalled != nil {
 		return f.GetNFTTokenIDsRegisteredByAddressCalled(address, options)
 	}
@@ -212,7 +263,7 @@ func (f *FacadeStub) GetNFTTokenIDsRegisteredByAddress(address string, options c
 }
 
 // GetDirectStakedInfo -
-func (f *FacadeStub) GetDirectStakedInfo() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetDirectStakedInfo(_ context.Context) (*data.GenericAPIResponse, error) {
 	if f.GetDirectStakedInfoCalled != nil {
 		return f.GetDirectStakedInfoCalled()
 	}
@@ -221,7 +272,7 @@ func (f *FacadeStub) GetDirectStakedInfo() (*data.GenericAPIResponse, error) {
 }
 
 // GetDelegatedInfo -
-func (f *FacadeStub) GetDelegatedInfo() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetDelegatedInfo(_ context.Context) (*data.GenericAPIResponse, error) {
 	if f.GetDelegatedInfoCalled != nil {
 		return f.GetDelegatedInfoCalled()
 	}
@@ -230,17 +281,17 @@ func (f *FacadeStub) GetDelegatedInfo() (*data.GenericAPIResponse, error) {
 }
 
 // GetEnableEpochsMetrics -
-func (f *FacadeStub) GetEnableEpochsMetrics() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetEnableEpochsMetrics(_ context.Context) (*data.GenericAPIResponse, error) {
 	return f.GetEnableEpochsMetricsHandler()
 }
 
 // GetRatingsConfig -
-func (f *FacadeStub) GetRatingsConfig() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetRatingsConfig(_ context.Context) (*data.GenericAPIResponse, error) {
 	return f.GetRatingsConfigCalled()
 }
 
 // GetESDTSupply -
-func (f *FacadeStub) GetESDTSupply(token string) (*data.ESDTSupplyResponse, error) {
+func (f *FacadeStub) GetESDTSupply(_ context.Context, token string) (*data.ESDTSupplyResponse, error) {
 	if f.GetESDTSupplyCalled != nil {
 		return f.GetESDTSupplyCalled(token)
 	}
@@ -249,7 +300,7 @@ func (f *FacadeStub) GetESDTSupply(token string) (*data.ESDTSupplyResponse, erro
 }
 
 // ValidatorStatistics -
-func (f *FacadeStub) ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error) {
+func (f *FacadeStub) ValidatorStatistics(_ context.Context) (map[string]*data.ValidatorApiResponse, error) {
 	if f.ValidatorStatisticsHandler != nil {
 		return f.ValidatorStatisticsHandler()
 	}
@@ -258,7 +309,7 @@ func (f *FacadeStub) ValidatorStatistics() (map[string]*data.ValidatorApiRespons
 }
 
 // AuctionList -
-func (f *FacadeStub) AuctionList() ([]*data.AuctionListValidatorAPIResponse, error) {
+func (f *FacadeStub) AuctionList(_ context.Context) ([]*data.AuctionListValidatorAPIResponse, error) {
 	if f.AuctionListHandler != nil {
 		return f.AuctionListHandler()
 	}
@@ -267,27 +318,27 @@ func (f *FacadeStub) AuctionList() ([]*data.AuctionListValidatorAPIResponse, err
 }
 
 // GetAccount -
-func (f *FacadeStub) GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
-	return f.GetAccountHandler(address, options)
+func (f *FacadeStub) GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+	return f.GetAccountHandler(ctx, address, options)
 }
 
 // GetAccounts -
-func (f *FacadeStub) GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
-	return f.GetAccountsHandler(addresses, options)
+func (f *FacadeStub) GetAccounts(ctx context.Context, addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
+	return f.GetAccountsHandler(ctx, addresses, options)
 }
 
 // GetKeyValuePairs -
-func (f *FacadeStub) GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetKeyValuePairs(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return f.GetKeyValuePairsHandler(address, options)
 }
 
 // GetValueForKey -
-func (f *FacadeStub) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
+func (f *FacadeStub) GetValueForKey(_ context.Context, address string, key string, options common.AccountQueryOptions) (string, error) {
 	return f.GetValueForKeyHandler(address, key, options)
 }
 
 // GetGuardianData -
-func (f *FacadeStub) GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetGuardianData(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return f.GetGuardianDataCalled(address, options)
 }
 
@@ -297,7 +348,7 @@ func (f *FacadeStub) GetShardIDForAddress(address string) (uint32, error) {
 }
 
 // GetESDTTokenData -
-func (f *FacadeStub) GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetESDTTokenData(_ context.Context, address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetESDTTokenDataCalled != nil {
 		return f.GetESDTTokenDataCalled(address, key, options)
 	}
@@ -306,7 +357,7 @@ func (f *FacadeStub) GetESDTTokenData(address string, key string, options common
 }
 
 // GetAllESDTTokens -
-func (f *FacadeStub) GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetAllESDTTokens(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetAllESDTTokensCalled != nil {
 		return f.GetAllESDTTokensCalled(address, options)
 	}
@@ -315,7 +366,7 @@ func (f *FacadeStub) GetAllESDTTokens(address string, options common.AccountQuer
 }
 
 // GetESDTNftTokenData -
-func (f *FacadeStub) GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetESDTNftTokenData(_ context.Context, address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.GetESDTNftTokenDataCalled != nil {
 		return f.GetESDTNftTokenDataCalled(address, key, nonce, options)
 	}
@@ -324,7 +375,7 @@ func (f *FacadeStub) GetESDTNftTokenData(address string, key string, nonce uint6
 }
 
 // IsOldStorageForToken -
-func (f *FacadeStub) IsOldStorageForToken(tokenID string, nonce uint64) (bool, error) {
+func (f *FacadeStub) IsOldStorageForToken(_ context.Context, tokenID string, nonce uint64) (bool, error) {
 	if f.IsOldStorageForTokenCalled != nil {
 		return f.IsOldStorageForTokenCalled(tokenID, nonce)
 	}
@@ -333,22 +384,22 @@ func (f *FacadeStub) IsOldStorageForToken(tokenID string, nonce uint64) (bool, e
 }
 
 // GetTransactions -
-func (f *FacadeStub) GetTransactions(address string) ([]data.DatabaseTransaction, error) {
-	return f.GetTransactionsHandler(address)
+func (f *FacadeStub) GetTransactions(_ context.Context, address string, options common.TransactionsHistoryOptions) (*data.DatabaseTransactionsPage, error) {
+	return f.GetTransactionsHandler(address, options)
 }
 
 // GetTransactionByHashAndSenderAddress -
-func (f *FacadeStub) GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
-	return f.GetTransactionByHashAndSenderAddressHandler(txHash, sndAddr, withEvents)
+func (f *FacadeStub) GetTransactionByHashAndSenderAddress(ctx context.Context, txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
+	return f.GetTransactionByHashAndSenderAddressHandler(ctx, txHash, sndAddr, withEvents)
 }
 
 // GetTransaction -
-func (f *FacadeStub) GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
-	return f.GetTransactionHandler(txHash, withResults)
+func (f *FacadeStub) GetTransaction(ctx context.Context, txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+	return f.GetTransactionHandler(ctx, txHash, withResults)
 }
 
 // GetTransactionsPool -
-func (f *FacadeStub) GetTransactionsPool(fields string) (*data.TransactionsPool, error) {
+func (f *FacadeStub) GetTransactionsPool(_ context.Context, fields string) (*data.TransactionsPool, error) {
 	if f.GetTransactionsPoolHandler != nil {
 		return f.GetTransactionsPoolHandler(fields)
 	}
@@ -357,7 +408,7 @@ func (f *FacadeStub) GetTransactionsPool(fields string) (*data.TransactionsPool,
 }
 
 // GetTransactionsPoolForShard -
-func (f *FacadeStub) GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
+func (f *FacadeStub) GetTransactionsPoolForShard(_ context.Context, shardID uint32, fields string) (*data.TransactionsPool, error) {
 	if f.GetTransactionsPoolForShardHandler != nil {
 		return f.GetTransactionsPoolForShardHandler(shardID, fields)
 	}
@@ -366,7 +417,7 @@ func (f *FacadeStub) GetTransactionsPoolForShard(shardID uint32, fields string)
 }
 
 // GetTransactionsPoolForSender -
-func (f *FacadeStub) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
+func (f *FacadeStub) GetTransactionsPoolForSender(_ context.Context, sender, fields string) (*data.TransactionsPoolForSender, error) {
 	if f.GetTransactionsPoolForSenderHandler != nil {
 		return f.GetTransactionsPoolForSenderHandler(sender, fields)
 	}
@@ -375,7 +426,7 @@ func (f *FacadeStub) GetTransactionsPoolForSender(sender, fields string) (*data.
 }
 
 // GetLastPoolNonceForSender -
-func (f *FacadeStub) GetLastPoolNonceForSender(sender string) (uint64, error) {
+func (f *FacadeStub) GetLastPoolNonceForSender(_ context.Context, sender string) (uint64, error) {
 	if f.GetLastPoolNonceForSenderHandler != nil {
 		return f.GetLastPoolNonceForSenderHandler(sender)
 	}
@@ -384,7 +435,7 @@ func (f *FacadeStub) GetLastPoolNonceForSender(sender string) (uint64, error) {
 }
 
 // GetTransactionsPoolNonceGapsForSender -
-func (f *FacadeStub) GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
+func (f *FacadeStub) GetTransactionsPoolNonceGapsForSender(_ context.Context, sender string) (*data.TransactionsPoolNonceGaps, error) {
 	if f.GetTransactionsPoolNonceGapsForSenderHandler != nil {
 		return f.GetTransactionsPoolNonceGapsForSenderHandler(sender)
 	}
@@ -393,12 +444,41 @@ func (f *FacadeStub) GetTransactionsPoolNonceGapsForSender(sender string) (*data
 }
 
 // SendTransaction -
-func (f *FacadeStub) SendTransaction(tx *data.Transaction) (int, string, error) {
+func (f *FacadeStub) SendTransaction(_ context.Context, tx *data.Transaction) (int, string, error) {
 	return f.SendTransactionHandler(tx)
 }
 
+// SendTransactionAndWait -
+func (f *FacadeStub) SendTransactionAndWait(ctx context.Context, tx *data.Transaction) (int, *data.TransactionStatusEvent, error) {
+	return f.SendTransactionAndWaitHandler(ctx, tx)
+}
+
+// WatchTransactionStatus -
+func (f *FacadeStub) WatchTransactionStatus(txHash string) (data.TransactionStatusSubscriptionHandler, error) {
+	return f.WatchTransactionStatusHandler(txHash)
+}
+
+// IsRelayerEnabled -
+func (f *FacadeStub) IsRelayerEnabled() bool {
+	if f.IsRelayerEnabledHandler != nil {
+		return f.IsRelayerEnabledHandler()
+	}
+
+	return true
+}
+
+// GetRelayerAddress -
+func (f *FacadeStub) GetRelayerAddress(sender string) (string, error) {
+	return f.GetRelayerAddressHandler(sender)
+}
+
+// RelayTransaction -
+func (f *FacadeStub) RelayTransaction(_ context.Context, tx *data.Transaction) (int, string, error) {
+	return f.RelayTransactionHandler(tx)
+}
+
 // SimulateTransaction -
-func (f *FacadeStub) SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) SimulateTransaction(_ context.Context, tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
 	return f.SimulateTransactionHandler(tx, checkSignature)
 }
 
@@ -408,52 +488,70 @@ func (f *FacadeStub) GetAddressConverter() (core.PubkeyConverter, error) {
 }
 
 // SendMultipleTransactions -
-func (f *FacadeStub) SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+func (f *FacadeStub) SendMultipleTransactions(_ context.Context, txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
 	return f.SendMultipleTransactionsHandler(txs)
 }
 
 // TransactionCostRequest -
-func (f *FacadeStub) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
+func (f *FacadeStub) TransactionCostRequest(_ context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
 	return f.TransactionCostRequestHandler(tx)
 }
 
 // GetTransactionStatus -
-func (f *FacadeStub) GetTransactionStatus(txHash string, sender string) (string, error) {
-	return f.GetTransactionStatusHandler(txHash, sender)
+func (f *FacadeStub) GetTransactionStatus(ctx context.Context, txHash string, sender string) (string, error) {
+	return f.GetTransactionStatusHandler(ctx, txHash, sender)
 }
 
 // GetProcessedTransactionStatus -
-func (f *FacadeStub) GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error) {
-	return f.GetProcessedTransactionStatusHandler(txHash)
+func (f *FacadeStub) GetProcessedTransactionStatus(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error) {
+	return f.GetProcessedTransactionStatusHandler(ctx, txHash)
 }
 
 // SendUserFunds -
-func (f *FacadeStub) SendUserFunds(receiver string, value *big.Int) error {
-	return f.SendUserFundsCalled(receiver, value)
+func (f *FacadeStub) SendUserFunds(_ context.Context, receiver string, value *big.Int, clientIP string) ([]string, error) {
+	return f.SendUserFundsCalled(receiver, value, clientIP)
 }
 
 // ExecuteSCQuery -
-func (f *FacadeStub) ExecuteSCQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
-	return f.ExecuteSCQueryHandler(query)
+func (f *FacadeStub) ExecuteSCQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+	return f.ExecuteSCQueryHandler(ctx, query)
 }
 
 // GetHeartbeatData -
-func (f *FacadeStub) GetHeartbeatData() (*data.HeartbeatResponse, error) {
-	return f.GetHeartbeatDataHandler()
+func (f *FacadeStub) GetHeartbeatData(ctx context.Context) (*data.HeartbeatResponse, error) {
+	return f.GetHeartbeatDataHandler(ctx)
 }
 
 // GetBlockByHash -
-func (f *FacadeStub) GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+func (f *FacadeStub) GetBlockByHash(_ context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 	return f.GetBlockByHashCalled(shardID, hash, options)
 }
 
 // GetBlockByNonce -
-func (f *FacadeStub) GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+func (f *FacadeStub) GetBlockByNonce(_ context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 	return f.GetBlockByNonceCalled(shardID, nonce, options)
 }
 
+// GetDatabaseBlockByNonce -
+func (f *FacadeStub) GetDatabaseBlockByNonce(_ context.Context, shardID uint32, nonce uint64) (*data.DatabaseBlock, error) {
+	if f.GetDatabaseBlockByNonceCalled != nil {
+		return f.GetDatabaseBlockByNonceCalled(shardID, nonce)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsByMiniblockHash -
+func (f *FacadeStub) GetTransactionsByMiniblockHash(_ context.Context, hash string) ([]data.DatabaseTransaction, error) {
+	if f.GetTransactionsByMiniblockHashCalled != nil {
+		return f.GetTransactionsByMiniblockHashCalled(hash)
+	}
+
+	return nil, nil
+}
+
 // GetBlocksByRound -
-func (f *FacadeStub) GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
+func (f *FacadeStub) GetBlocksByRound(_ context.Context, round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error) {
 	if f.GetBlocksByRoundCalled != nil {
 		return f.GetBlocksByRoundCalled(round, options)
 	}
@@ -461,35 +559,44 @@ func (f *FacadeStub) GetBlocksByRound(round uint64, options common.BlockQueryOpt
 }
 
 // GetInternalBlockByHash -
-func (f *FacadeStub) GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (f *FacadeStub) GetInternalBlockByHash(_ context.Context, shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return f.GetInternalBlockByHashCalled(shardID, hash, format)
 }
 
 // GetInternalBlockByNonce -
-func (f *FacadeStub) GetInternalBlockByNonce(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (f *FacadeStub) GetInternalBlockByNonce(_ context.Context, shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return f.GetInternalBlockByNonceCalled(shardID, nonce, format)
 }
 
 // GetInternalMiniBlockByHash -
-func (f *FacadeStub) GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
+func (f *FacadeStub) GetInternalMiniBlockByHash(_ context.Context, shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
 	return f.GetInternalMiniBlockByHashCalled(shardID, hash, epoch, format)
 }
 
 // GetInternalStartOfEpochMetaBlock -
-func (f *FacadeStub) GetInternalStartOfEpochMetaBlock(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (f *FacadeStub) GetInternalStartOfEpochMetaBlock(_ context.Context, epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	return f.GetInternalStartOfEpochMetaBlockCalled(epoch, format)
 }
 
 // GetHyperBlockByHash -
-func (f *FacadeStub) GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+func (f *FacadeStub) GetHyperBlockByHash(_ context.Context, hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
 	return f.GetHyperBlockByHashCalled(hash, options)
 }
 
 // GetHyperBlockByNonce -
-func (f *FacadeStub) GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+func (f *FacadeStub) GetHyperBlockByNonce(_ context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
 	return f.GetHyperBlockByNonceCalled(nonce, options)
 }
 
+// SubscribeToHyperblocks -
+func (f *FacadeStub) SubscribeToHyperblocks(fromNonce core.OptionalUint64) (data.HyperblockSubscriptionHandler, error) {
+	if f.SubscribeToHyperblocksCalled != nil {
+		return f.SubscribeToHyperblocksCalled(fromNonce)
+	}
+
+	return nil, nil
+}
+
 // GetMetrics -
 func (f *FacadeStub) GetMetrics() map[string]*data.EndpointMetrics {
 	return f.GetMetricsCalled()
@@ -500,13 +607,31 @@ func (f *FacadeStub) GetMetricsForPrometheus() string {
 	return f.GetPrometheusMetricsCalled()
 }
 
+// GetNodesCircuitBreakerStatus -
+func (f *FacadeStub) GetNodesCircuitBreakerStatus() *data.NodesCircuitBreakerStatus {
+	if f.GetNodesCircuitBreakerStatusCalled != nil {
+		return f.GetNodesCircuitBreakerStatusCalled()
+	}
+
+	return &data.NodesCircuitBreakerStatus{}
+}
+
+// GetConnectionPoolMetrics -
+func (f *FacadeStub) GetConnectionPoolMetrics() *data.ConnectionPoolMetrics {
+	if f.GetConnectionPoolMetricsCalled != nil {
+		return f.GetConnectionPoolMetricsCalled()
+	}
+
+	return &data.ConnectionPoolMetrics{}
+}
+
 // GetGenesisNodesPubKeys -
-func (f *FacadeStub) GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetGenesisNodesPubKeys(_ context.Context) (*data.GenericAPIResponse, error) {
 	return f.GetGenesisNodesPubKeysCalled()
 }
 
 // GetGasConfigs -
-func (f *FacadeStub) GetGasConfigs() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetGasConfigs(_ context.Context) (*data.GenericAPIResponse, error) {
 	return f.GetGasConfigsCalled()
 }
 
@@ -516,12 +641,12 @@ func (f *FacadeStub) GetAboutInfo() (*data.GenericAPIResponse, error) {
 }
 
 // GetNodesVersions -
-func (f *FacadeStub) GetNodesVersions() (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetNodesVersions(_ context.Context) (*data.GenericAPIResponse, error) {
 	return f.GetNodesVersionsCalled()
 }
 
 // GetAlteredAccountsByNonce -
-func (f *FacadeStub) GetAlteredAccountsByNonce(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
+func (f *FacadeStub) GetAlteredAccountsByNonce(_ context.Context, shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
 	if f.GetAlteredAccountsByNonceCalled != nil {
 		return f.GetAlteredAccountsByNonceCalled(shardID, nonce, options)
 	}
@@ -529,7 +654,7 @@ func (f *FacadeStub) GetAlteredAccountsByNonce(shardID uint32, nonce uint64, opt
 }
 
 // GetAlteredAccountsByHash -
-func (f *FacadeStub) GetAlteredAccountsByHash(shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
+func (f *FacadeStub) GetAlteredAccountsByHash(_ context.Context, shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
 	if f.GetAlteredAccountsByHashCalled != nil {
 		return f.GetAlteredAccountsByHashCalled(shardID, hash, options)
 	}
@@ -538,7 +663,7 @@ func (f *FacadeStub) GetAlteredAccountsByHash(shardID uint32, hash string, optio
 }
 
 // GetTriesStatistics -
-func (f *FacadeStub) GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
+func (f *FacadeStub) GetTriesStatistics(_ context.Context, shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
 	if f.GetTriesStatisticsCalled != nil {
 		return f.GetTriesStatisticsCalled(shardID)
 	}
@@ -546,22 +671,22 @@ func (f *FacadeStub) GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPI
 }
 
 // GetEpochStartData -
-func (f *FacadeStub) GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetEpochStartData(_ context.Context, epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
 	return f.GetEpochStartDataCalled(epoch, shardID)
 }
 
 // GetInternalStartOfEpochValidatorsInfo -
-func (f *FacadeStub) GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
+func (f *FacadeStub) GetInternalStartOfEpochValidatorsInfo(_ context.Context, epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
 	return f.GetInternalStartOfEpochValidatorsInfoCalled(epoch)
 }
 
 // GetCodeHash -
-func (f *FacadeStub) GetCodeHash(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) GetCodeHash(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return f.GetCodeHashCalled(address, options)
 }
 
 // IsDataTrieMigrated -
-func (f *FacadeStub) IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (f *FacadeStub) IsDataTrieMigrated(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if f.IsDataTrieMigratedCalled != nil {
 		return f.IsDataTrieMigratedCalled(address, options)
 	}
@@ -570,7 +695,7 @@ func (f *FacadeStub) IsDataTrieMigrated(address string, options common.AccountQu
 }
 
 // GetWaitingEpochsLeftForPublicKey -
-func (f *FacadeStub) GetWaitingEpochsLeftForPublicKey(publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
+func (f *FacadeStub) GetWaitingEpochsLeftForPublicKey(_ context.Context, publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
 	if f.GetWaitingEpochsLeftForPublicKeyCalled != nil {
 		return f.GetWaitingEpochsLeftForPublicKeyCalled(publicKey)
 	}
@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// HyperblockSubscriptionStub -
+type HyperblockSubscriptionStub struct {
+	NextCalled  func(ctx context.Context) (*data.HyperblockApiResponse, error)
+	CloseCalled func()
+}
+
+// Next -
+func (s *HyperblockSubscriptionStub) Next(ctx context.Context) (*data.HyperblockApiResponse, error) {
+	if s.NextCalled != nil {
+		return s.NextCalled(ctx)
+	}
+
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+// Close -
+func (s *HyperblockSubscriptionStub) Close() {
+	if s.CloseCalled != nil {
+		s.CloseCalled()
+	}
+}
@@ -0,0 +1,28 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// RateLimitStoreStub -
+type RateLimitStoreStub struct {
+	UpdateCalled func(key string, expiry time.Duration, handler func(state *data.RateLimitState)) error
+}
+
+// Update -
+func (s *RateLimitStoreStub) Update(key string, expiry time.Duration, handler func(state *data.RateLimitState)) error {
+	if s.UpdateCalled != nil {
+		return s.UpdateCalled(key, expiry, handler)
+	}
+
+	handler(&data.RateLimitState{})
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (s *RateLimitStoreStub) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -6,13 +6,29 @@ import (
 
 // StatusMetricsExporterStub -
 type StatusMetricsExporterStub struct {
-	AddRequestDataCalled func(path string, withError bool, duration time.Duration)
+	AddRequestDataCalled            func(path string, statusCode int, duration time.Duration)
+	IncrementInFlightRequestsCalled func(path string)
+	DecrementInFlightRequestsCalled func(path string)
 }
 
 // AddRequestData -
-func (s *StatusMetricsExporterStub) AddRequestData(path string, withError bool, duration time.Duration) {
+func (s *StatusMetricsExporterStub) AddRequestData(path string, statusCode int, duration time.Duration) {
 	if s.AddRequestDataCalled != nil {
-		s.AddRequestDataCalled(path, withError, duration)
+		s.AddRequestDataCalled(path, statusCode, duration)
+	}
+}
+
+// IncrementInFlightRequests -
+func (s *StatusMetricsExporterStub) IncrementInFlightRequests(path string) {
+	if s.IncrementInFlightRequestsCalled != nil {
+		s.IncrementInFlightRequestsCalled(path)
+	}
+}
+
+// DecrementInFlightRequests -
+func (s *StatusMetricsExporterStub) DecrementInFlightRequests(path string) {
+	if s.DecrementInFlightRequestsCalled != nil {
+		s.DecrementInFlightRequestsCalled(path)
 	}
 }
 
@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// TransactionStatusSubscriptionStub -
+type TransactionStatusSubscriptionStub struct {
+	NextCalled  func(ctx context.Context) (*data.TransactionStatusEvent, error)
+	CloseCalled func()
+}
+
+// Next -
+func (s *TransactionStatusSubscriptionStub) Next(ctx context.Context) (*data.TransactionStatusEvent, error) {
+	if s.NextCalled != nil {
+		return s.NextCalled(ctx)
+	}
+
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+// Close -
+func (s *TransactionStatusSubscriptionStub) Close() {
+	if s.CloseCalled != nil {
+		s.CloseCalled()
+	}
+}
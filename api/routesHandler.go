@@ -0,0 +1,139 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/api/middleware"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type argsRoutesHandler struct {
+	versionsRegistry             data.VersionsRegistryHandler
+	apiLoggingConfig             config.ApiLoggingConfig
+	credentialsChecker           *shared.CredentialsChecker
+	statusMetricsExtractor       middleware.StatusMetricsExtractor
+	rateLimitTimeWindowInSeconds int
+	rateLimiterConfig            config.RateLimiterConfig
+	trustedProxies               []string
+	accessLogSink                io.Writer
+	isProfileModeActivated       bool
+	shouldStartSwaggerUI         bool
+}
+
+// routesHandler serves the requests with a gin engine holding the routes of all versions. The engine is rebuilt and
+// swapped when the routes settings are updated, the requests in progress being finished by the previous engine
+type routesHandler struct {
+	args           argsRoutesHandler
+	rateLimitStore middleware.RateLimitStore
+	mutUpdate      sync.Mutex
+
+	mutEngine   sync.RWMutex
+	engine      *gin.Engine
+	versionsMap map[string]*data.VersionData
+}
+
+func newRoutesHandler(args argsRoutesHandler) (*routesHandler, error) {
+	if check.IfNil(args.versionsRegistry) {
+		return nil, ErrNilVersionsRegistry
+	}
+	if args.credentialsChecker == nil {
+		return nil, ErrNilCredentialsChecker
+	}
+
+	versionsMap, err := args.versionsRegistry.GetAllVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	rh := &routesHandler{
+		args: args,
+		// the states of all versions are kept in the same store, as the endpoints of each version have different
+		// paths. The store is shared by the rebuilt engines, so the limits are not reset by a reload
+		rateLimitStore: middleware.NewInMemoryRateLimitStore(),
+		versionsMap:    versionsMap,
+	}
+
+	rh.engine, err = rh.createEngine(versionsMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return rh, nil
+}
+
+func (rh *routesHandler) createEngine(versionsMap map[string]*data.VersionData) (*gin.Engine, error) {
+	ws := gin.New()
+	// the forwarded client IP headers are only honored for the trusted proxies, so the clients cannot spoof their IP
+	err := ws.SetTrustedProxies(rh.args.trustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	ws.Use(gin.Recovery())
+	ws.Use(cors.Default())
+
+	err = registerRoutes(ws, versionsMap, rh.args, rh.rateLimitStore)
+	if err != nil {
+		return nil, err
+	}
+
+	return ws, nil
+}
+
+// ServeHTTP serves the request with the current engine
+func (rh *routesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rh.mutEngine.RLock()
+	engine := rh.engine
+	rh.mutEngine.RUnlock()
+
+	engine.ServeHTTP(w, r)
+}
+
+// UpdateApiRoutesConfigs rebuilds the routes of all versions with the provided settings, mapped by version, and swaps
+// them with the current ones. The current routes are kept if the new ones cannot be built
+func (rh *routesHandler) UpdateApiRoutesConfigs(apiRoutesConfigs map[string]data.ApiRoutesConfig) error {
+	rh.mutUpdate.Lock()
+	defer rh.mutUpdate.Unlock()
+
+	rh.mutEngine.RLock()
+	currentVersionsMap := rh.versionsMap
+	rh.mutEngine.RUnlock()
+
+	newVersionsMap := make(map[string]*data.VersionData, len(currentVersionsMap))
+	for version, versionData := range currentVersionsMap {
+		apiRoutesConfig, found := apiRoutesConfigs[version]
+		if !found {
+			return fmt.Errorf("%w for version %s", ErrMissingApiRoutesConfig, version)
+		}
+
+		newVersionsMap[version] = &data.VersionData{
+			Facade:     versionData.Facade,
+			ApiHandler: versionData.ApiHandler,
+			ApiConfig:  apiRoutesConfig,
+		}
+	}
+
+	engine, err := rh.createEngine(newVersionsMap)
+	if err != nil {
+		return err
+	}
+
+	rh.mutEngine.Lock()
+	rh.engine = engine
+	rh.versionsMap = newVersionsMap
+	rh.mutEngine.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rh *routesHandler) IsInterfaceNil() bool {
+	return rh == nil
+}
@@ -0,0 +1,170 @@
+package api_test
+
+import (
+	"bytes"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/api"
+	"github.com/multiversx/mx-chain-proxy-go/api/middleware"
+	"github.com/multiversx/mx-chain-proxy-go/api/mock"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/configwatcher"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/versions"
+	"github.com/stretchr/testify/require"
+)
+
+const testVersion = "v1.0"
+
+func createApiRoutesConfig(networkConfigRoute data.RouteConfig) data.ApiRoutesConfig {
+	networkConfigRoute.Name = "/config"
+
+	return data.ApiRoutesConfig{
+		APIPackages: map[string]data.APIPackageConfig{
+			"network": {Routes: []data.RouteConfig{networkConfigRoute}},
+		},
+	}
+}
+
+func createTestServer(t *testing.T) (*http.Server, configwatcher.ApiRoutesHandler) {
+	facade := &mock.FacadeStub{
+		GetConfigMetricsHandler: func() (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{Code: data.ReturnCodeSuccess}, nil
+		},
+	}
+
+	return createTestServerWithFacade(t, facade, createApiRoutesConfig(data.RouteConfig{Open: true}), nil)
+}
+
+func createTestServerWithFacade(
+	t *testing.T,
+	facade *mock.FacadeStub,
+	apiRoutesConfig data.ApiRoutesConfig,
+	trustedProxies []string,
+) (*http.Server, configwatcher.ApiRoutesHandler) {
+	apiHandler, err := api.NewApiHandler(facade)
+	require.NoError(t, err)
+
+	versionsRegistry := versions.NewVersionsRegistry()
+	err = versionsRegistry.AddVersion(testVersion, &data.VersionData{
+		Facade:     facade,
+		ApiHandler: apiHandler,
+		ApiConfig:  apiRoutesConfig,
+	})
+	require.NoError(t, err)
+
+	credentialsChecker := shared.NewCredentialsChecker(config.CredentialsConfig{
+		Credentials: []data.Credential{{Username: "user", Password: "04f8996da763b7a969b1028ee3007569eaf3a635486ddab211d512c85b9df8fb"}},
+		Hasher:      config.TypeConfig{Type: "sha256"},
+	})
+
+	server, routesHandler, err := api.CreateServer(
+		versionsRegistry,
+		0,
+		config.ApiLoggingConfig{},
+		credentialsChecker,
+		&mock.StatusMetricsExporterStub{},
+		60,
+		config.RateLimiterConfig{Algorithm: middleware.TokenBucketAlgorithm},
+		trustedProxies,
+		nil,
+		false,
+		false,
+	)
+	require.NoError(t, err)
+
+	return server, routesHandler
+}
+
+func getNetworkConfig(server *http.Server, withCredentials bool) int {
+	req, _ := http.NewRequest(http.MethodGet, "/"+testVersion+"/network/config", nil)
+	if withCredentials {
+		req.SetBasicAuth("user", "user")
+	}
+	resp := httptest.NewRecorder()
+	server.Handler.ServeHTTP(resp, req)
+
+	return resp.Code
+}
+
+func TestRoutesHandler_UpdateApiRoutesConfigs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing version should error and keep the current routes", func(t *testing.T) {
+		t.Parallel()
+
+		server, routesHandler := createTestServer(t)
+		err := routesHandler.UpdateApiRoutesConfigs(map[string]data.ApiRoutesConfig{
+			"v_next": createApiRoutesConfig(data.RouteConfig{Open: false}),
+		})
+		require.ErrorIs(t, err, api.ErrMissingApiRoutesConfig)
+		require.Equal(t, http.StatusOK, getNetworkConfig(server, false))
+	})
+	t.Run("should apply the new routes settings", func(t *testing.T) {
+		t.Parallel()
+
+		server, routesHandler := createTestServer(t)
+		require.False(t, routesHandler.IsInterfaceNil())
+		require.Equal(t, http.StatusOK, getNetworkConfig(server, false))
+
+		err := routesHandler.UpdateApiRoutesConfigs(map[string]data.ApiRoutesConfig{
+			testVersion: createApiRoutesConfig(data.RouteConfig{Open: true, Secured: true}),
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusUnauthorized, getNetworkConfig(server, false))
+		require.Equal(t, http.StatusOK, getNetworkConfig(server, true))
+
+		err = routesHandler.UpdateApiRoutesConfigs(map[string]data.ApiRoutesConfig{
+			testVersion: createApiRoutesConfig(data.RouteConfig{Open: false}),
+		})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, getNetworkConfig(server, true))
+	})
+}
+
+func TestRoutesHandler_ClientIP(t *testing.T) {
+	t.Parallel()
+
+	sendUserFunds := func(trustedProxies []string) string {
+		clientIP := ""
+		facade := &mock.FacadeStub{
+			IsFaucetEnabledHandler: func() bool {
+				return true
+			},
+			SendUserFundsCalled: func(receiver string, value *big.Int, ip string) ([]string, error) {
+				clientIP = ip
+				return []string{"hash"}, nil
+			},
+		}
+		apiRoutesConfig := data.ApiRoutesConfig{
+			APIPackages: map[string]data.APIPackageConfig{
+				"transaction": {Routes: []data.RouteConfig{{Name: "/send-user-funds", Open: true}}},
+			},
+		}
+		server, _ := createTestServerWithFacade(t, facade, apiRoutesConfig, trustedProxies)
+
+		req, _ := http.NewRequest(http.MethodPost, "/"+testVersion+"/transaction/send-user-funds", bytes.NewBufferString(`{"receiver":"erd1"}`))
+		req.RemoteAddr = "10.0.0.1:1234"
+		req.Header.Set("X-Forwarded-For", "1.2.3.4")
+		resp := httptest.NewRecorder()
+		server.Handler.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		return clientIP
+	}
+
+	t.Run("no trusted proxy should use the remote address", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, "10.0.0.1", sendUserFunds(nil))
+	})
+	t.Run("trusted proxy should use the forwarded client IP", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, "1.2.3.4", sendUserFunds([]string{"10.0.0.0/8"}))
+	})
+}
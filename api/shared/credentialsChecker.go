@@ -0,0 +1,125 @@
+package shared
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/hashing/factory"
+	"github.com/multiversx/mx-chain-core-go/hashing/sha256"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+)
+
+var log = logger.GetOrCreate("api/shared")
+
+// CredentialsChecker verifies the username and password pairs against the ones from the credentials config
+type CredentialsChecker struct {
+	mutCredentials sync.RWMutex
+	accounts       map[string]string
+	hasher         hashing.Hasher
+}
+
+// NewCredentialsChecker returns a new instance of CredentialsChecker
+func NewCredentialsChecker(credentialsConfig config.CredentialsConfig) *CredentialsChecker {
+	hasher, err := factory.NewHasher(credentialsConfig.Hasher.Type)
+	if err != nil {
+		log.Warn("cannot create hasher from config. Will use Sha256 as default", "error", err)
+		hasher = sha256.NewSha256() // fallback in case the hasher creation failed
+	}
+
+	return &CredentialsChecker{
+		accounts: createAccountsMap(credentialsConfig),
+		hasher:   hasher,
+	}
+}
+
+// CheckCredentialsConfig returns an error if the provided credentials config cannot be used as it is: the hasher is
+// unknown or the usernames are empty or duplicated
+func CheckCredentialsConfig(credentialsConfig config.CredentialsConfig) error {
+	_, err := factory.NewHasher(credentialsConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+
+	usernames := make(map[string]struct{})
+	for _, pair := range credentialsConfig.Credentials {
+		if len(pair.Username) == 0 {
+			return errors.ErrEmptyUsername
+		}
+
+		_, found := usernames[pair.Username]
+		if found {
+			return fmt.Errorf("%w %s", errors.ErrDuplicatedUsername, pair.Username)
+		}
+		usernames[pair.Username] = struct{}{}
+	}
+
+	return nil
+}
+
+// Update replaces the username and password pairs and the hasher with the ones from the provided credentials config,
+// if it is valid
+func (cc *CredentialsChecker) Update(credentialsConfig config.CredentialsConfig) error {
+	err := CheckCredentialsConfig(credentialsConfig)
+	if err != nil {
+		return err
+	}
+
+	hasher, err := factory.NewHasher(credentialsConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+
+	cc.mutCredentials.Lock()
+	cc.accounts = createAccountsMap(credentialsConfig)
+	cc.hasher = hasher
+	cc.mutCredentials.Unlock()
+
+	return nil
+}
+
+func createAccountsMap(credentialsConfig config.CredentialsConfig) map[string]string {
+	accounts := make(map[string]string)
+	for _, pair := range credentialsConfig.Credentials {
+		accounts[pair.Username] = pair.Password
+	}
+
+	return accounts
+}
+
+// HasCredentials returns true if at least one username and password pair is configured
+func (cc *CredentialsChecker) HasCredentials() bool {
+	cc.mutCredentials.RLock()
+	defer cc.mutCredentials.RUnlock()
+
+	return len(cc.accounts) > 0
+}
+
+// Check returns nil if the password, once hashed, matches the configured one for the provided username
+func (cc *CredentialsChecker) Check(username string, password string) error {
+	cc.mutCredentials.RLock()
+	defer cc.mutCredentials.RUnlock()
+
+	if len(cc.accounts) == 0 {
+		return errors.ErrNoCredentialsFound
+	}
+
+	hashedPassword, ok := cc.accounts[username]
+	if !ok {
+		return errors.ErrUsernameDoesNotExist
+	}
+
+	if hashedPassword != hex.EncodeToString(cc.hasher.Compute(password)) {
+		return errors.ErrInvalidPassword
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cc *CredentialsChecker) IsInterfaceNil() bool {
+	return cc == nil
+}
@@ -0,0 +1,122 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCredentialsChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no credentials should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker := NewCredentialsChecker(config.CredentialsConfig{})
+		require.False(t, checker.HasCredentials())
+		require.Equal(t, errors.ErrNoCredentialsFound, checker.Check("user", "user"))
+	})
+	t.Run("unknown username should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker := NewCredentialsChecker(createCredentialsConfig())
+		require.True(t, checker.HasCredentials())
+		require.Equal(t, errors.ErrUsernameDoesNotExist, checker.Check("other", "user"))
+	})
+	t.Run("invalid password should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker := NewCredentialsChecker(createCredentialsConfig())
+		require.Equal(t, errors.ErrInvalidPassword, checker.Check("user", "pass"))
+	})
+	t.Run("valid credentials should work", func(t *testing.T) {
+		t.Parallel()
+
+		checker := NewCredentialsChecker(createCredentialsConfig())
+		require.Nil(t, checker.Check("user", "user"))
+	})
+	t.Run("invalid hasher should fallback to sha256", func(t *testing.T) {
+		t.Parallel()
+
+		credentialsConfig := createCredentialsConfig()
+		credentialsConfig.Hasher.Type = "invalid"
+		checker := NewCredentialsChecker(credentialsConfig)
+		require.Nil(t, checker.Check("user", "user"))
+	})
+}
+
+func TestCheckCredentialsConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		credentialsConfig := createCredentialsConfig()
+		credentialsConfig.Hasher.Type = "invalid"
+		require.Error(t, CheckCredentialsConfig(credentialsConfig))
+	})
+	t.Run("empty username should error", func(t *testing.T) {
+		t.Parallel()
+
+		credentialsConfig := createCredentialsConfig()
+		credentialsConfig.Credentials = append(credentialsConfig.Credentials, data.Credential{Password: "pass"})
+		require.Equal(t, errors.ErrEmptyUsername, CheckCredentialsConfig(credentialsConfig))
+	})
+	t.Run("duplicated username should error", func(t *testing.T) {
+		t.Parallel()
+
+		credentialsConfig := createCredentialsConfig()
+		credentialsConfig.Credentials = append(credentialsConfig.Credentials, credentialsConfig.Credentials[0])
+		require.ErrorIs(t, CheckCredentialsConfig(credentialsConfig), errors.ErrDuplicatedUsername)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, CheckCredentialsConfig(createCredentialsConfig()))
+		require.NoError(t, CheckCredentialsConfig(config.CredentialsConfig{Hasher: config.TypeConfig{Type: "sha256"}}))
+	})
+}
+
+func TestCredentialsChecker_Update(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid config should keep the current credentials", func(t *testing.T) {
+		t.Parallel()
+
+		checker := NewCredentialsChecker(createCredentialsConfig())
+		credentialsConfig := createCredentialsConfig()
+		credentialsConfig.Hasher.Type = "invalid"
+		require.Error(t, checker.Update(credentialsConfig))
+		require.Nil(t, checker.Check("user", "user"))
+	})
+	t.Run("should replace the credentials", func(t *testing.T) {
+		t.Parallel()
+
+		checker := NewCredentialsChecker(config.CredentialsConfig{})
+		require.False(t, checker.IsInterfaceNil())
+		require.Equal(t, errors.ErrNoCredentialsFound, checker.Check("user", "user"))
+
+		require.NoError(t, checker.Update(createCredentialsConfig()))
+		require.True(t, checker.HasCredentials())
+		require.Nil(t, checker.Check("user", "user"))
+
+		require.NoError(t, checker.Update(config.CredentialsConfig{Hasher: config.TypeConfig{Type: "sha256"}}))
+		require.False(t, checker.HasCredentials())
+	})
+}
+
+func createCredentialsConfig() config.CredentialsConfig {
+	return config.CredentialsConfig{
+		Credentials: []data.Credential{
+			{
+				Username: "user",
+				// sha256 of "user"
+				Password: "04f8996da763b7a969b1028ee3007569eaf3a635486ddab211d512c85b9df8fb",
+			},
+		},
+		Hasher: config.TypeConfig{Type: "sha256"},
+	}
+}
@@ -8,19 +8,32 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
-// RespondWith will respond with the generic API response
+// RespondWith will respond with the generic API response. The error responses also contain the ID of the request
 func RespondWith(c *gin.Context, status int, dataField interface{}, error string, code data.ReturnCode) {
-	c.JSON(
-		status,
-		data.GenericAPIResponse{
-			Data:  dataField,
-			Error: error,
-			Code:  code,
-		},
-	)
+	response := data.GenericAPIResponse{
+		Data:  dataField,
+		Error: error,
+		Code:  code,
+	}
+	if len(error) > 0 {
+		response.RequestID = GetRequestID(c)
+	}
+
+	c.JSON(status, response)
+}
+
+// GetRequestID returns the ID of the request, as set by the request ID middleware, or an empty string if there is none
+func GetRequestID(c *gin.Context) string {
+	requestInfo := common.RequestInfoFromContext(c.Request.Context())
+	if requestInfo == nil {
+		return ""
+	}
+
+	return requestInfo.ID
 }
 
 // FetchNonceFromRequest will try to fetch the nonce from the request
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"math/big"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -19,18 +20,30 @@ import (
 	marshalFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-logger-go/file"
+	"github.com/multiversx/mx-chain-proxy-go/accesslog"
 	"github.com/multiversx/mx-chain-proxy-go/api"
+	"github.com/multiversx/mx-chain-proxy-go/api/grpcapi"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/configwatcher"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/discovery"
+	"github.com/multiversx/mx-chain-proxy-go/facade"
 	"github.com/multiversx/mx-chain-proxy-go/metrics"
 	"github.com/multiversx/mx-chain-proxy-go/observer"
 	"github.com/multiversx/mx-chain-proxy-go/process"
 	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/multiversx/mx-chain-proxy-go/process/database"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
 	processFactory "github.com/multiversx/mx-chain-proxy-go/process/factory"
+	"github.com/multiversx/mx-chain-proxy-go/process/httpclient"
 	"github.com/multiversx/mx-chain-proxy-go/testing"
+	"github.com/multiversx/mx-chain-proxy-go/tracing"
 	versionsFactory "github.com/multiversx/mx-chain-proxy-go/versions/factory"
 	"github.com/urfave/cli"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 )
 
 const (
@@ -105,6 +118,13 @@ VERSION:
 		Value: "./config/walletKey.pem",
 	}
 
+	// externalConfigFile defines a flag for the path to the external toml configuration file
+	externalConfigFile = cli.StringFlag{
+		Name:  "config-external",
+		Usage: "The path for the external configuration file. This TOML file contains external configurations such as ElasticSearch's URL and login information",
+		Value: "./config/external.toml",
+	}
+
 	// credentialsConfigFile defines a flag for the path to the credentials toml configuration file
 	credentialsConfigFile = cli.StringFlag{
 		Name: "config-credentials",
@@ -180,6 +200,7 @@ func main() {
 	app.Usage = "This is the entry point for starting a new Multiversx node proxy"
 	app.Flags = []cli.Flag{
 		configurationFile,
+		externalConfigFile,
 		credentialsConfigFile,
 		apiConfigDirectory,
 		profileMode,
@@ -269,6 +290,12 @@ func startProxy(ctx *cli.Context) error {
 	}
 	log.Info(fmt.Sprintf("Initialized with main config from: %s", configurationFile))
 
+	externalConfigurationFileName := ctx.GlobalString(externalConfigFile.Name)
+	externalConfig, err := loadExternalConfig(externalConfigurationFileName)
+	if err != nil {
+		return err
+	}
+
 	closableComponents := data.NewClosableComponentsHandler()
 
 	credentialsConfigurationFileName := ctx.GlobalString(credentialsConfigFile.Name)
@@ -277,16 +304,48 @@ func startProxy(ctx *cli.Context) error {
 		return err
 	}
 
+	err = startTracing(generalConfig.Tracing, closableComponents)
+	if err != nil {
+		return err
+	}
+
 	statusMetricsProvider := metrics.NewStatusMetrics()
 
+	nodesDiscoverer, err := createNodesDiscoverer(generalConfig)
+	if err != nil {
+		return err
+	}
+
 	shouldStartSwaggerUI := ctx.GlobalBool(startSwaggerUI.Name)
 	skipStatusCheck := ctx.GlobalBool(noStatusCheck.Name)
-	versionsRegistry, err := createVersionsRegistryTestOrProduction(ctx, generalConfig, configurationFileName, statusMetricsProvider, closableComponents, skipStatusCheck)
+	versionsRegistry, bp, err := createVersionsRegistryTestOrProduction(ctx, generalConfig, externalConfig, configurationFileName, statusMetricsProvider, closableComponents, skipStatusCheck)
+	if err != nil {
+		return err
+	}
+
+	err = startNodesDiscovery(nodesDiscoverer, bp, closableComponents)
 	if err != nil {
 		return err
 	}
 
-	httpServer, err := startWebServer(versionsRegistry, generalConfig, *credentialsConfig, statusMetricsProvider, isProfileModeActivated, shouldStartSwaggerUI)
+	credentialsChecker := shared.NewCredentialsChecker(*credentialsConfig)
+
+	accessLogSink, err := createAccessLogSink(generalConfig.AccessLog, closableComponents)
+	if err != nil {
+		return err
+	}
+
+	httpServer, routesHandler, err := startWebServer(versionsRegistry, generalConfig, credentialsChecker, statusMetricsProvider, accessLogSink, isProfileModeActivated, shouldStartSwaggerUI)
+	if err != nil {
+		return err
+	}
+
+	err = startGrpcServer(versionsRegistry, generalConfig, credentialsChecker, closableComponents)
+	if err != nil {
+		return err
+	}
+
+	err = startConfigWatcher(ctx, generalConfig.ConfigReload, configurationFileName, bp, nodesDiscoverer, routesHandler, credentialsChecker, closableComponents)
 	if err != nil {
 		return err
 	}
@@ -314,11 +373,12 @@ func loadMainConfig(filepath string) (*config.Config, error) {
 func createVersionsRegistryTestOrProduction(
 	ctx *cli.Context,
 	cfg *config.Config,
+	externalConfig *config.ExternalConfig,
 	configurationFilePath string,
 	statusMetricsHandler data.StatusMetricsProvider,
 	closableComponents *data.ClosableComponentsHandler,
 	skipStatusCheck bool,
-) (data.VersionsRegistryHandler, error) {
+) (data.VersionsRegistryHandler, *process.BaseProcessor, error) {
 
 	var testHTTPServerEnabled bool
 	if ctx.IsSet(testHttpServerEn.Name) {
@@ -377,6 +437,7 @@ func createVersionsRegistryTestOrProduction(
 
 		return createVersionsRegistry(
 			testCfg,
+			&config.ExternalConfig{},
 			configurationFilePath,
 			statusMetricsHandler,
 			ctx.GlobalString(walletKeyPemFile.Name),
@@ -388,6 +449,7 @@ func createVersionsRegistryTestOrProduction(
 
 	return createVersionsRegistry(
 		cfg,
+		externalConfig,
 		configurationFilePath,
 		statusMetricsHandler,
 		ctx.GlobalString(walletKeyPemFile.Name),
@@ -397,79 +459,226 @@ func createVersionsRegistryTestOrProduction(
 	)
 }
 
+func createElasticSearchConnector(esConfig config.ElasticSearchConfig, requestTimeoutSec int) (process.DatabaseConnectorHandler, error) {
+	if !esConfig.Enabled {
+		return database.NewDisabledElasticSearchConnector(), nil
+	}
+
+	return database.NewElasticSearchConnector(database.ArgsElasticSearchConnector{
+		URL:            esConfig.URL,
+		Username:       esConfig.Username,
+		Password:       esConfig.Password,
+		RequestTimeout: time.Duration(requestTimeoutSec) * time.Second,
+	})
+}
+
+func createHedgedRequestsHandler(hedgedRequestsConfig config.HedgedRequestsConfig) (process.HedgedRequestsHandler, error) {
+	if !hedgedRequestsConfig.Enabled {
+		return process.NewDisabledHedgedRequestsHandler(), nil
+	}
+
+	return process.NewHedgedRequestsHandler(time.Duration(hedgedRequestsConfig.DelayMs) * time.Millisecond)
+}
+
+func createRequestsCoalescer(
+	requestsCoalescingConfig config.RequestsCoalescingConfig,
+	metricsHandler process.CoalescingMetricsHandler,
+) (process.RequestsCoalescer, error) {
+	if !requestsCoalescingConfig.Enabled {
+		return process.NewDisabledRequestsCoalescer(), nil
+	}
+
+	return process.NewRequestsCoalescer(metricsHandler)
+}
+
+func createResponseCacheComponents(
+	cacheConfig config.ResponseCacheConfig,
+	proc process.Processor,
+	statusMetricsHandler data.StatusMetricsProvider,
+	closableComponents *data.ClosableComponentsHandler,
+) (process.ResponseCacheHandler, process.FinalityHandler, error) {
+	if !cacheConfig.Enabled {
+		return &disabled.ResponseCacher{}, &disabled.FinalityHandler{}, nil
+	}
+
+	responseCacher, err := cache.NewResponseLRUCacher(cache.ArgsResponseLRUCacher{
+		Name:           "responses",
+		MaxSizeInBytes: cacheConfig.MaxSizeInMB * 1024 * 1024,
+		MaxNumEntries:  cacheConfig.MaxNumEntries,
+		MetricsHandler: statusMetricsHandler,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	refreshInterval := time.Duration(cacheConfig.FinalityRefreshIntervalSec) * time.Second
+	finalityTracker, err := process.NewFinalityTracker(proc, refreshInterval)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	closableComponents.Add(finalityTracker)
+	finalityTracker.StartFinalityChecks()
+
+	return responseCacher, finalityTracker, nil
+}
+
+func createTransactionPreflightChecker(
+	preflightConfig config.TransactionPreflightConfig,
+	accountProvider process.AccountProvider,
+	networkConfigProvider process.NetworkConfigProvider,
+	pubKeyConverter core.PubkeyConverter,
+) (process.TransactionPreflightHandler, error) {
+	if !preflightConfig.Enabled {
+		return &disabled.TransactionPreflightChecker{}, nil
+	}
+
+	return process.NewTransactionPreflightChecker(process.ArgsTransactionPreflightChecker{
+		AccountProvider:       accountProvider,
+		NetworkConfigProvider: networkConfigProvider,
+		PubKeyConverter:       pubKeyConverter,
+		MaxNonceGap:           preflightConfig.MaxNonceGap,
+	})
+}
+
+func createHyperblockStreamer(
+	streamConfig config.HyperblockStreamConfig,
+	hyperblockBuilder process.HyperblockBuilder,
+	nonceProvider process.HyperblockNonceProvider,
+	closableComponents *data.ClosableComponentsHandler,
+) (facade.HyperblockStreamer, error) {
+	if !streamConfig.Enabled {
+		return &disabled.HyperblockStreamer{}, nil
+	}
+
+	hyperblockStreamer, err := process.NewHyperblockStreamer(process.ArgsHyperblockStreamer{
+		HyperblockBuilder: hyperblockBuilder,
+		NonceProvider:     nonceProvider,
+		PollInterval:      time.Duration(streamConfig.PollIntervalMs) * time.Millisecond,
+		BufferSize:        streamConfig.BufferSize,
+		MaxResumeGap:      streamConfig.MaxResumeGap,
+		MaxSubscribers:    streamConfig.MaxSubscribers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	closableComponents.Add(hyperblockStreamer)
+	hyperblockStreamer.StartStreaming()
+
+	return hyperblockStreamer, nil
+}
+
 func createVersionsRegistry(
 	cfg *config.Config,
+	externalConfig *config.ExternalConfig,
 	configurationFilePath string,
 	statusMetricsHandler data.StatusMetricsProvider,
 	pemFileLocation string,
 	apiConfigDirectoryPath string,
 	closableComponents *data.ClosableComponentsHandler,
 	skipStatusCheck bool,
-) (data.VersionsRegistryHandler, error) {
+) (data.VersionsRegistryHandler, *process.BaseProcessor, error) {
 	pubKeyConverter, err := pubkeyConverter.NewBech32PubkeyConverter(cfg.AddressPubkeyConverter.Length, addressHRP)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	marshalizer, err := marshalFactory.NewMarshalizer(cfg.Marshalizer.Type)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	hasher, err := hasherFactory.NewHasher(cfg.Hasher.Type)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	numShards, err := getNumOfShards(cfg)
+	observersHttpClient, err := httpclient.NewObserversHttpClient(httpclient.ArgsObserversHttpClient{
+		Config:         cfg.ObserverHttpClient,
+		RequestTimeout: time.Duration(cfg.GeneralSettings.RequestTimeoutSec) * time.Second,
+	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	closableComponents.Add(observersHttpClient)
+
+	numShards, err := getNumOfShards(cfg, observersHttpClient)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	nodesProviderFactory, err := observer.NewNodesProviderFactory(*cfg, configurationFilePath, numShards)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	observersProvider, err := nodesProviderFactory.CreateObservers()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	fullHistoryNodesProvider, err := nodesProviderFactory.CreateFullHistoryNodes()
 	if err != nil {
 		if err != observer.ErrEmptyObserversList {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
 	shardCoord, err := sharding.NewMultiShardCoordinator(numShards, 0)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	bp, err := process.NewBaseProcessor(
-		cfg.GeneralSettings.RequestTimeoutSec,
+		observersHttpClient,
 		shardCoord,
 		observersProvider,
 		fullHistoryNodesProvider,
 		pubKeyConverter,
+		statusMetricsHandler,
 		skipStatusCheck,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	bp.StartNodesSyncStateChecks()
 
-	accntProc, err := process.NewAccountProcessor(bp, pubKeyConverter)
+	connector, err := createElasticSearchConnector(externalConfig.ElasticSearchConnector, cfg.GeneralSettings.RequestTimeoutSec)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	faucetValue := big.NewInt(0)
-	faucetValue.SetString(cfg.GeneralSettings.FaucetValue, 10)
-	faucetProc, err := processFactory.CreateFaucetProcessor(bp, shardCoord, faucetValue, pubKeyConverter, pemFileLocation)
+	hedgedRequestsHandler, err := createHedgedRequestsHandler(cfg.HedgedRequests)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	requestsCoalescer, err := createRequestsCoalescer(cfg.RequestsCoalescing, statusMetricsHandler)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accntProc, err := process.NewAccountProcessor(bp, pubKeyConverter, connector, hedgedRequestsHandler, requestsCoalescer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responseCacher, finalityHandler, err := createResponseCacheComponents(cfg.ResponseCache, bp, statusMetricsHandler, closableComponents)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	economicMetricsCacher := cache.NewGenericApiResponseMemoryCacher()
+	economicsCacheValidity := time.Duration(cfg.GeneralSettings.EconomicsMetricsCacheValidityDurationSec) * time.Second
+
+	nodeStatusProc, err := process.NewNodeStatusProcessor(bp, economicMetricsCacher, economicsCacheValidity)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	preflightChecker, err := createTransactionPreflightChecker(cfg.TransactionPreflight, accntProc, nodeStatusProc, pubKeyConverter)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	txProc, err := processFactory.CreateTransactionProcessor(
@@ -477,15 +686,52 @@ func createVersionsRegistry(
 		pubKeyConverter,
 		hasher,
 		marshalizer,
+		responseCacher,
+		finalityHandler,
+		preflightChecker,
 		cfg.GeneralSettings.AllowEntireTxPoolFetch,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	scQueryProc, err := process.NewSCQueryProcessor(bp, pubKeyConverter)
+	faucetValue := big.NewInt(0)
+	faucetValue.SetString(cfg.GeneralSettings.FaucetValue, 10)
+	faucetProc, err := processFactory.CreateFaucetProcessor(
+		bp,
+		shardCoord,
+		faucetValue,
+		pubKeyConverter,
+		pemFileLocation,
+		cfg.Faucet,
+		accntProc,
+		nodeStatusProc,
+		txProc,
+		txProc,
+	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	txStatusWatcher, err := process.NewTransactionStatusWatcher(process.ArgsTransactionStatusWatcher{
+		StatusProvider:     txProc,
+		PollInterval:       time.Duration(cfg.TransactionStatus.PollIntervalMs) * time.Millisecond,
+		SendAndWaitTimeout: time.Duration(cfg.TransactionStatus.SendAndWaitTimeoutSec) * time.Second,
+		WatchTimeout:       time.Duration(cfg.TransactionStatus.WatchTimeoutSec) * time.Second,
+		MaxWatchers:        cfg.TransactionStatus.MaxWatchers,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	relayerProc, err := processFactory.CreateRelayerProcessor(cfg.Relayer, bp, shardCoord, pubKeyConverter, txProc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scQueryProc, err := process.NewSCQueryProcessor(bp, pubKeyConverter, hedgedRequestsHandler, requestsCoalescer)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	htbCacher := cache.NewHeartbeatMemoryCacher()
@@ -493,7 +739,7 @@ func createVersionsRegistry(
 
 	nodeGroupProc, err := process.NewNodeGroupProcessor(bp, htbCacher, cacheValidity)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	valStatsCacher := cache.NewValidatorsStatsMemoryCacher()
@@ -501,15 +747,7 @@ func createVersionsRegistry(
 
 	valStatsProc, err := process.NewValidatorStatisticsProcessor(bp, valStatsCacher, cacheValidity)
 	if err != nil {
-		return nil, err
-	}
-
-	economicMetricsCacher := cache.NewGenericApiResponseMemoryCacher()
-	cacheValidity = time.Duration(cfg.GeneralSettings.EconomicsMetricsCacheValidityDurationSec) * time.Second
-
-	nodeStatusProc, err := process.NewNodeStatusProcessor(bp, economicMetricsCacher, cacheValidity)
-	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	closableComponents.Add(nodeGroupProc, valStatsProc, nodeStatusProc, bp)
@@ -518,38 +756,48 @@ func createVersionsRegistry(
 	valStatsProc.StartCacheUpdate()
 	nodeStatusProc.StartCacheUpdate()
 
-	blockProc, err := process.NewBlockProcessor(bp)
+	blockProc, err := process.NewBlockProcessor(bp, responseCacher, finalityHandler, connector)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	hyperblockStreamer, err := createHyperblockStreamer(cfg.HyperblockStream, blockProc, nodeStatusProc, closableComponents)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	blocksPrc, err := process.NewBlocksProcessor(bp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	proofProc, err := process.NewProofProcessor(bp, pubKeyConverter)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	esdtSuppliesProc, err := process.NewESDTSupplyProcessor(bp, scQueryProc)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	statusProc, err := process.NewStatusProcessor(bp, statusMetricsHandler)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	aboutInfoProc, err := process.NewAboutProcessor(bp, appVersion, commitID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	nodesAdminProc, err := processFactory.CreateNodesAdminProcessor(cfg.NodesAdmin, bp)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	facadeArgs := versionsFactory.FacadeArgs{
-		ActionsProcessor:             bp,
+		ActionsProcessor:             nodesAdminProc,
 		AccountProcessor:             accntProc,
 		FaucetProcessor:              faucetProc,
 		BlockProcessor:               blockProc,
@@ -564,46 +812,55 @@ func createVersionsRegistry(
 		ESDTSuppliesProcessor:        esdtSuppliesProc,
 		StatusProcessor:              statusProc,
 		AboutInfoProcessor:           aboutInfoProc,
+		HyperblockStreamer:           hyperblockStreamer,
+		TransactionStatusWatcher:     txStatusWatcher,
+		RelayerProcessor:             relayerProc,
 	}
 
 	apiConfigParser, err := versionsFactory.NewApiConfigParser(apiConfigDirectoryPath)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	versionsRegistry, err := versionsFactory.CreateVersionsRegistry(facadeArgs, apiConfigParser)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return versionsFactory.CreateVersionsRegistry(facadeArgs, apiConfigParser)
+	return versionsRegistry, bp, nil
 }
 
 func startWebServer(
 	versionsRegistry data.VersionsRegistryHandler,
 	generalConfig *config.Config,
-	credentialsConfig config.CredentialsConfig,
+	credentialsChecker *shared.CredentialsChecker,
 	statusMetricsProvider data.StatusMetricsProvider,
+	accessLogSink io.Writer,
 	isProfileModeActivated bool,
 	shouldStartSwaggerUI bool,
-) (*http.Server, error) {
-	var err error
-	var httpServer *http.Server
-
+) (*http.Server, configwatcher.ApiRoutesHandler, error) {
 	port := generalConfig.GeneralSettings.ServerPort
 
 	if generalConfig.GeneralSettings.RateLimitWindowDurationSeconds <= 0 {
-		return nil, fmt.Errorf("invalid value %d for RateLimitWindowDurationSeconds. It must be greater "+
+		return nil, nil, fmt.Errorf("invalid value %d for RateLimitWindowDurationSeconds. It must be greater "+
 			"than zero", generalConfig.GeneralSettings.RateLimitWindowDurationSeconds)
 	}
-	httpServer, err = api.CreateServer(
+	httpServer, routesHandler, err := api.CreateServer(
 		versionsRegistry,
 		port,
 		generalConfig.ApiLogging,
-		credentialsConfig,
+		credentialsChecker,
 		statusMetricsProvider,
 		generalConfig.GeneralSettings.RateLimitWindowDurationSeconds,
+		generalConfig.RateLimiter,
+		generalConfig.GeneralSettings.TrustedProxies,
+		accessLogSink,
 		isProfileModeActivated,
 		shouldStartSwaggerUI,
 	)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	go func() {
 		err = httpServer.ListenAndServe()
@@ -613,7 +870,218 @@ func startWebServer(
 		}
 	}()
 
-	return httpServer, nil
+	return httpServer, routesHandler, nil
+}
+
+// createAccessLogSink returns the sink of the access log, or nil if the access log is disabled
+func createAccessLogSink(accessLogConfig config.AccessLogConfig, closableComponents *data.ClosableComponentsHandler) (io.Writer, error) {
+	if !accessLogConfig.Enabled {
+		log.Debug("access log is disabled")
+		return nil, nil
+	}
+
+	sink, err := accesslog.NewSink(accessLogConfig)
+	if err != nil {
+		return nil, err
+	}
+	closableComponents.Add(sink)
+
+	log.Info("access log enabled", "sink", accessLogConfig.Sink)
+
+	return sink, nil
+}
+
+// startTracing sets the global tracer provider, used by the API and the processors, along with the W3C trace context
+// propagator, if the tracing is enabled
+func startTracing(tracingConfig config.TracingConfig, closableComponents *data.ClosableComponentsHandler) error {
+	if !tracingConfig.Enabled {
+		log.Debug("tracing is disabled")
+		return nil
+	}
+
+	tracerProvider, err := tracing.NewTracerProvider(tracingConfig)
+	if err != nil {
+		return err
+	}
+
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	closableComponents.Add(tracerProvider)
+
+	log.Info("tracing enabled", "exporter", tracingConfig.Exporter, "sample ratio", tracingConfig.SampleRatio)
+
+	return nil
+}
+
+// startGrpcServer starts the gRPC server, serving the default version of the facade, if a port is configured for it
+func startGrpcServer(
+	versionsRegistry data.VersionsRegistryHandler,
+	generalConfig *config.Config,
+	credentialsChecker *shared.CredentialsChecker,
+	closableComponents *data.ClosableComponentsHandler,
+) error {
+	port := generalConfig.GeneralSettings.GrpcPort
+	if port <= 0 {
+		log.Debug("gRPC server is disabled")
+		return nil
+	}
+
+	versionsMap, err := versionsRegistry.GetAllVersions()
+	if err != nil {
+		return err
+	}
+	defaultVersionData, ok := versionsMap[""]
+	if !ok {
+		return fmt.Errorf("cannot start the gRPC server: the default version is not registered")
+	}
+
+	grpcServer, err := grpcapi.NewServer(grpcapi.ArgsServer{
+		Facade:             defaultVersionData.Facade,
+		CredentialsChecker: credentialsChecker,
+		Secured:            generalConfig.GeneralSettings.GrpcSecured,
+	})
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		errServe := grpcServer.Serve(listener)
+		if errServe != nil {
+			log.Error("cannot serve gRPC", "err", errServe)
+			os.Exit(1)
+		}
+	}()
+	closableComponents.Add(grpcServer)
+
+	return nil
+}
+
+// createNodesDiscoverer resolves the DNS records of the nodes, adding the discovered nodes to the configured ones, so
+// they are used from the start, if the records can be resolved. It returns nil if the nodes discovery is disabled
+func createNodesDiscoverer(cfg *config.Config) (*discovery.NodesDiscoverer, error) {
+	if !cfg.NodesDiscovery.Enabled {
+		log.Debug("nodes discovery is disabled")
+		return nil, nil
+	}
+	// the observers of the test HTTP server are not configured
+	if testServer != nil {
+		log.Warn("nodes discovery is not available with the test HTTP server")
+		return nil, nil
+	}
+
+	observersResolver, err := discovery.NewRecordsResolver(net.DefaultResolver, cfg.NodesDiscovery.Observers)
+	if err != nil {
+		return nil, err
+	}
+
+	fullHistoryNodesResolver, err := discovery.NewRecordsResolver(net.DefaultResolver, cfg.NodesDiscovery.FullHistoryNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	nodesDiscoverer, err := discovery.NewNodesDiscoverer(discovery.ArgsNodesDiscoverer{
+		ObserversResolver:        observersResolver,
+		FullHistoryNodesResolver: fullHistoryNodesResolver,
+		StaticObservers:          cfg.Observers,
+		StaticFullHistoryNodes:   cfg.FullHistoryNodes,
+		Interval:                 time.Duration(cfg.NodesDiscovery.IntervalSec) * time.Second,
+		Timeout:                  time.Duration(cfg.NodesDiscovery.TimeoutSec) * time.Second,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.Observers = nodesDiscoverer.GetObservers()
+	cfg.FullHistoryNodes = nodesDiscoverer.GetFullHistoryNodes()
+	log.Info("nodes discovery enabled", "interval in seconds", cfg.NodesDiscovery.IntervalSec)
+
+	return nodesDiscoverer, nil
+}
+
+func startNodesDiscovery(
+	nodesDiscoverer *discovery.NodesDiscoverer,
+	bp *process.BaseProcessor,
+	closableComponents *data.ClosableComponentsHandler,
+) error {
+	if nodesDiscoverer == nil {
+		return nil
+	}
+
+	err := nodesDiscoverer.StartDiscovery(bp)
+	if err != nil {
+		return err
+	}
+	closableComponents.Add(nodesDiscoverer)
+
+	// the nodes reloaded through the API are applied by the discoverer as well, so the discovered nodes are kept
+	err = bp.SetNodesReloadHandler(nodesDiscoverer)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// startConfigWatcher starts reloading the observers, the full history nodes, the routes settings and the credentials
+// when their config files change or the proxy receives SIGHUP, if enabled
+func startConfigWatcher(
+	ctx *cli.Context,
+	configReloadConfig config.ConfigReloadConfig,
+	configurationFilePath string,
+	bp *process.BaseProcessor,
+	nodesDiscoverer *discovery.NodesDiscoverer,
+	routesHandler configwatcher.ApiRoutesHandler,
+	credentialsChecker *shared.CredentialsChecker,
+	closableComponents *data.ClosableComponentsHandler,
+) error {
+	if !configReloadConfig.Enabled {
+		log.Debug("config reload is disabled")
+		return nil
+	}
+	// the observers of the test HTTP server are not read from the config file
+	if testServer != nil {
+		log.Warn("config reload is not available with the test HTTP server")
+		return nil
+	}
+
+	// the discovered nodes are kept when the configured nodes are reloaded
+	var nodesHandler configwatcher.NodesHandler = bp
+	if nodesDiscoverer != nil {
+		nodesHandler = nodesDiscoverer
+	}
+
+	configLoader, err := configwatcher.NewConfigLoader(configwatcher.ArgsConfigLoader{
+		ConfigFilePath:      configurationFilePath,
+		CredentialsFilePath: ctx.GlobalString(credentialsConfigFile.Name),
+		ApiConfigDirectory:  ctx.GlobalString(apiConfigDirectory.Name),
+		ApiConfigNames:      versionsFactory.GetApiConfigNames(),
+		NumberOfShards:      bp.GetShardCoordinator().NumberOfShards(),
+		SkipNodesCheck:      nodesDiscoverer != nil,
+	})
+	if err != nil {
+		return err
+	}
+
+	configWatcher, err := configwatcher.NewConfigWatcher(configwatcher.ArgsConfigWatcher{
+		ConfigLoader:       configLoader,
+		NodesHandler:       nodesHandler,
+		ApiRoutesHandler:   routesHandler,
+		CredentialsHandler: credentialsChecker,
+		CheckInterval:      time.Duration(configReloadConfig.CheckIntervalSec) * time.Second,
+	})
+	if err != nil {
+		return err
+	}
+	closableComponents.Add(configWatcher)
+
+	log.Info("config reload enabled", "check interval in seconds", configReloadConfig.CheckIntervalSec)
+
+	return nil
 }
 
 func waitForServerShutdown(httpServer *http.Server, closableComponents *data.ClosableComponentsHandler) {
@@ -630,9 +1098,7 @@ func waitForServerShutdown(httpServer *http.Server, closableComponents *data.Clo
 }
 
 // getNumOfShards will delay the start of proxy until it successfully gets the number of shards
-func getNumOfShards(cfg *config.Config) (uint32, error) {
-	httpClient := &http.Client{}
-	httpClient.Timeout = time.Duration(cfg.GeneralSettings.RequestTimeoutSec) * time.Second
+func getNumOfShards(cfg *config.Config, httpClient process.HttpClient) (uint32, error) {
 	observersList := make([]string, 0, len(cfg.Observers))
 	for _, node := range cfg.Observers {
 		observersList = append(observersList, node.Address)
@@ -684,6 +1150,23 @@ func getWorkingDir(ctx *cli.Context, log logger.Logger) string {
 	return workingDir
 }
 
+// loadExternalConfig loads the external config file. A missing file is treated as all the external tools being disabled,
+// so the deployments which do not use them do not need the file
+func loadExternalConfig(filepath string) (*config.ExternalConfig, error) {
+	cfg := &config.ExternalConfig{}
+	_, err := os.Stat(filepath)
+	if os.IsNotExist(err) {
+		log.Info("external config file not found, the external tools are disabled", "file", filepath)
+		return cfg, nil
+	}
+
+	err = core.LoadTomlFile(cfg, filepath)
+	if err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 func loadCredentialsConfig(filepath string) (*config.CredentialsConfig, error) {
 	cfg := &config.CredentialsConfig{}
 	err := core.LoadTomlFile(cfg, filepath)
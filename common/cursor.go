@@ -0,0 +1,45 @@
+package common
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// EncodeCursor encodes the provided sort values of the last returned item into an opaque, URL-safe pagination cursor
+func EncodeCursor(sortValues []interface{}) (string, error) {
+	if len(sortValues) == 0 {
+		return "", ErrInvalidCursor
+	}
+
+	buff, err := json.Marshal(sortValues)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buff), nil
+}
+
+// DecodeCursor decodes the sort values held by a cursor previously built with EncodeCursor. Numeric values are kept
+// as json.Number so that they are forwarded without any loss of precision
+func DecodeCursor(cursor string) ([]interface{}, error) {
+	buff, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(buff))
+	decoder.UseNumber()
+
+	var sortValues []interface{}
+	err = decoder.Decode(&sortValues)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	if len(sortValues) == 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	return sortValues, nil
+}
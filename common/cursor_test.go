@@ -0,0 +1,66 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeCursor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty sort values should error", func(t *testing.T) {
+		t.Parallel()
+
+		cursor, err := EncodeCursor(nil)
+		require.Equal(t, ErrInvalidCursor, err)
+		require.Empty(t, cursor)
+	})
+	t.Run("should produce an URL safe value", func(t *testing.T) {
+		t.Parallel()
+
+		cursor, err := EncodeCursor([]interface{}{1700000000, 42, "erd1?sender/+"})
+		require.NoError(t, err)
+		require.NotContains(t, cursor, "+")
+		require.NotContains(t, cursor, "/")
+		require.NotContains(t, cursor, "=")
+	})
+}
+
+func TestDecodeCursor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid base64 should error", func(t *testing.T) {
+		t.Parallel()
+
+		sortValues, err := DecodeCursor("not a cursor!")
+		require.True(t, errors.Is(err, ErrInvalidCursor))
+		require.Nil(t, sortValues)
+	})
+	t.Run("not a json array should error", func(t *testing.T) {
+		t.Parallel()
+
+		sortValues, err := DecodeCursor("eyJhIjoxfQ") // {"a":1}
+		require.True(t, errors.Is(err, ErrInvalidCursor))
+		require.Nil(t, sortValues)
+	})
+	t.Run("empty json array should error", func(t *testing.T) {
+		t.Parallel()
+
+		sortValues, err := DecodeCursor("W10") // []
+		require.Equal(t, ErrInvalidCursor, err)
+		require.Nil(t, sortValues)
+	})
+	t.Run("roundtrip should keep the values", func(t *testing.T) {
+		t.Parallel()
+
+		cursor, err := EncodeCursor([]interface{}{uint64(18446744073709551615), 42, "erd1sender"})
+		require.NoError(t, err)
+
+		sortValues, err := DecodeCursor(cursor)
+		require.NoError(t, err)
+		require.Equal(t, []interface{}{json.Number("18446744073709551615"), json.Number("42"), "erd1sender"}, sortValues)
+	})
+}
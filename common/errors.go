@@ -0,0 +1,6 @@
+package common
+
+import "errors"
+
+// ErrInvalidCursor signals that an invalid pagination cursor has been provided
+var ErrInvalidCursor = errors.New("invalid cursor")
@@ -51,6 +51,31 @@ const (
 	UrlParameterWithAlteredAccounts = "withAlteredAccounts"
 	// UrlParameterWithKeys represents the name of an URL parameter
 	UrlParameterWithKeys = "withKeys"
+	// UrlParameterFilterSender represents the name of an URL parameter
+	UrlParameterFilterSender = "sender"
+	// UrlParameterFilterReceiver represents the name of an URL parameter
+	UrlParameterFilterReceiver = "receiver"
+	// UrlParameterFromTimestamp represents the name of an URL parameter
+	UrlParameterFromTimestamp = "from-timestamp"
+	// UrlParameterToTimestamp represents the name of an URL parameter
+	UrlParameterToTimestamp = "to-timestamp"
+	// UrlParameterFromNonce represents the name of an URL parameter
+	UrlParameterFromNonce = "from-nonce"
+	// UrlParameterToNonce represents the name of an URL parameter
+	UrlParameterToNonce = "to-nonce"
+	// UrlParameterToken represents the name of an URL parameter
+	UrlParameterToken = "token"
+	// UrlParameterCursor represents the name of an URL parameter
+	UrlParameterCursor = "cursor"
+	// UrlParameterSize represents the name of an URL parameter
+	UrlParameterSize = "size"
+)
+
+const (
+	// DefaultTransactionsHistoryPageSize is the number of transactions returned in a history page when no size is provided
+	DefaultTransactionsHistoryPageSize = 20
+	// MaxTransactionsHistoryPageSize is the maximum number of transactions that can be returned in a history page
+	MaxTransactionsHistoryPageSize = 100
 )
 
 // BlockQueryOptions holds options for block queries
@@ -87,6 +112,20 @@ type TransactionsPoolOptions struct {
 	NonceGaps bool
 }
 
+// TransactionsHistoryOptions holds the filters and the pagination options for address transactions history requests.
+// The timestamp and nonce ranges are inclusive, while the cursor is the opaque value returned along with the previous page
+type TransactionsHistoryOptions struct {
+	Sender        string
+	Receiver      string
+	Token         string
+	FromTimestamp core.OptionalUint64
+	ToTimestamp   core.OptionalUint64
+	FromNonce     core.OptionalUint64
+	ToNonce       core.OptionalUint64
+	Cursor        string
+	Size          uint32
+}
+
 // GetAlteredAccountsForBlockOptions specifies the options for returning altered accounts for a given block
 type GetAlteredAccountsForBlockOptions struct {
 	TokensFilter string
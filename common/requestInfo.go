@@ -0,0 +1,65 @@
+package common
+
+import (
+	"context"
+	"sync"
+)
+
+// RequestIDHeader is the header holding the ID of an API request, received from the clients, returned to them and
+// forwarded to the observers
+const RequestIDHeader = "X-Request-Id"
+
+type requestInfoKey struct{}
+
+// RequestInfo holds the details of an API request that are carried by its context down to the processors
+type RequestInfo struct {
+	ID string
+
+	mutObservers sync.Mutex
+	observers    []string
+}
+
+// NewRequestInfo returns a new instance of RequestInfo
+func NewRequestInfo(id string) *RequestInfo {
+	return &RequestInfo{
+		ID:        id,
+		observers: make([]string, 0),
+	}
+}
+
+// AddObserver records an observer contacted while serving the request
+func (ri *RequestInfo) AddObserver(address string) {
+	ri.mutObservers.Lock()
+	defer ri.mutObservers.Unlock()
+
+	for _, observer := range ri.observers {
+		if observer == address {
+			return
+		}
+	}
+
+	ri.observers = append(ri.observers, address)
+}
+
+// GetObservers returns the observers contacted while serving the request, in the order they were first contacted
+func (ri *RequestInfo) GetObservers() []string {
+	ri.mutObservers.Lock()
+	defer ri.mutObservers.Unlock()
+
+	observers := make([]string, len(ri.observers))
+	copy(observers, ri.observers)
+
+	return observers
+}
+
+// ContextWithRequestInfo returns a copy of the provided context carrying the request info
+func ContextWithRequestInfo(ctx context.Context, requestInfo *RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, requestInfo)
+}
+
+// RequestInfoFromContext returns the request info carried by the provided context, or nil if there is none
+func RequestInfoFromContext(ctx context.Context) *RequestInfo {
+	requestInfo, _ := ctx.Value(requestInfoKey{}).(*RequestInfo)
+
+	return requestInfo
+}
@@ -0,0 +1,55 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestInfo_AddObserver(t *testing.T) {
+	t.Parallel()
+
+	requestInfo := NewRequestInfo("id")
+	require.Empty(t, requestInfo.GetObservers())
+
+	requestInfo.AddObserver("observer1")
+	requestInfo.AddObserver("observer0")
+	requestInfo.AddObserver("observer1")
+	require.Equal(t, []string{"observer1", "observer0"}, requestInfo.GetObservers())
+}
+
+func TestRequestInfo_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	requestInfo := NewRequestInfo("id")
+	numCalls := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			switch idx % 2 {
+			case 0:
+				requestInfo.AddObserver(fmt.Sprintf("observer%d", idx%10))
+			case 1:
+				_ = requestInfo.GetObservers()
+			}
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+
+	require.Len(t, requestInfo.GetObservers(), 5)
+}
+
+func TestRequestInfoFromContext(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, RequestInfoFromContext(context.Background()))
+
+	requestInfo := NewRequestInfo("id")
+	ctx := ContextWithRequestInfo(context.Background(), requestInfo)
+	require.True(t, requestInfo == RequestInfoFromContext(ctx))
+}
@@ -18,6 +18,9 @@ type GeneralSettingsConfig struct {
 	AllowEntireTxPoolFetch                   bool
 	NumShardsTimeoutInSec                    int
 	TimeBetweenNodesRequestsInSec            int
+	GrpcPort                                 int
+	GrpcSecured                              bool
+	TrustedProxies                           []string
 }
 
 // Config will hold the whole config file's data
@@ -27,6 +30,23 @@ type Config struct {
 	Marshalizer            TypeConfig
 	Hasher                 TypeConfig
 	ApiLogging             ApiLoggingConfig
+	AccessLog              AccessLogConfig
+	ConfigReload           ConfigReloadConfig
+	NodesAdmin             NodesAdminConfig
+	NodesDiscovery         NodesDiscoveryConfig
+	ResponseCache          ResponseCacheConfig
+	LatencyAwareNodes      LatencyAwareNodesConfig
+	CircuitBreaker         CircuitBreakerConfig
+	HedgedRequests         HedgedRequestsConfig
+	ObserverHttpClient     ObserverHttpClientConfig
+	RateLimiter            RateLimiterConfig
+	RequestsCoalescing     RequestsCoalescingConfig
+	HyperblockStream       HyperblockStreamConfig
+	TransactionStatus      TransactionStatusConfig
+	TransactionPreflight   TransactionPreflightConfig
+	Relayer                RelayerConfig
+	Faucet                 FaucetConfig
+	Tracing                TracingConfig
 	Observers              []*data.NodeData
 	FullHistoryNodes       []*data.NodeData
 }
@@ -49,6 +69,201 @@ type ApiLoggingConfig struct {
 	ThresholdInMicroSeconds int
 }
 
+// AccessLogConfig holds the configuration related to the access log, receiving a JSON line for each API request
+type AccessLogConfig struct {
+	Enabled         bool
+	Sink            string
+	FilePath        string
+	MaxFileSizeInMB int
+	MaxBackups      int
+}
+
+// ConfigReloadConfig holds the configuration related to the reload of the observers, full history nodes, routes
+// settings and credentials when their config files change or the proxy receives SIGHUP
+type ConfigReloadConfig struct {
+	Enabled          bool
+	CheckIntervalSec int
+}
+
+// NodesAdminConfig holds the configuration related to the observers and full history nodes changed at runtime through
+// the admin routes
+type NodesAdminConfig struct {
+	OverlayFile string
+}
+
+// NodesDiscoveryConfig holds the configuration related to the discovery of the observers and the full history nodes
+// through DNS records, resolved periodically
+type NodesDiscoveryConfig struct {
+	Enabled          bool
+	IntervalSec      int
+	TimeoutSec       int
+	Observers        []DiscoveryRecordConfig
+	FullHistoryNodes []DiscoveryRecordConfig
+}
+
+// DiscoveryRecordConfig holds a DNS record resolved to the nodes of a shard and the settings of the resolved nodes
+type DiscoveryRecordConfig struct {
+	ShardId        uint32
+	Type           string
+	Name           string
+	Scheme         string
+	Port           int
+	IsFallback     bool
+	IsSnapshotless bool
+}
+
+// ResponseCacheConfig holds the configuration related to the cache of the responses for finalized data
+type ResponseCacheConfig struct {
+	Enabled                    bool
+	MaxSizeInMB                uint64
+	MaxNumEntries              int
+	FinalityRefreshIntervalSec int
+}
+
+// LatencyAwareNodesConfig holds the configuration related to the nodes providers that prefer the fastest healthy nodes
+type LatencyAwareNodesConfig struct {
+	EnabledForObservers        bool
+	EnabledForFullHistoryNodes bool
+	SmoothingFactor            float64
+	ExplorationRatio           float64
+	UnhealthyErrorRate         float64
+}
+
+// CircuitBreakerConfig holds the configuration related to the circuit breakers of the nodes
+type CircuitBreakerConfig struct {
+	Enabled          bool
+	FailureThreshold uint32
+	CoolDownSec      int
+}
+
+// HedgedRequestsConfig holds the configuration related to the hedged requests sent to the observers of the same shard
+type HedgedRequestsConfig struct {
+	Enabled bool
+	DelayMs int
+}
+
+// RequestsCoalescingConfig holds the configuration related to the merging of the identical concurrent requests
+type RequestsCoalescingConfig struct {
+	Enabled bool
+}
+
+// HyperblockStreamConfig holds the configuration related to the live hyperblocks stream
+type HyperblockStreamConfig struct {
+	Enabled        bool
+	PollIntervalMs int
+	BufferSize     int
+	MaxResumeGap   uint64
+	MaxSubscribers int
+}
+
+// TransactionStatusConfig holds the configuration related to the transaction status watching
+type TransactionStatusConfig struct {
+	PollIntervalMs        int
+	SendAndWaitTimeoutSec int
+	WatchTimeoutSec       int
+	MaxWatchers           int
+}
+
+// TransactionPreflightConfig holds the configuration related to the local checks of the transactions, done before
+// sending them to the observers
+type TransactionPreflightConfig struct {
+	Enabled     bool
+	MaxNonceGap uint64
+}
+
+// RelayerConfig holds the configuration related to the co-signing of relayed transactions
+type RelayerConfig struct {
+	Enabled                 bool
+	PemFile                 string
+	AllowedReceivers        []string
+	AllowedFunctions        []string
+	DailyGasBudgetPerSender uint64
+	MaxGasPrice             uint64
+}
+
+// TracingConfig holds the configuration related to the OpenTelemetry tracing of the API requests and of the requests
+// sent to the observers
+type TracingConfig struct {
+	Enabled      bool
+	Exporter     string
+	OtlpEndpoint string
+	OtlpInsecure bool
+	SampleRatio  float64
+	ServiceName  string
+}
+
+// FaucetConfig holds the configuration related to the limits of the faucet and to the dispensed ESDT tokens
+type FaucetConfig struct {
+	AddressCooldownSec   int
+	IPCooldownSec        int
+	LedgerFile           string
+	ESDTTransferGasLimit uint64
+	DailyBudgets         []FaucetAmountConfig
+	Tokens               []FaucetAmountConfig
+}
+
+// FaucetAmountConfig holds an amount of a token, as used by the faucet configuration. The EGLD token is identified by
+// "EGLD"
+type FaucetAmountConfig struct {
+	Token string
+	Value string
+}
+
+// ObserverHttpClientConfig holds the configuration related to the http client used for the requests sent to the nodes
+type ObserverHttpClientConfig struct {
+	MaxIdleConns             int
+	MaxIdleConnsPerHost      int
+	MaxConnsPerHost          int
+	IdleConnTimeoutSec       int
+	DialTimeoutSec           int
+	KeepAliveSec             int
+	TLSHandshakeTimeoutSec   int
+	ResponseHeaderTimeoutSec int
+	EnableHTTP2              bool
+	CACertificateFile        string
+	ClientCertificates       []ObserverClientCertificateConfig
+}
+
+// ObserverClientCertificateConfig holds the client certificate to be used when connecting to a node
+type ObserverClientCertificateConfig struct {
+	Address         string
+	CertificateFile string
+	KeyFile         string
+}
+
+// RateLimiterConfig holds the configuration related to the rate limiting of the API requests
+type RateLimiterConfig struct {
+	Algorithm    string
+	ApiKeyHeader string
+	Tiers        []RateLimitTierConfig
+	ApiKeys      []RateLimitApiKeyConfig
+}
+
+// RateLimitTierConfig holds the quotas of a rate limiting tier
+type RateLimitTierConfig struct {
+	Name            string
+	LimitMultiplier uint64
+}
+
+// RateLimitApiKeyConfig holds an API key and the rate limiting tier it belongs to
+type RateLimitApiKeyConfig struct {
+	Key  string
+	Tier string
+}
+
+// ExternalConfig will hold the configurations for external tools, such as ElasticSearch
+type ExternalConfig struct {
+	ElasticSearchConnector ElasticSearchConfig
+}
+
+// ElasticSearchConfig will hold the configuration for the ElasticSearch connector
+type ElasticSearchConfig struct {
+	Enabled  bool
+	URL      string
+	Username string
+	Password string
+}
+
 // CredentialsConfig holds the credential pairs
 type CredentialsConfig struct {
 	Credentials []data.Credential
@@ -0,0 +1,171 @@
+package configwatcher
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/api/shared"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/observer"
+)
+
+// ReloadableConfig holds the settings that can be reloaded without restarting the proxy
+type ReloadableConfig struct {
+	Observers        []*data.NodeData
+	FullHistoryNodes []*data.NodeData
+	ApiRoutesConfigs map[string]data.ApiRoutesConfig
+	Credentials      config.CredentialsConfig
+}
+
+// ArgsConfigLoader holds the arguments needed for creating a new config loader
+type ArgsConfigLoader struct {
+	ConfigFilePath      string
+	CredentialsFilePath string
+	ApiConfigDirectory  string
+	ApiConfigNames      map[string]string
+	NumberOfShards      uint32
+	// SkipNodesCheck is set when the nodes are discovered, the configured nodes being checked only together with them
+	SkipNodesCheck bool
+}
+
+type configLoader struct {
+	configFilePath      string
+	credentialsFilePath string
+	apiConfigFilePaths  map[string]string
+	numberOfShards      uint32
+	skipNodesCheck      bool
+}
+
+// NewConfigLoader returns a new instance of configLoader, loading the observers and the full history nodes from the
+// main config file, the credentials from the credentials file and the routes settings of each version from the file
+// with the provided name in the API config directory
+func NewConfigLoader(args ArgsConfigLoader) (*configLoader, error) {
+	if len(args.ConfigFilePath) == 0 || len(args.CredentialsFilePath) == 0 {
+		return nil, ErrEmptyFilePath
+	}
+	if len(args.ApiConfigNames) == 0 {
+		return nil, ErrNoApiConfigNames
+	}
+
+	apiConfigFilePaths := make(map[string]string, len(args.ApiConfigNames))
+	for version, apiConfigName := range args.ApiConfigNames {
+		apiConfigFilePaths[version] = filepath.Join(args.ApiConfigDirectory, fmt.Sprintf("%s.toml", apiConfigName))
+	}
+
+	return &configLoader{
+		configFilePath:      args.ConfigFilePath,
+		credentialsFilePath: args.CredentialsFilePath,
+		apiConfigFilePaths:  apiConfigFilePaths,
+		numberOfShards:      args.NumberOfShards,
+		skipNodesCheck:      args.SkipNodesCheck,
+	}, nil
+}
+
+// Load reads the reloadable settings from the config files and validates them
+func (loader *configLoader) Load() (*ReloadableConfig, error) {
+	mainConfig := &config.Config{}
+	err := core.LoadTomlFile(mainConfig, loader.configFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = loader.checkNodes(mainConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	apiRoutesConfigs := make(map[string]data.ApiRoutesConfig, len(loader.apiConfigFilePaths))
+	for version, apiConfigFilePath := range loader.apiConfigFilePaths {
+		apiRoutesConfig := data.ApiRoutesConfig{}
+		err = core.LoadTomlFile(&apiRoutesConfig, apiConfigFilePath)
+		if err != nil {
+			return nil, err
+		}
+
+		err = checkApiRoutesConfig(apiRoutesConfig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid API routes config %s: %w", apiConfigFilePath, err)
+		}
+
+		apiRoutesConfigs[version] = apiRoutesConfig
+	}
+
+	credentialsConfig := config.CredentialsConfig{}
+	err = core.LoadTomlFile(&credentialsConfig, loader.credentialsFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = shared.CheckCredentialsConfig(credentialsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid credentials: %w", err)
+	}
+
+	return &ReloadableConfig{
+		Observers:        mainConfig.Observers,
+		FullHistoryNodes: mainConfig.FullHistoryNodes,
+		ApiRoutesConfigs: apiRoutesConfigs,
+		Credentials:      credentialsConfig,
+	}, nil
+}
+
+func (loader *configLoader) checkNodes(mainConfig *config.Config) error {
+	if loader.skipNodesCheck {
+		return nil
+	}
+
+	err := observer.CheckNodes(mainConfig.Observers, loader.numberOfShards)
+	if err != nil {
+		return fmt.Errorf("invalid observers: %w", err)
+	}
+
+	// the full history nodes are optional
+	if len(mainConfig.FullHistoryNodes) > 0 {
+		err = observer.CheckNodes(mainConfig.FullHistoryNodes, loader.numberOfShards)
+		if err != nil {
+			return fmt.Errorf("invalid full history nodes: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func checkApiRoutesConfig(apiRoutesConfig data.ApiRoutesConfig) error {
+	for packageName, packageConfig := range apiRoutesConfig.APIPackages {
+		routes := make(map[string]struct{}, len(packageConfig.Routes))
+		for _, route := range packageConfig.Routes {
+			_, found := routes[route.Name]
+			if found {
+				return fmt.Errorf("%w %s in package %s", ErrDuplicatedRoute, route.Name, packageName)
+			}
+			routes[route.Name] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// GetFilePaths returns the paths of the files holding the reloadable settings
+func (loader *configLoader) GetFilePaths() []string {
+	filePaths := []string{loader.configFilePath, loader.credentialsFilePath}
+
+	// more versions can use the same API routes config file
+	apiConfigFilePaths := make(map[string]struct{})
+	for _, apiConfigFilePath := range loader.apiConfigFilePaths {
+		apiConfigFilePaths[apiConfigFilePath] = struct{}{}
+	}
+	for apiConfigFilePath := range apiConfigFilePaths {
+		filePaths = append(filePaths, apiConfigFilePath)
+	}
+	sort.Strings(filePaths[2:])
+
+	return filePaths
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (loader *configLoader) IsInterfaceNil() bool {
+	return loader == nil
+}
@@ -0,0 +1,251 @@
+package configwatcher_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/configwatcher"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/observer"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testConfig = `
+[[Observers]]
+   ShardId = 0
+   Address = "http://observer0:8080"
+
+[[Observers]]
+   ShardId = 4294967295
+   Address = "http://observer-meta:8080"
+   IsFallback = true
+`
+	testApiConfig = `
+[APIPackages]
+
+[APIPackages.address]
+Routes = [
+    { Name = "/:address", Open = true },
+    { Name = "/:address/balance", Open = true, Secured = true, RateLimit = 5 }
+]
+`
+	testCredentials = `
+Credentials = [
+    { Username = "user", Password = "04f8996da763b7a969b1028ee3007569eaf3a635486ddab211d512c85b9df8fb" }
+]
+
+[Hasher]
+Type = "sha256"
+`
+)
+
+type testConfigFiles struct {
+	configFilePath      string
+	credentialsFilePath string
+	apiConfigDirectory  string
+}
+
+func createTestConfigFiles(t *testing.T) *testConfigFiles {
+	directory := t.TempDir()
+	files := &testConfigFiles{
+		configFilePath:      filepath.Join(directory, "config.toml"),
+		credentialsFilePath: filepath.Join(directory, "credentials.toml"),
+		apiConfigDirectory:  directory,
+	}
+
+	writeTestFile(t, files.configFilePath, testConfig)
+	writeTestFile(t, files.credentialsFilePath, testCredentials)
+	writeTestFile(t, filepath.Join(directory, "v1_0.toml"), testApiConfig)
+
+	return files
+}
+
+func writeTestFile(t *testing.T, filePath string, content string) {
+	err := os.WriteFile(filePath, []byte(content), 0644)
+	require.NoError(t, err)
+}
+
+func createTestConfigLoaderArgs(files *testConfigFiles) configwatcher.ArgsConfigLoader {
+	return configwatcher.ArgsConfigLoader{
+		ConfigFilePath:      files.configFilePath,
+		CredentialsFilePath: files.credentialsFilePath,
+		ApiConfigDirectory:  files.apiConfigDirectory,
+		ApiConfigNames:      map[string]string{"v1.0": "v1_0", "": "v1_0"},
+		NumberOfShards:      1,
+	}
+}
+
+func TestNewConfigLoader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty config file path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestConfigLoaderArgs(&testConfigFiles{credentialsFilePath: "credentials.toml"})
+		loader, err := configwatcher.NewConfigLoader(args)
+		require.Nil(t, loader)
+		require.Equal(t, configwatcher.ErrEmptyFilePath, err)
+	})
+	t.Run("empty credentials file path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestConfigLoaderArgs(&testConfigFiles{configFilePath: "config.toml"})
+		loader, err := configwatcher.NewConfigLoader(args)
+		require.Nil(t, loader)
+		require.Equal(t, configwatcher.ErrEmptyFilePath, err)
+	})
+	t.Run("no API config names should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestConfigLoaderArgs(&testConfigFiles{configFilePath: "config.toml", credentialsFilePath: "credentials.toml"})
+		args.ApiConfigNames = nil
+		loader, err := configwatcher.NewConfigLoader(args)
+		require.Nil(t, loader)
+		require.Equal(t, configwatcher.ErrNoApiConfigNames, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTestConfigLoaderArgs(&testConfigFiles{configFilePath: "config.toml", credentialsFilePath: "credentials.toml"})
+		loader, err := configwatcher.NewConfigLoader(args)
+		require.NoError(t, err)
+		require.False(t, loader.IsInterfaceNil())
+	})
+}
+
+func TestConfigLoader_Load(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		files := createTestConfigFiles(t)
+		loader, _ := configwatcher.NewConfigLoader(createTestConfigLoaderArgs(files))
+
+		reloadableConfig, err := loader.Load()
+		require.NoError(t, err)
+		require.Equal(t, []*data.NodeData{
+			{ShardId: 0, Address: "http://observer0:8080"},
+			{ShardId: 4294967295, Address: "http://observer-meta:8080", IsFallback: true},
+		}, reloadableConfig.Observers)
+		require.Empty(t, reloadableConfig.FullHistoryNodes)
+		require.Len(t, reloadableConfig.ApiRoutesConfigs, 2)
+		require.Equal(t, reloadableConfig.ApiRoutesConfigs["v1.0"], reloadableConfig.ApiRoutesConfigs[""])
+		require.Equal(t, []data.RouteConfig{
+			{Name: "/:address", Open: true},
+			{Name: "/:address/balance", Open: true, Secured: true, RateLimit: 5},
+		}, reloadableConfig.ApiRoutesConfigs["v1.0"].APIPackages["address"].Routes)
+		require.Equal(t, "user", reloadableConfig.Credentials.Credentials[0].Username)
+		require.Equal(t, "sha256", reloadableConfig.Credentials.Hasher.Type)
+	})
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		files := createTestConfigFiles(t)
+		require.NoError(t, os.Remove(files.credentialsFilePath))
+		loader, _ := configwatcher.NewConfigLoader(createTestConfigLoaderArgs(files))
+
+		reloadableConfig, err := loader.Load()
+		require.Error(t, err)
+		require.Nil(t, reloadableConfig)
+	})
+	t.Run("no observers should error", func(t *testing.T) {
+		t.Parallel()
+
+		files := createTestConfigFiles(t)
+		writeTestFile(t, files.configFilePath, "")
+		loader, _ := configwatcher.NewConfigLoader(createTestConfigLoaderArgs(files))
+
+		reloadableConfig, err := loader.Load()
+		require.ErrorIs(t, err, observer.ErrEmptyObserversList)
+		require.Nil(t, reloadableConfig)
+	})
+	t.Run("no observers should work if the nodes check is skipped", func(t *testing.T) {
+		t.Parallel()
+
+		files := createTestConfigFiles(t)
+		writeTestFile(t, files.configFilePath, "")
+		args := createTestConfigLoaderArgs(files)
+		args.SkipNodesCheck = true
+		loader, _ := configwatcher.NewConfigLoader(args)
+
+		reloadableConfig, err := loader.Load()
+		require.NoError(t, err)
+		require.Empty(t, reloadableConfig.Observers)
+	})
+	t.Run("invalid full history nodes should error", func(t *testing.T) {
+		t.Parallel()
+
+		files := createTestConfigFiles(t)
+		writeTestFile(t, files.configFilePath, testConfig+`
+[[FullHistoryNodes]]
+   ShardId = 7
+   Address = "http://full-history:8080"
+`)
+		loader, _ := configwatcher.NewConfigLoader(createTestConfigLoaderArgs(files))
+
+		reloadableConfig, err := loader.Load()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid full history nodes")
+		require.Nil(t, reloadableConfig)
+	})
+	t.Run("duplicated route should error", func(t *testing.T) {
+		t.Parallel()
+
+		files := createTestConfigFiles(t)
+		writeTestFile(t, filepath.Join(files.apiConfigDirectory, "v1_0.toml"), `
+[APIPackages]
+
+[APIPackages.address]
+Routes = [
+    { Name = "/:address", Open = true },
+    { Name = "/:address", Open = false }
+]
+`)
+		loader, _ := configwatcher.NewConfigLoader(createTestConfigLoaderArgs(files))
+
+		reloadableConfig, err := loader.Load()
+		require.ErrorIs(t, err, configwatcher.ErrDuplicatedRoute)
+		require.Nil(t, reloadableConfig)
+	})
+	t.Run("invalid credentials should error", func(t *testing.T) {
+		t.Parallel()
+
+		files := createTestConfigFiles(t)
+		writeTestFile(t, files.credentialsFilePath, `
+Credentials = [
+    { Username = "", Password = "hash" }
+]
+
+[Hasher]
+Type = "sha256"
+`)
+		loader, _ := configwatcher.NewConfigLoader(createTestConfigLoaderArgs(files))
+
+		reloadableConfig, err := loader.Load()
+		require.ErrorIs(t, err, errors.ErrEmptyUsername)
+		require.Nil(t, reloadableConfig)
+	})
+}
+
+func TestConfigLoader_GetFilePaths(t *testing.T) {
+	t.Parallel()
+
+	loader, _ := configwatcher.NewConfigLoader(configwatcher.ArgsConfigLoader{
+		ConfigFilePath:      "config.toml",
+		CredentialsFilePath: "credentials.toml",
+		ApiConfigDirectory:  "apiConfig",
+		ApiConfigNames:      map[string]string{"v_next": "v_next", "v1.0": "v1_0", "": "v1_0"},
+	})
+
+	expectedFilePaths := []string{
+		"config.toml",
+		"credentials.toml",
+		filepath.Join("apiConfig", "v1_0.toml"),
+		filepath.Join("apiConfig", "v_next.toml"),
+	}
+	require.Equal(t, expectedFilePaths, loader.GetFilePaths())
+}
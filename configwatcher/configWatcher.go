@@ -0,0 +1,260 @@
+package configwatcher
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("configwatcher")
+
+// ArgsConfigWatcher holds the arguments needed for creating a new config watcher
+type ArgsConfigWatcher struct {
+	ConfigLoader       ConfigLoader
+	NodesHandler       NodesHandler
+	ApiRoutesHandler   ApiRoutesHandler
+	CredentialsHandler CredentialsHandler
+	CheckInterval      time.Duration
+}
+
+// reloadStep applies a part of a reloadable config
+type reloadStep struct {
+	name  string
+	apply func(reloadableConfig *ReloadableConfig) error
+}
+
+type configWatcher struct {
+	configLoader       ConfigLoader
+	nodesHandler       NodesHandler
+	apiRoutesHandler   ApiRoutesHandler
+	credentialsHandler CredentialsHandler
+
+	mutReload     sync.Mutex
+	currentConfig *ReloadableConfig
+	filesHashes   map[string][]byte
+
+	signals    chan os.Signal
+	cancelFunc context.CancelFunc
+}
+
+// NewConfigWatcher returns a new instance of configWatcher, which reloads the config whenever one of the config files
+// changes or the process receives SIGHUP
+func NewConfigWatcher(args ArgsConfigWatcher) (*configWatcher, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	currentConfig, err := args.ConfigLoader.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	cw := &configWatcher{
+		configLoader:       args.ConfigLoader,
+		nodesHandler:       args.NodesHandler,
+		apiRoutesHandler:   args.ApiRoutesHandler,
+		credentialsHandler: args.CredentialsHandler,
+		currentConfig:      currentConfig,
+		signals:            make(chan os.Signal, 1),
+	}
+	cw.filesHashes = cw.computeFilesHashes()
+
+	signal.Notify(cw.signals, syscall.SIGHUP)
+
+	var ctx context.Context
+	ctx, cw.cancelFunc = context.WithCancel(context.Background())
+	go cw.watch(ctx, args.CheckInterval)
+
+	return cw, nil
+}
+
+func checkArgs(args ArgsConfigWatcher) error {
+	if check.IfNil(args.ConfigLoader) {
+		return ErrNilConfigLoader
+	}
+	if check.IfNil(args.NodesHandler) {
+		return ErrNilNodesHandler
+	}
+	if check.IfNil(args.ApiRoutesHandler) {
+		return ErrNilApiRoutesHandler
+	}
+	if check.IfNil(args.CredentialsHandler) {
+		return ErrNilCredentialsHandler
+	}
+	if args.CheckInterval <= 0 {
+		return ErrInvalidCheckInterval
+	}
+
+	return nil
+}
+
+func (cw *configWatcher) watch(ctx context.Context, checkInterval time.Duration) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("config watcher is closing...")
+			return
+		case <-cw.signals:
+			log.Info("SIGHUP received, reloading the config")
+			_ = cw.Reload()
+		case <-ticker.C:
+			if cw.haveFilesChanged() {
+				log.Info("config files changed, reloading the config")
+				_ = cw.Reload()
+			}
+		}
+	}
+}
+
+// haveFilesChanged returns true if the content of any config file changed since the last check. A file which cannot be
+// read, for example while it is being replaced, is treated as unchanged until it can be read again
+func (cw *configWatcher) haveFilesChanged() bool {
+	cw.mutReload.Lock()
+	defer cw.mutReload.Unlock()
+
+	filesHashes := cw.computeFilesHashes()
+	changed := false
+	for filePath, hash := range filesHashes {
+		if string(cw.filesHashes[filePath]) != string(hash) {
+			changed = true
+			break
+		}
+	}
+
+	for filePath, hash := range filesHashes {
+		cw.filesHashes[filePath] = hash
+	}
+
+	return changed
+}
+
+func (cw *configWatcher) computeFilesHashes() map[string][]byte {
+	filesHashes := make(map[string][]byte)
+	for _, filePath := range cw.configLoader.GetFilePaths() {
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			log.Warn("cannot read config file", "file", filePath, "error", err.Error())
+			continue
+		}
+
+		hash := sha256.Sum256(content)
+		filesHashes[filePath] = hash[:]
+	}
+
+	return filesHashes
+}
+
+// Reload loads and validates the config and applies the changed settings. If the new config is invalid, the current
+// one is kept. If applying a setting fails, the settings already applied are reverted to the current config
+func (cw *configWatcher) Reload() error {
+	cw.mutReload.Lock()
+	defer cw.mutReload.Unlock()
+
+	newConfig, err := cw.configLoader.Load()
+	if err != nil {
+		log.Error("invalid config, not reloaded", "error", err.Error())
+		return err
+	}
+
+	diff := computeConfigsDiff(cw.currentConfig, newConfig)
+	if diff.isEmpty() {
+		log.Info("config reloaded, no changes")
+		return nil
+	}
+
+	steps := cw.createReloadSteps(diff)
+	for idx, step := range steps {
+		err = step.apply(newConfig)
+		if err == nil {
+			continue
+		}
+
+		err = fmt.Errorf("cannot apply the new %s: %w", step.name, err)
+		cw.rollback(steps[:idx])
+		log.Error("config not reloaded, the previous config was restored",
+			"error", err.Error(),
+			"changes", diff.String(),
+		)
+
+		return err
+	}
+
+	cw.currentConfig = newConfig
+	log.Info("config reloaded", "changes", diff.String())
+
+	return nil
+}
+
+// createReloadSteps returns the steps for the parts of the config which changed, so the unchanged components keep
+// their state, such as the nodes statistics
+func (cw *configWatcher) createReloadSteps(diff *configsDiff) []reloadStep {
+	steps := make([]reloadStep, 0)
+	if len(diff.observers) > 0 {
+		steps = append(steps, reloadStep{
+			name: "observers",
+			apply: func(reloadableConfig *ReloadableConfig) error {
+				return cw.nodesHandler.SetObservers(reloadableConfig.Observers)
+			},
+		})
+	}
+	if len(diff.fullHistoryNodes) > 0 {
+		steps = append(steps, reloadStep{
+			name: "full history nodes",
+			apply: func(reloadableConfig *ReloadableConfig) error {
+				return cw.nodesHandler.SetFullHistoryNodes(reloadableConfig.FullHistoryNodes)
+			},
+		})
+	}
+	if len(diff.apiRoutes) > 0 {
+		steps = append(steps, reloadStep{
+			name: "API routes config",
+			apply: func(reloadableConfig *ReloadableConfig) error {
+				return cw.apiRoutesHandler.UpdateApiRoutesConfigs(reloadableConfig.ApiRoutesConfigs)
+			},
+		})
+	}
+	if len(diff.credentials) > 0 {
+		steps = append(steps, reloadStep{
+			name: "credentials",
+			apply: func(reloadableConfig *ReloadableConfig) error {
+				return cw.credentialsHandler.Update(reloadableConfig.Credentials)
+			},
+		})
+	}
+
+	return steps
+}
+
+func (cw *configWatcher) rollback(appliedSteps []reloadStep) {
+	for idx := len(appliedSteps) - 1; idx >= 0; idx-- {
+		err := appliedSteps[idx].apply(cw.currentConfig)
+		if err != nil {
+			log.Error("cannot restore the previous config", "setting", appliedSteps[idx].name, "error", err.Error())
+		}
+	}
+}
+
+// Close stops watching the config files and the SIGHUP signal
+func (cw *configWatcher) Close() error {
+	signal.Stop(cw.signals)
+	cw.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cw *configWatcher) IsInterfaceNil() bool {
+	return cw == nil
+}
@@ -0,0 +1,306 @@
+package configwatcher_test
+
+import (
+	"errors"
+	"path/filepath"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/configwatcher"
+	"github.com/multiversx/mx-chain-proxy-go/configwatcher/mock"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+var expectedErr = errors.New("expected error")
+
+func createMockArgsConfigWatcher() configwatcher.ArgsConfigWatcher {
+	return configwatcher.ArgsConfigWatcher{
+		ConfigLoader:       &mock.ConfigLoaderStub{},
+		NodesHandler:       &mock.NodesHandlerStub{},
+		ApiRoutesHandler:   &mock.ApiRoutesHandlerStub{},
+		CredentialsHandler: &mock.CredentialsHandlerStub{},
+		CheckInterval:      time.Hour,
+	}
+}
+
+func createTestReloadableConfig(observerAddress string) *configwatcher.ReloadableConfig {
+	return &configwatcher.ReloadableConfig{
+		Observers: []*data.NodeData{{ShardId: 0, Address: observerAddress}},
+		ApiRoutesConfigs: map[string]data.ApiRoutesConfig{
+			"v1.0": {APIPackages: map[string]data.APIPackageConfig{
+				"address": {Routes: []data.RouteConfig{{Name: "/:address", Open: true}}},
+			}},
+		},
+		Credentials: config.CredentialsConfig{Hasher: config.TypeConfig{Type: "sha256"}},
+	}
+}
+
+func TestNewConfigWatcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil config loader should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsConfigWatcher()
+		args.ConfigLoader = nil
+		cw, err := configwatcher.NewConfigWatcher(args)
+		require.Nil(t, cw)
+		require.Equal(t, configwatcher.ErrNilConfigLoader, err)
+	})
+	t.Run("nil nodes handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsConfigWatcher()
+		args.NodesHandler = nil
+		cw, err := configwatcher.NewConfigWatcher(args)
+		require.Nil(t, cw)
+		require.Equal(t, configwatcher.ErrNilNodesHandler, err)
+	})
+	t.Run("nil API routes handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsConfigWatcher()
+		args.ApiRoutesHandler = nil
+		cw, err := configwatcher.NewConfigWatcher(args)
+		require.Nil(t, cw)
+		require.Equal(t, configwatcher.ErrNilApiRoutesHandler, err)
+	})
+	t.Run("nil credentials handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsConfigWatcher()
+		args.CredentialsHandler = nil
+		cw, err := configwatcher.NewConfigWatcher(args)
+		require.Nil(t, cw)
+		require.Equal(t, configwatcher.ErrNilCredentialsHandler, err)
+	})
+	t.Run("invalid check interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsConfigWatcher()
+		args.CheckInterval = 0
+		cw, err := configwatcher.NewConfigWatcher(args)
+		require.Nil(t, cw)
+		require.Equal(t, configwatcher.ErrInvalidCheckInterval, err)
+	})
+	t.Run("invalid initial config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsConfigWatcher()
+		args.ConfigLoader = &mock.ConfigLoaderStub{
+			LoadCalled: func() (*configwatcher.ReloadableConfig, error) {
+				return nil, expectedErr
+			},
+		}
+		cw, err := configwatcher.NewConfigWatcher(args)
+		require.Nil(t, cw)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cw, err := configwatcher.NewConfigWatcher(createMockArgsConfigWatcher())
+		require.NoError(t, err)
+		require.False(t, cw.IsInterfaceNil())
+		require.NoError(t, cw.Close())
+	})
+}
+
+func TestConfigWatcher_Reload(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid config should keep the current one", func(t *testing.T) {
+		t.Parallel()
+
+		numLoads := 0
+		args := createMockArgsConfigWatcher()
+		args.ConfigLoader = &mock.ConfigLoaderStub{
+			LoadCalled: func() (*configwatcher.ReloadableConfig, error) {
+				numLoads++
+				if numLoads > 1 {
+					return nil, expectedErr
+				}
+				return createTestReloadableConfig("http://observer0"), nil
+			},
+		}
+		args.NodesHandler = &mock.NodesHandlerStub{
+			SetObserversCalled: func(nodes []*data.NodeData) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		cw, _ := configwatcher.NewConfigWatcher(args)
+		defer func() {
+			_ = cw.Close()
+		}()
+
+		err := cw.Reload()
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should apply only the changed settings", func(t *testing.T) {
+		t.Parallel()
+
+		newConfig := createTestReloadableConfig("http://observer1")
+		newConfig.Credentials.Credentials = []data.Credential{{Username: "user", Password: "hash"}}
+		configs := []*configwatcher.ReloadableConfig{createTestReloadableConfig("http://observer0"), newConfig}
+
+		args := createMockArgsConfigWatcher()
+		args.ConfigLoader = &mock.ConfigLoaderStub{
+			LoadCalled: func() (*configwatcher.ReloadableConfig, error) {
+				reloadableConfig := configs[0]
+				configs = configs[1:]
+				return reloadableConfig, nil
+			},
+		}
+		var setObservers []*data.NodeData
+		var updatedCredentials config.CredentialsConfig
+		args.NodesHandler = &mock.NodesHandlerStub{
+			SetObserversCalled: func(nodes []*data.NodeData) error {
+				setObservers = nodes
+				return nil
+			},
+			SetFullHistoryNodesCalled: func(nodes []*data.NodeData) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		args.ApiRoutesHandler = &mock.ApiRoutesHandlerStub{
+			UpdateApiRoutesConfigsCalled: func(apiRoutesConfigs map[string]data.ApiRoutesConfig) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		args.CredentialsHandler = &mock.CredentialsHandlerStub{
+			UpdateCalled: func(credentialsConfig config.CredentialsConfig) error {
+				updatedCredentials = credentialsConfig
+				return nil
+			},
+		}
+		cw, _ := configwatcher.NewConfigWatcher(args)
+		defer func() {
+			_ = cw.Close()
+		}()
+
+		err := cw.Reload()
+		require.NoError(t, err)
+		require.Equal(t, newConfig.Observers, setObservers)
+		require.Equal(t, newConfig.Credentials, updatedCredentials)
+	})
+	t.Run("failed setting should roll back the applied ones", func(t *testing.T) {
+		t.Parallel()
+
+		oldConfig := createTestReloadableConfig("http://observer0")
+		newConfig := createTestReloadableConfig("http://observer1")
+		newConfig.ApiRoutesConfigs["v1.0"].APIPackages["address"].Routes[0].Secured = true
+		newConfig.Credentials.Hasher.Type = "blake2b"
+		configs := []*configwatcher.ReloadableConfig{oldConfig, newConfig}
+
+		args := createMockArgsConfigWatcher()
+		args.ConfigLoader = &mock.ConfigLoaderStub{
+			LoadCalled: func() (*configwatcher.ReloadableConfig, error) {
+				reloadableConfig := configs[0]
+				configs = configs[1:]
+				return reloadableConfig, nil
+			},
+		}
+		calls := make([]string, 0)
+		args.NodesHandler = &mock.NodesHandlerStub{
+			SetObserversCalled: func(nodes []*data.NodeData) error {
+				calls = append(calls, "observers "+nodes[0].Address)
+				return nil
+			},
+		}
+		args.ApiRoutesHandler = &mock.ApiRoutesHandlerStub{
+			UpdateApiRoutesConfigsCalled: func(apiRoutesConfigs map[string]data.ApiRoutesConfig) error {
+				calls = append(calls, "routes "+getSecuredString(apiRoutesConfigs))
+				return nil
+			},
+		}
+		args.CredentialsHandler = &mock.CredentialsHandlerStub{
+			UpdateCalled: func(credentialsConfig config.CredentialsConfig) error {
+				calls = append(calls, "credentials "+credentialsConfig.Hasher.Type)
+				return expectedErr
+			},
+		}
+		cw, _ := configwatcher.NewConfigWatcher(args)
+		defer func() {
+			_ = cw.Close()
+		}()
+
+		err := cw.Reload()
+		require.ErrorIs(t, err, expectedErr)
+		require.Equal(t, []string{
+			"observers http://observer1",
+			"routes secured",
+			"credentials blake2b",
+			"routes open",
+			"observers http://observer0",
+		}, calls)
+	})
+}
+
+func getSecuredString(apiRoutesConfigs map[string]data.ApiRoutesConfig) string {
+	if apiRoutesConfigs["v1.0"].APIPackages["address"].Routes[0].Secured {
+		return "secured"
+	}
+
+	return "open"
+}
+
+func TestConfigWatcher_ShouldReloadWhenAFileChanges(t *testing.T) {
+	t.Parallel()
+
+	filePath := filepath.Join(t.TempDir(), "config.toml")
+	writeTestFile(t, filePath, "initial")
+
+	numLoads := int32(0)
+	args := createMockArgsConfigWatcher()
+	args.CheckInterval = 10 * time.Millisecond
+	args.ConfigLoader = &mock.ConfigLoaderStub{
+		LoadCalled: func() (*configwatcher.ReloadableConfig, error) {
+			atomic.AddInt32(&numLoads, 1)
+			return createTestReloadableConfig("http://observer0"), nil
+		},
+		GetFilePathsCalled: func() []string {
+			return []string{filePath}
+		},
+	}
+	cw, _ := configwatcher.NewConfigWatcher(args)
+	defer func() {
+		_ = cw.Close()
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, int32(1), atomic.LoadInt32(&numLoads))
+
+	writeTestFile(t, filePath, "changed")
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&numLoads) == 2
+	}, time.Second, 10*time.Millisecond)
+}
+
+// the test is not parallel, as the signal is received by the whole process
+func TestConfigWatcher_ShouldReloadOnSIGHUP(t *testing.T) {
+	numLoads := int32(0)
+	args := createMockArgsConfigWatcher()
+	args.ConfigLoader = &mock.ConfigLoaderStub{
+		LoadCalled: func() (*configwatcher.ReloadableConfig, error) {
+			atomic.AddInt32(&numLoads, 1)
+			return createTestReloadableConfig("http://observer0"), nil
+		},
+	}
+	cw, _ := configwatcher.NewConfigWatcher(args)
+	defer func() {
+		_ = cw.Close()
+	}()
+
+	err := syscall.Kill(syscall.Getpid(), syscall.SIGHUP)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&numLoads) == 2
+	}, time.Second, 10*time.Millisecond)
+}
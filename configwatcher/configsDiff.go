@@ -0,0 +1,201 @@
+package configwatcher
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const defaultVersionName = "default"
+
+// configsDiff holds the human-readable changes between two reloadable configs, grouped by the component they apply to
+type configsDiff struct {
+	observers        []string
+	fullHistoryNodes []string
+	apiRoutes        []string
+	credentials      []string
+}
+
+func computeConfigsDiff(oldConfig *ReloadableConfig, newConfig *ReloadableConfig) *configsDiff {
+	return &configsDiff{
+		observers:        computeNodesDiff("observer", oldConfig.Observers, newConfig.Observers),
+		fullHistoryNodes: computeNodesDiff("full history node", oldConfig.FullHistoryNodes, newConfig.FullHistoryNodes),
+		apiRoutes:        computeApiRoutesDiff(oldConfig.ApiRoutesConfigs, newConfig.ApiRoutesConfigs),
+		credentials:      computeCredentialsDiff(oldConfig.Credentials, newConfig.Credentials),
+	}
+}
+
+func (diff *configsDiff) isEmpty() bool {
+	return len(diff.observers) == 0 && len(diff.fullHistoryNodes) == 0 && len(diff.apiRoutes) == 0 && len(diff.credentials) == 0
+}
+
+// String returns all the changes, separated by semicolons
+func (diff *configsDiff) String() string {
+	changes := make([]string, 0)
+	changes = append(changes, diff.observers...)
+	changes = append(changes, diff.fullHistoryNodes...)
+	changes = append(changes, diff.apiRoutes...)
+	changes = append(changes, diff.credentials...)
+
+	return strings.Join(changes, "; ")
+}
+
+func computeNodesDiff(nodeType string, oldNodes []*data.NodeData, newNodes []*data.NodeData) []string {
+	oldNodesMap := make(map[string]*data.NodeData, len(oldNodes))
+	for _, node := range oldNodes {
+		oldNodesMap[getNodeID(node)] = node
+	}
+	newNodesMap := make(map[string]*data.NodeData, len(newNodes))
+	for _, node := range newNodes {
+		newNodesMap[getNodeID(node)] = node
+	}
+
+	changes := make([]string, 0)
+	for _, node := range newNodes {
+		nodeID := getNodeID(node)
+		oldNode, found := oldNodesMap[nodeID]
+		if !found {
+			changes = append(changes, fmt.Sprintf("%s added: %s", nodeType, nodeID))
+			continue
+		}
+
+		if oldNode.IsFallback != node.IsFallback {
+			changes = append(changes, fmt.Sprintf("%s %s changed: IsFallback %t -> %t", nodeType, nodeID, oldNode.IsFallback, node.IsFallback))
+		}
+		if oldNode.IsSnapshotless != node.IsSnapshotless {
+			changes = append(changes, fmt.Sprintf("%s %s changed: IsSnapshotless %t -> %t", nodeType, nodeID, oldNode.IsSnapshotless, node.IsSnapshotless))
+		}
+	}
+	for _, node := range oldNodes {
+		nodeID := getNodeID(node)
+		_, found := newNodesMap[nodeID]
+		if !found {
+			changes = append(changes, fmt.Sprintf("%s removed: %s", nodeType, nodeID))
+		}
+	}
+
+	// the order of the nodes decides the order in which they are used
+	if len(changes) == 0 && !haveSameOrder(oldNodes, newNodes) {
+		changes = append(changes, fmt.Sprintf("%ss reordered", nodeType))
+	}
+
+	return changes
+}
+
+func getNodeID(node *data.NodeData) string {
+	return fmt.Sprintf("%s (shard %s)", node.Address, core.GetShardIDString(node.ShardId))
+}
+
+func haveSameOrder(oldNodes []*data.NodeData, newNodes []*data.NodeData) bool {
+	for idx := range newNodes {
+		if getNodeID(oldNodes[idx]) != getNodeID(newNodes[idx]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func computeApiRoutesDiff(oldConfigs map[string]data.ApiRoutesConfig, newConfigs map[string]data.ApiRoutesConfig) []string {
+	changes := make([]string, 0)
+	for _, version := range getSortedKeys(newConfigs) {
+		oldRoutes := getRoutesMap(oldConfigs[version])
+		newRoutes := getRoutesMap(newConfigs[version])
+
+		for _, routePath := range getSortedKeys(newRoutes) {
+			newRoute := newRoutes[routePath]
+			oldRoute, found := oldRoutes[routePath]
+			if !found {
+				changes = append(changes, fmt.Sprintf("route %s %s added: %s", getVersionName(version), routePath, describeRoute(newRoute)))
+				continue
+			}
+			if oldRoute != newRoute {
+				changes = append(changes, fmt.Sprintf("route %s %s changed: %s -> %s", getVersionName(version), routePath, describeRoute(oldRoute), describeRoute(newRoute)))
+			}
+		}
+		for _, routePath := range getSortedKeys(oldRoutes) {
+			_, found := newRoutes[routePath]
+			if !found {
+				changes = append(changes, fmt.Sprintf("route %s %s removed", getVersionName(version), routePath))
+			}
+		}
+	}
+
+	return changes
+}
+
+// getRoutesMap returns the routes of all packages, mapped by their path
+func getRoutesMap(apiRoutesConfig data.ApiRoutesConfig) map[string]data.RouteConfig {
+	routes := make(map[string]data.RouteConfig)
+	for packageName, packageConfig := range apiRoutesConfig.APIPackages {
+		for _, route := range packageConfig.Routes {
+			routes["/"+packageName+route.Name] = route
+		}
+	}
+
+	return routes
+}
+
+func describeRoute(route data.RouteConfig) string {
+	return fmt.Sprintf("Open %t, Secured %t, RateLimit %d, TimeoutSec %d", route.Open, route.Secured, route.RateLimit, route.TimeoutSec)
+}
+
+func getVersionName(version string) string {
+	if len(version) == 0 {
+		return defaultVersionName
+	}
+
+	return version
+}
+
+func computeCredentialsDiff(oldConfig config.CredentialsConfig, newConfig config.CredentialsConfig) []string {
+	changes := make([]string, 0)
+	if oldConfig.Hasher.Type != newConfig.Hasher.Type {
+		changes = append(changes, fmt.Sprintf("credentials hasher changed: %s -> %s", oldConfig.Hasher.Type, newConfig.Hasher.Type))
+	}
+
+	// the passwords are never written to the logs
+	oldPasswords := getPasswordsMap(oldConfig)
+	newPasswords := getPasswordsMap(newConfig)
+	for _, username := range getSortedKeys(newPasswords) {
+		oldPassword, found := oldPasswords[username]
+		if !found {
+			changes = append(changes, fmt.Sprintf("credentials user added: %s", username))
+			continue
+		}
+		if oldPassword != newPasswords[username] {
+			changes = append(changes, fmt.Sprintf("credentials password changed for user %s", username))
+		}
+	}
+	for _, username := range getSortedKeys(oldPasswords) {
+		_, found := newPasswords[username]
+		if !found {
+			changes = append(changes, fmt.Sprintf("credentials user removed: %s", username))
+		}
+	}
+
+	return changes
+}
+
+func getPasswordsMap(credentialsConfig config.CredentialsConfig) map[string]string {
+	passwords := make(map[string]string, len(credentialsConfig.Credentials))
+	for _, credential := range credentialsConfig.Credentials {
+		passwords[credential.Username] = credential.Password
+	}
+
+	return passwords
+}
+
+func getSortedKeys[T any](values map[string]T) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,120 @@
+package configwatcher
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestReloadableConfig() *ReloadableConfig {
+	return &ReloadableConfig{
+		Observers: []*data.NodeData{
+			{ShardId: 0, Address: "http://observer0"},
+			{ShardId: 4294967295, Address: "http://observer-meta"},
+		},
+		ApiRoutesConfigs: map[string]data.ApiRoutesConfig{
+			"v1.0": {APIPackages: map[string]data.APIPackageConfig{
+				"address": {Routes: []data.RouteConfig{
+					{Name: "/:address", Open: true},
+				}},
+			}},
+		},
+		Credentials: config.CredentialsConfig{
+			Credentials: []data.Credential{{Username: "user", Password: "hash"}},
+			Hasher:      config.TypeConfig{Type: "sha256"},
+		},
+	}
+}
+
+func TestComputeConfigsDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("same config should not have changes", func(t *testing.T) {
+		t.Parallel()
+
+		diff := computeConfigsDiff(createTestReloadableConfig(), createTestReloadableConfig())
+		require.True(t, diff.isEmpty())
+		require.Empty(t, diff.String())
+	})
+	t.Run("nodes changes", func(t *testing.T) {
+		t.Parallel()
+
+		newConfig := createTestReloadableConfig()
+		newConfig.Observers = []*data.NodeData{
+			{ShardId: 4294967295, Address: "http://observer-meta", IsFallback: true, IsSnapshotless: true},
+			{ShardId: 1, Address: "http://observer1"},
+		}
+		newConfig.FullHistoryNodes = []*data.NodeData{
+			{ShardId: 0, Address: "http://full-history0"},
+		}
+
+		diff := computeConfigsDiff(createTestReloadableConfig(), newConfig)
+		require.Equal(t, []string{
+			"observer http://observer-meta (shard metachain) changed: IsFallback false -> true",
+			"observer http://observer-meta (shard metachain) changed: IsSnapshotless false -> true",
+			"observer added: http://observer1 (shard 1)",
+			"observer removed: http://observer0 (shard 0)",
+		}, diff.observers)
+		require.Equal(t, []string{"full history node added: http://full-history0 (shard 0)"}, diff.fullHistoryNodes)
+		require.Empty(t, diff.apiRoutes)
+		require.Empty(t, diff.credentials)
+	})
+	t.Run("reordered nodes", func(t *testing.T) {
+		t.Parallel()
+
+		newConfig := createTestReloadableConfig()
+		newConfig.Observers[0], newConfig.Observers[1] = newConfig.Observers[1], newConfig.Observers[0]
+
+		diff := computeConfigsDiff(createTestReloadableConfig(), newConfig)
+		require.Equal(t, []string{"observers reordered"}, diff.observers)
+	})
+	t.Run("API routes changes", func(t *testing.T) {
+		t.Parallel()
+
+		newConfig := createTestReloadableConfig()
+		newConfig.ApiRoutesConfigs["v1.0"] = data.ApiRoutesConfig{APIPackages: map[string]data.APIPackageConfig{
+			"address": {Routes: []data.RouteConfig{
+				{Name: "/:address", Open: true, Secured: true, RateLimit: 10},
+			}},
+			"block": {Routes: []data.RouteConfig{
+				{Name: "/:shard/by-nonce/:nonce", Open: true},
+			}},
+		}}
+		newConfig.ApiRoutesConfigs[""] = data.ApiRoutesConfig{}
+
+		diff := computeConfigsDiff(createTestReloadableConfig(), newConfig)
+		require.Equal(t, []string{
+			"route v1.0 /address/:address changed: Open true, Secured false, RateLimit 0, TimeoutSec 0 -> Open true, Secured true, RateLimit 10, TimeoutSec 0",
+			"route v1.0 /block/:shard/by-nonce/:nonce added: Open true, Secured false, RateLimit 0, TimeoutSec 0",
+		}, diff.apiRoutes)
+
+		diff = computeConfigsDiff(newConfig, createTestReloadableConfig())
+		require.Contains(t, diff.apiRoutes, "route v1.0 /block/:shard/by-nonce/:nonce removed")
+	})
+	t.Run("credentials changes should not show the passwords", func(t *testing.T) {
+		t.Parallel()
+
+		newConfig := createTestReloadableConfig()
+		newConfig.Credentials = config.CredentialsConfig{
+			Credentials: []data.Credential{
+				{Username: "user", Password: "new hash"},
+				{Username: "admin", Password: "admin hash"},
+			},
+			Hasher: config.TypeConfig{Type: "blake2b"},
+		}
+
+		diff := computeConfigsDiff(createTestReloadableConfig(), newConfig)
+		require.Equal(t, []string{
+			"credentials hasher changed: sha256 -> blake2b",
+			"credentials user added: admin",
+			"credentials password changed for user user",
+		}, diff.credentials)
+		require.NotContains(t, diff.String(), "hash\"")
+		require.NotContains(t, diff.String(), "admin hash")
+
+		diff = computeConfigsDiff(newConfig, createTestReloadableConfig())
+		require.Contains(t, diff.credentials, "credentials user removed: admin")
+	})
+}
@@ -0,0 +1,27 @@
+package configwatcher
+
+import "errors"
+
+// ErrNilConfigLoader signals that a nil config loader has been provided
+var ErrNilConfigLoader = errors.New("nil config loader")
+
+// ErrNilNodesHandler signals that a nil nodes handler has been provided
+var ErrNilNodesHandler = errors.New("nil nodes handler")
+
+// ErrNilApiRoutesHandler signals that a nil API routes handler has been provided
+var ErrNilApiRoutesHandler = errors.New("nil API routes handler")
+
+// ErrNilCredentialsHandler signals that a nil credentials handler has been provided
+var ErrNilCredentialsHandler = errors.New("nil credentials handler")
+
+// ErrInvalidCheckInterval signals that an invalid interval between two checks of the config files has been provided
+var ErrInvalidCheckInterval = errors.New("invalid check interval")
+
+// ErrEmptyFilePath signals that an empty config file path has been provided
+var ErrEmptyFilePath = errors.New("empty config file path")
+
+// ErrNoApiConfigNames signals that no API routes config names have been provided
+var ErrNoApiConfigNames = errors.New("no API routes config names")
+
+// ErrDuplicatedRoute signals that an API routes config contains the same route more times
+var ErrDuplicatedRoute = errors.New("duplicated route")
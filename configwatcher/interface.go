@@ -0,0 +1,32 @@
+package configwatcher
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ConfigLoader defines what a component able to load and validate the reloadable settings should do
+type ConfigLoader interface {
+	Load() (*ReloadableConfig, error)
+	GetFilePaths() []string
+	IsInterfaceNil() bool
+}
+
+// NodesHandler defines what a component able to replace the observers and the full history nodes should do
+type NodesHandler interface {
+	SetObservers(nodes []*data.NodeData) error
+	SetFullHistoryNodes(nodes []*data.NodeData) error
+	IsInterfaceNil() bool
+}
+
+// ApiRoutesHandler defines what a component able to replace the routes settings of the API versions should do
+type ApiRoutesHandler interface {
+	UpdateApiRoutesConfigs(apiRoutesConfigs map[string]data.ApiRoutesConfig) error
+	IsInterfaceNil() bool
+}
+
+// CredentialsHandler defines what a component able to replace the credentials of the secured endpoints should do
+type CredentialsHandler interface {
+	Update(credentialsConfig config.CredentialsConfig) error
+	IsInterfaceNil() bool
+}
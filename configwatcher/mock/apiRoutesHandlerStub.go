@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// ApiRoutesHandlerStub -
+type ApiRoutesHandlerStub struct {
+	UpdateApiRoutesConfigsCalled func(apiRoutesConfigs map[string]data.ApiRoutesConfig) error
+}
+
+// UpdateApiRoutesConfigs -
+func (stub *ApiRoutesHandlerStub) UpdateApiRoutesConfigs(apiRoutesConfigs map[string]data.ApiRoutesConfig) error {
+	if stub.UpdateApiRoutesConfigsCalled != nil {
+		return stub.UpdateApiRoutesConfigsCalled(apiRoutesConfigs)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *ApiRoutesHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
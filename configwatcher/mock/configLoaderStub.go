@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/configwatcher"
+
+// ConfigLoaderStub -
+type ConfigLoaderStub struct {
+	LoadCalled         func() (*configwatcher.ReloadableConfig, error)
+	GetFilePathsCalled func() []string
+}
+
+// Load -
+func (stub *ConfigLoaderStub) Load() (*configwatcher.ReloadableConfig, error) {
+	if stub.LoadCalled != nil {
+		return stub.LoadCalled()
+	}
+
+	return &configwatcher.ReloadableConfig{}, nil
+}
+
+// GetFilePaths -
+func (stub *ConfigLoaderStub) GetFilePaths() []string {
+	if stub.GetFilePathsCalled != nil {
+		return stub.GetFilePathsCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *ConfigLoaderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/config"
+
+// CredentialsHandlerStub -
+type CredentialsHandlerStub struct {
+	UpdateCalled func(credentialsConfig config.CredentialsConfig) error
+}
+
+// Update -
+func (stub *CredentialsHandlerStub) Update(credentialsConfig config.CredentialsConfig) error {
+	if stub.UpdateCalled != nil {
+		return stub.UpdateCalled(credentialsConfig)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *CredentialsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
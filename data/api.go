@@ -1,6 +1,7 @@
 package data
 
 import (
+	"context"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -8,9 +9,10 @@ import (
 
 // GenericAPIResponse defines the structure of all responses on API endpoints
 type GenericAPIResponse struct {
-	Data  interface{} `json:"data"`
-	Error string      `json:"error"`
-	Code  ReturnCode  `json:"code"`
+	Data      interface{} `json:"data"`
+	Error     string      `json:"error"`
+	Code      ReturnCode  `json:"code"`
+	RequestID string      `json:"requestId,omitempty"`
 }
 
 // NetworkConfig is a dto that will keep information about the network config
@@ -19,6 +21,8 @@ type NetworkConfig struct {
 		ChainID               string `json:"erd_chain_id"`
 		MinGasLimit           uint64 `json:"erd_min_gas_limit"`
 		MinGasPrice           uint64 `json:"erd_min_gas_price"`
+		GasPerDataByte        uint64 `json:"erd_gas_per_data_byte"`
+		GasPriceModifier      string `json:"erd_gas_price_modifier"`
 		MinTransactionVersion uint32 `json:"erd_min_transaction_version"`
 	} `json:"config"`
 }
@@ -35,6 +39,18 @@ const (
 
 	// ReturnCodeRequestError defines a request which hasn't been executed successfully due to a bad request received
 	ReturnCodeRequestError ReturnCode = "bad_request"
+
+	// ReturnCodeInvalidSignature defines a transaction rejected because its signature does not match its sender
+	ReturnCodeInvalidSignature ReturnCode = "invalid_signature"
+
+	// ReturnCodeNonceTooLow defines a transaction rejected because its nonce was already used by its sender
+	ReturnCodeNonceTooLow ReturnCode = "nonce_too_low"
+
+	// ReturnCodeNonceTooHigh defines a transaction rejected because its nonce is too far ahead of the sender's nonce
+	ReturnCodeNonceTooHigh ReturnCode = "nonce_too_high"
+
+	// ReturnCodeInsufficientBalance defines a transaction rejected because its sender cannot afford it
+	ReturnCodeInsufficientBalance ReturnCode = "insufficient_balance"
 )
 
 // VersionData holds the components specific for each version
@@ -85,10 +101,30 @@ type VersionsRegistryHandler interface {
 type StatusMetricsProvider interface {
 	GetAll() map[string]*EndpointMetrics
 	GetMetricsForPrometheus() string
-	AddRequestData(path string, withError bool, duration time.Duration)
+	AddRequestData(path string, statusCode int, duration time.Duration)
+	IncrementInFlightRequests(path string)
+	DecrementInFlightRequests(path string)
+	AddObserverRequestData(address string, shardID uint32, duration time.Duration, isSuccessful bool, isTimeout bool)
+	SetObserverSyncState(address string, shardID uint32, isSynced bool)
+	AddCacheRequestData(cacheName string, isHit bool)
+	GetCacheMetrics() map[string]*CacheMetrics
+	AddCoalescingRequestData(operation string, isCoalesced bool)
+	GetCoalescingMetrics() map[string]*CoalescingMetrics
 	IsInterfaceNil() bool
 }
 
+// HyperblockSubscriptionHandler defines what a subscription to the live hyperblocks stream should do
+type HyperblockSubscriptionHandler interface {
+	Next(ctx context.Context) (*HyperblockApiResponse, error)
+	Close()
+}
+
+// TransactionStatusSubscriptionHandler defines what a subscription to the status transitions of a transaction should do
+type TransactionStatusSubscriptionHandler interface {
+	Next(ctx context.Context) (*TransactionStatusEvent, error)
+	Close()
+}
+
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
 	APIPackages map[string]APIPackageConfig
@@ -101,10 +137,11 @@ type APIPackageConfig struct {
 
 // RouteConfig holds the configuration for a single route
 type RouteConfig struct {
-	Name      string
-	Open      bool
-	Secured   bool
-	RateLimit uint64
+	Name       string
+	Open       bool
+	Secured    bool
+	RateLimit  uint64
+	TimeoutSec int
 }
 
 // Credential holds an username and a password
@@ -0,0 +1,15 @@
+package data
+
+import "encoding/json"
+
+// BatchRequest holds the relative API paths to be fetched in a single call, e.g. /address/erd1.../esdt
+type BatchRequest struct {
+	Paths []string `json:"paths"`
+}
+
+// BatchResponseItem holds the response of one of the paths of a batch request
+type BatchResponseItem struct {
+	Path   string          `json:"path"`
+	Status int             `json:"status"`
+	Body   json.RawMessage `json:"body"`
+}
@@ -4,3 +4,7 @@ import "github.com/multiversx/mx-chain-core-go/data/transaction"
 
 // TxStatusUnknown defines the response that should be received from an observer when transaction status is unknown
 const TxStatusUnknown transaction.TxStatus = "unknown"
+
+// TxStatusPartiallyExecuted defines the status of a transaction which was executed in its source shard, but whose
+// execution is still pending in the destination shard or in the shards of its smart contract results
+const TxStatusPartiallyExecuted transaction.TxStatus = "partially-executed"
@@ -21,3 +21,17 @@ func (dt *DatabaseTransaction) CalculateFee() string {
 
 	return fee.String()
 }
+
+// DatabaseBlock extends indexer.Block with the 'hash' field and the transactions included in the block
+type DatabaseBlock struct {
+	Hash string `json:"hash"`
+	data.Block
+	Transactions []DatabaseTransaction `json:"transactions"`
+}
+
+// DatabaseTransactionsPage holds a page of transactions fetched from the database along with the cursor that can be
+// used for fetching the next page. The cursor is empty when there are no more transactions
+type DatabaseTransactionsPage struct {
+	Transactions []DatabaseTransaction `json:"transactions"`
+	NextCursor   string                `json:"nextCursor"`
+}
@@ -0,0 +1,21 @@
+package data
+
+import "math/big"
+
+// EGLDTokenIdentifier is the identifier used for the EGLD amounts of the faucet payouts
+const EGLDTokenIdentifier = "EGLD"
+
+// FaucetToken holds an ESDT token dispensed by the faucet, alongside EGLD
+type FaucetToken struct {
+	Identifier string
+	Value      *big.Int
+}
+
+// FaucetPayout holds the funds sent by the faucet to a receiver, as recorded in the payouts ledger
+type FaucetPayout struct {
+	Receiver  string              `json:"receiver"`
+	ClientIP  string              `json:"clientIP,omitempty"`
+	Amounts   map[string]*big.Int `json:"amounts"`
+	TxHashes  []string            `json:"txHashes,omitempty"`
+	Timestamp int64               `json:"timestamp"`
+}
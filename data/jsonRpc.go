@@ -0,0 +1,62 @@
+package data
+
+import "encoding/json"
+
+// JsonRpcVersion is the version of the JSON-RPC protocol served by the proxy
+const JsonRpcVersion = "2.0"
+
+const (
+	// JsonRpcParseErrorCode signals that the request body is not a valid JSON
+	JsonRpcParseErrorCode = -32700
+
+	// JsonRpcInvalidRequestCode signals that the request is not a valid JSON-RPC request object
+	JsonRpcInvalidRequestCode = -32600
+
+	// JsonRpcMethodNotFoundCode signals that the method does not exist or its route is not opened
+	JsonRpcMethodNotFoundCode = -32601
+
+	// JsonRpcInvalidParamsCode signals that the parameters of the method are invalid
+	JsonRpcInvalidParamsCode = -32602
+
+	// JsonRpcInternalErrorCode signals an internal error of the JSON-RPC gateway
+	JsonRpcInternalErrorCode = -32603
+
+	// JsonRpcServerErrorCode signals that the request was not executed successfully by the proxy
+	JsonRpcServerErrorCode = -32000
+
+	// JsonRpcUnauthorizedCode signals that the route of the method requires authentication
+	JsonRpcUnauthorizedCode = -32001
+
+	// JsonRpcRateLimitedCode signals that the rate limit of the route of the method was exceeded
+	JsonRpcRateLimitedCode = -32005
+)
+
+// JsonRpcRequest represents a JSON-RPC 2.0 request object. A request without an ID is a notification
+type JsonRpcRequest struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+}
+
+// JsonRpcResponse represents a JSON-RPC 2.0 response object
+type JsonRpcResponse struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *JsonRpcError   `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// JsonRpcError represents a JSON-RPC 2.0 error object
+type JsonRpcError struct {
+	Code    int                  `json:"code"`
+	Message string               `json:"message"`
+	Data    *JsonRpcErrorDetails `json:"data,omitempty"`
+}
+
+// JsonRpcErrorDetails holds the details of an error returned by the REST route of a JSON-RPC method
+type JsonRpcErrorDetails struct {
+	HttpStatus    int        `json:"httpStatus"`
+	ReturnCode    ReturnCode `json:"returnCode,omitempty"`
+	RetryAfterSec string     `json:"retryAfterSec,omitempty"`
+}
@@ -10,3 +10,15 @@ type EndpointMetrics struct {
 	LowestResponseTime  time.Duration `json:"lowest_response_time"`
 	HighestResponseTime time.Duration `json:"highest_response_time"`
 }
+
+// CacheMetrics holds statistics about the requests for a specific cache
+type CacheMetrics struct {
+	NumHits   uint64 `json:"num_hits"`
+	NumMisses uint64 `json:"num_misses"`
+}
+
+// CoalescingMetrics holds statistics about the requests of a specific operation that can be coalesced
+type CoalescingMetrics struct {
+	NumSent      uint64 `json:"num_sent"`
+	NumCoalesced uint64 `json:"num_coalesced"`
+}
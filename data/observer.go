@@ -37,3 +37,75 @@ const (
 	// AvailabilityRecent means that the observer can be used only for recent data
 	AvailabilityRecent ObserverDataAvailabilityType = "recent"
 )
+
+// CircuitBreakerState represents the state of the circuit breaker of a node
+type CircuitBreakerState string
+
+const (
+	// CircuitBreakerClosed means that the node is healthy and the requests are sent to it
+	CircuitBreakerClosed CircuitBreakerState = "closed"
+
+	// CircuitBreakerOpen means that the node failed too many times and it is skipped until the cool-down period passes
+	CircuitBreakerOpen CircuitBreakerState = "open"
+
+	// CircuitBreakerHalfOpen means that the cool-down period passed and a probe request is allowed to reach the node
+	CircuitBreakerHalfOpen CircuitBreakerState = "half-open"
+
+	// CircuitBreakerDisabled means that the circuit breaker is not enabled
+	CircuitBreakerDisabled CircuitBreakerState = "disabled"
+)
+
+// NodeCircuitBreakerStatus holds the circuit breaker details of a node
+type NodeCircuitBreakerStatus struct {
+	Address             string              `json:"address"`
+	ShardId             uint32              `json:"shard"`
+	IsSynced            bool                `json:"isSynced"`
+	IsFallback          bool                `json:"isFallback"`
+	IsSnapshotless      bool                `json:"isSnapshotless"`
+	State               CircuitBreakerState `json:"state"`
+	ConsecutiveFailures uint32              `json:"consecutiveFailures"`
+}
+
+// NodesCircuitBreakerStatus holds the circuit breaker details of both the observers and the full history nodes
+type NodesCircuitBreakerStatus struct {
+	Observers        []*NodeCircuitBreakerStatus `json:"observers"`
+	FullHistoryNodes []*NodeCircuitBreakerStatus `json:"fullHistoryNodes"`
+}
+
+// HostConnectionsMetrics holds the connections metrics of the http client towards a node
+type HostConnectionsMetrics struct {
+	OpenConnections   int64  `json:"openConnections"`
+	DialedConnections uint64 `json:"dialedConnections"`
+	DialErrors        uint64 `json:"dialErrors"`
+	ReusedConnections uint64 `json:"reusedConnections"`
+	InFlightRequests  int64  `json:"inFlightRequests"`
+}
+
+// ConnectionPoolMetrics holds the connections metrics of the http client used for the requests sent to the nodes
+type ConnectionPoolMetrics struct {
+	Total HostConnectionsMetrics             `json:"total"`
+	Hosts map[string]*HostConnectionsMetrics `json:"hosts"`
+}
+
+// NodesOverlay holds the changes made at runtime over the nodes from the config file: the added nodes, the addresses of
+// the removed nodes and the addresses of the drained nodes, which do not receive new requests
+type NodesOverlay struct {
+	Added   []*NodeData
+	Removed []string
+	Drained []string
+}
+
+// NodesOverlays holds the runtime changes of both the observers and the full history nodes
+type NodesOverlays struct {
+	Observers        NodesOverlay
+	FullHistoryNodes NodesOverlay
+}
+
+// NodeAdminRequest holds the node to be added, removed, drained or undrained through the admin routes. Only the
+// address is needed, except for adding a node
+type NodeAdminRequest struct {
+	ShardId        uint32 `json:"shardId"`
+	Address        string `json:"address"`
+	IsFallback     bool   `json:"isFallback"`
+	IsSnapshotless bool   `json:"isSnapshotless"`
+}
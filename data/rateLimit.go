@@ -0,0 +1,11 @@
+package data
+
+// RateLimitState holds the state of the rate limiter for a client on an endpoint. The token bucket algorithm uses the
+// Tokens and LastUpdateNano fields, while the sliding window algorithm uses the window fields
+type RateLimitState struct {
+	Tokens          float64 `json:"tokens"`
+	LastUpdateNano  int64   `json:"lastUpdateNano"`
+	WindowStartNano int64   `json:"windowStartNano"`
+	CurrentCount    uint64  `json:"currentCount"`
+	PreviousCount   uint64  `json:"previousCount"`
+}
@@ -155,8 +155,34 @@ type ResponseTransactionSimulationCrossShard struct {
 
 // MultipleTransactionsResponseData holds the data which is returned when sending a bulk of transactions
 type MultipleTransactionsResponseData struct {
-	NumOfTxs  uint64         `json:"txsSent"`
-	TxsHashes map[int]string `json:"txsHashes"`
+	NumOfTxs   uint64                   `json:"txsSent"`
+	TxsHashes  map[int]string           `json:"txsHashes"`
+	TxsResults []*TransactionSendResult `json:"txsResults,omitempty"`
+}
+
+const (
+	// TxSendErrorValidation signals that a transaction of a bulk did not pass the validation of its fields
+	TxSendErrorValidation = "validation"
+
+	// TxSendErrorShardUnavailable signals that no observer of the sender shard could be reached
+	TxSendErrorShardUnavailable = "shard-unavailable"
+
+	// TxSendErrorObserverRejected signals that the observer refused a transaction of a bulk
+	TxSendErrorObserverRejected = "observer-rejected"
+)
+
+// TransactionSendError holds the reason for which a transaction of a bulk was not sent
+type TransactionSendError struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+// TransactionSendResult holds the result of sending a transaction of a bulk: either its hash or the reason it was
+// not sent
+type TransactionSendResult struct {
+	Index  int                   `json:"index"`
+	TxHash string                `json:"txHash,omitempty"`
+	Error  *TransactionSendError `json:"error,omitempty"`
 }
 
 // ResponseMultipleTransactions defines a response from the node holding the number of transactions sent to the chain
@@ -287,3 +313,11 @@ type ProcessStatusResponse struct {
 	Status string `json:"status"`
 	Reason string `json:"reason"`
 }
+
+// TransactionStatusEvent represents a transition of the process status of a transaction
+type TransactionStatusEvent struct {
+	TxHash  string `json:"txHash"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	IsFinal bool   `json:"isFinal"`
+}
@@ -0,0 +1,36 @@
+package discovery
+
+import "errors"
+
+// ErrNilResolver signals that a nil DNS resolver has been provided
+var ErrNilResolver = errors.New("nil DNS resolver")
+
+// ErrNilNodesResolver signals that a nil nodes resolver has been provided
+var ErrNilNodesResolver = errors.New("nil nodes resolver")
+
+// ErrNilNodesHandler signals that a nil nodes handler has been provided
+var ErrNilNodesHandler = errors.New("nil nodes handler")
+
+// ErrEmptyRecordName signals that a DNS record without name has been provided
+var ErrEmptyRecordName = errors.New("empty record name")
+
+// ErrInvalidRecordType signals that a DNS record with an unsupported type has been provided
+var ErrInvalidRecordType = errors.New("invalid record type, SRV or A expected")
+
+// ErrInvalidRecordPort signals that an A record without a valid port has been provided
+var ErrInvalidRecordPort = errors.New("invalid record port")
+
+// ErrInvalidRecordScheme signals that a DNS record with an unsupported URL scheme has been provided
+var ErrInvalidRecordScheme = errors.New("invalid record scheme, http or https expected")
+
+// ErrInvalidInterval signals that an invalid interval between two discoveries has been provided
+var ErrInvalidInterval = errors.New("invalid discovery interval")
+
+// ErrInvalidTimeout signals that an invalid timeout of the DNS resolution has been provided
+var ErrInvalidTimeout = errors.New("invalid discovery timeout")
+
+// ErrDiscoveryNotStarted signals that the nodes cannot be set before starting the discovery
+var ErrDiscoveryNotStarted = errors.New("the nodes discovery is not started")
+
+// ErrDiscoveryAlreadyStarted signals that the nodes discovery has already been started
+var ErrDiscoveryAlreadyStarted = errors.New("the nodes discovery is already started")
@@ -0,0 +1,27 @@
+package discovery
+
+import (
+	"context"
+	"net"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// Resolver defines what a DNS resolver should do. It is implemented by *net.Resolver
+type Resolver interface {
+	LookupSRV(ctx context.Context, service string, proto string, name string) (string, []*net.SRV, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// NodesResolver defines what a component able to resolve the nodes from DNS records should do
+type NodesResolver interface {
+	ResolveNodes(ctx context.Context) ([]*data.NodeData, error)
+	IsInterfaceNil() bool
+}
+
+// NodesHandler defines what a component able to replace the observers and the full history nodes should do
+type NodesHandler interface {
+	SetObservers(nodes []*data.NodeData) error
+	SetFullHistoryNodes(nodes []*data.NodeData) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// NodesHandlerStub -
+type NodesHandlerStub struct {
+	SetObserversCalled        func(nodes []*data.NodeData) error
+	SetFullHistoryNodesCalled func(nodes []*data.NodeData) error
+}
+
+// SetObservers -
+func (stub *NodesHandlerStub) SetObservers(nodes []*data.NodeData) error {
+	if stub.SetObserversCalled != nil {
+		return stub.SetObserversCalled(nodes)
+	}
+
+	return nil
+}
+
+// SetFullHistoryNodes -
+func (stub *NodesHandlerStub) SetFullHistoryNodes(nodes []*data.NodeData) error {
+	if stub.SetFullHistoryNodesCalled != nil {
+		return stub.SetFullHistoryNodesCalled(nodes)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *NodesHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// NodesResolverStub -
+type NodesResolverStub struct {
+	ResolveNodesCalled func(ctx context.Context) ([]*data.NodeData, error)
+}
+
+// ResolveNodes -
+func (stub *NodesResolverStub) ResolveNodes(ctx context.Context) ([]*data.NodeData, error) {
+	if stub.ResolveNodesCalled != nil {
+		return stub.ResolveNodesCalled(ctx)
+	}
+
+	return make([]*data.NodeData, 0), nil
+}
+
+// IsInterfaceNil -
+func (stub *NodesResolverStub) IsInterfaceNil() bool {
+	return stub == nil
+}
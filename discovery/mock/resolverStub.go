@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"context"
+	"net"
+)
+
+// ResolverStub -
+type ResolverStub struct {
+	LookupSRVCalled  func(ctx context.Context, service string, proto string, name string) (string, []*net.SRV, error)
+	LookupHostCalled func(ctx context.Context, host string) ([]string, error)
+}
+
+// LookupSRV -
+func (stub *ResolverStub) LookupSRV(ctx context.Context, service string, proto string, name string) (string, []*net.SRV, error) {
+	if stub.LookupSRVCalled != nil {
+		return stub.LookupSRVCalled(ctx, service, proto, name)
+	}
+
+	return "", nil, nil
+}
+
+// LookupHost -
+func (stub *ResolverStub) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if stub.LookupHostCalled != nil {
+		return stub.LookupHostCalled(ctx, host)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,315 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("discovery")
+
+// ArgsNodesDiscoverer holds the arguments needed for creating a new nodes discoverer
+type ArgsNodesDiscoverer struct {
+	ObserversResolver        NodesResolver
+	FullHistoryNodesResolver NodesResolver
+	StaticObservers          []*data.NodeData
+	StaticFullHistoryNodes   []*data.NodeData
+	Interval                 time.Duration
+	Timeout                  time.Duration
+}
+
+// discoveredNodes holds the static and the discovered nodes of a type
+type discoveredNodes struct {
+	name        string
+	resolver    NodesResolver
+	staticNodes []*data.NodeData
+	nodes       []*data.NodeData
+	setNodes    func(nodes []*data.NodeData) error
+}
+
+// NodesDiscoverer periodically resolves the DNS records of the observers and of the full history nodes and replaces
+// the nodes whenever the resolved ones change. The discovered nodes are used together with the static ones
+type NodesDiscoverer struct {
+	mut              sync.Mutex
+	observers        *discoveredNodes
+	fullHistoryNodes *discoveredNodes
+	nodesHandler     NodesHandler
+	interval         time.Duration
+	timeout          time.Duration
+	cancelFunc       context.CancelFunc
+}
+
+// NewNodesDiscoverer returns a new instance of NodesDiscoverer. The records are resolved once, so the discovered nodes
+// are available from the start. If the records cannot be resolved, only the static nodes are used until a later
+// discovery succeeds. The periodical discovery begins only when calling StartDiscovery
+func NewNodesDiscoverer(args ArgsNodesDiscoverer) (*NodesDiscoverer, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	nd := &NodesDiscoverer{
+		observers: &discoveredNodes{
+			name:        "observers",
+			resolver:    args.ObserversResolver,
+			staticNodes: args.StaticObservers,
+		},
+		fullHistoryNodes: &discoveredNodes{
+			name:        "full history nodes",
+			resolver:    args.FullHistoryNodesResolver,
+			staticNodes: args.StaticFullHistoryNodes,
+		},
+		interval: args.Interval,
+		timeout:  args.Timeout,
+	}
+
+	for _, nodes := range nd.getAllDiscoveredNodes() {
+		nodes.nodes, err = nd.resolveNodes(nodes.resolver)
+		if err != nil {
+			log.Error("initial nodes discovery failed, starting with the static nodes", "type", nodes.name,
+				"static nodes", getNodesDescription(nodes.staticNodes), "retry interval", nd.interval, "error", err.Error())
+			nodes.nodes = nil
+			continue
+		}
+
+		log.Info("nodes discovered", "type", nodes.name, "nodes", getNodesDescription(nodes.nodes))
+	}
+
+	return nd, nil
+}
+
+func checkArgs(args ArgsNodesDiscoverer) error {
+	if check.IfNil(args.ObserversResolver) {
+		return fmt.Errorf("%w for observers", ErrNilNodesResolver)
+	}
+	if check.IfNil(args.FullHistoryNodesResolver) {
+		return fmt.Errorf("%w for full history nodes", ErrNilNodesResolver)
+	}
+	if args.Interval <= 0 {
+		return ErrInvalidInterval
+	}
+	if args.Timeout <= 0 {
+		return ErrInvalidTimeout
+	}
+
+	return nil
+}
+
+func (nd *NodesDiscoverer) getAllDiscoveredNodes() []*discoveredNodes {
+	return []*discoveredNodes{nd.observers, nd.fullHistoryNodes}
+}
+
+func (nd *NodesDiscoverer) resolveNodes(resolver NodesResolver) ([]*data.NodeData, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), nd.timeout)
+	defer cancel()
+
+	return resolver.ResolveNodes(ctx)
+}
+
+// GetObservers returns the static observers together with the discovered ones
+func (nd *NodesDiscoverer) GetObservers() []*data.NodeData {
+	nd.mut.Lock()
+	defer nd.mut.Unlock()
+
+	return mergeNodes(nd.observers.staticNodes, nd.observers.nodes)
+}
+
+// GetFullHistoryNodes returns the static full history nodes together with the discovered ones
+func (nd *NodesDiscoverer) GetFullHistoryNodes() []*data.NodeData {
+	nd.mut.Lock()
+	defer nd.mut.Unlock()
+
+	return mergeNodes(nd.fullHistoryNodes.staticNodes, nd.fullHistoryNodes.nodes)
+}
+
+// StartDiscovery begins resolving the records periodically, replacing the nodes of the provided handler whenever the
+// resolved ones change
+func (nd *NodesDiscoverer) StartDiscovery(nodesHandler NodesHandler) error {
+	if check.IfNil(nodesHandler) {
+		return ErrNilNodesHandler
+	}
+
+	nd.mut.Lock()
+	defer nd.mut.Unlock()
+
+	if !check.IfNil(nd.nodesHandler) {
+		return ErrDiscoveryAlreadyStarted
+	}
+
+	nd.nodesHandler = nodesHandler
+	nd.observers.setNodes = nodesHandler.SetObservers
+	nd.fullHistoryNodes.setNodes = nodesHandler.SetFullHistoryNodes
+
+	var ctx context.Context
+	ctx, nd.cancelFunc = context.WithCancel(context.Background())
+	go nd.discoverPeriodically(ctx)
+
+	return nil
+}
+
+func (nd *NodesDiscoverer) discoverPeriodically(ctx context.Context) {
+	ticker := time.NewTicker(nd.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("nodes discoverer is closing...")
+			return
+		case <-ticker.C:
+			nd.Discover()
+		}
+	}
+}
+
+// Discover resolves the records once and replaces the nodes whose resolved set changed. If the records cannot be
+// resolved or the new nodes cannot be applied, the previous nodes are kept and the change is retried on the next
+// discovery
+func (nd *NodesDiscoverer) Discover() {
+	for _, nodes := range nd.getAllDiscoveredNodes() {
+		newNodes, err := nd.resolveNodes(nodes.resolver)
+		if err != nil {
+			log.Warn("nodes discovery failed, keeping the previous nodes", "type", nodes.name, "error", err.Error())
+			continue
+		}
+
+		nd.applyDiscoveredNodes(nodes, newNodes)
+	}
+}
+
+func (nd *NodesDiscoverer) applyDiscoveredNodes(nodes *discoveredNodes, newNodes []*data.NodeData) {
+	nd.mut.Lock()
+	defer nd.mut.Unlock()
+
+	if nodes.setNodes == nil || areSameNodes(nodes.nodes, newNodes) {
+		return
+	}
+
+	added, removed := computeMembershipChanges(nodes.nodes, newNodes)
+	err := nodes.setNodes(mergeNodes(nodes.staticNodes, newNodes))
+	if err != nil {
+		log.Warn("cannot apply the discovered nodes, keeping the previous nodes", "type", nodes.name,
+			"added", added, "removed", removed, "error", err.Error())
+		return
+	}
+
+	nodes.nodes = newNodes
+	log.Info("discovered nodes changed", "type", nodes.name, "added", added, "removed", removed)
+}
+
+// SetObservers replaces the static observers, keeping the discovered ones
+func (nd *NodesDiscoverer) SetObservers(nodes []*data.NodeData) error {
+	return nd.setStaticNodes(nd.observers, nodes)
+}
+
+// SetFullHistoryNodes replaces the static full history nodes, keeping the discovered ones
+func (nd *NodesDiscoverer) SetFullHistoryNodes(nodes []*data.NodeData) error {
+	return nd.setStaticNodes(nd.fullHistoryNodes, nodes)
+}
+
+func (nd *NodesDiscoverer) setStaticNodes(nodes *discoveredNodes, staticNodes []*data.NodeData) error {
+	nd.mut.Lock()
+	defer nd.mut.Unlock()
+
+	if nodes.setNodes == nil {
+		return ErrDiscoveryNotStarted
+	}
+
+	err := nodes.setNodes(mergeNodes(staticNodes, nodes.nodes))
+	if err != nil {
+		return err
+	}
+
+	nodes.staticNodes = staticNodes
+
+	return nil
+}
+
+// mergeNodes returns the static nodes followed by the discovered nodes which are not static as well
+func mergeNodes(staticNodes []*data.NodeData, discoveredNodes []*data.NodeData) []*data.NodeData {
+	nodes := make([]*data.NodeData, 0, len(staticNodes)+len(discoveredNodes))
+	nodes = append(nodes, staticNodes...)
+	for _, node := range discoveredNodes {
+		if containsNode(staticNodes, node.Address, node.ShardId) {
+			continue
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	return nodes
+}
+
+func areSameNodes(nodes []*data.NodeData, otherNodes []*data.NodeData) bool {
+	if len(nodes) != len(otherNodes) {
+		return false
+	}
+
+	for i := range nodes {
+		if *nodes[i] != *otherNodes[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func computeMembershipChanges(oldNodes []*data.NodeData, newNodes []*data.NodeData) (string, string) {
+	added := make([]*data.NodeData, 0)
+	for _, node := range newNodes {
+		if !containsNode(oldNodes, node.Address, node.ShardId) {
+			added = append(added, node)
+		}
+	}
+
+	removed := make([]*data.NodeData, 0)
+	for _, node := range oldNodes {
+		if !containsNode(newNodes, node.Address, node.ShardId) {
+			removed = append(removed, node)
+		}
+	}
+
+	return getNodesDescription(added), getNodesDescription(removed)
+}
+
+func getNodesDescription(nodes []*data.NodeData) string {
+	descriptions := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		descriptions = append(descriptions, fmt.Sprintf("%s (shard %d)", node.Address, node.ShardId))
+	}
+
+	return strings.Join(descriptions, ", ")
+}
+
+func containsNode(nodes []*data.NodeData, address string, shardID uint32) bool {
+	for _, node := range nodes {
+		if node.Address == address && node.ShardId == shardID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Close stops the periodical discovery
+func (nd *NodesDiscoverer) Close() error {
+	nd.mut.Lock()
+	defer nd.mut.Unlock()
+
+	if nd.cancelFunc != nil {
+		nd.cancelFunc()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nd *NodesDiscoverer) IsInterfaceNil() bool {
+	return nd == nil
+}
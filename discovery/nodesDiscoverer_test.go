@@ -0,0 +1,295 @@
+package discovery_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/discovery"
+	"github.com/multiversx/mx-chain-proxy-go/discovery/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsNodesDiscoverer() discovery.ArgsNodesDiscoverer {
+	return discovery.ArgsNodesDiscoverer{
+		ObserversResolver:        &mock.NodesResolverStub{},
+		FullHistoryNodesResolver: &mock.NodesResolverStub{},
+		Interval:                 time.Hour,
+		Timeout:                  time.Second,
+	}
+}
+
+// nodesResolverHolder allows changing the resolved nodes between two discoveries
+type nodesResolverHolder struct {
+	mut   sync.Mutex
+	nodes []*data.NodeData
+	err   error
+}
+
+func (holder *nodesResolverHolder) set(nodes []*data.NodeData, err error) {
+	holder.mut.Lock()
+	holder.nodes = nodes
+	holder.err = err
+	holder.mut.Unlock()
+}
+
+func (holder *nodesResolverHolder) createNodesResolver() *mock.NodesResolverStub {
+	return &mock.NodesResolverStub{
+		ResolveNodesCalled: func(_ context.Context) ([]*data.NodeData, error) {
+			holder.mut.Lock()
+			defer holder.mut.Unlock()
+
+			return holder.nodes, holder.err
+		},
+	}
+}
+
+func TestNewNodesDiscoverer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil observers resolver should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesDiscoverer()
+		args.ObserversResolver = nil
+		nd, err := discovery.NewNodesDiscoverer(args)
+		require.ErrorIs(t, err, discovery.ErrNilNodesResolver)
+		require.True(t, check.IfNil(nd))
+	})
+	t.Run("nil full history nodes resolver should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesDiscoverer()
+		args.FullHistoryNodesResolver = nil
+		nd, err := discovery.NewNodesDiscoverer(args)
+		require.ErrorIs(t, err, discovery.ErrNilNodesResolver)
+		require.True(t, check.IfNil(nd))
+	})
+	t.Run("invalid interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesDiscoverer()
+		args.Interval = 0
+		nd, err := discovery.NewNodesDiscoverer(args)
+		require.Equal(t, discovery.ErrInvalidInterval, err)
+		require.True(t, check.IfNil(nd))
+	})
+	t.Run("invalid timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesDiscoverer()
+		args.Timeout = 0
+		nd, err := discovery.NewNodesDiscoverer(args)
+		require.Equal(t, discovery.ErrInvalidTimeout, err)
+		require.True(t, check.IfNil(nd))
+	})
+	t.Run("initial discovery failure should start with the static nodes", func(t *testing.T) {
+		t.Parallel()
+
+		staticFullHistoryNodes := []*data.NodeData{{ShardId: 0, Address: "http://static:8080"}}
+		args := createMockArgsNodesDiscoverer()
+		args.StaticFullHistoryNodes = staticFullHistoryNodes
+		args.FullHistoryNodesResolver = &mock.NodesResolverStub{
+			ResolveNodesCalled: func(_ context.Context) ([]*data.NodeData, error) {
+				return nil, expectedErr
+			},
+		}
+		nd, err := discovery.NewNodesDiscoverer(args)
+		require.NoError(t, err)
+		require.False(t, check.IfNil(nd))
+
+		require.Equal(t, staticFullHistoryNodes, nd.GetFullHistoryNodes())
+		require.Empty(t, nd.GetObservers())
+	})
+	t.Run("should merge the static and the discovered nodes", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNodesDiscoverer()
+		args.StaticObservers = []*data.NodeData{{ShardId: 0, Address: "http://static:8080"}}
+		args.ObserversResolver = &mock.NodesResolverStub{
+			ResolveNodesCalled: func(_ context.Context) ([]*data.NodeData, error) {
+				return []*data.NodeData{
+					{ShardId: 0, Address: "http://static:8080", IsFallback: true},
+					{ShardId: 1, Address: "http://discovered:8080"},
+				}, nil
+			},
+		}
+		nd, err := discovery.NewNodesDiscoverer(args)
+		require.NoError(t, err)
+		require.False(t, check.IfNil(nd))
+
+		require.Equal(t, []*data.NodeData{
+			{ShardId: 0, Address: "http://static:8080"},
+			{ShardId: 1, Address: "http://discovered:8080"},
+		}, nd.GetObservers())
+		require.Empty(t, nd.GetFullHistoryNodes())
+	})
+}
+
+func TestNodesDiscoverer_StartDiscovery(t *testing.T) {
+	t.Parallel()
+
+	nd, _ := discovery.NewNodesDiscoverer(createMockArgsNodesDiscoverer())
+	defer func() {
+		_ = nd.Close()
+	}()
+
+	require.Equal(t, discovery.ErrDiscoveryNotStarted, nd.SetObservers(nil))
+	require.Equal(t, discovery.ErrNilNodesHandler, nd.StartDiscovery(nil))
+	require.NoError(t, nd.StartDiscovery(&mock.NodesHandlerStub{}))
+	require.Equal(t, discovery.ErrDiscoveryAlreadyStarted, nd.StartDiscovery(&mock.NodesHandlerStub{}))
+}
+
+func TestNodesDiscoverer_Discover(t *testing.T) {
+	t.Parallel()
+
+	staticObservers := []*data.NodeData{{ShardId: 0, Address: "http://static:8080"}}
+	firstNodes := []*data.NodeData{
+		{ShardId: 0, Address: "http://observer-0:8080"},
+		{ShardId: 1, Address: "http://observer-1:8080"},
+	}
+	holder := &nodesResolverHolder{}
+	holder.set(firstNodes, nil)
+
+	args := createMockArgsNodesDiscoverer()
+	args.StaticObservers = staticObservers
+	args.ObserversResolver = holder.createNodesResolver()
+	nd, _ := discovery.NewNodesDiscoverer(args)
+
+	numCalls := 0
+	var setObservers []*data.NodeData
+	var setObserversErr error
+	nodesHandler := &mock.NodesHandlerStub{
+		SetObserversCalled: func(nodes []*data.NodeData) error {
+			numCalls++
+			if setObserversErr != nil {
+				return setObserversErr
+			}
+
+			setObservers = nodes
+			return nil
+		},
+		SetFullHistoryNodesCalled: func(_ []*data.NodeData) error {
+			require.Fail(t, "should have not been called")
+			return nil
+		},
+	}
+	require.NoError(t, nd.StartDiscovery(nodesHandler))
+	defer func() {
+		_ = nd.Close()
+	}()
+
+	// unchanged nodes should not be set
+	nd.Discover()
+	require.Equal(t, 0, numCalls)
+
+	// failed discovery should keep the previous nodes
+	holder.set(nil, expectedErr)
+	nd.Discover()
+	require.Equal(t, 0, numCalls)
+
+	// changed nodes should be set together with the static nodes
+	secondNodes := []*data.NodeData{
+		{ShardId: 0, Address: "http://observer-2:8080"},
+		{ShardId: 1, Address: "http://observer-1:8080", IsSnapshotless: true},
+	}
+	holder.set(secondNodes, nil)
+	nd.Discover()
+	require.Equal(t, 1, numCalls)
+	require.Equal(t, append(staticObservers, secondNodes...), setObservers)
+	require.Equal(t, append(staticObservers, secondNodes...), nd.GetObservers())
+
+	// nodes not applied should be retried on the next discovery
+	thirdNodes := []*data.NodeData{{ShardId: 1, Address: "http://observer-1:8080"}}
+	holder.set(thirdNodes, nil)
+	setObserversErr = expectedErr
+	nd.Discover()
+	require.Equal(t, 2, numCalls)
+	require.Equal(t, append(staticObservers, secondNodes...), nd.GetObservers())
+
+	setObserversErr = nil
+	nd.Discover()
+	require.Equal(t, 3, numCalls)
+	require.Equal(t, append(staticObservers, thirdNodes...), setObservers)
+
+	// the static nodes should be replaced keeping the discovered nodes
+	newStaticObservers := []*data.NodeData{{ShardId: 0, Address: "http://new-static:8080"}}
+	require.NoError(t, nd.SetObservers(newStaticObservers))
+	require.Equal(t, 4, numCalls)
+	require.Equal(t, append(newStaticObservers, thirdNodes...), setObservers)
+
+	setObserversErr = expectedErr
+	require.Equal(t, expectedErr, nd.SetObservers(staticObservers))
+	require.Equal(t, append(newStaticObservers, thirdNodes...), nd.GetObservers())
+}
+
+func TestNodesDiscoverer_InitialDiscoveryFailureShouldBeRetried(t *testing.T) {
+	t.Parallel()
+
+	staticObservers := []*data.NodeData{{ShardId: 0, Address: "http://static:8080"}}
+	holder := &nodesResolverHolder{}
+	holder.set(nil, expectedErr)
+
+	args := createMockArgsNodesDiscoverer()
+	args.StaticObservers = staticObservers
+	args.ObserversResolver = holder.createNodesResolver()
+	nd, err := discovery.NewNodesDiscoverer(args)
+	require.NoError(t, err)
+	require.Equal(t, staticObservers, nd.GetObservers())
+
+	var setObservers []*data.NodeData
+	nodesHandler := &mock.NodesHandlerStub{
+		SetObserversCalled: func(nodes []*data.NodeData) error {
+			setObservers = nodes
+			return nil
+		},
+	}
+	require.NoError(t, nd.StartDiscovery(nodesHandler))
+	defer func() {
+		_ = nd.Close()
+	}()
+
+	// the lookup still fails, so only the static nodes are used
+	nd.Discover()
+	require.Nil(t, setObservers)
+
+	discoveredNodes := []*data.NodeData{{ShardId: 1, Address: "http://observer-1:8080"}}
+	holder.set(discoveredNodes, nil)
+	nd.Discover()
+	require.Equal(t, append(staticObservers, discoveredNodes...), setObservers)
+	require.Equal(t, append(staticObservers, discoveredNodes...), nd.GetObservers())
+}
+
+func TestNodesDiscoverer_ShouldDiscoverPeriodically(t *testing.T) {
+	t.Parallel()
+
+	holder := &nodesResolverHolder{}
+	args := createMockArgsNodesDiscoverer()
+	args.FullHistoryNodesResolver = holder.createNodesResolver()
+	args.Interval = time.Millisecond * 10
+	nd, _ := discovery.NewNodesDiscoverer(args)
+
+	discoveredNodes := []*data.NodeData{{ShardId: 0, Address: "http://full-history-node:8080"}}
+	chSetNodes := make(chan []*data.NodeData, 1)
+	nodesHandler := &mock.NodesHandlerStub{
+		SetFullHistoryNodesCalled: func(nodes []*data.NodeData) error {
+			chSetNodes <- nodes
+			return nil
+		},
+	}
+	require.NoError(t, nd.StartDiscovery(nodesHandler))
+	defer func() {
+		_ = nd.Close()
+	}()
+
+	holder.set(discoveredNodes, nil)
+	select {
+	case nodes := <-chSetNodes:
+		require.Equal(t, discoveredNodes, nodes)
+	case <-time.After(time.Second):
+		require.Fail(t, "the discovered nodes should have been set")
+	}
+}
@@ -0,0 +1,155 @@
+package discovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const (
+	// SRVRecordType identifies the SRV records, which provide both the host and the port of each node
+	SRVRecordType = "SRV"
+
+	// ARecordType identifies the A (or AAAA) records, which provide only the IP of each node, the port being configured
+	ARecordType = "A"
+
+	defaultScheme = "http"
+)
+
+type recordsResolver struct {
+	resolver Resolver
+	records  []config.DiscoveryRecordConfig
+}
+
+// NewRecordsResolver returns a new instance of recordsResolver, which resolves the provided DNS records to nodes
+func NewRecordsResolver(resolver Resolver, records []config.DiscoveryRecordConfig) (*recordsResolver, error) {
+	if check.IfNilReflect(resolver) {
+		return nil, ErrNilResolver
+	}
+
+	for _, record := range records {
+		err := checkRecord(record)
+		if err != nil {
+			return nil, fmt.Errorf("%w for record %s", err, record.Name)
+		}
+	}
+
+	return &recordsResolver{
+		resolver: resolver,
+		records:  records,
+	}, nil
+}
+
+func checkRecord(record config.DiscoveryRecordConfig) error {
+	if len(record.Name) == 0 {
+		return ErrEmptyRecordName
+	}
+	if record.Type != SRVRecordType && record.Type != ARecordType {
+		return ErrInvalidRecordType
+	}
+	if record.Type == ARecordType && (record.Port <= 0 || record.Port > 65535) {
+		return ErrInvalidRecordPort
+	}
+	if len(record.Scheme) > 0 && record.Scheme != "http" && record.Scheme != "https" {
+		return ErrInvalidRecordScheme
+	}
+
+	return nil
+}
+
+// ResolveNodes resolves all the records, returning the nodes sorted by shard and address. If any record cannot be
+// resolved, an error is returned, so the nodes of a shard are not dropped because of a failed lookup. A record which
+// does not exist resolves to no nodes
+func (rr *recordsResolver) ResolveNodes(ctx context.Context) ([]*data.NodeData, error) {
+	nodes := make([]*data.NodeData, 0)
+	for _, record := range rr.records {
+		addresses, err := rr.resolveAddresses(ctx, record)
+		if err != nil {
+			return nil, fmt.Errorf("cannot resolve the %s record %s: %w", record.Type, record.Name, err)
+		}
+
+		for _, address := range addresses {
+			if containsNode(nodes, address, record.ShardId) {
+				continue
+			}
+
+			nodes = append(nodes, &data.NodeData{
+				ShardId:        record.ShardId,
+				Address:        address,
+				IsFallback:     record.IsFallback,
+				IsSnapshotless: record.IsSnapshotless,
+			})
+		}
+	}
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].ShardId != nodes[j].ShardId {
+			return nodes[i].ShardId < nodes[j].ShardId
+		}
+
+		return nodes[i].Address < nodes[j].Address
+	})
+
+	return nodes, nil
+}
+
+func (rr *recordsResolver) resolveAddresses(ctx context.Context, record config.DiscoveryRecordConfig) ([]string, error) {
+	scheme := record.Scheme
+	if len(scheme) == 0 {
+		scheme = defaultScheme
+	}
+
+	if record.Type == ARecordType {
+		hosts, err := rr.resolver.LookupHost(ctx, record.Name)
+		if isNotFoundError(err) {
+			return make([]string, 0), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		addresses := make([]string, 0, len(hosts))
+		for _, host := range hosts {
+			addresses = append(addresses, createAddress(scheme, host, record.Port))
+		}
+
+		return addresses, nil
+	}
+
+	_, srvRecords, err := rr.resolver.LookupSRV(ctx, "", "", record.Name)
+	if isNotFoundError(err) {
+		return make([]string, 0), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]string, 0, len(srvRecords))
+	for _, srvRecord := range srvRecords {
+		addresses = append(addresses, createAddress(scheme, strings.TrimSuffix(srvRecord.Target, "."), int(srvRecord.Port)))
+	}
+
+	return addresses, nil
+}
+
+func isNotFoundError(err error) bool {
+	dnsErr := &net.DNSError{}
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+func createAddress(scheme string, host string, port int) string {
+	return fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(host, strconv.Itoa(port)))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rr *recordsResolver) IsInterfaceNil() bool {
+	return rr == nil
+}
@@ -0,0 +1,136 @@
+package discovery_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/discovery"
+	"github.com/multiversx/mx-chain-proxy-go/discovery/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var expectedErr = errors.New("expected error")
+
+func createFakeResolver() *mock.ResolverStub {
+	return &mock.ResolverStub{
+		LookupSRVCalled: func(_ context.Context, _ string, _ string, name string) (string, []*net.SRV, error) {
+			switch name {
+			case "_observer._tcp.shard-0.svc":
+				return "", []*net.SRV{
+					{Target: "observer-1.shard-0.svc.", Port: 8080},
+					{Target: "observer-0.shard-0.svc.", Port: 8080},
+				}, nil
+			case "_observer._tcp.metachain.svc":
+				return "", []*net.SRV{{Target: "observer-0.metachain.svc.", Port: 9090}}, nil
+			default:
+				return "", nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+			}
+		},
+		LookupHostCalled: func(_ context.Context, host string) ([]string, error) {
+			if host == "fallback.shard-0.svc" {
+				return []string{"10.0.0.2", "fd00::1"}, nil
+			}
+
+			return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+		},
+	}
+}
+
+func TestNewRecordsResolver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil resolver should error", func(t *testing.T) {
+		t.Parallel()
+
+		rr, err := discovery.NewRecordsResolver(nil, nil)
+		require.Equal(t, discovery.ErrNilResolver, err)
+		require.True(t, check.IfNil(rr))
+	})
+	t.Run("invalid records should error", func(t *testing.T) {
+		t.Parallel()
+
+		records := map[error]config.DiscoveryRecordConfig{
+			discovery.ErrEmptyRecordName:     {Type: discovery.SRVRecordType},
+			discovery.ErrInvalidRecordType:   {Type: "CNAME", Name: "observers.svc"},
+			discovery.ErrInvalidRecordPort:   {Type: discovery.ARecordType, Name: "observers.svc"},
+			discovery.ErrInvalidRecordScheme: {Type: discovery.SRVRecordType, Name: "observers.svc", Scheme: "ftp"},
+		}
+		for recordErr, record := range records {
+			rr, err := discovery.NewRecordsResolver(&mock.ResolverStub{}, []config.DiscoveryRecordConfig{record})
+			require.ErrorIs(t, err, recordErr)
+			require.True(t, check.IfNil(rr))
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rr, err := discovery.NewRecordsResolver(&mock.ResolverStub{}, nil)
+		require.NoError(t, err)
+		require.False(t, check.IfNil(rr))
+	})
+}
+
+func TestRecordsResolver_ResolveNodes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should resolve the SRV and A records", func(t *testing.T) {
+		t.Parallel()
+
+		records := []config.DiscoveryRecordConfig{
+			{ShardId: core.MetachainShardId, Type: discovery.SRVRecordType, Name: "_observer._tcp.metachain.svc", Scheme: "https"},
+			{ShardId: 0, Type: discovery.SRVRecordType, Name: "_observer._tcp.shard-0.svc"},
+			{ShardId: 0, Type: discovery.ARecordType, Name: "fallback.shard-0.svc", Port: 8080, IsFallback: true, IsSnapshotless: true},
+			{ShardId: 1, Type: discovery.SRVRecordType, Name: "_observer._tcp.shard-1.svc"},
+		}
+		rr, _ := discovery.NewRecordsResolver(createFakeResolver(), records)
+
+		nodes, err := rr.ResolveNodes(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []*data.NodeData{
+			{ShardId: 0, Address: "http://10.0.0.2:8080", IsFallback: true, IsSnapshotless: true},
+			{ShardId: 0, Address: "http://[fd00::1]:8080", IsFallback: true, IsSnapshotless: true},
+			{ShardId: 0, Address: "http://observer-0.shard-0.svc:8080"},
+			{ShardId: 0, Address: "http://observer-1.shard-0.svc:8080"},
+			{ShardId: core.MetachainShardId, Address: "https://observer-0.metachain.svc:9090"},
+		}, nodes)
+	})
+	t.Run("duplicated addresses should be resolved once", func(t *testing.T) {
+		t.Parallel()
+
+		records := []config.DiscoveryRecordConfig{
+			{ShardId: 0, Type: discovery.SRVRecordType, Name: "_observer._tcp.shard-0.svc"},
+			{ShardId: 0, Type: discovery.SRVRecordType, Name: "_observer._tcp.shard-0.svc", IsFallback: true},
+		}
+		rr, _ := discovery.NewRecordsResolver(createFakeResolver(), records)
+
+		nodes, err := rr.ResolveNodes(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []*data.NodeData{
+			{ShardId: 0, Address: "http://observer-0.shard-0.svc:8080"},
+			{ShardId: 0, Address: "http://observer-1.shard-0.svc:8080"},
+		}, nodes)
+	})
+	t.Run("lookup error should error", func(t *testing.T) {
+		t.Parallel()
+
+		resolver := &mock.ResolverStub{
+			LookupHostCalled: func(_ context.Context, _ string) ([]string, error) {
+				return nil, expectedErr
+			},
+		}
+		records := []config.DiscoveryRecordConfig{
+			{ShardId: 0, Type: discovery.ARecordType, Name: "observers.svc", Port: 8080},
+		}
+		rr, _ := discovery.NewRecordsResolver(resolver, records)
+
+		nodes, err := rr.ResolveNodes(context.Background())
+		require.ErrorIs(t, err, expectedErr)
+		require.Nil(t, nodes)
+	})
+}
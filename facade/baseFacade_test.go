@@ -1,6 +1,7 @@
 package facade_test
 
 import (
+	"context"
 	"errors"
 	"math/big"
 	"testing"
@@ -45,6 +46,9 @@ func TestNewProxyFacade_NilActionsProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -70,6 +74,9 @@ func TestNewProxyFacade_NilAccountProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -95,6 +102,9 @@ func TestNewProxyFacade_NilTransactionProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -120,6 +130,9 @@ func TestNewProxyFacade_NilGetValuesProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -145,6 +158,9 @@ func TestNewProxyFacade_NilNodeGroupProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -170,6 +186,9 @@ func TestNewProxyFacade_NilValStatsProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -195,6 +214,9 @@ func TestNewProxyFacade_NilFaucetProcShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -220,6 +242,9 @@ func TestNewProxyFacade_NilNodeProcessor(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -245,6 +270,9 @@ func TestNewProxyFacade_NilBlocksProcessor(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -270,6 +298,9 @@ func TestNewProxyFacade_NilProofProcessor(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -295,6 +326,9 @@ func TestNewProxyFacade_NilStatusProcessorShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		nil,
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
@@ -320,12 +354,99 @@ func TestNewProxyFacade_NilAboutInfoProcessorShouldErr(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		nil,
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
 	assert.Nil(t, epf)
 	assert.Equal(t, facade.ErrNilAboutInfoProcessor, err)
 }
 
+func TestNewProxyFacade_NilHyperblockStreamerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		nil,
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilHyperblockStreamer, err)
+}
+
+func TestNewProxyFacade_NilTransactionStatusWatcherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		nil,
+		&mock.RelayerProcessorStub{},
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilTransactionStatusWatcher, err)
+}
+
+func TestNewProxyFacade_NilRelayerProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.NodeGroupProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.BlocksProcessorStub{},
+		&mock.ProofProcessorStub{},
+		publicKeyConverter,
+		&mock.ESDTSuppliesProcessorStub{},
+		&mock.StatusProcessorStub{},
+		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		nil,
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilRelayerProcessor, err)
+}
+
 func TestNewProxyFacade_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -345,6 +466,9 @@ func TestNewProxyFacade_ShouldWork(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
 	assert.NotNil(t, epf)
@@ -393,14 +517,17 @@ func TestNewProxyFacade_GetBlocksByRound(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 	require.NoError(t, err)
 
-	ret, err := epf.GetBlocksByRound(3, common.BlockQueryOptions{WithTransactions: true})
+	ret, err := epf.GetBlocksByRound(context.Background(), 3, common.BlockQueryOptions{WithTransactions: true})
 	require.Equal(t, errGetBlockByRound, err)
 	require.Nil(t, ret)
 
-	ret, err = epf.GetBlocksByRound(4, common.BlockQueryOptions{WithTransactions: true})
+	ret, err = epf.GetBlocksByRound(context.Background(), 4, common.BlockQueryOptions{WithTransactions: true})
 	require.Nil(t, err)
 	require.Equal(t, expectedResponse, ret)
 }
@@ -412,7 +539,7 @@ func TestProxyFacade_GetAccount(t *testing.T) {
 	epf, _ := facade.NewProxyFacade(
 		&mock.ActionsProcessorStub{},
 		&mock.AccountProcessorStub{
-			GetAccountCalled: func(address string, options common.AccountQueryOptions) (account *data.AccountModel, e error) {
+			GetAccountCalled: func(_ context.Context, address string, options common.AccountQueryOptions) (account *data.AccountModel, e error) {
 				wasCalled = true
 				return &data.AccountModel{}, nil
 			},
@@ -430,9 +557,12 @@ func TestProxyFacade_GetAccount(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
-	_, _ = epf.GetAccount("", common.AccountQueryOptions{})
+	_, _ = epf.GetAccount(context.Background(), "", common.AccountQueryOptions{})
 
 	assert.True(t, wasCalled)
 }
@@ -463,9 +593,12 @@ func TestProxyFacade_SendTransaction(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
-	_, _, _ = epf.SendTransaction(&data.Transaction{})
+	_, _, _ = epf.SendTransaction(context.Background(), &data.Transaction{})
 
 	assert.True(t, wasCalled)
 }
@@ -495,9 +628,12 @@ func TestProxyFacade_SimulateTransaction(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
-	_, _ = epf.SimulateTransaction(&data.Transaction{}, false)
+	_, _ = epf.SimulateTransaction(context.Background(), &data.Transaction{}, false)
 
 	assert.True(t, wasCalled)
 }
@@ -505,47 +641,24 @@ func TestProxyFacade_SimulateTransaction(t *testing.T) {
 func TestProxyFacade_SendUserFunds(t *testing.T) {
 	t.Parallel()
 
-	wasCalled := false
+	expectedErr := errors.New("expected error")
 	epf, _ := facade.NewProxyFacade(
 		&mock.ActionsProcessorStub{},
-		&mock.AccountProcessorStub{
-			GetAccountCalled: func(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
-				return &data.AccountModel{
-					Account: data.Account{
-						Nonce: uint64(0),
-					},
-				}, nil
-			},
-		},
-		&mock.TransactionProcessorStub{
-			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
-				wasCalled = true
-				return 0, "", nil
-			},
-		},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
 		&mock.SCQueryServiceStub{},
 		&mock.NodeGroupProcessorStub{},
 		&mock.ValidatorStatisticsProcessorStub{},
 		&mock.FaucetProcessorStub{
-			SenderDetailsFromPemCalled: func(receiver string) (crypto.PrivateKey, string, error) {
-				return getPrivKey(), "rcvr", nil
-			},
-			GenerateTxForSendUserFundsCalled: func(senderSk crypto.PrivateKey, senderPk string, senderNonce uint64, receiver string, value *big.Int, config *data.NetworkConfig) (*data.Transaction, error) {
-				return &data.Transaction{}, nil
-			},
-		},
-		&mock.NodeStatusProcessorStub{
-			GetConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
-				return &data.GenericAPIResponse{
-					Data: map[string]interface{}{
-						"config": map[string]interface{}{
-							"erd_chain_id":                "chainID",
-							"erd_min_transaction_version": 1.0,
-						},
-					},
-				}, nil
+			SendUserFundsCalled: func(receiver string, value *big.Int, clientIP string) ([]string, error) {
+				assert.Equal(t, "rcvr", receiver)
+				assert.Equal(t, big.NewInt(10), value)
+				assert.Equal(t, "1.1.1.1", clientIP)
+
+				return []string{"hash"}, expectedErr
 			},
 		},
+		&mock.NodeStatusProcessorStub{},
 		&mock.BlockProcessorStub{},
 		&mock.BlocksProcessorStub{},
 		&mock.ProofProcessorStub{},
@@ -553,11 +666,14 @@ func TestProxyFacade_SendUserFunds(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
-	_ = epf.SendUserFunds("", big.NewInt(0))
-
-	assert.True(t, wasCalled)
+	txHashes, err := epf.SendUserFunds(context.Background(), "rcvr", big.NewInt(10), "1.1.1.1")
+	assert.Equal(t, []string{"hash"}, txHashes)
+	assert.Equal(t, expectedErr, err)
 }
 
 func TestProxyFacade_GetDataValue(t *testing.T) {
@@ -569,7 +685,7 @@ func TestProxyFacade_GetDataValue(t *testing.T) {
 		&mock.AccountProcessorStub{},
 		&mock.TransactionProcessorStub{},
 		&mock.SCQueryServiceStub{
-			ExecuteQueryCalled: func(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+			ExecuteQueryCalled: func(_ context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
 				wasCalled = true
 				return &vm.VMOutputApi{}, data.BlockInfo{}, nil
 			},
@@ -585,9 +701,12 @@ func TestProxyFacade_GetDataValue(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
-	_, _, _ = epf.ExecuteSCQuery(nil)
+	_, _, _ = epf.ExecuteSCQuery(context.Background(), nil)
 
 	assert.True(t, wasCalled)
 }
@@ -609,7 +728,7 @@ func TestProxyFacade_GetHeartbeatData(t *testing.T) {
 		&mock.TransactionProcessorStub{},
 		&mock.SCQueryServiceStub{},
 		&mock.NodeGroupProcessorStub{
-			GetHeartbeatDataCalled: func() (*data.HeartbeatResponse, error) {
+			GetHeartbeatDataCalled: func(_ context.Context) (*data.HeartbeatResponse, error) {
 				return expectedResults, nil
 			},
 		},
@@ -623,9 +742,12 @@ func TestProxyFacade_GetHeartbeatData(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
-	actualResult, _ := epf.GetHeartbeatData()
+	actualResult, _ := epf.GetHeartbeatData(context.Background())
 
 	assert.Equal(t, expectedResults, actualResult)
 }
@@ -658,6 +780,9 @@ func TestProxyFacade_ReloadObservers(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
 	actualResult := epf.ReloadObservers()
@@ -693,6 +818,9 @@ func TestProxyFacade_ReloadFullHistoryObservers(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
 	actualResult := epf.ReloadFullHistoryObservers()
@@ -732,9 +860,12 @@ func TestProxyFacade_GetBlockByHash(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
-	actualResult, err := epf.GetBlockByHash(0, "aaaa", common.BlockQueryOptions{})
+	actualResult, err := epf.GetBlockByHash(context.Background(), 0, "aaaa", common.BlockQueryOptions{})
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -772,9 +903,12 @@ func TestProxyFacade_GetBlockByNonce(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
-	actualResult, err := epf.GetBlockByNonce(0, 10, common.BlockQueryOptions{})
+	actualResult, err := epf.GetBlockByNonce(context.Background(), 0, 10, common.BlockQueryOptions{})
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -814,9 +948,12 @@ func TestProxyFacade_GetInternalBlockByHash(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
-	actualResult, err := epf.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	actualResult, err := epf.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -854,9 +991,12 @@ func TestProxyFacade_GetInternalBlockByNonce(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
-	actualResult, err := epf.GetInternalBlockByNonce(0, 10, common.Internal)
+	actualResult, err := epf.GetInternalBlockByNonce(context.Background(), 0, 10, common.Internal)
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -894,9 +1034,12 @@ func TestProxyFacade_GetInternalMiniBlockByHash(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
-	actualResult, err := epf.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	actualResult, err := epf.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -932,9 +1075,12 @@ func TestProxyFacade_GetRatingsConfig(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
-	actualResult, err := epf.GetRatingsConfig()
+	actualResult, err := epf.GetRatingsConfig(context.Background())
 	require.Nil(t, err)
 
 	assert.Equal(t, expectedResult, actualResult)
@@ -997,25 +1143,28 @@ func TestProxyFacade_GetTransactionsPool(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
-	actualTxPool, err := epf.GetTransactionsPool("")
+	actualTxPool, err := epf.GetTransactionsPool(context.Background(), "")
 	require.Nil(t, err)
 	assert.Equal(t, expectedTxPool, actualTxPool)
 
-	actualTxPool, err = epf.GetTransactionsPoolForShard(0, "")
+	actualTxPool, err = epf.GetTransactionsPoolForShard(context.Background(), 0, "")
 	require.Nil(t, err)
 	assert.Equal(t, expectedTxPool, actualTxPool)
 
-	actualTxPoolForSender, err := epf.GetTransactionsPoolForSender("", "")
+	actualTxPoolForSender, err := epf.GetTransactionsPoolForSender(context.Background(), "", "")
 	require.Nil(t, err)
 	assert.Equal(t, expectedTxPoolForSender, actualTxPoolForSender)
 
-	actualNonce, err := epf.GetLastPoolNonceForSender("")
+	actualNonce, err := epf.GetLastPoolNonceForSender(context.Background(), "")
 	require.Nil(t, err)
 	assert.Equal(t, providedNonce, actualNonce)
 
-	actualNonceGaps, err := epf.GetTransactionsPoolNonceGapsForSender("")
+	actualNonceGaps, err := epf.GetTransactionsPoolNonceGapsForSender(context.Background(), "")
 	require.Nil(t, err)
 	assert.Equal(t, expectedNonceGaps, actualNonceGaps)
 }
@@ -1051,9 +1200,12 @@ func TestProxyFacade_GetGasConfigs(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
-	actualResult, err := epf.GetGasConfigs()
+	actualResult, err := epf.GetGasConfigs(context.Background())
 	require.Nil(t, err)
 
 	assert.True(t, wasCalled)
@@ -1088,9 +1240,12 @@ func TestProxyFacade_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 		&mock.ESDTSuppliesProcessorStub{},
 		&mock.StatusProcessorStub{},
 		&mock.AboutInfoProcessorStub{},
+		&mock.HyperblockStreamerStub{},
+		&mock.TransactionStatusWatcherStub{},
+		&mock.RelayerProcessorStub{},
 	)
 
-	actualResult, _ := epf.GetWaitingEpochsLeftForPublicKey("key")
+	actualResult, _ := epf.GetWaitingEpochsLeftForPublicKey(context.Background(), "key")
 
 	assert.Equal(t, expectedResults, actualResult)
 }
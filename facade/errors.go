@@ -43,3 +43,12 @@ var ErrNilStatusProcessor = errors.New("nil status processor")
 
 // ErrNilAboutInfoProcessor signals that a nil about info processor has been provided
 var ErrNilAboutInfoProcessor = errors.New("nil about info processor")
+
+// ErrNilHyperblockStreamer signals that a nil hyperblock streamer has been provided
+var ErrNilHyperblockStreamer = errors.New("nil hyperblock streamer")
+
+// ErrNilTransactionStatusWatcher signals that a nil transaction status watcher has been provided
+var ErrNilTransactionStatusWatcher = errors.New("nil transaction status watcher")
+
+// ErrNilRelayerProcessor signals that a nil relayer processor has been provided
+var ErrNilRelayerProcessor = errors.New("nil relayer processor provided")
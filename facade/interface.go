@@ -1,11 +1,12 @@
 package facade
 
 import (
+	"context"
 	"math/big"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
-	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
@@ -14,136 +15,158 @@ import (
 type ActionsProcessor interface {
 	ReloadObservers() data.NodesReloadResponse
 	ReloadFullHistoryObservers() data.NodesReloadResponse
+	AddNode(nodesType data.NodeType, request *data.NodeAdminRequest) data.NodesReloadResponse
+	RemoveNode(nodesType data.NodeType, address string) data.NodesReloadResponse
+	DrainNode(nodesType data.NodeType, address string) data.NodesReloadResponse
+	UndrainNode(nodesType data.NodeType, address string) data.NodesReloadResponse
 }
 
 // AccountProcessor defines what an account request processor should do
 type AccountProcessor interface {
-	GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
-	GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
+	GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+	GetAccounts(ctx context.Context, addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
 	GetShardIDForAddress(address string) (uint32, error)
-	GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error)
-	GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetCodeHash(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
-	IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetValueForKey(ctx context.Context, address string, key string, options common.AccountQueryOptions) (string, error)
+	GetAllESDTTokens(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetKeyValuePairs(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTTokenData(ctx context.Context, address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTsWithRole(ctx context.Context, address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTsRoles(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTNftTokenData(ctx context.Context, address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetNFTTokenIDsRegisteredByAddress(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetCodeHash(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetGuardianData(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	IsDataTrieMigrated(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetTransactions(ctx context.Context, address string, options common.TransactionsHistoryOptions) (*data.DatabaseTransactionsPage, error)
 }
 
 // TransactionProcessor defines what a transaction request processor should do
 type TransactionProcessor interface {
-	SendTransaction(tx *data.Transaction) (int, string, error)
-	SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
-	SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
-	TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error)
-	GetTransactionStatus(txHash string, sender string) (string, error)
-	GetTransaction(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error)
-	GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error)
-	GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error)
+	SendTransaction(ctx context.Context, tx *data.Transaction) (int, string, error)
+	SendMultipleTransactions(ctx context.Context, txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
+	SimulateTransaction(ctx context.Context, tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
+	TransactionCostRequest(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error)
+	GetTransactionStatus(ctx context.Context, txHash string, sender string) (string, error)
+	GetTransaction(ctx context.Context, txHash string, withEvents bool) (*transaction.ApiTransactionResult, error)
+	GetProcessedTransactionStatus(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error)
+	GetTransactionProgressStatus(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error)
+	GetTransactionByHashAndSenderAddress(ctx context.Context, txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error)
 	ComputeTransactionHash(tx *data.Transaction) (string, error)
-	GetTransactionsPool(fields string) (*data.TransactionsPool, error)
-	GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error)
-	GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error)
-	GetLastPoolNonceForSender(sender string) (uint64, error)
-	GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error)
+	GetTransactionsPool(ctx context.Context, fields string) (*data.TransactionsPool, error)
+	GetTransactionsPoolForShard(ctx context.Context, shardID uint32, fields string) (*data.TransactionsPool, error)
+	GetTransactionsPoolForSender(ctx context.Context, sender, fields string) (*data.TransactionsPoolForSender, error)
+	GetLastPoolNonceForSender(ctx context.Context, sender string) (uint64, error)
+	GetTransactionsPoolNonceGapsForSender(ctx context.Context, sender string) (*data.TransactionsPoolNonceGaps, error)
+	IsInterfaceNil() bool
 }
 
 // ProofProcessor defines what a proof request processor should do
 type ProofProcessor interface {
-	GetProof(rootHash string, address string) (*data.GenericAPIResponse, error)
-	GetProofDataTrie(rootHash string, address string, key string) (*data.GenericAPIResponse, error)
-	GetProofCurrentRootHash(address string) (*data.GenericAPIResponse, error)
-	VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error)
+	GetProof(ctx context.Context, rootHash string, address string) (*data.GenericAPIResponse, error)
+	GetProofDataTrie(ctx context.Context, rootHash string, address string, key string) (*data.GenericAPIResponse, error)
+	GetProofCurrentRootHash(ctx context.Context, address string) (*data.GenericAPIResponse, error)
+	VerifyProof(ctx context.Context, rootHash string, address string, proof []string) (*data.GenericAPIResponse, error)
 }
 
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
-	ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
 }
 
 // NodeGroupProcessor defines what a node group processor should do
 type NodeGroupProcessor interface {
-	GetHeartbeatData() (*data.HeartbeatResponse, error)
-	IsOldStorageForToken(tokenID string, nonce uint64) (bool, error)
-	GetWaitingEpochsLeftForPublicKey(publicKey string) (*data.WaitingEpochsLeftApiResponse, error)
+	GetHeartbeatData(ctx context.Context) (*data.HeartbeatResponse, error)
+	IsOldStorageForToken(ctx context.Context, tokenID string, nonce uint64) (bool, error)
+	GetWaitingEpochsLeftForPublicKey(ctx context.Context, publicKey string) (*data.WaitingEpochsLeftApiResponse, error)
 }
 
 // ValidatorStatisticsProcessor defines what a validator statistics processor should do
 type ValidatorStatisticsProcessor interface {
-	GetValidatorStatistics() (*data.ValidatorStatisticsResponse, error)
-	GetAuctionList() (*data.AuctionListResponse, error)
+	GetValidatorStatistics(ctx context.Context) (*data.ValidatorStatisticsResponse, error)
+	GetAuctionList(ctx context.Context) (*data.AuctionListResponse, error)
 }
 
 // ESDTSupplyProcessor defines what an esdt supply processor should do
 type ESDTSupplyProcessor interface {
-	GetESDTSupply(token string) (*data.ESDTSupplyResponse, error)
+	GetESDTSupply(ctx context.Context, token string) (*data.ESDTSupplyResponse, error)
 }
 
 // NodeStatusProcessor defines what a node status processor should do
 type NodeStatusProcessor interface {
-	GetNetworkConfigMetrics() (*data.GenericAPIResponse, error)
-	GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error)
+	GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error)
 	GetEconomicsDataMetrics() (*data.GenericAPIResponse, error)
-	GetLatestFullySynchronizedHyperblockNonce() (uint64, error)
-	GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error)
-	GetEnableEpochsMetrics() (*data.GenericAPIResponse, error)
-	GetDirectStakedInfo() (*data.GenericAPIResponse, error)
-	GetDelegatedInfo() (*data.GenericAPIResponse, error)
-	GetRatingsConfig() (*data.GenericAPIResponse, error)
-	GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error)
-	GetGasConfigs() (*data.GenericAPIResponse, error)
-	GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPIResponse, error)
-	GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error)
+	GetLatestFullySynchronizedHyperblockNonce(ctx context.Context) (uint64, error)
+	GetAllIssuedESDTs(ctx context.Context, tokenType string) (*data.GenericAPIResponse, error)
+	GetEnableEpochsMetrics(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetDirectStakedInfo(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetDelegatedInfo(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetRatingsConfig(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetGenesisNodesPubKeys(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetGasConfigs(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetTriesStatistics(ctx context.Context, shardID uint32) (*data.TrieStatisticsAPIResponse, error)
+	GetEpochStartData(ctx context.Context, epoch uint32, shardID uint32) (*data.GenericAPIResponse, error)
 }
 
 // BlocksProcessor defines what a blocks processor should do
 type BlocksProcessor interface {
-	GetBlocksByRound(round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
+	GetBlocksByRound(ctx context.Context, round uint64, options common.BlockQueryOptions) (*data.BlocksApiResponse, error)
 }
 
 // BlockProcessor defines what a block processor should do
 type BlockProcessor interface {
-	GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
-	GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
-	GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
-	GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
-
-	GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
-	GetInternalBlockByNonce(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
-	GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
-	GetInternalStartOfEpochMetaBlock(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
-
-	GetAlteredAccountsByNonce(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
-	GetAlteredAccountsByHash(shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
-	GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*data.ValidatorsInfoApiResponse, error)
+	GetBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error)
+	GetHyperBlockByHash(ctx context.Context, hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+
+	GetInternalBlockByHash(ctx context.Context, shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
+	GetInternalBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
+	GetInternalMiniBlockByHash(ctx context.Context, shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error)
+	GetInternalStartOfEpochMetaBlock(ctx context.Context, epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error)
+
+	GetAlteredAccountsByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
+	GetAlteredAccountsByHash(ctx context.Context, shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error)
+	GetInternalStartOfEpochValidatorsInfo(ctx context.Context, epoch uint32) (*data.ValidatorsInfoApiResponse, error)
+
+	GetDatabaseBlockByNonce(ctx context.Context, shardID uint32, nonce uint64) (*data.DatabaseBlock, error)
+	GetTransactionsByMiniblockHash(ctx context.Context, hash string) ([]data.DatabaseTransaction, error)
 }
 
 // FaucetProcessor defines what a component which will handle faucets should do
 type FaucetProcessor interface {
 	IsEnabled() bool
-	SenderDetailsFromPem(receiver string) (crypto.PrivateKey, string, error)
-	GenerateTxForSendUserFunds(
-		senderSk crypto.PrivateKey,
-		senderPk string,
-		senderNonce uint64,
-		receiver string,
-		value *big.Int,
-		networkConfig *data.NetworkConfig,
-	) (*data.Transaction, error)
+	SendUserFunds(ctx context.Context, receiver string, value *big.Int, clientIP string) ([]string, error)
+}
+
+// RelayerProcessor defines what a component which will co-sign and send the relayed transactions should do
+type RelayerProcessor interface {
+	IsEnabled() bool
+	GetRelayerAddress(sender string) (string, error)
+	RelayTransaction(ctx context.Context, tx *data.Transaction) (int, string, error)
 }
 
 // StatusProcessor defines what a component which will handle status request should do
 type StatusProcessor interface {
 	GetMetrics() map[string]*data.EndpointMetrics
 	GetMetricsForPrometheus() string
+	GetNodesCircuitBreakerStatus() *data.NodesCircuitBreakerStatus
+	GetConnectionPoolMetrics() *data.ConnectionPoolMetrics
 }
 
 // AboutInfoProcessor defines the behaviour of about info processor
 type AboutInfoProcessor interface {
 	GetAboutInfo() *data.GenericAPIResponse
-	GetNodesVersions() (*data.GenericAPIResponse, error)
+	GetNodesVersions(ctx context.Context) (*data.GenericAPIResponse, error)
+}
+
+// HyperblockStreamer defines what a component able to stream the new hyperblocks should do
+type HyperblockStreamer interface {
+	Subscribe(fromNonce core.OptionalUint64) (data.HyperblockSubscriptionHandler, error)
+}
+
+// TransactionStatusWatcher defines what a component able to follow the status transitions of transactions should do
+type TransactionStatusWatcher interface {
+	SendTransactionAndWait(ctx context.Context, tx *data.Transaction) (int, *data.TransactionStatusEvent, error)
+	WatchTransaction(txHash string) (data.TransactionStatusSubscriptionHandler, error)
 }
@@ -1,6 +1,10 @@
 package mock
 
-import "github.com/multiversx/mx-chain-proxy-go/data"
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
 
 // AboutInfoProcessorStub -
 type AboutInfoProcessorStub struct {
@@ -18,7 +22,7 @@ func (stub *AboutInfoProcessorStub) GetAboutInfo() *data.GenericAPIResponse {
 }
 
 // GetNodesVersions -
-func (stub *AboutInfoProcessorStub) GetNodesVersions() (*data.GenericAPIResponse, error) {
+func (stub *AboutInfoProcessorStub) GetNodesVersions(_ context.Context) (*data.GenericAPIResponse, error) {
 	if stub.GetNodesVersionsCalled != nil {
 		return stub.GetNodesVersionsCalled()
 	}
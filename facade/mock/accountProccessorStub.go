@@ -1,17 +1,18 @@
 package mock
 
 import (
+	"context"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // AccountProcessorStub -
 type AccountProcessorStub struct {
-	GetAccountCalled                        func(address string, options common.AccountQueryOptions) (*data.AccountModel, error)
-	GetAccountsCalled                       func(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
+	GetAccountCalled                        func(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+	GetAccountsCalled                       func(ctx context.Context, addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error)
 	GetValueForKeyCalled                    func(address string, key string, options common.AccountQueryOptions) (string, error)
 	GetShardIDForAddressCalled              func(address string) (uint32, error)
-	GetTransactionsCalled                   func(address string) ([]data.DatabaseTransaction, error)
+	GetTransactionsCalled                   func(address string, options common.TransactionsHistoryOptions) (*data.DatabaseTransactionsPage, error)
 	ValidatorStatisticsCalled               func() (map[string]*data.ValidatorApiResponse, error)
 	GetAllESDTTokensCalled                  func(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
 	GetESDTTokenDataCalled                  func(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error)
@@ -26,32 +27,32 @@ type AccountProcessorStub struct {
 }
 
 // GetKeyValuePairs -
-func (aps *AccountProcessorStub) GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetKeyValuePairs(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetKeyValuePairsCalled(address, options)
 }
 
 // GetAllESDTTokens -
-func (aps *AccountProcessorStub) GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetAllESDTTokens(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetAllESDTTokensCalled(address, options)
 }
 
 // GetESDTTokenData -
-func (aps *AccountProcessorStub) GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetESDTTokenData(_ context.Context, address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetESDTTokenDataCalled(address, key, options)
 }
 
 // GetESDTNftTokenData -
-func (aps *AccountProcessorStub) GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetESDTNftTokenData(_ context.Context, address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetESDTNftTokenDataCalled(address, key, nonce, options)
 }
 
 // GetESDTsWithRole -
-func (aps *AccountProcessorStub) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetESDTsWithRole(_ context.Context, address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetESDTsWithRoleCalled(address, role, options)
 }
 
 // GetESDTsRoles -
-func (aps *AccountProcessorStub) GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetESDTsRoles(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if aps.GetESDTsRolesCalled != nil {
 		return aps.GetESDTsRolesCalled(address, options)
 	}
@@ -60,27 +61,27 @@ func (aps *AccountProcessorStub) GetESDTsRoles(address string, options common.Ac
 }
 
 // GetNFTTokenIDsRegisteredByAddress -
-func (aps *AccountProcessorStub) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetNFTTokenIDsRegisteredByAddress(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetNFTTokenIDsRegisteredByAddressCalled(address, options)
 }
 
 // GetAccount -
-func (aps *AccountProcessorStub) GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
-	return aps.GetAccountCalled(address, options)
+func (aps *AccountProcessorStub) GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+	return aps.GetAccountCalled(ctx, address, options)
 }
 
 // GetAccounts -
-func (aps *AccountProcessorStub) GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
-	return aps.GetAccountsCalled(addresses, options)
+func (aps *AccountProcessorStub) GetAccounts(ctx context.Context, addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
+	return aps.GetAccountsCalled(ctx, addresses, options)
 }
 
 // GetValueForKey -
-func (aps *AccountProcessorStub) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
+func (aps *AccountProcessorStub) GetValueForKey(_ context.Context, address string, key string, options common.AccountQueryOptions) (string, error) {
 	return aps.GetValueForKeyCalled(address, key, options)
 }
 
 // GetGuardianData -
-func (aps *AccountProcessorStub) GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetGuardianData(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetGuardianDataCalled(address, options)
 }
 
@@ -90,7 +91,7 @@ func (aps *AccountProcessorStub) GetShardIDForAddress(address string) (uint32, e
 }
 
 // GetCodeHash -
-func (aps *AccountProcessorStub) GetCodeHash(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetCodeHash(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	return aps.GetCodeHashCalled(address, options)
 }
 
@@ -100,7 +101,7 @@ func (aps *AccountProcessorStub) ValidatorStatistics() (map[string]*data.Validat
 }
 
 // IsDataTrieMigrated --
-func (aps *AccountProcessorStub) IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) IsDataTrieMigrated(_ context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	if aps.IsDataTrieMigratedCalled != nil {
 		return aps.IsDataTrieMigratedCalled(address, options)
 	}
@@ -112,3 +113,12 @@ func (aps *AccountProcessorStub) IsDataTrieMigrated(address string, options comm
 func (aps *AccountProcessorStub) AuctionList() ([]*data.AuctionListValidatorAPIResponse, error) {
 	return nil, nil
 }
+
+// GetTransactions -
+func (aps *AccountProcessorStub) GetTransactions(_ context.Context, address string, options common.TransactionsHistoryOptions) (*data.DatabaseTransactionsPage, error) {
+	if aps.GetTransactionsCalled != nil {
+		return aps.GetTransactionsCalled(address, options)
+	}
+
+	return nil, nil
+}
@@ -8,6 +8,10 @@ import (
 type ActionsProcessorStub struct {
 	ReloadObserversCalled            func() data.NodesReloadResponse
 	ReloadFullHistoryObserversCalled func() data.NodesReloadResponse
+	AddNodeCalled                    func(nodesType data.NodeType, request *data.NodeAdminRequest) data.NodesReloadResponse
+	RemoveNodeCalled                 func(nodesType data.NodeType, address string) data.NodesReloadResponse
+	DrainNodeCalled                  func(nodesType data.NodeType, address string) data.NodesReloadResponse
+	UndrainNodeCalled                func(nodesType data.NodeType, address string) data.NodesReloadResponse
 }
 
 // ReloadObservers -
@@ -27,3 +31,39 @@ func (a *ActionsProcessorStub) ReloadFullHistoryObservers() data.NodesReloadResp
 
 	return data.NodesReloadResponse{}
 }
+
+// AddNode -
+func (a *ActionsProcessorStub) AddNode(nodesType data.NodeType, request *data.NodeAdminRequest) data.NodesReloadResponse {
+	if a.AddNodeCalled != nil {
+		return a.AddNodeCalled(nodesType, request)
+	}
+
+	return data.NodesReloadResponse{}
+}
+
+// RemoveNode -
+func (a *ActionsProcessorStub) RemoveNode(nodesType data.NodeType, address string) data.NodesReloadResponse {
+	if a.RemoveNodeCalled != nil {
+		return a.RemoveNodeCalled(nodesType, address)
+	}
+
+	return data.NodesReloadResponse{}
+}
+
+// DrainNode -
+func (a *ActionsProcessorStub) DrainNode(nodesType data.NodeType, address string) data.NodesReloadResponse {
+	if a.DrainNodeCalled != nil {
+		return a.DrainNodeCalled(nodesType, address)
+	}
+
+	return data.NodesReloadResponse{}
+}
+
+// UndrainNode -
+func (a *ActionsProcessorStub) UndrainNode(nodesType data.NodeType, address string) data.NodesReloadResponse {
+	if a.UndrainNodeCalled != nil {
+		return a.UndrainNodeCalled(nodesType, address)
+	}
+
+	return data.NodesReloadResponse{}
+}
@@ -1,6 +1,10 @@
 package mock
 
-import "github.com/multiversx/mx-chain-proxy-go/data"
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
 
 // ESDTSuppliesProcessorStub -
 type ESDTSuppliesProcessorStub struct {
@@ -8,7 +12,7 @@ type ESDTSuppliesProcessorStub struct {
 }
 
 // GetESDTSupply -
-func (e *ESDTSuppliesProcessorStub) GetESDTSupply(token string) (*data.ESDTSupplyResponse, error) {
+func (e *ESDTSuppliesProcessorStub) GetESDTSupply(_ context.Context, token string) (*data.ESDTSupplyResponse, error) {
 	if e.GetESDTSupplyCalled != nil {
 		return e.GetESDTSupplyCalled(token)
 	}
@@ -1,17 +1,13 @@
 package mock
 
 import (
+	"context"
 	"math/big"
-
-	crypto "github.com/multiversx/mx-chain-crypto-go"
-	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 type FaucetProcessorStub struct {
-	IsEnabledCalled                  func() bool
-	GenerateTxForSendUserFundsCalled func(senderSk crypto.PrivateKey, senderPk string, senderNonce uint64,
-		receiver string, value *big.Int, networkConfig *data.NetworkConfig) (*data.Transaction, error)
-	SenderDetailsFromPemCalled func(receiver string) (crypto.PrivateKey, string, error)
+	IsEnabledCalled     func() bool
+	SendUserFundsCalled func(receiver string, value *big.Int, clientIP string) ([]string, error)
 }
 
 func (fps *FaucetProcessorStub) IsEnabled() bool {
@@ -22,17 +18,10 @@ func (fps *FaucetProcessorStub) IsEnabled() bool {
 	return true
 }
 
-func (fps *FaucetProcessorStub) SenderDetailsFromPem(receiver string) (crypto.PrivateKey, string, error) {
-	return fps.SenderDetailsFromPemCalled(receiver)
-}
+func (fps *FaucetProcessorStub) SendUserFunds(_ context.Context, receiver string, value *big.Int, clientIP string) ([]string, error) {
+	if fps.SendUserFundsCalled != nil {
+		return fps.SendUserFundsCalled(receiver, value, clientIP)
+	}
 
-func (fps *FaucetProcessorStub) GenerateTxForSendUserFunds(
-	senderSk crypto.PrivateKey,
-	senderPk string,
-	senderNonce uint64,
-	receiver string,
-	value *big.Int,
-	networkConfig *data.NetworkConfig,
-) (*data.Transaction, error) {
-	return fps.GenerateTxForSendUserFundsCalled(senderSk, senderPk, senderNonce, receiver, value, networkConfig)
+	return make([]string, 0), nil
 }
@@ -0,0 +1,20 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// HyperblockStreamerStub -
+type HyperblockStreamerStub struct {
+	SubscribeCalled func(fromNonce core.OptionalUint64) (data.HyperblockSubscriptionHandler, error)
+}
+
+// Subscribe -
+func (stub *HyperblockStreamerStub) Subscribe(fromNonce core.OptionalUint64) (data.HyperblockSubscriptionHandler, error) {
+	if stub.SubscribeCalled != nil {
+		return stub.SubscribeCalled(fromNonce)
+	}
+
+	return nil, nil
+}
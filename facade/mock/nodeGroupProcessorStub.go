@@ -1,26 +1,30 @@
 package mock
 
-import "github.com/multiversx/mx-chain-proxy-go/data"
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
 
 // NodeGroupProcessorStub represents a stub implementation of a NodeGroupProcessor
 type NodeGroupProcessorStub struct {
-	GetHeartbeatDataCalled                 func() (*data.HeartbeatResponse, error)
+	GetHeartbeatDataCalled                 func(ctx context.Context) (*data.HeartbeatResponse, error)
 	IsOldStorageForTokenCalled             func(tokenID string, nonce uint64) (bool, error)
 	GetWaitingEpochsLeftForPublicKeyCalled func(publicKey string) (*data.WaitingEpochsLeftApiResponse, error)
 }
 
 // IsOldStorageForToken -
-func (hbps *NodeGroupProcessorStub) IsOldStorageForToken(tokenID string, nonce uint64) (bool, error) {
+func (hbps *NodeGroupProcessorStub) IsOldStorageForToken(_ context.Context, tokenID string, nonce uint64) (bool, error) {
 	return hbps.IsOldStorageForTokenCalled(tokenID, nonce)
 }
 
 // GetHeartbeatData -
-func (hbps *NodeGroupProcessorStub) GetHeartbeatData() (*data.HeartbeatResponse, error) {
-	return hbps.GetHeartbeatDataCalled()
+func (hbps *NodeGroupProcessorStub) GetHeartbeatData(ctx context.Context) (*data.HeartbeatResponse, error) {
+	return hbps.GetHeartbeatDataCalled(ctx)
 }
 
 // GetWaitingEpochsLeftForPublicKey -
-func (hbps *NodeGroupProcessorStub) GetWaitingEpochsLeftForPublicKey(publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
+func (hbps *NodeGroupProcessorStub) GetWaitingEpochsLeftForPublicKey(_ context.Context, publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
 	if hbps.GetWaitingEpochsLeftForPublicKeyCalled != nil {
 		return hbps.GetWaitingEpochsLeftForPublicKeyCalled(publicKey)
 	}
@@ -1,6 +1,10 @@
 package mock
 
-import "github.com/multiversx/mx-chain-proxy-go/data"
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
 
 // ProofProcessorStub -
 type ProofProcessorStub struct {
@@ -11,7 +15,7 @@ type ProofProcessorStub struct {
 }
 
 // GetProof -
-func (pp *ProofProcessorStub) GetProof(rootHash string, address string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessorStub) GetProof(_ context.Context, rootHash string, address string) (*data.GenericAPIResponse, error) {
 	if pp.GetProofCalled != nil {
 		return pp.GetProofCalled(rootHash, address)
 	}
@@ -20,7 +24,7 @@ func (pp *ProofProcessorStub) GetProof(rootHash string, address string) (*data.G
 }
 
 // GetProofDataTrie -
-func (pp *ProofProcessorStub) GetProofDataTrie(rootHash string, address string, key string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessorStub) GetProofDataTrie(_ context.Context, rootHash string, address string, key string) (*data.GenericAPIResponse, error) {
 	if pp.GetProofDataTrieCalled != nil {
 		return pp.GetProofDataTrieCalled(rootHash, address, key)
 	}
@@ -29,7 +33,7 @@ func (pp *ProofProcessorStub) GetProofDataTrie(rootHash string, address string,
 }
 
 // GetProofCurrentRootHash -
-func (pp *ProofProcessorStub) GetProofCurrentRootHash(address string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessorStub) GetProofCurrentRootHash(_ context.Context, address string) (*data.GenericAPIResponse, error) {
 	if pp.GetProofCurrentRootHashCalled != nil {
 		return pp.GetProofCurrentRootHashCalled(address)
 	}
@@ -38,7 +42,7 @@ func (pp *ProofProcessorStub) GetProofCurrentRootHash(address string) (*data.Gen
 }
 
 // VerifyProof -
-func (pp *ProofProcessorStub) VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessorStub) VerifyProof(_ context.Context, rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
 	if pp.VerifyProofCalled != nil {
 		return pp.VerifyProofCalled(rootHash, address, proof)
 	}
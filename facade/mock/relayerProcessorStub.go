@@ -0,0 +1,41 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// RelayerProcessorStub -
+type RelayerProcessorStub struct {
+	IsEnabledCalled         func() bool
+	GetRelayerAddressCalled func(sender string) (string, error)
+	RelayTransactionCalled  func(tx *data.Transaction) (int, string, error)
+}
+
+// IsEnabled -
+func (stub *RelayerProcessorStub) IsEnabled() bool {
+	if stub.IsEnabledCalled != nil {
+		return stub.IsEnabledCalled()
+	}
+
+	return true
+}
+
+// GetRelayerAddress -
+func (stub *RelayerProcessorStub) GetRelayerAddress(sender string) (string, error) {
+	if stub.GetRelayerAddressCalled != nil {
+		return stub.GetRelayerAddressCalled(sender)
+	}
+
+	return "", nil
+}
+
+// RelayTransaction -
+func (stub *RelayerProcessorStub) RelayTransaction(_ context.Context, tx *data.Transaction) (int, string, error) {
+	if stub.RelayTransactionCalled != nil {
+		return stub.RelayTransactionCalled(tx)
+	}
+
+	return 0, "", nil
+}
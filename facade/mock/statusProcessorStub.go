@@ -6,8 +6,10 @@ import (
 
 // StatusProcessorStub -
 type StatusProcessorStub struct {
-	GetMetricsCalled              func() map[string]*data.EndpointMetrics
-	GetMetricsForPrometheusCalled func() string
+	GetMetricsCalled                   func() map[string]*data.EndpointMetrics
+	GetMetricsForPrometheusCalled      func() string
+	GetNodesCircuitBreakerStatusCalled func() *data.NodesCircuitBreakerStatus
+	GetConnectionPoolMetricsCalled     func() *data.ConnectionPoolMetrics
 }
 
 // GetMetricsForPrometheus -
@@ -27,3 +29,21 @@ func (s *StatusProcessorStub) GetMetrics() map[string]*data.EndpointMetrics {
 
 	return nil
 }
+
+// GetNodesCircuitBreakerStatus -
+func (s *StatusProcessorStub) GetNodesCircuitBreakerStatus() *data.NodesCircuitBreakerStatus {
+	if s.GetNodesCircuitBreakerStatusCalled != nil {
+		return s.GetNodesCircuitBreakerStatusCalled()
+	}
+
+	return &data.NodesCircuitBreakerStatus{}
+}
+
+// GetConnectionPoolMetrics -
+func (s *StatusProcessorStub) GetConnectionPoolMetrics() *data.ConnectionPoolMetrics {
+	if s.GetConnectionPoolMetricsCalled != nil {
+		return s.GetConnectionPoolMetricsCalled()
+	}
+
+	return &data.ConnectionPoolMetrics{}
+}
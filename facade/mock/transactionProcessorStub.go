@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"errors"
 	"math/big"
 
@@ -17,10 +18,11 @@ type TransactionProcessorStub struct {
 	SimulateTransactionCalled                   func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
 	SendUserFundsCalled                         func(receiver string, value *big.Int) error
 	TransactionCostRequestCalled                func(tx *data.Transaction) (*data.TxCostResponseData, error)
-	GetTransactionStatusCalled                  func(txHash string, sender string) (string, error)
-	GetProcessedTransactionStatusCalled         func(txHash string) (*data.ProcessStatusResponse, error)
-	GetTransactionCalled                        func(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error)
-	GetTransactionByHashAndSenderAddressCalled  func(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error)
+	GetTransactionStatusCalled                  func(ctx context.Context, txHash string, sender string) (string, error)
+	GetProcessedTransactionStatusCalled         func(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error)
+	GetTransactionProgressStatusCalled          func(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error)
+	GetTransactionCalled                        func(ctx context.Context, txHash string, withEvents bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionByHashAndSenderAddressCalled  func(ctx context.Context, txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error)
 	ComputeTransactionHashCalled                func(tx *data.Transaction) (string, error)
 	GetTransactionsPoolCalled                   func(fields string) (*data.TransactionsPool, error)
 	GetTransactionsPoolForShardCalled           func(shardID uint32, fields string) (*data.TransactionsPool, error)
@@ -30,7 +32,7 @@ type TransactionProcessorStub struct {
 }
 
 // SimulateTransaction -
-func (tps *TransactionProcessorStub) SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+func (tps *TransactionProcessorStub) SimulateTransaction(_ context.Context, tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
 	if tps.SimulateTransactionCalled != nil {
 		return tps.SimulateTransactionCalled(tx, checkSignature)
 	}
@@ -39,7 +41,7 @@ func (tps *TransactionProcessorStub) SimulateTransaction(tx *data.Transaction, c
 }
 
 // SendTransaction -
-func (tps *TransactionProcessorStub) SendTransaction(tx *data.Transaction) (int, string, error) {
+func (tps *TransactionProcessorStub) SendTransaction(_ context.Context, tx *data.Transaction) (int, string, error) {
 	if tps.SendTransactionCalled != nil {
 		return tps.SendTransactionCalled(tx)
 	}
@@ -48,7 +50,7 @@ func (tps *TransactionProcessorStub) SendTransaction(tx *data.Transaction) (int,
 }
 
 // SendMultipleTransactions -
-func (tps *TransactionProcessorStub) SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+func (tps *TransactionProcessorStub) SendMultipleTransactions(_ context.Context, txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
 	if tps.SendMultipleTransactionsCalled != nil {
 		return tps.SendMultipleTransactionsCalled(txs)
 	}
@@ -75,43 +77,52 @@ func (tps *TransactionProcessorStub) SendUserFunds(receiver string, value *big.I
 }
 
 // GetTransactionStatus -
-func (tps *TransactionProcessorStub) GetTransactionStatus(txHash string, sender string) (string, error) {
+func (tps *TransactionProcessorStub) GetTransactionStatus(ctx context.Context, txHash string, sender string) (string, error) {
 	if tps.GetTransactionStatusCalled != nil {
-		return tps.GetTransactionStatusCalled(txHash, sender)
+		return tps.GetTransactionStatusCalled(ctx, txHash, sender)
 	}
 
 	return "", errNotImplemented
 }
 
 // GetProcessedTransactionStatus -
-func (tps *TransactionProcessorStub) GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error) {
+func (tps *TransactionProcessorStub) GetProcessedTransactionStatus(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error) {
 	if tps.GetProcessedTransactionStatusCalled != nil {
-		return tps.GetProcessedTransactionStatusCalled(txHash)
+		return tps.GetProcessedTransactionStatusCalled(ctx, txHash)
+	}
+
+	return &data.ProcessStatusResponse{}, errNotImplemented
+}
+
+// GetTransactionProgressStatus -
+func (tps *TransactionProcessorStub) GetTransactionProgressStatus(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error) {
+	if tps.GetTransactionProgressStatusCalled != nil {
+		return tps.GetTransactionProgressStatusCalled(ctx, txHash)
 	}
 
 	return &data.ProcessStatusResponse{}, errNotImplemented
 }
 
 // GetTransaction -
-func (tps *TransactionProcessorStub) GetTransaction(txHash string, withEvents bool) (*transaction.ApiTransactionResult, error) {
+func (tps *TransactionProcessorStub) GetTransaction(ctx context.Context, txHash string, withEvents bool) (*transaction.ApiTransactionResult, error) {
 	if tps.GetTransactionCalled != nil {
-		return tps.GetTransactionCalled(txHash, withEvents)
+		return tps.GetTransactionCalled(ctx, txHash, withEvents)
 	}
 
 	return nil, errNotImplemented
 }
 
 // GetTransactionByHashAndSenderAddress -
-func (tps *TransactionProcessorStub) GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
+func (tps *TransactionProcessorStub) GetTransactionByHashAndSenderAddress(ctx context.Context, txHash string, sndAddr string, withEvents bool) (*transaction.ApiTransactionResult, int, error) {
 	if tps.GetTransactionByHashAndSenderAddressCalled != nil {
-		return tps.GetTransactionByHashAndSenderAddressCalled(txHash, sndAddr, withEvents)
+		return tps.GetTransactionByHashAndSenderAddressCalled(ctx, txHash, sndAddr, withEvents)
 	}
 
 	return nil, 0, errNotImplemented
 }
 
 // TransactionCostRequest -
-func (tps *TransactionProcessorStub) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
+func (tps *TransactionProcessorStub) TransactionCostRequest(_ context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
 	if tps.TransactionCostRequestCalled != nil {
 		return tps.TransactionCostRequestCalled(tx)
 	}
@@ -120,7 +131,7 @@ func (tps *TransactionProcessorStub) TransactionCostRequest(tx *data.Transaction
 }
 
 // GetTransactionsPool -
-func (tps *TransactionProcessorStub) GetTransactionsPool(fields string) (*data.TransactionsPool, error) {
+func (tps *TransactionProcessorStub) GetTransactionsPool(_ context.Context, fields string) (*data.TransactionsPool, error) {
 	if tps.GetTransactionsPoolCalled != nil {
 		return tps.GetTransactionsPoolCalled(fields)
 	}
@@ -129,7 +140,7 @@ func (tps *TransactionProcessorStub) GetTransactionsPool(fields string) (*data.T
 }
 
 // GetTransactionsPoolForShard -
-func (tps *TransactionProcessorStub) GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
+func (tps *TransactionProcessorStub) GetTransactionsPoolForShard(_ context.Context, shardID uint32, fields string) (*data.TransactionsPool, error) {
 	if tps.GetTransactionsPoolForShardCalled != nil {
 		return tps.GetTransactionsPoolForShardCalled(shardID, fields)
 	}
@@ -138,7 +149,7 @@ func (tps *TransactionProcessorStub) GetTransactionsPoolForShard(shardID uint32,
 }
 
 // GetTransactionsPoolForSender -
-func (tps *TransactionProcessorStub) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
+func (tps *TransactionProcessorStub) GetTransactionsPoolForSender(_ context.Context, sender, fields string) (*data.TransactionsPoolForSender, error) {
 	if tps.GetTransactionsPoolForSenderCalled != nil {
 		return tps.GetTransactionsPoolForSenderCalled(sender, fields)
 	}
@@ -147,7 +158,7 @@ func (tps *TransactionProcessorStub) GetTransactionsPoolForSender(sender, fields
 }
 
 // GetLastPoolNonceForSender -
-func (tps *TransactionProcessorStub) GetLastPoolNonceForSender(sender string) (uint64, error) {
+func (tps *TransactionProcessorStub) GetLastPoolNonceForSender(_ context.Context, sender string) (uint64, error) {
 	if tps.GetLastPoolNonceForSenderCalled != nil {
 		return tps.GetLastPoolNonceForSenderCalled(sender)
 	}
@@ -156,10 +167,15 @@ func (tps *TransactionProcessorStub) GetLastPoolNonceForSender(sender string) (u
 }
 
 // GetTransactionsPoolNonceGapsForSender -
-func (tps *TransactionProcessorStub) GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
+func (tps *TransactionProcessorStub) GetTransactionsPoolNonceGapsForSender(_ context.Context, sender string) (*data.TransactionsPoolNonceGaps, error) {
 	if tps.GetTransactionsPoolNonceGapsForSenderCalled != nil {
 		return tps.GetTransactionsPoolNonceGapsForSenderCalled(sender)
 	}
 
 	return nil, errNotImplemented
 }
+
+// IsInterfaceNil -
+func (tps *TransactionProcessorStub) IsInterfaceNil() bool {
+	return tps == nil
+}
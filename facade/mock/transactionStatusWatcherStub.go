@@ -0,0 +1,31 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// TransactionStatusWatcherStub -
+type TransactionStatusWatcherStub struct {
+	SendTransactionAndWaitCalled func(ctx context.Context, tx *data.Transaction) (int, *data.TransactionStatusEvent, error)
+	WatchTransactionCalled       func(txHash string) (data.TransactionStatusSubscriptionHandler, error)
+}
+
+// SendTransactionAndWait -
+func (stub *TransactionStatusWatcherStub) SendTransactionAndWait(ctx context.Context, tx *data.Transaction) (int, *data.TransactionStatusEvent, error) {
+	if stub.SendTransactionAndWaitCalled != nil {
+		return stub.SendTransactionAndWaitCalled(ctx, tx)
+	}
+
+	return 0, nil, nil
+}
+
+// WatchTransaction -
+func (stub *TransactionStatusWatcherStub) WatchTransaction(txHash string) (data.TransactionStatusSubscriptionHandler, error) {
+	if stub.WatchTransactionCalled != nil {
+		return stub.WatchTransactionCalled(txHash)
+	}
+
+	return nil, nil
+}
@@ -10,3 +10,12 @@ var ErrFaucetPemFileDoesNotExist = errors.New("faucet pem file does not exist")
 
 // ErrNilPubKeyConverter signals that the provided pub key converter is nil
 var ErrNilPubKeyConverter = errors.New("nil pub key converter")
+
+// ErrEmptyLedgerFilePath signals that an empty payouts ledger file path has been provided
+var ErrEmptyLedgerFilePath = errors.New("empty payouts ledger file path")
+
+// ErrNilPayoutsLedger signals that a nil payouts ledger has been provided
+var ErrNilPayoutsLedger = errors.New("nil payouts ledger")
+
+// ErrNilFaucetPayout signals that a nil faucet payout has been provided
+var ErrNilFaucetPayout = errors.New("nil faucet payout")
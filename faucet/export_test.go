@@ -0,0 +1,13 @@
+package faucet
+
+import "time"
+
+// SetTimeHandler -
+func (pl *PayoutsLimiter) SetTimeHandler(handler func() time.Time) {
+	pl.getTimeHandler = handler
+}
+
+// SetTimeHandler -
+func (pl *PayoutsLedger) SetTimeHandler(handler func() time.Time) {
+	pl.getTimeHandler = handler
+}
@@ -0,0 +1,14 @@
+package faucet
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// PayoutsLedgerHandler defines what a payouts ledger should be able to do
+type PayoutsLedgerHandler interface {
+	LoadPayouts(since time.Time) ([]*data.FaucetPayout, error)
+	Append(payout *data.FaucetPayout, since time.Time) error
+	IsInterfaceNil() bool
+}
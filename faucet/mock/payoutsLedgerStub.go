@@ -0,0 +1,36 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// PayoutsLedgerStub -
+type PayoutsLedgerStub struct {
+	LoadPayoutsCalled func(since time.Time) ([]*data.FaucetPayout, error)
+	AppendCalled      func(payout *data.FaucetPayout, since time.Time) error
+}
+
+// LoadPayouts -
+func (stub *PayoutsLedgerStub) LoadPayouts(since time.Time) ([]*data.FaucetPayout, error) {
+	if stub.LoadPayoutsCalled != nil {
+		return stub.LoadPayoutsCalled(since)
+	}
+
+	return make([]*data.FaucetPayout, 0), nil
+}
+
+// Append -
+func (stub *PayoutsLedgerStub) Append(payout *data.FaucetPayout, since time.Time) error {
+	if stub.AppendCalled != nil {
+		return stub.AppendCalled(payout, since)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *PayoutsLedgerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,185 @@
+package faucet
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const ledgerFilePermissions = 0644
+
+// ledgerPruneInterval is the minimum duration between two removals of the expired payouts from the file
+const ledgerPruneInterval = time.Hour
+
+var log = logger.GetOrCreate("faucet")
+
+// PayoutsLedger persists the faucet payouts in a local file, one JSON payout per line, so the faucet limits survive
+// the restarts of the proxy
+type PayoutsLedger struct {
+	mut            sync.Mutex
+	filePath       string
+	lastPruneTime  time.Time
+	getTimeHandler func() time.Time
+}
+
+// NewPayoutsLedger creates a new instance of PayoutsLedger. The file and its directory are created if missing
+func NewPayoutsLedger(filePath string) (*PayoutsLedger, error) {
+	if len(filePath) == 0 {
+		return nil, ErrEmptyLedgerFilePath
+	}
+
+	err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PayoutsLedger{
+		filePath:       filePath,
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+// LoadPayouts returns the payouts recorded since the provided time. The older payouts are removed from the file
+func (pl *PayoutsLedger) LoadPayouts(since time.Time) ([]*data.FaucetPayout, error) {
+	pl.mut.Lock()
+	defer pl.mut.Unlock()
+
+	return pl.prunePayouts(since)
+}
+
+// prunePayouts removes the payouts older than the provided time from the file and returns the remaining ones
+func (pl *PayoutsLedger) prunePayouts(since time.Time) ([]*data.FaucetPayout, error) {
+	payouts, err := pl.readPayouts(since.Unix())
+	if err != nil {
+		return nil, err
+	}
+
+	err = pl.rewritePayouts(payouts)
+	if err != nil {
+		return nil, err
+	}
+
+	pl.lastPruneTime = pl.getTimeHandler()
+
+	return payouts, nil
+}
+
+func (pl *PayoutsLedger) readPayouts(sinceTimestamp int64) ([]*data.FaucetPayout, error) {
+	file, err := os.Open(pl.filePath)
+	if os.IsNotExist(err) {
+		return make([]*data.FaucetPayout, 0), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	payouts := make([]*data.FaucetPayout, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		payout := &data.FaucetPayout{}
+		err = json.Unmarshal(line, payout)
+		if err != nil {
+			// a line might be truncated if the proxy was stopped while writing it
+			log.Warn("PayoutsLedger: skipping invalid payout", "file", pl.filePath, "error", err.Error())
+			continue
+		}
+		if payout.Timestamp < sinceTimestamp {
+			continue
+		}
+
+		payouts = append(payouts, payout)
+	}
+
+	return payouts, scanner.Err()
+}
+
+// rewritePayouts replaces the content of the file with the provided payouts, through a temporary file, so the ledger
+// is not lost if the proxy is stopped meanwhile
+func (pl *PayoutsLedger) rewritePayouts(payouts []*data.FaucetPayout) error {
+	tempFilePath := pl.filePath + ".tmp"
+	file, err := os.OpenFile(tempFilePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, ledgerFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	err = writePayouts(file, payouts)
+	errClose := file.Close()
+	if err != nil {
+		return err
+	}
+	if errClose != nil {
+		return errClose
+	}
+
+	return os.Rename(tempFilePath, pl.filePath)
+}
+
+// Append records the provided payout at the end of the file. The payouts older than the provided time are removed from
+// the file as well, at most once per ledgerPruneInterval, so the file does not grow while the proxy is running
+func (pl *PayoutsLedger) Append(payout *data.FaucetPayout, since time.Time) error {
+	pl.mut.Lock()
+	defer pl.mut.Unlock()
+
+	file, err := os.OpenFile(pl.filePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, ledgerFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	err = writePayouts(file, []*data.FaucetPayout{payout})
+	errClose := file.Close()
+	if err != nil {
+		return err
+	}
+	if errClose != nil {
+		return errClose
+	}
+
+	if pl.getTimeHandler().Sub(pl.lastPruneTime) < ledgerPruneInterval {
+		return nil
+	}
+
+	_, err = pl.prunePayouts(since)
+
+	return err
+}
+
+func writePayouts(file *os.File, payouts []*data.FaucetPayout) error {
+	writer := bufio.NewWriter(file)
+	for _, payout := range payouts {
+		payoutBytes, err := json.Marshal(payout)
+		if err != nil {
+			return err
+		}
+
+		_, err = writer.Write(append(payoutBytes, '\n'))
+		if err != nil {
+			return err
+		}
+	}
+
+	err := writer.Flush()
+	if err != nil {
+		return err
+	}
+
+	return file.Sync()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pl *PayoutsLedger) IsInterfaceNil() bool {
+	return pl == nil
+}
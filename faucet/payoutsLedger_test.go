@@ -0,0 +1,117 @@
+package faucet_test
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/faucet"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestPayout(receiver string, timestamp int64) *data.FaucetPayout {
+	return &data.FaucetPayout{
+		Receiver:  receiver,
+		ClientIP:  "127.0.0.1",
+		Amounts:   map[string]*big.Int{data.EGLDTokenIdentifier: big.NewInt(100)},
+		TxHashes:  []string{"hash"},
+		Timestamp: timestamp,
+	}
+}
+
+func TestNewPayoutsLedger(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty file path should error", func(t *testing.T) {
+		t.Parallel()
+
+		pl, err := faucet.NewPayoutsLedger("")
+		require.Nil(t, pl)
+		require.Equal(t, faucet.ErrEmptyLedgerFilePath, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pl, err := faucet.NewPayoutsLedger(filepath.Join(t.TempDir(), "faucet", "payouts.json"))
+		require.NoError(t, err)
+		require.False(t, pl.IsInterfaceNil())
+	})
+}
+
+func TestPayoutsLedger_AppendAndLoadPayouts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file should return no payouts", func(t *testing.T) {
+		t.Parallel()
+
+		pl, _ := faucet.NewPayoutsLedger(filepath.Join(t.TempDir(), "payouts.json"))
+		payouts, err := pl.LoadPayouts(time.Unix(0, 0))
+		require.NoError(t, err)
+		require.Empty(t, payouts)
+	})
+	t.Run("appended payouts should survive a new ledger instance", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "payouts.json")
+		pl, _ := faucet.NewPayoutsLedger(filePath)
+		require.NoError(t, pl.Append(createTestPayout("alice", 1000), time.Unix(0, 0)))
+		require.NoError(t, pl.Append(createTestPayout("bob", 2000), time.Unix(0, 0)))
+
+		pl, _ = faucet.NewPayoutsLedger(filePath)
+		payouts, err := pl.LoadPayouts(time.Unix(0, 0))
+		require.NoError(t, err)
+		require.Equal(t, []*data.FaucetPayout{createTestPayout("alice", 1000), createTestPayout("bob", 2000)}, payouts)
+	})
+	t.Run("old payouts and invalid lines should be removed from the file", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "payouts.json")
+		pl, _ := faucet.NewPayoutsLedger(filePath)
+		require.NoError(t, pl.Append(createTestPayout("alice", 1000), time.Unix(0, 0)))
+		require.NoError(t, pl.Append(createTestPayout("bob", 2000), time.Unix(0, 0)))
+
+		file, err := os.OpenFile(filePath, os.O_APPEND|os.O_WRONLY, 0644)
+		require.NoError(t, err)
+		_, err = file.WriteString(`{"receiver":"carol","amou`)
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+
+		payouts, err := pl.LoadPayouts(time.Unix(1500, 0))
+		require.NoError(t, err)
+		require.Equal(t, []*data.FaucetPayout{createTestPayout("bob", 2000)}, payouts)
+
+		content, err := os.ReadFile(filePath)
+		require.NoError(t, err)
+		require.Equal(t, 1, strings.Count(string(content), "\n"))
+		require.Contains(t, string(content), `"receiver":"bob"`)
+	})
+	t.Run("expired payouts should be removed from the file when appending, once per prune interval", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "payouts.json")
+		pl, _ := faucet.NewPayoutsLedger(filePath)
+		currentTime := time.Unix(1700000000, 0)
+		pl.SetTimeHandler(func() time.Time {
+			return currentTime
+		})
+
+		require.NoError(t, pl.Append(createTestPayout("alice", 1000), time.Unix(0, 0)))
+		require.NoError(t, pl.Append(createTestPayout("bob", 2000), time.Unix(1500, 0)))
+		content, err := os.ReadFile(filePath)
+		require.NoError(t, err)
+		require.Equal(t, 2, strings.Count(string(content), "\n"))
+
+		currentTime = currentTime.Add(time.Hour)
+		require.NoError(t, pl.Append(createTestPayout("carol", 3000), time.Unix(1500, 0)))
+		content, err = os.ReadFile(filePath)
+		require.NoError(t, err)
+		require.Equal(t, 2, strings.Count(string(content), "\n"))
+		require.NotContains(t, string(content), `"receiver":"alice"`)
+		require.Contains(t, string(content), `"receiver":"bob"`)
+		require.Contains(t, string(content), `"receiver":"carol"`)
+	})
+}
@@ -0,0 +1,190 @@
+package faucet
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const minPayoutsRetention = 24 * time.Hour
+
+// ArgsPayoutsLimiter holds the arguments needed to create a new PayoutsLimiter
+type ArgsPayoutsLimiter struct {
+	Ledger          PayoutsLedgerHandler
+	AddressCooldown time.Duration
+	IPCooldown      time.Duration
+	DailyBudgets    map[string]*big.Int
+}
+
+// PayoutsLimiter keeps track of the faucet payouts and refuses the ones which would break the cooldown of the
+// receiver address or of the client IP, or which would exceed the daily budget of a token
+type PayoutsLimiter struct {
+	ledger          PayoutsLedgerHandler
+	addressCooldown time.Duration
+	ipCooldown      time.Duration
+	dailyBudgets    map[string]*big.Int
+	retention       time.Duration
+
+	mutPayouts     sync.Mutex
+	payouts        []*data.FaucetPayout
+	getTimeHandler func() time.Time
+}
+
+// NewPayoutsLimiter creates a new instance of PayoutsLimiter, loading the recent payouts from the ledger
+func NewPayoutsLimiter(args ArgsPayoutsLimiter) (*PayoutsLimiter, error) {
+	if check.IfNil(args.Ledger) {
+		return nil, ErrNilPayoutsLedger
+	}
+
+	retention := minPayoutsRetention
+	if args.AddressCooldown > retention {
+		retention = args.AddressCooldown
+	}
+	if args.IPCooldown > retention {
+		retention = args.IPCooldown
+	}
+
+	pl := &PayoutsLimiter{
+		ledger:          args.Ledger,
+		addressCooldown: args.AddressCooldown,
+		ipCooldown:      args.IPCooldown,
+		dailyBudgets:    args.DailyBudgets,
+		retention:       retention,
+		getTimeHandler:  time.Now,
+	}
+
+	payouts, err := pl.ledger.LoadPayouts(pl.getTimeHandler().Add(-retention))
+	if err != nil {
+		return nil, err
+	}
+	pl.payouts = payouts
+	log.Debug("faucet payouts loaded", "num payouts", len(payouts))
+
+	return pl, nil
+}
+
+// Reserve checks the provided payout against the cooldowns and the daily budgets. If it is allowed, the payout is
+// timestamped and accounted until it is either confirmed or cancelled
+func (pl *PayoutsLimiter) Reserve(payout *data.FaucetPayout) error {
+	if payout == nil {
+		return ErrNilFaucetPayout
+	}
+
+	pl.mutPayouts.Lock()
+	defer pl.mutPayouts.Unlock()
+
+	now := pl.getTimeHandler()
+	pl.removeExpiredPayouts(now)
+
+	err := pl.checkCooldowns(payout, now)
+	if err != nil {
+		return err
+	}
+
+	err = pl.checkDailyBudgets(payout, now)
+	if err != nil {
+		return err
+	}
+
+	payout.Timestamp = now.Unix()
+	pl.payouts = append(pl.payouts, payout)
+
+	return nil
+}
+
+func (pl *PayoutsLimiter) removeExpiredPayouts(now time.Time) {
+	oldestTimestamp := now.Add(-pl.retention).Unix()
+	retainedPayouts := pl.payouts[:0]
+	for _, payout := range pl.payouts {
+		if payout.Timestamp >= oldestTimestamp {
+			retainedPayouts = append(retainedPayouts, payout)
+		}
+	}
+
+	pl.payouts = retainedPayouts
+}
+
+func (pl *PayoutsLimiter) checkCooldowns(payout *data.FaucetPayout, now time.Time) error {
+	addressCooldownStart := now.Add(-pl.addressCooldown).Unix()
+	ipCooldownStart := now.Add(-pl.ipCooldown).Unix()
+	for _, previousPayout := range pl.payouts {
+		if previousPayout.Receiver == payout.Receiver && previousPayout.Timestamp > addressCooldownStart {
+			return &apiErrors.ErrFaucetLimitReached{
+				Reason: fmt.Sprintf("address %s already received funds in the last %s", payout.Receiver, pl.addressCooldown),
+			}
+		}
+
+		isSameClient := len(payout.ClientIP) > 0 && previousPayout.ClientIP == payout.ClientIP
+		if isSameClient && previousPayout.Timestamp > ipCooldownStart {
+			return &apiErrors.ErrFaucetLimitReached{
+				Reason: fmt.Sprintf("funds were already requested from this IP in the last %s", pl.ipCooldown),
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkDailyBudgets verifies that the amounts of the payout, added to the ones sent since the start of the current
+// UTC day, do not exceed the configured daily budgets
+func (pl *PayoutsLimiter) checkDailyBudgets(payout *data.FaucetPayout, now time.Time) error {
+	year, month, day := now.UTC().Date()
+	dayStart := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix()
+
+	for token, amount := range payout.Amounts {
+		budget, found := pl.dailyBudgets[token]
+		if !found {
+			continue
+		}
+
+		spent := big.NewInt(0).Set(amount)
+		for _, previousPayout := range pl.payouts {
+			previousAmount, ok := previousPayout.Amounts[token]
+			if !ok || previousPayout.Timestamp < dayStart {
+				continue
+			}
+
+			spent.Add(spent, previousAmount)
+		}
+
+		if spent.Cmp(budget) > 0 {
+			return &apiErrors.ErrFaucetLimitReached{
+				Reason: fmt.Sprintf("the daily budget of %s has been reached", token),
+			}
+		}
+	}
+
+	return nil
+}
+
+// Confirm records the reserved payout in the ledger, which also drops the payouts older than the retention
+func (pl *PayoutsLimiter) Confirm(payout *data.FaucetPayout) error {
+	if payout == nil {
+		return ErrNilFaucetPayout
+	}
+
+	return pl.ledger.Append(payout, pl.getTimeHandler().Add(-pl.retention))
+}
+
+// Cancel releases a reserved payout which was not sent
+func (pl *PayoutsLimiter) Cancel(payout *data.FaucetPayout) {
+	pl.mutPayouts.Lock()
+	defer pl.mutPayouts.Unlock()
+
+	for idx, reservedPayout := range pl.payouts {
+		if reservedPayout == payout {
+			pl.payouts = append(pl.payouts[:idx], pl.payouts[idx+1:]...)
+			return
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pl *PayoutsLimiter) IsInterfaceNil() bool {
+	return pl == nil
+}
@@ -0,0 +1,192 @@
+package faucet_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/faucet"
+	"github.com/multiversx/mx-chain-proxy-go/faucet/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createPayout(receiver string, clientIP string, egldAmount int64) *data.FaucetPayout {
+	return &data.FaucetPayout{
+		Receiver: receiver,
+		ClientIP: clientIP,
+		Amounts:  map[string]*big.Int{data.EGLDTokenIdentifier: big.NewInt(egldAmount)},
+	}
+}
+
+func requireFaucetLimitReached(t *testing.T, err error) {
+	var limitErr *apiErrors.ErrFaucetLimitReached
+	require.True(t, errors.As(err, &limitErr))
+}
+
+func createTestPayoutsLimiter(t *testing.T, ledger faucet.PayoutsLedgerHandler, currentTime *time.Time) *faucet.PayoutsLimiter {
+	pl, err := faucet.NewPayoutsLimiter(faucet.ArgsPayoutsLimiter{
+		Ledger:          ledger,
+		AddressCooldown: time.Hour,
+		IPCooldown:      time.Minute,
+		DailyBudgets:    map[string]*big.Int{data.EGLDTokenIdentifier: big.NewInt(250)},
+	})
+	require.NoError(t, err)
+	pl.SetTimeHandler(func() time.Time {
+		return *currentTime
+	})
+
+	return pl
+}
+
+func TestNewPayoutsLimiter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil ledger should error", func(t *testing.T) {
+		t.Parallel()
+
+		pl, err := faucet.NewPayoutsLimiter(faucet.ArgsPayoutsLimiter{})
+		require.Nil(t, pl)
+		require.Equal(t, faucet.ErrNilPayoutsLedger, err)
+	})
+	t.Run("ledger error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		pl, err := faucet.NewPayoutsLimiter(faucet.ArgsPayoutsLimiter{
+			Ledger: &mock.PayoutsLedgerStub{
+				LoadPayoutsCalled: func(since time.Time) ([]*data.FaucetPayout, error) {
+					return nil, expectedErr
+				},
+			},
+		})
+		require.Nil(t, pl)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("payouts should be loaded for the longest cooldown", func(t *testing.T) {
+		t.Parallel()
+
+		var loadedSince time.Time
+		pl, err := faucet.NewPayoutsLimiter(faucet.ArgsPayoutsLimiter{
+			Ledger: &mock.PayoutsLedgerStub{
+				LoadPayoutsCalled: func(since time.Time) ([]*data.FaucetPayout, error) {
+					loadedSince = since
+					return nil, nil
+				},
+			},
+			AddressCooldown: 48 * time.Hour,
+		})
+		require.NoError(t, err)
+		require.False(t, pl.IsInterfaceNil())
+		require.InDelta(t, time.Now().Add(-48*time.Hour).Unix(), loadedSince.Unix(), 5)
+	})
+}
+
+func TestPayoutsLimiter_Reserve(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil payout should error", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1700000000, 0)
+		pl := createTestPayoutsLimiter(t, &mock.PayoutsLedgerStub{}, &currentTime)
+		require.Equal(t, faucet.ErrNilFaucetPayout, pl.Reserve(nil))
+	})
+	t.Run("same address should wait for the address cooldown", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1700000000, 0)
+		pl := createTestPayoutsLimiter(t, &mock.PayoutsLedgerStub{}, &currentTime)
+
+		payout := createPayout("alice", "1.1.1.1", 10)
+		require.NoError(t, pl.Reserve(payout))
+		require.Equal(t, currentTime.Unix(), payout.Timestamp)
+
+		currentTime = currentTime.Add(30 * time.Minute)
+		requireFaucetLimitReached(t, pl.Reserve(createPayout("alice", "2.2.2.2", 10)))
+
+		currentTime = currentTime.Add(31 * time.Minute)
+		require.NoError(t, pl.Reserve(createPayout("alice", "2.2.2.2", 10)))
+	})
+	t.Run("same IP should wait for the IP cooldown", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1700000000, 0)
+		pl := createTestPayoutsLimiter(t, &mock.PayoutsLedgerStub{}, &currentTime)
+
+		require.NoError(t, pl.Reserve(createPayout("alice", "1.1.1.1", 10)))
+		requireFaucetLimitReached(t, pl.Reserve(createPayout("bob", "1.1.1.1", 10)))
+		require.NoError(t, pl.Reserve(createPayout("bob", "", 10)))
+
+		currentTime = currentTime.Add(2 * time.Minute)
+		require.NoError(t, pl.Reserve(createPayout("carol", "1.1.1.1", 10)))
+	})
+	t.Run("daily budget should be reset at the start of the UTC day", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Date(2023, 11, 14, 22, 0, 0, 0, time.UTC)
+		pl := createTestPayoutsLimiter(t, &mock.PayoutsLedgerStub{}, &currentTime)
+
+		require.NoError(t, pl.Reserve(createPayout("alice", "", 100)))
+		require.NoError(t, pl.Reserve(createPayout("bob", "", 100)))
+		requireFaucetLimitReached(t, pl.Reserve(createPayout("carol", "", 100)))
+		require.NoError(t, pl.Reserve(createPayout("carol", "", 50)))
+
+		currentTime = currentTime.Add(3 * time.Hour)
+		require.NoError(t, pl.Reserve(createPayout("dave", "", 250)))
+	})
+	t.Run("payouts loaded from the ledger should be accounted", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Now()
+		ledger := &mock.PayoutsLedgerStub{
+			LoadPayoutsCalled: func(since time.Time) ([]*data.FaucetPayout, error) {
+				payout := createPayout("alice", "", 10)
+				payout.Timestamp = currentTime.Add(-time.Minute).Unix()
+				return []*data.FaucetPayout{payout}, nil
+			},
+		}
+		pl := createTestPayoutsLimiter(t, ledger, &currentTime)
+
+		requireFaucetLimitReached(t, pl.Reserve(createPayout("alice", "", 10)))
+	})
+}
+
+func TestPayoutsLimiter_ConfirmAndCancel(t *testing.T) {
+	t.Parallel()
+
+	t.Run("confirm should append the payout to the ledger", func(t *testing.T) {
+		t.Parallel()
+
+		var appendedPayouts []*data.FaucetPayout
+		currentTime := time.Unix(1700000000, 0)
+		ledger := &mock.PayoutsLedgerStub{
+			AppendCalled: func(payout *data.FaucetPayout, since time.Time) error {
+				require.Equal(t, currentTime.Add(-24*time.Hour), since)
+				appendedPayouts = append(appendedPayouts, payout)
+				return nil
+			},
+		}
+		pl := createTestPayoutsLimiter(t, ledger, &currentTime)
+
+		payout := createPayout("alice", "", 10)
+		require.NoError(t, pl.Reserve(payout))
+		require.NoError(t, pl.Confirm(payout))
+		require.Equal(t, []*data.FaucetPayout{payout}, appendedPayouts)
+		require.Equal(t, faucet.ErrNilFaucetPayout, pl.Confirm(nil))
+	})
+	t.Run("cancel should release the reservation", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1700000000, 0)
+		pl := createTestPayoutsLimiter(t, &mock.PayoutsLedgerStub{}, &currentTime)
+
+		payout := createPayout("alice", "1.1.1.1", 200)
+		require.NoError(t, pl.Reserve(payout))
+		pl.Cancel(payout)
+
+		require.NoError(t, pl.Reserve(createPayout("alice", "1.1.1.1", 200)))
+	})
+}
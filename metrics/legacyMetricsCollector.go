@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	numRequestsDesc = prometheus.NewDesc("num_requests", "Number of HTTP requests served by the proxy, by route.", []string{"endpoint"}, nil)
+	numErrorsDesc   = prometheus.NewDesc("num_errors", "Number of HTTP requests served by the proxy with a status code other than 200, by route.", []string{"endpoint"}, nil)
+
+	totalResponseTimeDesc   = prometheus.NewDesc("total_response_time_ns", "Total duration of the HTTP requests served by the proxy, by route.", []string{"endpoint"}, nil)
+	highestResponseTimeDesc = prometheus.NewDesc("highest_response_time_ns", "Highest duration of the HTTP requests served by the proxy, by route.", []string{"endpoint"}, nil)
+	lowestResponseTimeDesc  = prometheus.NewDesc("lowest_response_time_ns", "Lowest duration of the HTTP requests served by the proxy, by route.", []string{"endpoint"}, nil)
+
+	cacheHitsDesc   = prometheus.NewDesc("cache_hits", "Number of hits of the responses caches.", []string{"cache"}, nil)
+	cacheMissesDesc = prometheus.NewDesc("cache_misses", "Number of misses of the responses caches.", []string{"cache"}, nil)
+
+	coalescingSentDesc      = prometheus.NewDesc("coalescing_sent_requests", "Number of requests sent to the observers, by coalesced operation.", []string{"operation"}, nil)
+	coalescingCoalescedDesc = prometheus.NewDesc("coalescing_coalesced_requests", "Number of requests merged with an identical request in flight, by coalesced operation.", []string{"operation"}, nil)
+)
+
+// legacyMetricsCollector exposes the endpoints, cache and coalescing metrics, kept for the /status/metrics route, under
+// the names they always had in the prometheus format
+type legacyMetricsCollector struct {
+	statusMetrics *statusMetrics
+}
+
+// Describe sends the descriptors of the legacy metrics
+func (collector *legacyMetricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- numRequestsDesc
+	ch <- numErrorsDesc
+	ch <- totalResponseTimeDesc
+	ch <- highestResponseTimeDesc
+	ch <- lowestResponseTimeDesc
+	ch <- cacheHitsDesc
+	ch <- cacheMissesDesc
+	ch <- coalescingSentDesc
+	ch <- coalescingCoalescedDesc
+}
+
+// Collect sends the current values of the legacy metrics
+func (collector *legacyMetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	collector.statusMetrics.mutEndpointsOperations.RLock()
+	for endpointPath, endpointData := range collector.statusMetrics.endpointMetrics {
+		ch <- prometheus.MustNewConstMetric(numRequestsDesc, prometheus.CounterValue, float64(endpointData.NumRequests), endpointPath)
+		ch <- prometheus.MustNewConstMetric(numErrorsDesc, prometheus.CounterValue, float64(endpointData.NumErrors), endpointPath)
+		ch <- prometheus.MustNewConstMetric(totalResponseTimeDesc, prometheus.CounterValue, float64(endpointData.TotalResponseTime), endpointPath)
+		ch <- prometheus.MustNewConstMetric(highestResponseTimeDesc, prometheus.GaugeValue, float64(endpointData.HighestResponseTime), endpointPath)
+		ch <- prometheus.MustNewConstMetric(lowestResponseTimeDesc, prometheus.GaugeValue, float64(endpointData.LowestResponseTime), endpointPath)
+	}
+	collector.statusMetrics.mutEndpointsOperations.RUnlock()
+
+	for cacheName, cacheData := range collector.statusMetrics.GetCacheMetrics() {
+		ch <- prometheus.MustNewConstMetric(cacheHitsDesc, prometheus.CounterValue, float64(cacheData.NumHits), cacheName)
+		ch <- prometheus.MustNewConstMetric(cacheMissesDesc, prometheus.CounterValue, float64(cacheData.NumMisses), cacheName)
+	}
+
+	for operation, coalescingData := range collector.statusMetrics.GetCoalescingMetrics() {
+		ch <- prometheus.MustNewConstMetric(coalescingSentDesc, prometheus.CounterValue, float64(coalescingData.NumSent), operation)
+		ch <- prometheus.MustNewConstMetric(coalescingCoalescedDesc, prometheus.CounterValue, float64(coalescingData.NumCoalesced), operation)
+	}
+}
@@ -1,38 +1,104 @@
 package metrics
 
 import (
-	"fmt"
-	"strings"
+	"bytes"
+	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core"
+	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
 )
 
-// statusMetrics will handle displaying at /status/metrics all collected metrics
+var log = logger.GetOrCreate("metrics")
+
+// statusMetrics will handle displaying at /status/metrics all collected metrics. The histograms, the in flight
+// requests and the observers metrics are only exposed at /status/prometheus-metrics
 type statusMetrics struct {
 	endpointMetrics        map[string]*data.EndpointMetrics
 	mutEndpointsOperations sync.RWMutex
+
+	cacheMetrics    map[string]*data.CacheMetrics
+	mutCacheMetrics sync.RWMutex
+
+	coalescingMetrics    map[string]*data.CoalescingMetrics
+	mutCoalescingMetrics sync.RWMutex
+
+	registry                 *prometheus.Registry
+	requestsDuration         *prometheus.HistogramVec
+	inFlightRequests         *prometheus.GaugeVec
+	observerRequestsDuration *prometheus.HistogramVec
+	observerRequestErrors    *prometheus.CounterVec
+	observerRequestTimeouts  *prometheus.CounterVec
+	observerSyncState        *prometheus.GaugeVec
 }
 
 // NewStatusMetrics will return an instance of the struct
 func NewStatusMetrics() *statusMetrics {
-	return &statusMetrics{
-		endpointMetrics: make(map[string]*data.EndpointMetrics),
+	sm := &statusMetrics{
+		endpointMetrics:   make(map[string]*data.EndpointMetrics),
+		cacheMetrics:      make(map[string]*data.CacheMetrics),
+		coalescingMetrics: make(map[string]*data.CoalescingMetrics),
+		registry:          prometheus.NewRegistry(),
+		requestsDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "Duration of the HTTP requests served by the proxy, by route and status code.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"endpoint", "status_code"}),
+		inFlightRequests: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "http_requests_in_flight",
+			Help: "Number of HTTP requests currently served by the proxy, by route.",
+		}, []string{"endpoint"}),
+		observerRequestsDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "observer_request_duration_seconds",
+			Help:    "Duration of the requests sent to the observers, by observer address and shard.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"address", "shard"}),
+		observerRequestErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "observer_request_errors_total",
+			Help: "Number of failed requests sent to the observers, timeouts included, by observer address and shard.",
+		}, []string{"address", "shard"}),
+		observerRequestTimeouts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "observer_request_timeouts_total",
+			Help: "Number of timed out requests sent to the observers, by observer address and shard.",
+		}, []string{"address", "shard"}),
+		observerSyncState: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "observer_synced",
+			Help: "Sync state of the observers, as of the last sync check: 1 if synced, 0 otherwise.",
+		}, []string{"address", "shard"}),
 	}
+
+	sm.registry.MustRegister(
+		sm.requestsDuration,
+		sm.inFlightRequests,
+		sm.observerRequestsDuration,
+		sm.observerRequestErrors,
+		sm.observerRequestTimeouts,
+		sm.observerSyncState,
+		&legacyMetricsCollector{statusMetrics: sm},
+	)
+
+	return sm
 }
 
-// AddRequestData will add the received data to the metrics map
-func (sm *statusMetrics) AddRequestData(path string, withError bool, duration time.Duration) {
+// AddRequestData will add the received data to the metrics map and to the requests duration histogram. Every status
+// code other than 200 counts as an error
+func (sm *statusMetrics) AddRequestData(path string, statusCode int, duration time.Duration) {
 	// TODO: refactor this by using a buffered channel that receives new request data and stores them into the map
 	// from time to time
 
+	sm.requestsDuration.WithLabelValues(path, strconv.Itoa(statusCode)).Observe(duration.Seconds())
+
 	sm.mutEndpointsOperations.Lock()
 	defer sm.mutEndpointsOperations.Unlock()
 
 	currentData := sm.endpointMetrics[path]
 	withErrorIncrementalStep := uint64(0)
-	if withError {
+	if statusCode != http.StatusOK {
 		withErrorIncrementalStep = 1
 	}
 	if currentData == nil {
@@ -58,6 +124,40 @@ func (sm *statusMetrics) AddRequestData(path string, withError bool, duration ti
 	currentData.TotalResponseTime += duration
 }
 
+// IncrementInFlightRequests records the start of a request on the provided path
+func (sm *statusMetrics) IncrementInFlightRequests(path string) {
+	sm.inFlightRequests.WithLabelValues(path).Inc()
+}
+
+// DecrementInFlightRequests records the end of a request on the provided path
+func (sm *statusMetrics) DecrementInFlightRequests(path string) {
+	sm.inFlightRequests.WithLabelValues(path).Dec()
+}
+
+// AddObserverRequestData records the duration and the outcome of a request sent to an observer
+func (sm *statusMetrics) AddObserverRequestData(address string, shardID uint32, duration time.Duration, isSuccessful bool, isTimeout bool) {
+	shard := core.GetShardIDString(shardID)
+	sm.observerRequestsDuration.WithLabelValues(address, shard).Observe(duration.Seconds())
+	if isSuccessful {
+		return
+	}
+
+	sm.observerRequestErrors.WithLabelValues(address, shard).Inc()
+	if isTimeout {
+		sm.observerRequestTimeouts.WithLabelValues(address, shard).Inc()
+	}
+}
+
+// SetObserverSyncState records the sync state of an observer
+func (sm *statusMetrics) SetObserverSyncState(address string, shardID uint32, isSynced bool) {
+	value := float64(0)
+	if isSynced {
+		value = 1
+	}
+
+	sm.observerSyncState.WithLabelValues(address, core.GetShardIDString(shardID)).Set(value)
+}
+
 // GetAll returns the metrics map
 func (sm *statusMetrics) GetAll() map[string]*data.EndpointMetrics {
 	sm.mutEndpointsOperations.RLock()
@@ -71,21 +171,95 @@ func (sm *statusMetrics) GetAll() map[string]*data.EndpointMetrics {
 	return newMap
 }
 
-// GetMetricsForPrometheus returns the metrics in a prometheus format
-func (sm *statusMetrics) GetMetricsForPrometheus() string {
-	metricsMap := sm.GetAll()
+// AddCacheRequestData will record a hit or a miss for the provided cache
+func (sm *statusMetrics) AddCacheRequestData(cacheName string, isHit bool) {
+	sm.mutCacheMetrics.Lock()
+	defer sm.mutCacheMetrics.Unlock()
+
+	currentData := sm.cacheMetrics[cacheName]
+	if currentData == nil {
+		currentData = &data.CacheMetrics{}
+		sm.cacheMetrics[cacheName] = currentData
+	}
+
+	if isHit {
+		currentData.NumHits++
+		return
+	}
 
-	stringBuilder := strings.Builder{}
+	currentData.NumMisses++
+}
+
+// GetCacheMetrics returns the cache metrics map
+func (sm *statusMetrics) GetCacheMetrics() map[string]*data.CacheMetrics {
+	sm.mutCacheMetrics.RLock()
+	defer sm.mutCacheMetrics.RUnlock()
+
+	newMap := make(map[string]*data.CacheMetrics)
+	for key, value := range sm.cacheMetrics {
+		newMap[key] = &data.CacheMetrics{
+			NumHits:   value.NumHits,
+			NumMisses: value.NumMisses,
+		}
+	}
+
+	return newMap
+}
+
+// AddCoalescingRequestData will record a request of the provided operation, either sent or coalesced with an identical
+// request in flight
+func (sm *statusMetrics) AddCoalescingRequestData(operation string, isCoalesced bool) {
+	sm.mutCoalescingMetrics.Lock()
+	defer sm.mutCoalescingMetrics.Unlock()
+
+	currentData := sm.coalescingMetrics[operation]
+	if currentData == nil {
+		currentData = &data.CoalescingMetrics{}
+		sm.coalescingMetrics[operation] = currentData
+	}
+
+	if isCoalesced {
+		currentData.NumCoalesced++
+		return
+	}
+
+	currentData.NumSent++
+}
+
+// GetCoalescingMetrics returns the coalescing metrics map
+func (sm *statusMetrics) GetCoalescingMetrics() map[string]*data.CoalescingMetrics {
+	sm.mutCoalescingMetrics.RLock()
+	defer sm.mutCoalescingMetrics.RUnlock()
+
+	newMap := make(map[string]*data.CoalescingMetrics)
+	for key, value := range sm.coalescingMetrics {
+		newMap[key] = &data.CoalescingMetrics{
+			NumSent:      value.NumSent,
+			NumCoalesced: value.NumCoalesced,
+		}
+	}
 
-	for endpointPath, endpointData := range metricsMap {
-		stringBuilder.WriteString(fmt.Sprintf("num_requests{endpoint=\"%s\"} %d\n", endpointPath, endpointData.NumRequests))
-		stringBuilder.WriteString(fmt.Sprintf("num_errors{endpoint=\"%s\"} %d\n", endpointPath, endpointData.NumErrors))
-		stringBuilder.WriteString(fmt.Sprintf("total_response_time_ns{endpoint=\"%s\"} %d\n", endpointPath, endpointData.TotalResponseTime))
-		stringBuilder.WriteString(fmt.Sprintf("highest_response_time_ns{endpoint=\"%s\"} %d\n", endpointPath, endpointData.HighestResponseTime))
-		stringBuilder.WriteString(fmt.Sprintf("lowest_response_time_ns{endpoint=\"%s\"} %d\n", endpointPath, endpointData.LowestResponseTime))
+	return newMap
+}
+
+// GetMetricsForPrometheus returns the metrics in the prometheus text exposition format
+func (sm *statusMetrics) GetMetricsForPrometheus() string {
+	metricFamilies, err := sm.registry.Gather()
+	if err != nil {
+		// the gathered metric families are still valid, only the failing ones are missing
+		log.Warn("statusMetrics.GetMetricsForPrometheus: cannot gather all metrics", "error", err)
+	}
+
+	buff := bytes.NewBuffer(nil)
+	encoder := expfmt.NewEncoder(buff, expfmt.FmtText)
+	for _, metricFamily := range metricFamilies {
+		err = encoder.Encode(metricFamily)
+		if err != nil {
+			log.Warn("statusMetrics.GetMetricsForPrometheus: cannot encode metric", "name", metricFamily.GetName(), "error", err)
+		}
 	}
 
-	return stringBuilder.String()
+	return buff.String()
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -2,12 +2,18 @@ package metrics
 
 import (
 	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
 	"github.com/stretchr/testify/require"
 )
 
@@ -38,7 +44,7 @@ func testFirstMetric(t *testing.T) {
 	sm := NewStatusMetrics()
 
 	testEndpoint, testDuration := "/network/config", 1*time.Second
-	sm.AddRequestData(testEndpoint, false, testDuration)
+	sm.AddRequestData(testEndpoint, http.StatusOK, testDuration)
 
 	res := sm.GetAll()
 	require.Equal(t, res[testEndpoint], &data.EndpointMetrics{
@@ -57,9 +63,9 @@ func testWhenMultipleMetrics(t *testing.T) {
 
 	testEndpoint := "/network/config"
 	testDuration0, testDuration1, testDuration2 := 4*time.Millisecond, 20*time.Millisecond, 2*time.Millisecond
-	sm.AddRequestData(testEndpoint, false, testDuration0)
-	sm.AddRequestData(testEndpoint, true, testDuration1)
-	sm.AddRequestData(testEndpoint, false, testDuration2)
+	sm.AddRequestData(testEndpoint, http.StatusOK, testDuration0)
+	sm.AddRequestData(testEndpoint, http.StatusInternalServerError, testDuration1)
+	sm.AddRequestData(testEndpoint, http.StatusOK, testDuration2)
 
 	res := sm.GetAll()
 	require.Equal(t, res[testEndpoint], &data.EndpointMetrics{
@@ -81,11 +87,11 @@ func testWhenMultipleEntriesForMultipleEndpoints(t *testing.T) {
 	testDuration0End0, testDuration1End0 := time.Second, 5*time.Second
 	testDuration0End1, testDuration1End1 := time.Hour, 4*time.Hour
 
-	sm.AddRequestData(testEndpoint0, true, testDuration0End0)
-	sm.AddRequestData(testEndpoint0, false, testDuration1End0)
+	sm.AddRequestData(testEndpoint0, http.StatusInternalServerError, testDuration0End0)
+	sm.AddRequestData(testEndpoint0, http.StatusOK, testDuration1End0)
 
-	sm.AddRequestData(testEndpoint1, true, testDuration0End1)
-	sm.AddRequestData(testEndpoint1, true, testDuration1End1)
+	sm.AddRequestData(testEndpoint1, http.StatusInternalServerError, testDuration0End1)
+	sm.AddRequestData(testEndpoint1, http.StatusInternalServerError, testDuration1End1)
 
 	res := sm.GetAll()
 
@@ -113,20 +119,103 @@ func testMetricsForPrometheus(t *testing.T) {
 
 	testEndpoint := "/network/config"
 	testDuration0, testDuration1, testDuration2 := 4*time.Millisecond, 20*time.Millisecond, 2*time.Millisecond
-	sm.AddRequestData(testEndpoint, false, testDuration0)
-	sm.AddRequestData(testEndpoint, true, testDuration1)
-	sm.AddRequestData(testEndpoint, false, testDuration2)
+	sm.AddRequestData(testEndpoint, http.StatusOK, testDuration0)
+	sm.AddRequestData(testEndpoint, http.StatusInternalServerError, testDuration1)
+	sm.AddRequestData(testEndpoint, http.StatusOK, testDuration2)
+
+	metricFamilies := parsePrometheusMetrics(t, sm.GetMetricsForPrometheus())
+
+	endpointLabels := map[string]string{"endpoint": testEndpoint}
+	require.Equal(t, float64(3), getMetric(t, metricFamilies, "num_requests", endpointLabels).GetCounter().GetValue())
+	require.Equal(t, float64(1), getMetric(t, metricFamilies, "num_errors", endpointLabels).GetCounter().GetValue())
+	require.Equal(t, float64(26000000), getMetric(t, metricFamilies, "total_response_time_ns", endpointLabels).GetCounter().GetValue())
+	require.Equal(t, float64(20000000), getMetric(t, metricFamilies, "highest_response_time_ns", endpointLabels).GetGauge().GetValue())
+	require.Equal(t, float64(2000000), getMetric(t, metricFamilies, "lowest_response_time_ns", endpointLabels).GetGauge().GetValue())
+
+	okHistogram := getMetric(t, metricFamilies, "http_request_duration_seconds", map[string]string{"endpoint": testEndpoint, "status_code": "200"}).GetHistogram()
+	require.Equal(t, uint64(2), okHistogram.GetSampleCount())
+	require.InDelta(t, (testDuration0 + testDuration2).Seconds(), okHistogram.GetSampleSum(), 1e-9)
+	require.Equal(t, uint64(2), okHistogram.GetBucket()[0].GetCumulativeCount())
+
+	errorHistogram := getMetric(t, metricFamilies, "http_request_duration_seconds", map[string]string{"endpoint": testEndpoint, "status_code": "500"}).GetHistogram()
+	require.Equal(t, uint64(1), errorHistogram.GetSampleCount())
+	require.InDelta(t, testDuration1.Seconds(), errorHistogram.GetSampleSum(), 1e-9)
+}
+
+func parsePrometheusMetrics(t *testing.T, metrics string) map[string]*dto.MetricFamily {
+	parser := expfmt.TextParser{}
+	metricFamilies, err := parser.TextToMetricFamilies(strings.NewReader(metrics))
+	require.NoError(t, err)
+
+	return metricFamilies
+}
+
+func getMetric(t *testing.T, metricFamilies map[string]*dto.MetricFamily, name string, labels map[string]string) *dto.Metric {
+	metricFamily, ok := metricFamilies[name]
+	require.True(t, ok, "missing metric %s", name)
+
+	for _, metric := range metricFamily.GetMetric() {
+		metricLabels := make(map[string]string)
+		for _, label := range metric.GetLabel() {
+			metricLabels[label.GetName()] = label.GetValue()
+		}
+		if reflect.DeepEqual(labels, metricLabels) {
+			return metric
+		}
+	}
+
+	require.Fail(t, "missing labels", "metric %s has no labels %v", name, labels)
+	return nil
+}
+
+func TestStatusMetrics_InFlightRequests(t *testing.T) {
+	t.Parallel()
+
+	sm := NewStatusMetrics()
+	sm.IncrementInFlightRequests("/address/:address")
+	sm.IncrementInFlightRequests("/address/:address")
+	sm.IncrementInFlightRequests("/network/config")
+	sm.DecrementInFlightRequests("/address/:address")
+
+	metricFamilies := parsePrometheusMetrics(t, sm.GetMetricsForPrometheus())
+	require.Equal(t, float64(1), getMetric(t, metricFamilies, "http_requests_in_flight", map[string]string{"endpoint": "/address/:address"}).GetGauge().GetValue())
+	require.Equal(t, float64(1), getMetric(t, metricFamilies, "http_requests_in_flight", map[string]string{"endpoint": "/network/config"}).GetGauge().GetValue())
+}
+
+func TestStatusMetrics_AddObserverRequestData(t *testing.T) {
+	t.Parallel()
+
+	sm := NewStatusMetrics()
+	sm.AddObserverRequestData("http://observer-0", 0, 10*time.Millisecond, true, false)
+	sm.AddObserverRequestData("http://observer-0", 0, 30*time.Millisecond, false, false)
+	sm.AddObserverRequestData("http://observer-0", 0, 2*time.Second, false, true)
+	sm.AddObserverRequestData("http://observer-meta", core.MetachainShardId, 20*time.Millisecond, true, false)
+
+	metricFamilies := parsePrometheusMetrics(t, sm.GetMetricsForPrometheus())
+
+	shard0Labels := map[string]string{"address": "http://observer-0", "shard": "0"}
+	shard0Histogram := getMetric(t, metricFamilies, "observer_request_duration_seconds", shard0Labels).GetHistogram()
+	require.Equal(t, uint64(3), shard0Histogram.GetSampleCount())
+	require.InDelta(t, 2.04, shard0Histogram.GetSampleSum(), 1e-9)
+	require.Equal(t, float64(2), getMetric(t, metricFamilies, "observer_request_errors_total", shard0Labels).GetCounter().GetValue())
+	require.Equal(t, float64(1), getMetric(t, metricFamilies, "observer_request_timeouts_total", shard0Labels).GetCounter().GetValue())
+
+	metaLabels := map[string]string{"address": "http://observer-meta", "shard": "metachain"}
+	require.Equal(t, uint64(1), getMetric(t, metricFamilies, "observer_request_duration_seconds", metaLabels).GetHistogram().GetSampleCount())
+	require.Len(t, metricFamilies["observer_request_errors_total"].GetMetric(), 1)
+}
 
-	res := sm.GetMetricsForPrometheus()
+func TestStatusMetrics_SetObserverSyncState(t *testing.T) {
+	t.Parallel()
 
-	expectedString := `num_requests{endpoint="/network/config"} 3
-num_errors{endpoint="/network/config"} 1
-total_response_time_ns{endpoint="/network/config"} 26000000
-highest_response_time_ns{endpoint="/network/config"} 20000000
-lowest_response_time_ns{endpoint="/network/config"} 2000000
-`
+	sm := NewStatusMetrics()
+	sm.SetObserverSyncState("http://observer-0", 0, true)
+	sm.SetObserverSyncState("http://observer-1", 1, true)
+	sm.SetObserverSyncState("http://observer-1", 1, false)
 
-	require.Equal(t, expectedString, res)
+	metricFamilies := parsePrometheusMetrics(t, sm.GetMetricsForPrometheus())
+	require.Equal(t, float64(1), getMetric(t, metricFamilies, "observer_synced", map[string]string{"address": "http://observer-0", "shard": "0"}).GetGauge().GetValue())
+	require.Equal(t, float64(0), getMetric(t, metricFamilies, "observer_synced", map[string]string{"address": "http://observer-1", "shard": "1"}).GetGauge().GetValue())
 }
 
 func TestStatusMetrics_ConcurrentOperations(t *testing.T) {
@@ -146,7 +235,7 @@ func TestStatusMetrics_ConcurrentOperations(t *testing.T) {
 		go func(index int) {
 			switch index % 4 {
 			case 0:
-				sm.AddRequestData(fmt.Sprintf("endpoint_%d", index%5), false, time.Hour*time.Duration(index))
+				sm.AddRequestData(fmt.Sprintf("endpoint_%d", index%5), http.StatusOK, time.Hour*time.Duration(index))
 			case 1:
 				res := sm.GetAll()
 				delete(res, "endpoint_0")
@@ -160,3 +249,37 @@ func TestStatusMetrics_ConcurrentOperations(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestStatusMetrics_AddCacheRequestData(t *testing.T) {
+	t.Parallel()
+
+	sm := NewStatusMetrics()
+	sm.AddCacheRequestData("responses", true)
+	sm.AddCacheRequestData("responses", true)
+	sm.AddCacheRequestData("responses", false)
+
+	cacheMetrics := sm.GetCacheMetrics()
+	require.Equal(t, &data.CacheMetrics{NumHits: 2, NumMisses: 1}, cacheMetrics["responses"])
+
+	prometheusMetrics := sm.GetMetricsForPrometheus()
+	require.Contains(t, prometheusMetrics, "cache_hits{cache=\"responses\"} 2\n")
+	require.Contains(t, prometheusMetrics, "cache_misses{cache=\"responses\"} 1\n")
+}
+
+func TestStatusMetrics_AddCoalescingRequestData(t *testing.T) {
+	t.Parallel()
+
+	sm := NewStatusMetrics()
+	sm.AddCoalescingRequestData("account", false)
+	sm.AddCoalescingRequestData("account", true)
+	sm.AddCoalescingRequestData("account", true)
+	sm.AddCoalescingRequestData("vm-query", false)
+
+	coalescingMetrics := sm.GetCoalescingMetrics()
+	require.Equal(t, &data.CoalescingMetrics{NumSent: 1, NumCoalesced: 2}, coalescingMetrics["account"])
+	require.Equal(t, &data.CoalescingMetrics{NumSent: 1}, coalescingMetrics["vm-query"])
+
+	prometheusMetrics := sm.GetMetricsForPrometheus()
+	require.Contains(t, prometheusMetrics, "coalescing_sent_requests{operation=\"account\"} 1\n")
+	require.Contains(t, prometheusMetrics, "coalescing_coalesced_requests{operation=\"account\"} 2\n")
+}
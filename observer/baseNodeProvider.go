@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/observer/holder"
@@ -18,54 +20,93 @@ type baseNodeProvider struct {
 	configurationFilePath string
 	regularNodes          NodesHolder
 	snapshotlessNodes     NodesHolder
+	drainedNodes          map[string]struct{}
+	circuitBreaker        NodesCircuitBreaker
+
+	// the changes of the nodes are serialized, as each of them is computed from the current configured nodes and overlay
+	mutUpdate       sync.Mutex
+	configuredNodes []*data.NodeData
+	overlay         data.NodesOverlay
+
+	mutReloadHandler sync.RWMutex
+	reloadHandler    NodesReloadHandler
 }
 
 func (bnp *baseNodeProvider) initNodes(nodes []*data.NodeData) error {
-	if len(nodes) == 0 {
-		return ErrEmptyObserversList
-	}
+	bnp.mutUpdate.Lock()
+	defer bnp.mutUpdate.Unlock()
 
-	newNodes := make(map[uint32][]*data.NodeData)
-	for _, observer := range nodes {
-		shardId := observer.ShardId
-		newNodes[shardId] = append(newNodes[shardId], observer)
-		isMeta := shardId == core.MetachainShardId
-		if isMeta {
-			continue
-		}
+	return bnp.applyNodesUnprotected(nodes, bnp.overlay)
+}
 
-		if shardId >= bnp.numOfShards {
-			return fmt.Errorf("%w for observer %s, provided shard %d, number of shards configured %d",
-				ErrInvalidShard,
-				observer.Address,
-				observer.ShardId,
-				bnp.numOfShards,
-			)
-		}
+// applyNodesUnprotected replaces the current nodes with the configured ones, changed by the overlay, if they are valid.
+// It should be called under the update mutex
+func (bnp *baseNodeProvider) applyNodesUnprotected(configuredNodes []*data.NodeData, overlay data.NodesOverlay) error {
+	nodes := applyOverlay(configuredNodes, overlay)
+	err := CheckNodes(nodes, bnp.numOfShards)
+	if err != nil {
+		return err
 	}
 
-	err := checkNodesInShards(newNodes)
+	// the addresses which are not used anymore are dropped, so they do not affect the nodes added later
+	overlay.Removed = filterAddresses(overlay.Removed, configuredNodes)
+	overlay.Drained = filterAddresses(overlay.Drained, nodes)
+	drainedNodes := sliceToSet(overlay.Drained)
+	err = checkDrainedNodes(nodes, drainedNodes)
 	if err != nil {
 		return err
 	}
 
-	bnp.mutNodes.Lock()
-	defer bnp.mutNodes.Unlock()
-
-	bnp.shardIds = getSortedShardIDsSlice(newNodes)
+	newNodes := nodesSliceToShardedMap(nodes)
 	syncedNodes, syncedFallbackNodes, syncedSnapshotlessNodes, syncedSnapshotlessFallbackNodes := initAllNodesSlice(newNodes)
-	bnp.regularNodes, err = holder.NewNodesHolder(syncedNodes, syncedFallbackNodes, data.AvailabilityAll)
+	regularNodes, err := holder.NewNodesHolder(syncedNodes, syncedFallbackNodes, data.AvailabilityAll)
 	if err != nil {
 		return err
 	}
-	bnp.snapshotlessNodes, err = holder.NewNodesHolder(syncedSnapshotlessNodes, syncedSnapshotlessFallbackNodes, data.AvailabilityRecent)
+	snapshotlessNodes, err := holder.NewNodesHolder(syncedSnapshotlessNodes, syncedSnapshotlessFallbackNodes, data.AvailabilityRecent)
 	if err != nil {
 		return err
 	}
 
+	bnp.mutNodes.Lock()
+	bnp.shardIds = getSortedShardIDsSlice(newNodes)
+	bnp.regularNodes = regularNodes
+	bnp.snapshotlessNodes = snapshotlessNodes
+	bnp.drainedNodes = drainedNodes
+	bnp.mutNodes.Unlock()
+
+	bnp.configuredNodes = configuredNodes
+	bnp.overlay = overlay
+
 	return nil
 }
 
+// CheckNodes returns an error if the provided nodes cannot be used: the list is empty, a node is assigned to a shard
+// above the number of shards or a shard has only snapshotless nodes
+func CheckNodes(nodes []*data.NodeData, numOfShards uint32) error {
+	if len(nodes) == 0 {
+		return ErrEmptyObserversList
+	}
+
+	for _, observer := range nodes {
+		isMeta := observer.ShardId == core.MetachainShardId
+		if isMeta {
+			continue
+		}
+
+		if observer.ShardId >= numOfShards {
+			return fmt.Errorf("%w for observer %s, provided shard %d, number of shards configured %d",
+				ErrInvalidShard,
+				observer.Address,
+				observer.ShardId,
+				numOfShards,
+			)
+		}
+	}
+
+	return checkNodesInShards(nodesSliceToShardedMap(nodes))
+}
+
 func checkNodesInShards(nodes map[uint32][]*data.NodeData) error {
 	for shardID, nodesInShard := range nodes {
 		atLeastOneRegularNode := false
@@ -114,6 +155,72 @@ func (bnp *baseNodeProvider) UpdateNodesBasedOnSyncState(nodesWithSyncStatus []*
 	bnp.snapshotlessNodes.UpdateNodes(snapshotlessNodes)
 }
 
+// RecordNodeRequest will notify the circuit breaker of the node that a request is sent to it
+func (bnp *baseNodeProvider) RecordNodeRequest(address string) {
+	bnp.circuitBreaker.RecordRequestSent(address)
+}
+
+// RecordNodeResponse will feed the result of a request to the circuit breaker of the node
+func (bnp *baseNodeProvider) RecordNodeResponse(address string, _ time.Duration, isSuccessful bool) {
+	bnp.circuitBreaker.RecordResult(address, isSuccessful)
+}
+
+// GetCircuitBreakerStatus returns the circuit breaker details for all the nodes
+func (bnp *baseNodeProvider) GetCircuitBreakerStatus() []*data.NodeCircuitBreakerStatus {
+	nodes := bnp.GetAllNodesWithSyncState()
+
+	nodesStatus := make([]*data.NodeCircuitBreakerStatus, 0, len(nodes))
+	for _, node := range nodes {
+		state, consecutiveFailures := bnp.circuitBreaker.GetState(node.Address)
+		nodesStatus = append(nodesStatus, &data.NodeCircuitBreakerStatus{
+			Address:             node.Address,
+			ShardId:             node.ShardId,
+			IsSynced:            node.IsSynced,
+			IsFallback:          node.IsFallback,
+			IsSnapshotless:      node.IsSnapshotless,
+			State:               state,
+			ConsecutiveFailures: consecutiveFailures,
+		})
+	}
+
+	return nodesStatus
+}
+
+// filterAvailableNodes will remove the nodes whose circuit is open. If all the circuits are open, the provided nodes
+// are returned as they are, so that the requests are still attempted instead of failing without reaching any node
+func (bnp *baseNodeProvider) filterAvailableNodes(nodes []*data.NodeData) []*data.NodeData {
+	availableNodes := make([]*data.NodeData, 0, len(nodes))
+	for _, node := range nodes {
+		if bnp.circuitBreaker.IsAvailable(node.Address) {
+			availableNodes = append(availableNodes, node)
+		}
+	}
+
+	if len(availableNodes) == 0 {
+		return nodes
+	}
+
+	return availableNodes
+}
+
+// filterDrainedNodes will remove the drained nodes, so they do not receive new requests. The requests already sent to
+// them are not affected
+func (bnp *baseNodeProvider) filterDrainedNodes(nodes []*data.NodeData) []*data.NodeData {
+	if len(bnp.drainedNodes) == 0 {
+		return nodes
+	}
+
+	activeNodes := make([]*data.NodeData, 0, len(nodes))
+	for _, node := range nodes {
+		_, isDrained := bnp.drainedNodes[node.Address]
+		if !isDrained {
+			activeNodes = append(activeNodes, node)
+		}
+	}
+
+	return activeNodes
+}
+
 // PrintNodesInShards will only print the nodes in shards
 func (bnp *baseNodeProvider) PrintNodesInShards() {
 	bnp.mutNodes.RLock()
@@ -137,6 +244,117 @@ func splitNodesByDataAvailability(nodes []*data.NodeData) ([]*data.NodeData, []*
 	return regularNodes, snapshotlessNodes
 }
 
+// SetNodes validates the provided nodes and replaces the current ones with them. The current nodes are kept if the
+// provided ones are not valid
+func (bnp *baseNodeProvider) SetNodes(nodes []*data.NodeData) error {
+	return bnp.initNodes(nodes)
+}
+
+// AddNode adds the provided node, if the resulting nodes are valid
+func (bnp *baseNodeProvider) AddNode(node *data.NodeData) error {
+	bnp.mutUpdate.Lock()
+	defer bnp.mutUpdate.Unlock()
+
+	nodes := applyOverlay(bnp.configuredNodes, bnp.overlay)
+	if containsNode(nodes, node.Address, node.ShardId) {
+		return fmt.Errorf("%w: %s in shard %d", ErrNodeAlreadyExists, node.Address, node.ShardId)
+	}
+
+	overlay := cloneOverlay(bnp.overlay)
+	overlay.Added = append(overlay.Added, &data.NodeData{
+		ShardId:        node.ShardId,
+		Address:        node.Address,
+		IsFallback:     node.IsFallback,
+		IsSnapshotless: node.IsSnapshotless,
+	})
+
+	return bnp.applyNodesUnprotected(bnp.configuredNodes, overlay)
+}
+
+// RemoveNode removes the nodes with the provided address, if the remaining nodes are valid
+func (bnp *baseNodeProvider) RemoveNode(address string) error {
+	bnp.mutUpdate.Lock()
+	defer bnp.mutUpdate.Unlock()
+
+	err := bnp.checkNodeExistsUnprotected(address)
+	if err != nil {
+		return err
+	}
+	err = checkShardsAfterRemoval(applyOverlay(bnp.configuredNodes, bnp.overlay), address)
+	if err != nil {
+		return err
+	}
+
+	overlay := cloneOverlay(bnp.overlay)
+	overlay.Added = removeNodesWithAddress(overlay.Added, address)
+	if containsAddress(bnp.configuredNodes, address) && !containsString(overlay.Removed, address) {
+		overlay.Removed = append(overlay.Removed, address)
+	}
+	overlay.Drained = removeString(overlay.Drained, address)
+
+	return bnp.applyNodesUnprotected(bnp.configuredNodes, overlay)
+}
+
+// DrainNode stops sending new requests to the nodes with the provided address, if each shard is left with at least
+// one historical node which is not drained
+func (bnp *baseNodeProvider) DrainNode(address string) error {
+	bnp.mutUpdate.Lock()
+	defer bnp.mutUpdate.Unlock()
+
+	err := bnp.checkNodeExistsUnprotected(address)
+	if err != nil {
+		return err
+	}
+	if containsString(bnp.overlay.Drained, address) {
+		return fmt.Errorf("%w: %s", ErrNodeAlreadyDrained, address)
+	}
+
+	overlay := cloneOverlay(bnp.overlay)
+	overlay.Drained = append(overlay.Drained, address)
+
+	return bnp.applyNodesUnprotected(bnp.configuredNodes, overlay)
+}
+
+// UndrainNode resumes sending new requests to the nodes with the provided address
+func (bnp *baseNodeProvider) UndrainNode(address string) error {
+	bnp.mutUpdate.Lock()
+	defer bnp.mutUpdate.Unlock()
+
+	if !containsString(bnp.overlay.Drained, address) {
+		return fmt.Errorf("%w: %s", ErrNodeNotDrained, address)
+	}
+
+	overlay := cloneOverlay(bnp.overlay)
+	overlay.Drained = removeString(overlay.Drained, address)
+
+	return bnp.applyNodesUnprotected(bnp.configuredNodes, overlay)
+}
+
+func (bnp *baseNodeProvider) checkNodeExistsUnprotected(address string) error {
+	nodes := applyOverlay(bnp.configuredNodes, bnp.overlay)
+	if !containsAddress(nodes, address) {
+		return fmt.Errorf("%w: %s", ErrNodeNotFound, address)
+	}
+
+	return nil
+}
+
+// GetOverlay returns the changes made at runtime over the configured nodes
+func (bnp *baseNodeProvider) GetOverlay() data.NodesOverlay {
+	bnp.mutUpdate.Lock()
+	defer bnp.mutUpdate.Unlock()
+
+	return cloneOverlay(bnp.overlay)
+}
+
+// SetOverlay replaces the changes made at runtime over the configured nodes, if the resulting nodes are valid
+func (bnp *baseNodeProvider) SetOverlay(overlay data.NodesOverlay) error {
+	bnp.mutUpdate.Lock()
+	defer bnp.mutUpdate.Unlock()
+
+	return bnp.applyNodesUnprotected(bnp.configuredNodes, cloneOverlay(overlay))
+}
+
 // ReloadNodes will reload the observers or the full history observers
 func (bnp *baseNodeProvider) ReloadNodes(nodesType data.NodeType) data.NodesReloadResponse {
 	newConfig, err := loadMainConfig(bnp.configurationFilePath)
@@ -153,58 +371,72 @@ func (bnp *baseNodeProvider) ReloadNodes(nodesType data.NodeType) data.NodesRelo
 		nodes = newConfig.FullHistoryNodes
 	}
 
-	newNodes := nodesSliceToShardedMap(nodes)
-
-	bnp.mutNodes.Lock()
-	defer bnp.mutNodes.Unlock()
-	bnp.shardIds = getSortedShardIDsSlice(newNodes)
-	syncedNodes, syncedFallbackNodes, syncedSnapshotlessNodes, syncedSnapshotlessFallbackNodes := initAllNodesSlice(newNodes)
-	bnp.regularNodes, err = holder.NewNodesHolder(syncedNodes, syncedFallbackNodes, data.AvailabilityAll)
+	err = bnp.applyReloadedNodes(nodesType, nodes)
 	if err != nil {
-		log.Error("cannot reload regular nodes: NewNodesHolder", "error", err)
+		log.Error("cannot reload nodes", "error", err)
 		return data.NodesReloadResponse{
 			OkRequest:   true,
 			Description: "not reloaded",
-			Error:       "cannot create the regular nodes holder: " + err.Error(),
-		}
-	}
-
-	bnp.snapshotlessNodes, err = holder.NewNodesHolder(syncedSnapshotlessNodes, syncedSnapshotlessFallbackNodes, data.AvailabilityRecent)
-	if err != nil {
-		log.Error("cannot reload snapshotless nodes: NewNodesHolder", "error", err)
-		return data.NodesReloadResponse{
-			OkRequest:   true,
-			Description: "not reloaded",
-			Error:       "cannot create the snapshotless nodes holder: " + err.Error(),
+			Error:       "cannot apply the nodes: " + err.Error(),
 		}
 	}
 
 	return data.NodesReloadResponse{
 		OkRequest:   true,
-		Description: prepareReloadResponseMessage(newNodes),
+		Description: prepareReloadResponseMessage(nodesSliceToShardedMap(nodes)),
 		Error:       "",
 	}
 }
 
+// applyReloadedNodes applies the reloaded nodes through the reload handler, if set, so the nodes it adds to the configured
+// ones, such as the discovered nodes, are kept
+func (bnp *baseNodeProvider) applyReloadedNodes(nodesType data.NodeType, nodes []*data.NodeData) error {
+	bnp.mutReloadHandler.RLock()
+	reloadHandler := bnp.reloadHandler
+	bnp.mutReloadHandler.RUnlock()
+
+	if check.IfNil(reloadHandler) {
+		return bnp.initNodes(nodes)
+	}
+	if nodesType == data.FullHistoryNode {
+		return reloadHandler.SetFullHistoryNodes(nodes)
+	}
+
+	return reloadHandler.SetObservers(nodes)
+}
+
+// SetNodesReloadHandler sets the component the reloaded nodes are applied through
+func (bnp *baseNodeProvider) SetNodesReloadHandler(handler NodesReloadHandler) error {
+	if check.IfNil(handler) {
+		return ErrNilNodesReloadHandler
+	}
+
+	bnp.mutReloadHandler.Lock()
+	bnp.reloadHandler = handler
+	bnp.mutReloadHandler.Unlock()
+
+	return nil
+}
+
 func (bnp *baseNodeProvider) getSyncedNodesForShardUnprotected(shardID uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
 	var syncedNodes []*data.NodeData
 
-	syncedNodes = bnp.getSyncedNodes(dataAvailability, shardID)
+	syncedNodes = bnp.filterDrainedNodes(bnp.getSyncedNodes(dataAvailability, shardID))
 	if len(syncedNodes) != 0 {
 		return syncedNodes, nil
 	}
 
-	fallbackNodesSource := bnp.getFallbackNodes(dataAvailability, shardID)
+	fallbackNodesSource := bnp.filterDrainedNodes(bnp.getFallbackNodes(dataAvailability, shardID))
 	if len(fallbackNodesSource) != 0 {
 		return fallbackNodesSource, nil
 	}
 
-	outOfSyncNodes := bnp.getOutOfSyncNodes(dataAvailability, shardID)
+	outOfSyncNodes := bnp.filterDrainedNodes(bnp.getOutOfSyncNodes(dataAvailability, shardID))
 	if len(outOfSyncNodes) > 0 {
 		return outOfSyncNodes, nil
 	}
 
-	outOfSyncFallbackNodesSource := bnp.getOutOfSyncFallbackNodes(dataAvailability, shardID)
+	outOfSyncFallbackNodesSource := bnp.filterDrainedNodes(bnp.getOutOfSyncFallbackNodes(dataAvailability, shardID))
 	if len(outOfSyncFallbackNodesSource) != 0 {
 		return outOfSyncFallbackNodesSource, nil
 	}
@@ -113,6 +113,347 @@ func TestBaseNodeProvider_ReloadNodesShouldWork(t *testing.T) {
 	})
 }
 
+// nodesReloadHandlerStub -
+type nodesReloadHandlerStub struct {
+	SetObserversCalled        func(nodes []*data.NodeData) error
+	SetFullHistoryNodesCalled func(nodes []*data.NodeData) error
+}
+
+// SetObservers -
+func (stub *nodesReloadHandlerStub) SetObservers(nodes []*data.NodeData) error {
+	if stub.SetObserversCalled != nil {
+		return stub.SetObserversCalled(nodes)
+	}
+
+	return nil
+}
+
+// SetFullHistoryNodes -
+func (stub *nodesReloadHandlerStub) SetFullHistoryNodes(nodes []*data.NodeData) error {
+	if stub.SetFullHistoryNodesCalled != nil {
+		return stub.SetFullHistoryNodesCalled(nodes)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *nodesReloadHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func TestBaseNodeProvider_ReloadNodesWithReloadHandlerShouldApplyTheNodesThroughIt(t *testing.T) {
+	t.Parallel()
+
+	discoveredNode := &data.NodeData{ShardId: 0, Address: "discovered-observer-shard-0"}
+	bnp := &baseNodeProvider{
+		configurationFilePath: configurationPath,
+		shardIds:              []uint32{0, 1, 2, core.MetachainShardId},
+		numOfShards:           3,
+	}
+
+	var reloadedNodes []*data.NodeData
+	reloadHandler := &nodesReloadHandlerStub{
+		// the handler, such as the nodes discoverer, adds its own nodes to the reloaded ones
+		SetObserversCalled: func(nodes []*data.NodeData) error {
+			reloadedNodes = nodes
+			return bnp.SetNodes(append(nodes, discoveredNode))
+		},
+		SetFullHistoryNodesCalled: func(_ []*data.NodeData) error {
+			require.Fail(t, "should have not been called")
+			return nil
+		},
+	}
+
+	require.Equal(t, ErrNilNodesReloadHandler, bnp.SetNodesReloadHandler(nil))
+	err := bnp.SetNodesReloadHandler(reloadHandler)
+	require.NoError(t, err)
+
+	response := bnp.ReloadNodes(data.Observer)
+	require.True(t, response.OkRequest)
+	require.Empty(t, response.Error)
+	require.Equal(t, 4, len(reloadedNodes))
+	require.Equal(t, 5, len(bnp.configuredNodes))
+	require.True(t, containsNode(bnp.configuredNodes, discoveredNode.Address, discoveredNode.ShardId))
+}
+
+func TestCheckNodes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty nodes should err", func(t *testing.T) {
+		t.Parallel()
+
+		err := CheckNodes(nil, 1)
+		require.Equal(t, ErrEmptyObserversList, err)
+	})
+	t.Run("invalid shard should err", func(t *testing.T) {
+		t.Parallel()
+
+		err := CheckNodes([]*data.NodeData{{Address: "addr0", ShardId: 1}}, 1)
+		require.True(t, errors.Is(err, ErrInvalidShard))
+	})
+	t.Run("only snapshotless nodes in shard should err", func(t *testing.T) {
+		t.Parallel()
+
+		err := CheckNodes([]*data.NodeData{{Address: "addr0", ShardId: 0, IsSnapshotless: true}}, 1)
+		require.Contains(t, err.Error(), "observers for shard 0 must include at least one historical")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		err := CheckNodes([]*data.NodeData{
+			{Address: "addr0", ShardId: 0},
+			{Address: "addr1", ShardId: 0, IsSnapshotless: true},
+			{Address: "addr2", ShardId: core.MetachainShardId},
+		}, 1)
+		require.NoError(t, err)
+	})
+}
+
+func TestBaseNodeProvider_SetNodes(t *testing.T) {
+	t.Parallel()
+
+	initialNodes := []*data.NodeData{
+		{Address: "addr0", ShardId: 0},
+		{Address: "addr1", ShardId: core.MetachainShardId},
+	}
+
+	t.Run("invalid nodes should keep the current ones", func(t *testing.T) {
+		t.Parallel()
+
+		bnp := &baseNodeProvider{
+			numOfShards: 1,
+		}
+		require.NoError(t, bnp.initNodes(initialNodes))
+
+		err := bnp.SetNodes([]*data.NodeData{{Address: "addr2", ShardId: 1}})
+		require.True(t, errors.Is(err, ErrInvalidShard))
+		require.Equal(t, []string{"addr0", "addr1"}, getNodesAddresses(bnp.GetAllNodesWithSyncState()))
+	})
+	t.Run("should replace the nodes", func(t *testing.T) {
+		t.Parallel()
+
+		bnp := &baseNodeProvider{
+			numOfShards: 2,
+		}
+		require.NoError(t, bnp.initNodes(initialNodes))
+
+		newNodes := []*data.NodeData{
+			{Address: "addr2", ShardId: 0},
+			{Address: "addr3", ShardId: 1},
+			{Address: "addr4", ShardId: 1, IsSnapshotless: true},
+		}
+		err := bnp.SetNodes(newNodes)
+		require.NoError(t, err)
+		require.Equal(t, []uint32{0, 1}, bnp.shardIds)
+		require.Equal(t, []string{"addr2", "addr3", "addr4"}, getNodesAddresses(bnp.GetAllNodesWithSyncState()))
+	})
+}
+
+func getNodesAddresses(nodes []*data.NodeData) []string {
+	addresses := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		addresses = append(addresses, node.Address)
+	}
+
+	return addresses
+}
+
+func createBaseNodeProviderForAdmin(t *testing.T) *baseNodeProvider {
+	bnp := &baseNodeProvider{
+		numOfShards: 1,
+	}
+	err := bnp.initNodes([]*data.NodeData{
+		{Address: "addr0", ShardId: 0},
+		{Address: "addr1", ShardId: 0},
+		{Address: "addr2", ShardId: core.MetachainShardId},
+	})
+	require.NoError(t, err)
+
+	return bnp
+}
+
+func TestBaseNodeProvider_AddNode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("existing node should error", func(t *testing.T) {
+		t.Parallel()
+
+		bnp := createBaseNodeProviderForAdmin(t)
+		err := bnp.AddNode(&data.NodeData{Address: "addr0", ShardId: 0})
+		require.True(t, errors.Is(err, ErrNodeAlreadyExists))
+	})
+	t.Run("invalid shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		bnp := createBaseNodeProviderForAdmin(t)
+		err := bnp.AddNode(&data.NodeData{Address: "addr3", ShardId: 1})
+		require.True(t, errors.Is(err, ErrInvalidShard))
+		require.Empty(t, bnp.GetOverlay().Added)
+	})
+	t.Run("should add the node and keep it when the nodes are set", func(t *testing.T) {
+		t.Parallel()
+
+		bnp := createBaseNodeProviderForAdmin(t)
+		err := bnp.AddNode(&data.NodeData{Address: "addr3", ShardId: 0, IsFallback: true})
+		require.NoError(t, err)
+		require.Equal(t, []string{"addr0", "addr1", "addr3", "addr2"}, getNodesAddresses(bnp.GetAllNodesWithSyncState()))
+		require.Equal(t, []*data.NodeData{{Address: "addr3", ShardId: 0, IsFallback: true}}, bnp.GetOverlay().Added)
+
+		err = bnp.SetNodes([]*data.NodeData{
+			{Address: "addr4", ShardId: 0},
+			{Address: "addr2", ShardId: core.MetachainShardId},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"addr4", "addr3", "addr2"}, getNodesAddresses(bnp.GetAllNodesWithSyncState()))
+	})
+}
+
+func TestBaseNodeProvider_RemoveNode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown node should error", func(t *testing.T) {
+		t.Parallel()
+
+		bnp := createBaseNodeProviderForAdmin(t)
+		err := bnp.RemoveNode("addr3")
+		require.True(t, errors.Is(err, ErrNodeNotFound))
+	})
+	t.Run("last node of a shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		bnp := createBaseNodeProviderForAdmin(t)
+		require.NoError(t, bnp.RemoveNode("addr0"))
+
+		err := bnp.RemoveNode("addr1")
+		require.True(t, errors.Is(err, ErrNoNodesLeftInShard))
+		require.Equal(t, []string{"addr1", "addr2"}, getNodesAddresses(bnp.GetAllNodesWithSyncState()))
+	})
+	t.Run("should remove the configured and the added nodes", func(t *testing.T) {
+		t.Parallel()
+
+		bnp := createBaseNodeProviderForAdmin(t)
+		require.NoError(t, bnp.AddNode(&data.NodeData{Address: "addr3", ShardId: 0}))
+		require.NoError(t, bnp.DrainNode("addr1"))
+
+		require.NoError(t, bnp.RemoveNode("addr1"))
+		require.NoError(t, bnp.RemoveNode("addr3"))
+		require.Equal(t, []string{"addr0", "addr2"}, getNodesAddresses(bnp.GetAllNodesWithSyncState()))
+		require.Equal(t, data.NodesOverlay{
+			Added:   []*data.NodeData{},
+			Removed: []string{"addr1"},
+			Drained: []string{},
+		}, bnp.GetOverlay())
+
+		// a removed configured node can be added back
+		require.NoError(t, bnp.AddNode(&data.NodeData{Address: "addr1", ShardId: 0}))
+		require.Equal(t, []string{"addr0", "addr1", "addr2"}, getNodesAddresses(bnp.GetAllNodesWithSyncState()))
+	})
+}
+
+func TestBaseNodeProvider_DrainNode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown node should error", func(t *testing.T) {
+		t.Parallel()
+
+		bnp := createBaseNodeProviderForAdmin(t)
+		err := bnp.DrainNode("addr3")
+		require.True(t, errors.Is(err, ErrNodeNotFound))
+	})
+	t.Run("last active node of a shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		bnp := createBaseNodeProviderForAdmin(t)
+		err := bnp.DrainNode("addr2")
+		require.True(t, errors.Is(err, ErrAllNodesDrained))
+	})
+	t.Run("drained node should error", func(t *testing.T) {
+		t.Parallel()
+
+		bnp := createBaseNodeProviderForAdmin(t)
+		require.NoError(t, bnp.DrainNode("addr0"))
+
+		err := bnp.DrainNode("addr0")
+		require.True(t, errors.Is(err, ErrNodeAlreadyDrained))
+	})
+	t.Run("drained node should not receive requests until undrained", func(t *testing.T) {
+		t.Parallel()
+
+		bnp := createBaseNodeProviderForAdmin(t)
+		require.NoError(t, bnp.DrainNode("addr0"))
+
+		nodes, err := bnp.getSyncedNodesForShardUnprotected(0, data.AvailabilityAll)
+		require.NoError(t, err)
+		require.Equal(t, []string{"addr1"}, getNodesAddresses(nodes))
+
+		// the drained nodes are still checked for their sync state
+		require.Equal(t, []string{"addr0", "addr1", "addr2"}, getNodesAddresses(bnp.GetAllNodesWithSyncState()))
+
+		require.NoError(t, bnp.UndrainNode("addr0"))
+		nodes, err = bnp.getSyncedNodesForShardUnprotected(0, data.AvailabilityAll)
+		require.NoError(t, err)
+		require.Equal(t, []string{"addr0", "addr1"}, getNodesAddresses(nodes))
+
+		err = bnp.UndrainNode("addr0")
+		require.True(t, errors.Is(err, ErrNodeNotDrained))
+	})
+}
+
+func TestBaseNodeProvider_SetOverlay(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid overlay should keep the current nodes", func(t *testing.T) {
+		t.Parallel()
+
+		bnp := createBaseNodeProviderForAdmin(t)
+		err := bnp.SetOverlay(data.NodesOverlay{Drained: []string{"addr0", "addr1"}})
+		require.True(t, errors.Is(err, ErrAllNodesDrained))
+		require.Empty(t, bnp.GetOverlay().Drained)
+	})
+	t.Run("should apply the overlay and drop the unknown addresses", func(t *testing.T) {
+		t.Parallel()
+
+		bnp := createBaseNodeProviderForAdmin(t)
+		err := bnp.SetOverlay(data.NodesOverlay{
+			Added:   []*data.NodeData{{Address: "addr3", ShardId: 0}},
+			Removed: []string{"addr1", "unknown"},
+			Drained: []string{"addr0", "unknown"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"addr0", "addr3", "addr2"}, getNodesAddresses(bnp.GetAllNodesWithSyncState()))
+		require.Equal(t, data.NodesOverlay{
+			Added:   []*data.NodeData{{Address: "addr3", ShardId: 0}},
+			Removed: []string{"addr1"},
+			Drained: []string{"addr0"},
+		}, bnp.GetOverlay())
+	})
+}
+
+func TestDisabledNodesProvider_SetNodes(t *testing.T) {
+	t.Parallel()
+
+	dnp := NewDisabledNodesProvider("full history nodes not supported")
+	require.NoError(t, dnp.SetNodes(nil))
+
+	err := dnp.SetNodes([]*data.NodeData{{Address: "addr0", ShardId: 0}})
+	require.True(t, errors.Is(err, ErrDisabledNodesProvider))
+	require.Contains(t, err.Error(), "full history nodes not supported")
+}
+
+func TestDisabledNodesProvider_NodesChanges(t *testing.T) {
+	t.Parallel()
+
+	dnp := NewDisabledNodesProvider("full history nodes not supported")
+	require.True(t, errors.Is(dnp.AddNode(&data.NodeData{Address: "addr0"}), ErrDisabledNodesProvider))
+	require.True(t, errors.Is(dnp.RemoveNode("addr0"), ErrDisabledNodesProvider))
+	require.True(t, errors.Is(dnp.DrainNode("addr0"), ErrDisabledNodesProvider))
+	require.True(t, errors.Is(dnp.UndrainNode("addr0"), ErrDisabledNodesProvider))
+	require.Equal(t, data.NodesOverlay{}, dnp.GetOverlay())
+	require.NoError(t, dnp.SetOverlay(data.NodesOverlay{}))
+	require.True(t, errors.Is(dnp.SetOverlay(data.NodesOverlay{Drained: []string{"addr0"}}), ErrDisabledNodesProvider))
+}
+
 func TestBaseNodeProvider_prepareReloadResponseMessage(t *testing.T) {
 	addr0, addr1, addr2 := "addr0", "addr1", "addr2"
 	newNodes := map[uint32][]*data.NodeData{
@@ -0,0 +1,34 @@
+package circuitBreaker
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+type disabledNodesCircuitBreaker struct {
+}
+
+// NewDisabledNodesCircuitBreaker returns a new instance of disabledNodesCircuitBreaker
+func NewDisabledNodesCircuitBreaker() *disabledNodesCircuitBreaker {
+	return &disabledNodesCircuitBreaker{}
+}
+
+// IsAvailable returns true as this is a disabled component
+func (dncb *disabledNodesCircuitBreaker) IsAvailable(_ string) bool {
+	return true
+}
+
+// RecordRequestSent does nothing as this is a disabled component
+func (dncb *disabledNodesCircuitBreaker) RecordRequestSent(_ string) {
+}
+
+// RecordResult does nothing as this is a disabled component
+func (dncb *disabledNodesCircuitBreaker) RecordResult(_ string, _ bool) {
+}
+
+// GetState returns the disabled state
+func (dncb *disabledNodesCircuitBreaker) GetState(_ string) (data.CircuitBreakerState, uint32) {
+	return data.CircuitBreakerDisabled, 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dncb *disabledNodesCircuitBreaker) IsInterfaceNil() bool {
+	return dncb == nil
+}
@@ -0,0 +1,9 @@
+package circuitBreaker
+
+import "errors"
+
+// ErrInvalidFailureThreshold signals that an invalid failure threshold has been provided
+var ErrInvalidFailureThreshold = errors.New("invalid failure threshold")
+
+// ErrInvalidCoolDown signals that an invalid cool-down duration has been provided
+var ErrInvalidCoolDown = errors.New("invalid cool-down duration")
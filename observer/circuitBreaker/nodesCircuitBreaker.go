@@ -0,0 +1,163 @@
+package circuitBreaker
+
+import (
+	"sync"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("observer/circuitBreaker")
+
+// ArgsNodesCircuitBreaker holds the arguments needed to create a new nodesCircuitBreaker
+type ArgsNodesCircuitBreaker struct {
+	FailureThreshold uint32
+	CoolDown         time.Duration
+}
+
+type nodeBreaker struct {
+	state               data.CircuitBreakerState
+	consecutiveFailures uint32
+	openedAt            time.Time
+	probeStartedAt      time.Time
+}
+
+// nodesCircuitBreaker holds a circuit breaker for each node. After FailureThreshold consecutive failures, the circuit
+// of a node is opened and the node is skipped. Once the cool-down period passes, the first request sent to the node
+// moves the circuit to half-open and acts as a probe: a success closes the circuit, while a failure opens it again
+type nodesCircuitBreaker struct {
+	failureThreshold uint32
+	coolDown         time.Duration
+	getTimeHandler   func() time.Time
+
+	mutBreakers sync.Mutex
+	breakers    map[string]*nodeBreaker
+}
+
+// NewNodesCircuitBreaker returns a new instance of nodesCircuitBreaker
+func NewNodesCircuitBreaker(args ArgsNodesCircuitBreaker) (*nodesCircuitBreaker, error) {
+	if args.FailureThreshold == 0 {
+		return nil, ErrInvalidFailureThreshold
+	}
+	if args.CoolDown <= 0 {
+		return nil, ErrInvalidCoolDown
+	}
+
+	return &nodesCircuitBreaker{
+		failureThreshold: args.FailureThreshold,
+		coolDown:         args.CoolDown,
+		getTimeHandler:   time.Now,
+		breakers:         make(map[string]*nodeBreaker),
+	}, nil
+}
+
+// IsAvailable returns true if requests can be sent to the node with the provided address. The circuit state is not
+// changed here: a node with an open circuit becomes available once the cool-down period passes, while a half-open
+// circuit is available only if its probe request was not sent within the last cool-down period
+func (ncb *nodesCircuitBreaker) IsAvailable(address string) bool {
+	ncb.mutBreakers.Lock()
+	defer ncb.mutBreakers.Unlock()
+
+	breaker, found := ncb.breakers[address]
+	if !found {
+		return true
+	}
+
+	now := ncb.getTimeHandler()
+	switch breaker.state {
+	case data.CircuitBreakerOpen:
+		return now.Sub(breaker.openedAt) >= ncb.coolDown
+	case data.CircuitBreakerHalfOpen:
+		return now.Sub(breaker.probeStartedAt) >= ncb.coolDown
+	default:
+		return true
+	}
+}
+
+// RecordRequestSent will update the circuit breaker of the node with the provided address when a request is actually
+// sent to it. If the cool-down period of an open circuit passed, the circuit becomes half-open and the request is
+// the probe. If the probe result is never recorded, a new probe is allowed after another cool-down period
+func (ncb *nodesCircuitBreaker) RecordRequestSent(address string) {
+	ncb.mutBreakers.Lock()
+	defer ncb.mutBreakers.Unlock()
+
+	breaker, found := ncb.breakers[address]
+	if !found {
+		return
+	}
+
+	now := ncb.getTimeHandler()
+	switch breaker.state {
+	case data.CircuitBreakerOpen:
+		if now.Sub(breaker.openedAt) < ncb.coolDown {
+			return
+		}
+
+		log.Debug("circuit breaker is half-open", "address", address)
+		breaker.state = data.CircuitBreakerHalfOpen
+		breaker.probeStartedAt = now
+	case data.CircuitBreakerHalfOpen:
+		if now.Sub(breaker.probeStartedAt) < ncb.coolDown {
+			return
+		}
+
+		breaker.probeStartedAt = now
+	}
+}
+
+// RecordResult will update the circuit breaker of the node with the provided address, based on the result of a request
+func (ncb *nodesCircuitBreaker) RecordResult(address string, isSuccessful bool) {
+	ncb.mutBreakers.Lock()
+	defer ncb.mutBreakers.Unlock()
+
+	breaker, found := ncb.breakers[address]
+	if !found {
+		if isSuccessful {
+			return
+		}
+
+		breaker = &nodeBreaker{state: data.CircuitBreakerClosed}
+		ncb.breakers[address] = breaker
+	}
+
+	if isSuccessful {
+		if breaker.state != data.CircuitBreakerClosed {
+			log.Info("circuit breaker closed", "address", address)
+		}
+
+		breaker.state = data.CircuitBreakerClosed
+		breaker.consecutiveFailures = 0
+		return
+	}
+
+	breaker.consecutiveFailures++
+	shouldOpen := breaker.state == data.CircuitBreakerHalfOpen ||
+		(breaker.state == data.CircuitBreakerClosed && breaker.consecutiveFailures >= ncb.failureThreshold)
+	if !shouldOpen {
+		return
+	}
+
+	log.Warn("circuit breaker opened", "address", address, "consecutive failures", breaker.consecutiveFailures)
+	breaker.state = data.CircuitBreakerOpen
+	breaker.openedAt = ncb.getTimeHandler()
+}
+
+// GetState returns the state of the circuit breaker of the node with the provided address, along with the number of
+// consecutive failures
+func (ncb *nodesCircuitBreaker) GetState(address string) (data.CircuitBreakerState, uint32) {
+	ncb.mutBreakers.Lock()
+	defer ncb.mutBreakers.Unlock()
+
+	breaker, found := ncb.breakers[address]
+	if !found {
+		return data.CircuitBreakerClosed, 0
+	}
+
+	return breaker.state, breaker.consecutiveFailures
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ncb *nodesCircuitBreaker) IsInterfaceNil() bool {
+	return ncb == nil
+}
@@ -0,0 +1,214 @@
+package circuitBreaker
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgs() ArgsNodesCircuitBreaker {
+	return ArgsNodesCircuitBreaker{
+		FailureThreshold: 2,
+		CoolDown:         10 * time.Second,
+	}
+}
+
+func createCircuitBreakerWithTime(currentTime *time.Time) *nodesCircuitBreaker {
+	ncb, _ := NewNodesCircuitBreaker(createArgs())
+	ncb.getTimeHandler = func() time.Time {
+		return *currentTime
+	}
+
+	return ncb
+}
+
+func TestNewNodesCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid failure threshold should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.FailureThreshold = 0
+		ncb, err := NewNodesCircuitBreaker(args)
+		assert.Nil(t, ncb)
+		assert.Equal(t, ErrInvalidFailureThreshold, err)
+	})
+	t.Run("invalid cool-down should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.CoolDown = 0
+		ncb, err := NewNodesCircuitBreaker(args)
+		assert.Nil(t, ncb)
+		assert.Equal(t, ErrInvalidCoolDown, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ncb, err := NewNodesCircuitBreaker(createArgs())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(ncb))
+	})
+}
+
+func TestNodesCircuitBreaker_ShouldOpenAfterConsecutiveFailures(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	ncb := createCircuitBreakerWithTime(&currentTime)
+
+	assert.True(t, ncb.IsAvailable("addr"))
+	state, failures := ncb.GetState("addr")
+	assert.Equal(t, data.CircuitBreakerClosed, state)
+	assert.Equal(t, uint32(0), failures)
+
+	// a success in between resets the consecutive failures
+	ncb.RecordResult("addr", false)
+	ncb.RecordResult("addr", true)
+	ncb.RecordResult("addr", false)
+	assert.True(t, ncb.IsAvailable("addr"))
+	state, failures = ncb.GetState("addr")
+	assert.Equal(t, data.CircuitBreakerClosed, state)
+	assert.Equal(t, uint32(1), failures)
+
+	ncb.RecordResult("addr", false)
+	assert.False(t, ncb.IsAvailable("addr"))
+	state, failures = ncb.GetState("addr")
+	assert.Equal(t, data.CircuitBreakerOpen, state)
+	assert.Equal(t, uint32(2), failures)
+
+	// other nodes are not affected
+	assert.True(t, ncb.IsAvailable("other addr"))
+}
+
+func TestNodesCircuitBreaker_HalfOpenShouldAllowOneProbe(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	ncb := createCircuitBreakerWithTime(&currentTime)
+	ncb.RecordResult("addr", false)
+	ncb.RecordResult("addr", false)
+
+	currentTime = currentTime.Add(9 * time.Second)
+	assert.False(t, ncb.IsAvailable("addr"))
+
+	currentTime = currentTime.Add(time.Second)
+	assert.True(t, ncb.IsAvailable("addr"))
+	assert.True(t, ncb.IsAvailable("addr"))
+	state, _ := ncb.GetState("addr")
+	assert.Equal(t, data.CircuitBreakerOpen, state)
+
+	ncb.RecordRequestSent("addr")
+	state, _ = ncb.GetState("addr")
+	assert.Equal(t, data.CircuitBreakerHalfOpen, state)
+
+	// the probe is in flight, so the others should skip the node
+	assert.False(t, ncb.IsAvailable("addr"))
+
+	t.Run("failed probe should open the circuit again", func(t *testing.T) {
+		ncb.RecordResult("addr", false)
+		state, _ = ncb.GetState("addr")
+		assert.Equal(t, data.CircuitBreakerOpen, state)
+		assert.False(t, ncb.IsAvailable("addr"))
+	})
+	t.Run("successful probe should close the circuit", func(t *testing.T) {
+		currentTime = currentTime.Add(10 * time.Second)
+		require.True(t, ncb.IsAvailable("addr"))
+		ncb.RecordRequestSent("addr")
+
+		ncb.RecordResult("addr", true)
+		state, failures := ncb.GetState("addr")
+		assert.Equal(t, data.CircuitBreakerClosed, state)
+		assert.Equal(t, uint32(0), failures)
+		assert.True(t, ncb.IsAvailable("addr"))
+		assert.True(t, ncb.IsAvailable("addr"))
+	})
+}
+
+func TestNodesCircuitBreaker_UnfinishedProbeShouldBeRetriedAfterCoolDown(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	ncb := createCircuitBreakerWithTime(&currentTime)
+	ncb.RecordResult("addr", false)
+	ncb.RecordResult("addr", false)
+
+	currentTime = currentTime.Add(10 * time.Second)
+	assert.True(t, ncb.IsAvailable("addr"))
+	ncb.RecordRequestSent("addr")
+	assert.False(t, ncb.IsAvailable("addr"))
+
+	currentTime = currentTime.Add(10 * time.Second)
+	assert.True(t, ncb.IsAvailable("addr"))
+	ncb.RecordRequestSent("addr")
+	assert.False(t, ncb.IsAvailable("addr"))
+}
+
+func TestNodesCircuitBreaker_RecordRequestSentBeforeCoolDownShouldNotChangeState(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	ncb := createCircuitBreakerWithTime(&currentTime)
+
+	// unknown nodes are not tracked
+	ncb.RecordRequestSent("addr")
+	state, _ := ncb.GetState("addr")
+	assert.Equal(t, data.CircuitBreakerClosed, state)
+
+	ncb.RecordResult("addr", false)
+	ncb.RecordResult("addr", false)
+	currentTime = currentTime.Add(5 * time.Second)
+	ncb.RecordRequestSent("addr")
+	state, _ = ncb.GetState("addr")
+	assert.Equal(t, data.CircuitBreakerOpen, state)
+	assert.False(t, ncb.IsAvailable("addr"))
+}
+
+func TestNodesCircuitBreaker_ConcurrentOperationsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	ncb, _ := NewNodesCircuitBreaker(createArgs())
+
+	numOperations := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numOperations)
+	for i := 0; i < numOperations; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			switch idx % 4 {
+			case 0:
+				ncb.RecordResult("addr", idx%3 == 0)
+			case 1:
+				_ = ncb.IsAvailable("addr")
+			case 2:
+				ncb.RecordRequestSent("addr")
+			case 3:
+				_, _ = ncb.GetState("addr")
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestDisabledNodesCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	dncb := NewDisabledNodesCircuitBreaker()
+	require.False(t, check.IfNil(dncb))
+
+	dncb.RecordResult("addr", false)
+	dncb.RecordResult("addr", false)
+	dncb.RecordRequestSent("addr")
+	assert.True(t, dncb.IsAvailable("addr"))
+
+	state, failures := dncb.GetState("addr")
+	assert.Equal(t, data.CircuitBreakerDisabled, state)
+	assert.Equal(t, uint32(0), failures)
+}
@@ -1,6 +1,7 @@
 package observer
 
 import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/observer/mapCounters"
 )
@@ -17,10 +18,16 @@ func NewCircularQueueNodesProvider(
 	observers []*data.NodeData,
 	configurationFilePath string,
 	numberOfShards uint32,
+	nodesCircuitBreaker NodesCircuitBreaker,
 ) (*circularQueueNodesProvider, error) {
+	if check.IfNil(nodesCircuitBreaker) {
+		return nil, ErrNilNodesCircuitBreaker
+	}
+
 	bop := &baseNodeProvider{
 		configurationFilePath: configurationFilePath,
 		numOfShards:           numberOfShards,
+		circuitBreaker:        nodesCircuitBreaker,
 	}
 
 	err := bop.initNodes(observers)
@@ -51,7 +58,7 @@ func (cqnp *circularQueueNodesProvider) GetNodesByShardId(shardId uint32, dataAv
 
 	sliceToRet := append(syncedNodesForShard[position:], syncedNodesForShard[:position]...)
 
-	return sliceToRet, nil
+	return cqnp.filterAvailableNodes(sliceToRet), nil
 }
 
 // GetAllNodes will return a slice containing all observers
@@ -71,7 +78,7 @@ func (cqnp *circularQueueNodesProvider) GetAllNodes(dataAvailability data.Observ
 
 	sliceToRet := append(allNodes[position:], allNodes[:position]...)
 
-	return sliceToRet, nil
+	return cqnp.filterAvailableNodes(sliceToRet), nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -8,6 +8,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/observer/circuitBreaker"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -31,7 +32,7 @@ func TestNewCircularQueueObserverProvider_EmptyObserversListShouldErr(t *testing
 
 	cfg := getDummyConfig()
 	cfg.Observers = make([]*data.NodeData, 0)
-	cqop, err := NewCircularQueueNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	cqop, err := NewCircularQueueNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), circuitBreaker.NewDisabledNodesCircuitBreaker())
 	assert.Nil(t, cqop)
 	assert.Equal(t, ErrEmptyObserversList, err)
 }
@@ -40,7 +41,7 @@ func TestNewCircularQueueObserverProvider_ShouldWork(t *testing.T) {
 	t.Parallel()
 
 	cfg := getDummyConfig()
-	cqop, err := NewCircularQueueNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	cqop, err := NewCircularQueueNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), circuitBreaker.NewDisabledNodesCircuitBreaker())
 	assert.Nil(t, err)
 	assert.False(t, check.IfNil(cqop))
 }
@@ -50,7 +51,7 @@ func TestCircularQueueObserversProvider_GetObserversByShardIdShouldWork(t *testi
 
 	shardId := uint32(0)
 	cfg := getDummyConfig()
-	cqop, _ := NewCircularQueueNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	cqop, _ := NewCircularQueueNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), circuitBreaker.NewDisabledNodesCircuitBreaker())
 
 	res, err := cqop.GetNodesByShardId(shardId, data.AvailabilityAll)
 	assert.Nil(t, err)
@@ -77,7 +78,7 @@ func TestCircularQueueObserversProvider_GetObserversByShardIdShouldBalanceObserv
 			},
 		},
 	}
-	cqop, _ := NewCircularQueueNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	cqop, _ := NewCircularQueueNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), circuitBreaker.NewDisabledNodesCircuitBreaker())
 
 	res1, _ := cqop.GetNodesByShardId(shardId, data.AvailabilityAll)
 	res2, _ := cqop.GetNodesByShardId(shardId, data.AvailabilityAll)
@@ -94,7 +95,7 @@ func TestCircularQueueObserversProvider_GetAllObserversShouldWork(t *testing.T)
 	t.Parallel()
 
 	cfg := getDummyConfig()
-	cqop, _ := NewCircularQueueNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	cqop, _ := NewCircularQueueNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), circuitBreaker.NewDisabledNodesCircuitBreaker())
 
 	res, err := cqop.GetAllNodes(data.AvailabilityAll)
 	assert.NoError(t, err)
@@ -120,7 +121,7 @@ func TestCircularQueueObserversProvider_GetAllObserversShouldWorkAndBalanceObser
 			},
 		},
 	}
-	cqop, _ := NewCircularQueueNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	cqop, _ := NewCircularQueueNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), circuitBreaker.NewDisabledNodesCircuitBreaker())
 
 	res1, _ := cqop.GetAllNodes(data.AvailabilityAll)
 	res2, _ := cqop.GetAllNodes(data.AvailabilityAll)
@@ -166,7 +167,7 @@ func TestCircularQueueObserversProvider_GetAllObservers_ConcurrentSafe(t *testin
 
 	expectedNumOfTimesAnObserverIsCalled := (numOfTimesToCallForEachRoutine * numOfGoRoutinesToStart) / len(observers)
 
-	cqop, _ := NewCircularQueueNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	cqop, _ := NewCircularQueueNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), circuitBreaker.NewDisabledNodesCircuitBreaker())
 
 	for i := 0; i < numOfGoRoutinesToStart; i++ {
 		for j := 0; j < numOfTimesToCallForEachRoutine; j++ {
@@ -225,7 +226,7 @@ func TestCircularQueueObserversProvider_GetObserversByShardId_ConcurrentSafe(t *
 
 	expectedNumOfTimesAnObserverIsCalled := 2 * ((numOfTimesToCallForEachRoutine * numOfGoRoutinesToStart) / len(observers))
 
-	cqop, _ := NewCircularQueueNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	cqop, _ := NewCircularQueueNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), circuitBreaker.NewDisabledNodesCircuitBreaker())
 
 	for i := 0; i < numOfGoRoutinesToStart; i++ {
 		for j := 0; j < numOfTimesToCallForEachRoutine; j++ {
@@ -246,3 +247,28 @@ func TestCircularQueueObserversProvider_GetObserversByShardId_ConcurrentSafe(t *
 	}
 	mutMap.RUnlock()
 }
+
+func TestCircularQueueObserversProvider_ShouldSkipNodesWithOpenCircuit(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "addr0", ShardId: 0},
+		{Address: "addr1", ShardId: 0},
+		{Address: "addr2", ShardId: 0},
+	}
+	nodesCircuitBreaker, _ := circuitBreaker.NewNodesCircuitBreaker(circuitBreaker.ArgsNodesCircuitBreaker{
+		FailureThreshold: 1,
+		CoolDown:         time.Hour,
+	})
+	cqop, _ := NewCircularQueueNodesProvider(observers, "path", 1, nodesCircuitBreaker)
+	cqop.RecordNodeResponse("addr1", time.Second, false)
+
+	for i := 0; i < 3; i++ {
+		res, err := cqop.GetNodesByShardId(0, data.AvailabilityAll)
+		assert.Nil(t, err)
+		assert.Len(t, res, 2)
+		for _, node := range res {
+			assert.NotEqual(t, "addr1", node.Address)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package observer
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
@@ -44,6 +46,71 @@ func (d *disabledNodesProvider) ReloadNodes(_ data.NodeType) data.NodesReloadRes
 	return data.NodesReloadResponse{Description: "disabled nodes provider", Error: d.returnMessage}
 }
 
+// SetNodesReloadHandler returns nil as the nodes of a disabled nodes provider cannot be reloaded
+func (d *disabledNodesProvider) SetNodesReloadHandler(_ NodesReloadHandler) error {
+	return nil
+}
+
+// SetNodes returns an error if nodes are provided, as a disabled nodes provider can only be enabled by a restart
+func (d *disabledNodesProvider) SetNodes(nodes []*data.NodeData) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	return d.createDisabledError()
+}
+
+// AddNode returns an error as it is disabled
+func (d *disabledNodesProvider) AddNode(_ *data.NodeData) error {
+	return d.createDisabledError()
+}
+
+// RemoveNode returns an error as it is disabled
+func (d *disabledNodesProvider) RemoveNode(_ string) error {
+	return d.createDisabledError()
+}
+
+// DrainNode returns an error as it is disabled
+func (d *disabledNodesProvider) DrainNode(_ string) error {
+	return d.createDisabledError()
+}
+
+// UndrainNode returns an error as it is disabled
+func (d *disabledNodesProvider) UndrainNode(_ string) error {
+	return d.createDisabledError()
+}
+
+// GetOverlay returns an empty overlay
+func (d *disabledNodesProvider) GetOverlay() data.NodesOverlay {
+	return data.NodesOverlay{}
+}
+
+// SetOverlay returns an error if the overlay is not empty, as there are no nodes to apply it on
+func (d *disabledNodesProvider) SetOverlay(overlay data.NodesOverlay) error {
+	if len(overlay.Added) == 0 && len(overlay.Removed) == 0 && len(overlay.Drained) == 0 {
+		return nil
+	}
+
+	return d.createDisabledError()
+}
+
+func (d *disabledNodesProvider) createDisabledError() error {
+	return fmt.Errorf("%w: %s", ErrDisabledNodesProvider, d.returnMessage)
+}
+
+// RecordNodeRequest does nothing as it is disabled
+func (d *disabledNodesProvider) RecordNodeRequest(_ string) {
+}
+
+// RecordNodeResponse does nothing as it is disabled
+func (d *disabledNodesProvider) RecordNodeResponse(_ string, _ time.Duration, _ bool) {
+}
+
+// GetCircuitBreakerStatus returns an empty slice
+func (d *disabledNodesProvider) GetCircuitBreakerStatus() []*data.NodeCircuitBreakerStatus {
+	return make([]*data.NodeCircuitBreakerStatus, 0)
+}
+
 // PrintNodesInShards does nothing as it is disabled
 func (d *disabledNodesProvider) PrintNodesInShards() {
 }
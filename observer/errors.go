@@ -10,3 +10,42 @@ var ErrShardNotAvailable = errors.New("the specified shard ID does not exist in
 
 // ErrInvalidShard signals that an invalid shard has been provided
 var ErrInvalidShard = errors.New("invalid shard")
+
+// ErrInvalidSmoothingFactor signals that an invalid smoothing factor has been provided
+var ErrInvalidSmoothingFactor = errors.New("invalid smoothing factor")
+
+// ErrInvalidExplorationRatio signals that an invalid exploration ratio has been provided
+var ErrInvalidExplorationRatio = errors.New("invalid exploration ratio")
+
+// ErrInvalidUnhealthyErrorRate signals that an invalid unhealthy error rate has been provided
+var ErrInvalidUnhealthyErrorRate = errors.New("invalid unhealthy error rate")
+
+// ErrNilNodesCircuitBreaker signals that a nil nodes circuit breaker has been provided
+var ErrNilNodesCircuitBreaker = errors.New("nil nodes circuit breaker")
+
+// ErrDisabledNodesProvider signals that the nodes of a disabled nodes provider cannot be set
+var ErrDisabledNodesProvider = errors.New("the nodes provider is disabled, it can only be enabled by a restart")
+
+// ErrNodeAlreadyExists signals that a node with the same address already exists in the same shard
+var ErrNodeAlreadyExists = errors.New("node already exists")
+
+// ErrNodeNotFound signals that no node with the provided address exists
+var ErrNodeNotFound = errors.New("node not found")
+
+// ErrNodeAlreadyDrained signals that the node with the provided address is already drained
+var ErrNodeAlreadyDrained = errors.New("node already drained")
+
+// ErrNodeNotDrained signals that the node with the provided address is not drained
+var ErrNodeNotDrained = errors.New("node not drained")
+
+// ErrAllNodesDrained signals that all the historical nodes of a shard would be drained
+var ErrAllNodesDrained = errors.New("all the historical nodes would be drained in shard")
+
+// ErrNoNodesLeftInShard signals that a shard would be left without nodes
+var ErrNoNodesLeftInShard = errors.New("no nodes would be left in shard")
+
+// ErrEmptyOverlayFilePath signals that an empty path of the nodes overlay file has been provided
+var ErrEmptyOverlayFilePath = errors.New("empty nodes overlay file path")
+
+// ErrNilNodesReloadHandler signals that a nil nodes reload handler has been provided
+var ErrNilNodesReloadHandler = errors.New("nil nodes reload handler")
@@ -1,6 +1,10 @@
 package observer
 
-import "github.com/multiversx/mx-chain-proxy-go/data"
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
 
 // NodesProviderHandler defines what a nodes provider should be able to do
 type NodesProviderHandler interface {
@@ -9,10 +13,28 @@ type NodesProviderHandler interface {
 	UpdateNodesBasedOnSyncState(nodesWithSyncStatus []*data.NodeData)
 	GetAllNodesWithSyncState() []*data.NodeData
 	ReloadNodes(nodesType data.NodeType) data.NodesReloadResponse
+	SetNodesReloadHandler(handler NodesReloadHandler) error
+	SetNodes(nodes []*data.NodeData) error
+	AddNode(node *data.NodeData) error
+	RemoveNode(address string) error
+	DrainNode(address string) error
+	UndrainNode(address string) error
+	GetOverlay() data.NodesOverlay
+	SetOverlay(overlay data.NodesOverlay) error
+	RecordNodeRequest(address string)
+	RecordNodeResponse(address string, responseTime time.Duration, isSuccessful bool)
+	GetCircuitBreakerStatus() []*data.NodeCircuitBreakerStatus
 	PrintNodesInShards()
 	IsInterfaceNil() bool
 }
 
+// NodesReloadHandler defines what a component able to apply the reloaded observers and full history nodes should do
+type NodesReloadHandler interface {
+	SetObservers(nodes []*data.NodeData) error
+	SetFullHistoryNodes(nodes []*data.NodeData) error
+	IsInterfaceNil() bool
+}
+
 // NodesHolder defines the actions of a component that is able to hold nodes
 type NodesHolder interface {
 	UpdateNodes(nodesWithSyncStatus []*data.NodeData)
@@ -31,3 +53,12 @@ type CounterMapsHolder interface {
 	ComputeAllNodesPosition(availability data.ObserverDataAvailabilityType, numNodes uint32) (uint32, error)
 	IsInterfaceNil() bool
 }
+
+// NodesCircuitBreaker defines the actions of a component that is able to hold a circuit breaker for each node
+type NodesCircuitBreaker interface {
+	IsAvailable(address string) bool
+	RecordRequestSent(address string)
+	RecordResult(address string, isSuccessful bool)
+	GetState(address string) (data.CircuitBreakerState, uint32)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,290 @@
+package observer
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type nodeStats struct {
+	latencyEWMA   float64
+	errorRateEWMA float64
+	hasLatency    bool
+}
+
+// latencyAwareNodesProvider will handle the providing of nodes based on their performance. For each node, an
+// exponentially weighted moving average of the response time and of the error rate is kept, so that the fastest
+// healthy node is returned first. From time to time, a random node is returned first so that the slower or the
+// failing nodes are still probed and can recover their position
+type latencyAwareNodesProvider struct {
+	*baseNodeProvider
+	smoothingFactor    float64
+	explorationRatio   float64
+	unhealthyErrorRate float64
+	randFloat          func() float64
+	randIntn           func(n int) int
+
+	mutStats   sync.RWMutex
+	nodesStats map[string]*nodeStats
+}
+
+// NewLatencyAwareNodesProvider returns a new instance of latencyAwareNodesProvider
+func NewLatencyAwareNodesProvider(
+	observers []*data.NodeData,
+	configurationFilePath string,
+	numberOfShards uint32,
+	cfg config.LatencyAwareNodesConfig,
+	nodesCircuitBreaker NodesCircuitBreaker,
+) (*latencyAwareNodesProvider, error) {
+	err := checkLatencyAwareNodesConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(nodesCircuitBreaker) {
+		return nil, ErrNilNodesCircuitBreaker
+	}
+
+	bop := &baseNodeProvider{
+		configurationFilePath: configurationFilePath,
+		numOfShards:           numberOfShards,
+		circuitBreaker:        nodesCircuitBreaker,
+	}
+
+	err = bop.initNodes(observers)
+	if err != nil {
+		return nil, err
+	}
+
+	lanp := &latencyAwareNodesProvider{
+		baseNodeProvider:   bop,
+		smoothingFactor:    cfg.SmoothingFactor,
+		explorationRatio:   cfg.ExplorationRatio,
+		unhealthyErrorRate: cfg.UnhealthyErrorRate,
+		randFloat:          rand.Float64,
+		randIntn:           rand.Intn,
+		nodesStats:         make(map[string]*nodeStats),
+	}
+	lanp.resetNodesStats(observers)
+
+	return lanp, nil
+}
+
+func checkLatencyAwareNodesConfig(cfg config.LatencyAwareNodesConfig) error {
+	if cfg.SmoothingFactor <= 0 || cfg.SmoothingFactor > 1 {
+		return ErrInvalidSmoothingFactor
+	}
+	if cfg.ExplorationRatio < 0 || cfg.ExplorationRatio >= 1 {
+		return ErrInvalidExplorationRatio
+	}
+	if cfg.UnhealthyErrorRate <= 0 || cfg.UnhealthyErrorRate > 1 {
+		return ErrInvalidUnhealthyErrorRate
+	}
+
+	return nil
+}
+
+// resetNodesStats keeps the statistics only for the provided nodes, so that removed nodes are not tracked anymore
+func (lanp *latencyAwareNodesProvider) resetNodesStats(nodes []*data.NodeData) {
+	lanp.mutStats.Lock()
+	defer lanp.mutStats.Unlock()
+
+	newNodesStats := make(map[string]*nodeStats, len(nodes))
+	for _, node := range nodes {
+		stats, found := lanp.nodesStats[node.Address]
+		if !found {
+			stats = &nodeStats{}
+		}
+
+		newNodesStats[node.Address] = stats
+	}
+
+	lanp.nodesStats = newNodesStats
+}
+
+// RecordNodeResponse will update the statistics and the circuit breaker of the node with the provided address. The
+// response time is only taken into account for successful requests, as failed requests can be either very fast
+// (connection refused) or very slow (timeout)
+func (lanp *latencyAwareNodesProvider) RecordNodeResponse(address string, responseTime time.Duration, isSuccessful bool) {
+	lanp.baseNodeProvider.RecordNodeResponse(address, responseTime, isSuccessful)
+
+	lanp.mutStats.Lock()
+	defer lanp.mutStats.Unlock()
+
+	stats, found := lanp.nodesStats[address]
+	if !found {
+		return
+	}
+
+	errorSample := 1.0
+	if isSuccessful {
+		errorSample = 0
+		stats.latencyEWMA = lanp.computeEWMA(stats.latencyEWMA, float64(responseTime), stats.hasLatency)
+		stats.hasLatency = true
+	}
+
+	stats.errorRateEWMA = lanp.computeEWMA(stats.errorRateEWMA, errorSample, true)
+}
+
+func (lanp *latencyAwareNodesProvider) computeEWMA(previous float64, sample float64, hasPrevious bool) float64 {
+	if !hasPrevious {
+		return sample
+	}
+
+	return lanp.smoothingFactor*sample + (1-lanp.smoothingFactor)*previous
+}
+
+// GetNodesByShardId will return a slice of the nodes for the given shard, the fastest healthy node being the first one
+func (lanp *latencyAwareNodesProvider) GetNodesByShardId(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+	lanp.mutNodes.RLock()
+	defer lanp.mutNodes.RUnlock()
+
+	syncedNodesForShard, err := lanp.getSyncedNodesForShardUnprotected(shardId, dataAvailability)
+	if err != nil {
+		return nil, err
+	}
+
+	return lanp.sortNodes(lanp.filterAvailableNodes(syncedNodesForShard)), nil
+}
+
+// GetAllNodes will return a slice containing all the nodes, sorted by their performance
+func (lanp *latencyAwareNodesProvider) GetAllNodes(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+	lanp.mutNodes.RLock()
+	defer lanp.mutNodes.RUnlock()
+
+	allNodes, err := lanp.getSyncedNodesUnprotected(dataAvailability)
+	if err != nil {
+		return nil, err
+	}
+
+	return lanp.sortNodes(lanp.filterAvailableNodes(allNodes)), nil
+}
+
+// sortNodes returns a copy of the provided nodes, healthy nodes being placed before the unhealthy ones and, within the
+// same category, faster nodes before the slower ones. Nodes without any recorded response are considered the fastest,
+// so that they are probed as soon as possible
+func (lanp *latencyAwareNodesProvider) sortNodes(nodes []*data.NodeData) []*data.NodeData {
+	sortedNodes := make([]*data.NodeData, len(nodes))
+	copy(sortedNodes, nodes)
+
+	lanp.mutStats.RLock()
+	isUnhealthy := make(map[string]bool, len(sortedNodes))
+	scores := make(map[string]float64, len(sortedNodes))
+	for _, node := range sortedNodes {
+		isUnhealthy[node.Address], scores[node.Address] = lanp.computeScoreUnprotected(node.Address)
+	}
+	lanp.mutStats.RUnlock()
+
+	sort.SliceStable(sortedNodes, func(i, j int) bool {
+		addressI, addressJ := sortedNodes[i].Address, sortedNodes[j].Address
+		if isUnhealthy[addressI] != isUnhealthy[addressJ] {
+			return !isUnhealthy[addressI]
+		}
+
+		return scores[addressI] < scores[addressJ]
+	})
+
+	lanp.applyExploration(sortedNodes)
+
+	return sortedNodes
+}
+
+func (lanp *latencyAwareNodesProvider) computeScoreUnprotected(address string) (bool, float64) {
+	stats, found := lanp.nodesStats[address]
+	if !found {
+		return false, 0
+	}
+
+	isUnhealthy := stats.errorRateEWMA >= lanp.unhealthyErrorRate
+	if !stats.hasLatency {
+		return isUnhealthy, 0
+	}
+	if stats.errorRateEWMA >= 1 {
+		return isUnhealthy, math.MaxFloat64
+	}
+
+	// the response time is increased proportionally with the error rate, as a failed request also implies retrying
+	// on another node
+	return isUnhealthy, stats.latencyEWMA / (1 - stats.errorRateEWMA)
+}
+
+// applyExploration will move a random node on the first position, with a probability equal to the exploration ratio
+func (lanp *latencyAwareNodesProvider) applyExploration(nodes []*data.NodeData) {
+	if len(nodes) < 2 || lanp.explorationRatio == 0 {
+		return
+	}
+	if lanp.randFloat() >= lanp.explorationRatio {
+		return
+	}
+
+	explorationIndex := 1 + lanp.randIntn(len(nodes)-1)
+	explorationNode := nodes[explorationIndex]
+	copy(nodes[1:explorationIndex+1], nodes[:explorationIndex])
+	nodes[0] = explorationNode
+}
+
+// ReloadNodes will reload the nodes and will drop the statistics of the nodes that were removed
+func (lanp *latencyAwareNodesProvider) ReloadNodes(nodesType data.NodeType) data.NodesReloadResponse {
+	response := lanp.baseNodeProvider.ReloadNodes(nodesType)
+	lanp.resetNodesStats(lanp.GetAllNodesWithSyncState())
+
+	return response
+}
+
+// SetNodes will replace the nodes and will drop the statistics of the nodes that were removed
+func (lanp *latencyAwareNodesProvider) SetNodes(nodes []*data.NodeData) error {
+	err := lanp.baseNodeProvider.SetNodes(nodes)
+	if err != nil {
+		return err
+	}
+
+	lanp.resetNodesStats(lanp.GetAllNodesWithSyncState())
+
+	return nil
+}
+
+// AddNode will add the provided node and will start keeping its statistics
+func (lanp *latencyAwareNodesProvider) AddNode(node *data.NodeData) error {
+	err := lanp.baseNodeProvider.AddNode(node)
+	if err != nil {
+		return err
+	}
+
+	lanp.resetNodesStats(lanp.GetAllNodesWithSyncState())
+
+	return nil
+}
+
+// RemoveNode will remove the nodes with the provided address and will drop their statistics
+func (lanp *latencyAwareNodesProvider) RemoveNode(address string) error {
+	err := lanp.baseNodeProvider.RemoveNode(address)
+	if err != nil {
+		return err
+	}
+
+	lanp.resetNodesStats(lanp.GetAllNodesWithSyncState())
+
+	return nil
+}
+
+// SetOverlay will replace the runtime changes of the nodes and will keep the statistics only for the resulting nodes
+func (lanp *latencyAwareNodesProvider) SetOverlay(overlay data.NodesOverlay) error {
+	err := lanp.baseNodeProvider.SetOverlay(overlay)
+	if err != nil {
+		return err
+	}
+
+	lanp.resetNodesStats(lanp.GetAllNodesWithSyncState())
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lanp *latencyAwareNodesProvider) IsInterfaceNil() bool {
+	return lanp == nil
+}
@@ -0,0 +1,253 @@
+package observer
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/observer/circuitBreaker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getLatencyAwareNodesConfig() config.LatencyAwareNodesConfig {
+	return config.LatencyAwareNodesConfig{
+		SmoothingFactor:    0.5,
+		ExplorationRatio:   0,
+		UnhealthyErrorRate: 0.5,
+	}
+}
+
+func getNodesInShard0() []*data.NodeData {
+	return []*data.NodeData{
+		{Address: "addr0", ShardId: 0},
+		{Address: "addr1", ShardId: 0},
+		{Address: "addr2", ShardId: 0},
+	}
+}
+
+func getAddresses(nodes []*data.NodeData) []string {
+	addresses := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		addresses = append(addresses, node.Address)
+	}
+
+	return addresses
+}
+
+func TestNewLatencyAwareNodesProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid smoothing factor should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := getLatencyAwareNodesConfig()
+		cfg.SmoothingFactor = 0
+		lanp, err := NewLatencyAwareNodesProvider(getNodesInShard0(), "path", 1, cfg, circuitBreaker.NewDisabledNodesCircuitBreaker())
+		assert.Nil(t, lanp)
+		assert.Equal(t, ErrInvalidSmoothingFactor, err)
+
+		cfg.SmoothingFactor = 1.1
+		lanp, err = NewLatencyAwareNodesProvider(getNodesInShard0(), "path", 1, cfg, circuitBreaker.NewDisabledNodesCircuitBreaker())
+		assert.Nil(t, lanp)
+		assert.Equal(t, ErrInvalidSmoothingFactor, err)
+	})
+	t.Run("invalid exploration ratio should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := getLatencyAwareNodesConfig()
+		cfg.ExplorationRatio = -0.1
+		lanp, err := NewLatencyAwareNodesProvider(getNodesInShard0(), "path", 1, cfg, circuitBreaker.NewDisabledNodesCircuitBreaker())
+		assert.Nil(t, lanp)
+		assert.Equal(t, ErrInvalidExplorationRatio, err)
+
+		cfg.ExplorationRatio = 1
+		lanp, err = NewLatencyAwareNodesProvider(getNodesInShard0(), "path", 1, cfg, circuitBreaker.NewDisabledNodesCircuitBreaker())
+		assert.Nil(t, lanp)
+		assert.Equal(t, ErrInvalidExplorationRatio, err)
+	})
+	t.Run("invalid unhealthy error rate should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := getLatencyAwareNodesConfig()
+		cfg.UnhealthyErrorRate = 0
+		lanp, err := NewLatencyAwareNodesProvider(getNodesInShard0(), "path", 1, cfg, circuitBreaker.NewDisabledNodesCircuitBreaker())
+		assert.Nil(t, lanp)
+		assert.Equal(t, ErrInvalidUnhealthyErrorRate, err)
+	})
+	t.Run("empty observers list should error", func(t *testing.T) {
+		t.Parallel()
+
+		lanp, err := NewLatencyAwareNodesProvider(make([]*data.NodeData, 0), "path", 1, getLatencyAwareNodesConfig(), circuitBreaker.NewDisabledNodesCircuitBreaker())
+		assert.Nil(t, lanp)
+		assert.Equal(t, ErrEmptyObserversList, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		lanp, err := NewLatencyAwareNodesProvider(getNodesInShard0(), "path", 1, getLatencyAwareNodesConfig(), circuitBreaker.NewDisabledNodesCircuitBreaker())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(lanp))
+	})
+}
+
+func TestLatencyAwareNodesProvider_GetNodesByShardIdShouldPreferFastestNodes(t *testing.T) {
+	t.Parallel()
+
+	lanp, _ := NewLatencyAwareNodesProvider(getNodesInShard0(), "path", 1, getLatencyAwareNodesConfig(), circuitBreaker.NewDisabledNodesCircuitBreaker())
+
+	// nodes without samples keep the configured order
+	nodes, err := lanp.GetNodesByShardId(0, data.AvailabilityAll)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"addr0", "addr1", "addr2"}, getAddresses(nodes))
+
+	lanp.RecordNodeResponse("addr0", 500*time.Millisecond, true)
+	lanp.RecordNodeResponse("addr1", 100*time.Millisecond, true)
+	lanp.RecordNodeResponse("addr2", 50*time.Millisecond, true)
+
+	nodes, err = lanp.GetNodesByShardId(0, data.AvailabilityAll)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"addr2", "addr1", "addr0"}, getAddresses(nodes))
+
+	// addr2 becomes slower, the moving average is 0.5 * 50ms + 0.5 * 250ms = 150ms
+	lanp.RecordNodeResponse("addr2", 250*time.Millisecond, true)
+
+	nodes, err = lanp.GetNodesByShardId(0, data.AvailabilityAll)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"addr1", "addr2", "addr0"}, getAddresses(nodes))
+}
+
+func TestLatencyAwareNodesProvider_GetNodesByShardIdShouldPlaceUnhealthyNodesLast(t *testing.T) {
+	t.Parallel()
+
+	lanp, _ := NewLatencyAwareNodesProvider(getNodesInShard0(), "path", 1, getLatencyAwareNodesConfig(), circuitBreaker.NewDisabledNodesCircuitBreaker())
+	lanp.RecordNodeResponse("addr0", 500*time.Millisecond, true)
+	lanp.RecordNodeResponse("addr1", 100*time.Millisecond, true)
+	lanp.RecordNodeResponse("addr2", 10*time.Millisecond, true)
+
+	// error rate for addr2 becomes 0.5, so it is unhealthy
+	lanp.RecordNodeResponse("addr2", time.Second, false)
+
+	nodes, err := lanp.GetNodesByShardId(0, data.AvailabilityAll)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"addr1", "addr0", "addr2"}, getAddresses(nodes))
+
+	// error rate for addr2 decreases to 0.25, it is healthy again but its score is 10ms / 0.75
+	lanp.RecordNodeResponse("addr2", 10*time.Millisecond, true)
+
+	nodes, err = lanp.GetNodesByShardId(0, data.AvailabilityAll)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"addr2", "addr1", "addr0"}, getAddresses(nodes))
+}
+
+func TestLatencyAwareNodesProvider_NodesWithOnlyFailuresShouldBeUnhealthy(t *testing.T) {
+	t.Parallel()
+
+	lanp, _ := NewLatencyAwareNodesProvider(getNodesInShard0(), "path", 1, getLatencyAwareNodesConfig(), circuitBreaker.NewDisabledNodesCircuitBreaker())
+	lanp.RecordNodeResponse("addr0", time.Millisecond, false)
+	lanp.RecordNodeResponse("addr1", 100*time.Millisecond, true)
+
+	nodes, err := lanp.GetNodesByShardId(0, data.AvailabilityAll)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"addr2", "addr1", "addr0"}, getAddresses(nodes))
+}
+
+func TestLatencyAwareNodesProvider_ShouldFeedTheCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	nodesCircuitBreaker, _ := circuitBreaker.NewNodesCircuitBreaker(circuitBreaker.ArgsNodesCircuitBreaker{
+		FailureThreshold: 1,
+		CoolDown:         time.Hour,
+	})
+	lanp, _ := NewLatencyAwareNodesProvider(getNodesInShard0(), "path", 1, getLatencyAwareNodesConfig(), nodesCircuitBreaker)
+	lanp.RecordNodeResponse("addr2", time.Millisecond, false)
+
+	nodes, err := lanp.GetNodesByShardId(0, data.AvailabilityAll)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"addr0", "addr1"}, getAddresses(nodes))
+}
+
+func TestLatencyAwareNodesProvider_RecordNodeResponseForUnknownNodeShouldNotTrackIt(t *testing.T) {
+	t.Parallel()
+
+	lanp, _ := NewLatencyAwareNodesProvider(getNodesInShard0(), "path", 1, getLatencyAwareNodesConfig(), circuitBreaker.NewDisabledNodesCircuitBreaker())
+	lanp.RecordNodeResponse("unknown", time.Millisecond, true)
+
+	lanp.mutStats.RLock()
+	_, found := lanp.nodesStats["unknown"]
+	lanp.mutStats.RUnlock()
+	assert.False(t, found)
+}
+
+func TestLatencyAwareNodesProvider_ExplorationShouldMoveRandomNodeFirst(t *testing.T) {
+	t.Parallel()
+
+	cfg := getLatencyAwareNodesConfig()
+	cfg.ExplorationRatio = 0.1
+	lanp, _ := NewLatencyAwareNodesProvider(getNodesInShard0(), "path", 1, cfg, circuitBreaker.NewDisabledNodesCircuitBreaker())
+	lanp.RecordNodeResponse("addr0", 10*time.Millisecond, true)
+	lanp.RecordNodeResponse("addr1", 20*time.Millisecond, true)
+	lanp.RecordNodeResponse("addr2", 30*time.Millisecond, true)
+
+	randValue := 0.5
+	lanp.randFloat = func() float64 {
+		return randValue
+	}
+	lanp.randIntn = func(n int) int {
+		assert.Equal(t, 2, n)
+		return 1
+	}
+
+	nodes, _ := lanp.GetNodesByShardId(0, data.AvailabilityAll)
+	assert.Equal(t, []string{"addr0", "addr1", "addr2"}, getAddresses(nodes))
+
+	randValue = 0.05
+	nodes, _ = lanp.GetNodesByShardId(0, data.AvailabilityAll)
+	assert.Equal(t, []string{"addr2", "addr0", "addr1"}, getAddresses(nodes))
+}
+
+func TestLatencyAwareNodesProvider_GetAllNodesShouldSortNodes(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "addr0", ShardId: 0},
+		{Address: "addr1", ShardId: 1},
+	}
+	lanp, _ := NewLatencyAwareNodesProvider(observers, "path", 2, getLatencyAwareNodesConfig(), circuitBreaker.NewDisabledNodesCircuitBreaker())
+	lanp.RecordNodeResponse("addr0", 20*time.Millisecond, true)
+	lanp.RecordNodeResponse("addr1", 10*time.Millisecond, true)
+
+	nodes, err := lanp.GetAllNodes(data.AvailabilityAll)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"addr1", "addr0"}, getAddresses(nodes))
+}
+
+func TestLatencyAwareNodesProvider_ConcurrentOperationsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	cfg := getLatencyAwareNodesConfig()
+	cfg.ExplorationRatio = 0.5
+	lanp, _ := NewLatencyAwareNodesProvider(getNodesInShard0(), "path", 1, cfg, circuitBreaker.NewDisabledNodesCircuitBreaker())
+
+	numOperations := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numOperations)
+	for i := 0; i < numOperations; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			switch idx % 3 {
+			case 0:
+				lanp.RecordNodeResponse("addr1", time.Duration(idx)*time.Millisecond, idx%2 == 0)
+			case 1:
+				_, _ = lanp.GetNodesByShardId(0, data.AvailabilityAll)
+			case 2:
+				_, _ = lanp.GetAllNodes(data.AvailabilityAll)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
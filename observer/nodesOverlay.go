@@ -0,0 +1,141 @@
+package observer
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// applyOverlay returns the configured nodes without the removed ones, followed by the added nodes
+func applyOverlay(configuredNodes []*data.NodeData, overlay data.NodesOverlay) []*data.NodeData {
+	removed := sliceToSet(overlay.Removed)
+
+	nodes := make([]*data.NodeData, 0, len(configuredNodes)+len(overlay.Added))
+	for _, node := range configuredNodes {
+		_, isRemoved := removed[node.Address]
+		if !isRemoved {
+			nodes = append(nodes, node)
+		}
+	}
+	for _, node := range overlay.Added {
+		if !containsNode(nodes, node.Address, node.ShardId) {
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes
+}
+
+// checkDrainedNodes returns an error if a shard would be left without a historical node which is not drained, as the
+// requests for that shard could not be served anymore
+func checkDrainedNodes(nodes []*data.NodeData, drained map[string]struct{}) error {
+	for shardID, nodesInShard := range nodesSliceToShardedMap(nodes) {
+		atLeastOneActiveNode := false
+		for _, node := range nodesInShard {
+			_, isDrained := drained[node.Address]
+			if !isDrained && !node.IsSnapshotless {
+				atLeastOneActiveNode = true
+				break
+			}
+		}
+		if !atLeastOneActiveNode {
+			return fmt.Errorf("%w %d", ErrAllNodesDrained, shardID)
+		}
+	}
+
+	return nil
+}
+
+// checkShardsAfterRemoval returns an error if removing the nodes with the provided address would leave their shards
+// without nodes, as the requests for those shards could not be served anymore
+func checkShardsAfterRemoval(nodes []*data.NodeData, address string) error {
+	remainingNodes := nodesSliceToShardedMap(removeNodesWithAddress(nodes, address))
+	for _, node := range nodes {
+		if node.Address == address && len(remainingNodes[node.ShardId]) == 0 {
+			return fmt.Errorf("%w %d", ErrNoNodesLeftInShard, node.ShardId)
+		}
+	}
+
+	return nil
+}
+
+func cloneOverlay(overlay data.NodesOverlay) data.NodesOverlay {
+	return data.NodesOverlay{
+		Added:   append(make([]*data.NodeData, 0, len(overlay.Added)), overlay.Added...),
+		Removed: append(make([]string, 0, len(overlay.Removed)), overlay.Removed...),
+		Drained: append(make([]string, 0, len(overlay.Drained)), overlay.Drained...),
+	}
+}
+
+func containsNode(nodes []*data.NodeData, address string, shardID uint32) bool {
+	for _, node := range nodes {
+		if node.Address == address && node.ShardId == shardID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsAddress(nodes []*data.NodeData, address string) bool {
+	for _, node := range nodes {
+		if node.Address == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+func removeNodesWithAddress(nodes []*data.NodeData, address string) []*data.NodeData {
+	remainingNodes := make([]*data.NodeData, 0, len(nodes))
+	for _, node := range nodes {
+		if node.Address != address {
+			remainingNodes = append(remainingNodes, node)
+		}
+	}
+
+	return remainingNodes
+}
+
+func removeString(values []string, value string) []string {
+	remainingValues := make([]string, 0, len(values))
+	for _, existingValue := range values {
+		if existingValue != value {
+			remainingValues = append(remainingValues, existingValue)
+		}
+	}
+
+	return remainingValues
+}
+
+func containsString(values []string, value string) bool {
+	for _, existingValue := range values {
+		if existingValue == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// filterAddresses keeps only the addresses of the provided nodes
+func filterAddresses(addresses []string, nodes []*data.NodeData) []string {
+	filteredAddresses := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		if containsAddress(nodes, address) {
+			filteredAddresses = append(filteredAddresses, address)
+		}
+	}
+
+	return filteredAddresses
+}
+
+func sliceToSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+
+	return set
+}
@@ -0,0 +1,75 @@
+package observer
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// nodesOverlayStorer persists the runtime changes of the nodes in a local TOML file, so they survive the restarts of
+// the proxy
+type nodesOverlayStorer struct {
+	mut      sync.Mutex
+	filePath string
+}
+
+// NewNodesOverlayStorer creates a new instance of nodesOverlayStorer. The directory of the file is created if missing
+func NewNodesOverlayStorer(filePath string) (*nodesOverlayStorer, error) {
+	if len(filePath) == 0 {
+		return nil, ErrEmptyOverlayFilePath
+	}
+
+	err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nodesOverlayStorer{
+		filePath: filePath,
+	}, nil
+}
+
+// Load returns the stored runtime changes of the nodes. No changes are returned if the file does not exist yet
+func (nos *nodesOverlayStorer) Load() (*data.NodesOverlays, error) {
+	nos.mut.Lock()
+	defer nos.mut.Unlock()
+
+	overlays := &data.NodesOverlays{}
+	_, err := os.Stat(nos.filePath)
+	if os.IsNotExist(err) {
+		return overlays, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = core.LoadTomlFile(overlays, nos.filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return overlays, nil
+}
+
+// Save replaces the stored runtime changes of the nodes, through a temporary file, so the previous changes are not
+// lost if the proxy is stopped meanwhile
+func (nos *nodesOverlayStorer) Save(overlays data.NodesOverlays) error {
+	nos.mut.Lock()
+	defer nos.mut.Unlock()
+
+	tempFilePath := nos.filePath + ".tmp"
+	err := core.SaveTomlFile(&overlays, tempFilePath)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFilePath, nos.filePath)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nos *nodesOverlayStorer) IsInterfaceNil() bool {
+	return nos == nil
+}
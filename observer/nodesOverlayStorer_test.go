@@ -0,0 +1,78 @@
+package observer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNodesOverlayStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty file path should error", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := NewNodesOverlayStorer("")
+		require.Equal(t, ErrEmptyOverlayFilePath, err)
+		require.Nil(t, storer)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := NewNodesOverlayStorer(filepath.Join(t.TempDir(), "db", "overlay.toml"))
+		require.NoError(t, err)
+		require.False(t, storer.IsInterfaceNil())
+	})
+}
+
+func TestNodesOverlayStorer_LoadSave(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file should return empty overlays", func(t *testing.T) {
+		t.Parallel()
+
+		storer, _ := NewNodesOverlayStorer(filepath.Join(t.TempDir(), "overlay.toml"))
+		overlays, err := storer.Load()
+		require.NoError(t, err)
+		require.Equal(t, &data.NodesOverlays{}, overlays)
+	})
+	t.Run("invalid file should error", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "overlay.toml")
+		require.NoError(t, os.WriteFile(filePath, []byte("invalid = ["), 0644))
+
+		storer, _ := NewNodesOverlayStorer(filePath)
+		overlays, err := storer.Load()
+		require.Error(t, err)
+		require.Nil(t, overlays)
+	})
+	t.Run("saved overlays should be loaded", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "overlay.toml")
+		storer, _ := NewNodesOverlayStorer(filePath)
+		overlays := data.NodesOverlays{
+			Observers: data.NodesOverlay{
+				Added:   []*data.NodeData{{ShardId: 1, Address: "addr1", IsFallback: true}},
+				Removed: []string{"addr0"},
+			},
+			FullHistoryNodes: data.NodesOverlay{
+				Drained: []string{"addr2"},
+			},
+		}
+		require.NoError(t, storer.Save(overlays))
+
+		loadedOverlays, err := storer.Load()
+		require.NoError(t, err)
+		require.Equal(t, overlays.Observers.Added, loadedOverlays.Observers.Added)
+		require.Equal(t, overlays.Observers.Removed, loadedOverlays.Observers.Removed)
+		require.Equal(t, overlays.FullHistoryNodes.Drained, loadedOverlays.FullHistoryNodes.Drained)
+
+		_, err = os.Stat(filePath + ".tmp")
+		require.True(t, os.IsNotExist(err))
+	})
+}
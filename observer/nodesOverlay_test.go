@@ -0,0 +1,74 @@
+package observer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyOverlay(t *testing.T) {
+	t.Parallel()
+
+	configuredNodes := []*data.NodeData{
+		{Address: "addr0", ShardId: 0},
+		{Address: "addr1", ShardId: 0},
+	}
+
+	t.Run("empty overlay should return the configured nodes", func(t *testing.T) {
+		t.Parallel()
+
+		nodes := applyOverlay(configuredNodes, data.NodesOverlay{})
+		require.Equal(t, configuredNodes, nodes)
+	})
+	t.Run("should remove and add nodes", func(t *testing.T) {
+		t.Parallel()
+
+		nodes := applyOverlay(configuredNodes, data.NodesOverlay{
+			Added: []*data.NodeData{
+				{Address: "addr0", ShardId: 0},
+				{Address: "addr1", ShardId: 1},
+				{Address: "addr2", ShardId: 0},
+			},
+			Removed: []string{"addr1"},
+		})
+		require.Equal(t, []*data.NodeData{
+			{Address: "addr0", ShardId: 0},
+			{Address: "addr1", ShardId: 1},
+			{Address: "addr2", ShardId: 0},
+		}, nodes)
+	})
+}
+
+func TestCheckDrainedNodes(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{
+		{Address: "addr0", ShardId: 0},
+		{Address: "addr1", ShardId: 0, IsSnapshotless: true},
+		{Address: "addr2", ShardId: 1},
+	}
+
+	require.NoError(t, checkDrainedNodes(nodes, sliceToSet([]string{"addr1"})))
+
+	err := checkDrainedNodes(nodes, sliceToSet([]string{"addr0"}))
+	require.True(t, errors.Is(err, ErrAllNodesDrained))
+	require.Contains(t, err.Error(), "shard 0")
+}
+
+func TestCheckShardsAfterRemoval(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{
+		{Address: "addr0", ShardId: 0},
+		{Address: "addr1", ShardId: 0},
+		{Address: "addr2", ShardId: 1},
+	}
+
+	require.NoError(t, checkShardsAfterRemoval(nodes, "addr0"))
+
+	err := checkShardsAfterRemoval(nodes, "addr2")
+	require.True(t, errors.Is(err, ErrNoNodesLeftInShard))
+	require.Contains(t, err.Error(), "shard 1")
+}
@@ -1,8 +1,11 @@
 package observer
 
 import (
+	"time"
+
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/observer/circuitBreaker"
 )
 
 var log = logger.GetOrCreate("observer")
@@ -25,26 +28,60 @@ func NewNodesProviderFactory(cfg config.Config, configurationFilePath string, nu
 
 // CreateObservers will create and return an object of type NodesProviderHandler based on a flag
 func (npf *nodesProviderFactory) CreateObservers() (NodesProviderHandler, error) {
+	nodesCircuitBreaker, err := npf.createNodesCircuitBreaker()
+	if err != nil {
+		return nil, err
+	}
+
+	if npf.cfg.LatencyAwareNodes.EnabledForObservers {
+		return NewLatencyAwareNodesProvider(
+			npf.cfg.Observers,
+			npf.configurationFilePath,
+			npf.numberOfShards,
+			npf.cfg.LatencyAwareNodes,
+			nodesCircuitBreaker)
+	}
 	if npf.cfg.GeneralSettings.BalancedObservers {
 		return NewCircularQueueNodesProvider(
 			npf.cfg.Observers,
 			npf.configurationFilePath,
-			npf.numberOfShards)
+			npf.numberOfShards,
+			nodesCircuitBreaker)
 	}
 
 	return NewSimpleNodesProvider(
 		npf.cfg.Observers,
 		npf.configurationFilePath,
-		npf.numberOfShards)
+		npf.numberOfShards,
+		nodesCircuitBreaker)
 }
 
 // CreateFullHistoryNodes will create and return an object of type NodesProviderHandler based on a flag
 func (npf *nodesProviderFactory) CreateFullHistoryNodes() (NodesProviderHandler, error) {
+	nodesCircuitBreaker, err := npf.createNodesCircuitBreaker()
+	if err != nil {
+		return nil, err
+	}
+
+	if npf.cfg.LatencyAwareNodes.EnabledForFullHistoryNodes {
+		nodesProviderHandler, err := NewLatencyAwareNodesProvider(
+			npf.cfg.FullHistoryNodes,
+			npf.configurationFilePath,
+			npf.numberOfShards,
+			npf.cfg.LatencyAwareNodes,
+			nodesCircuitBreaker)
+		if err != nil {
+			return getDisabledFullHistoryNodesProviderIfNeeded(err)
+		}
+
+		return nodesProviderHandler, nil
+	}
 	if npf.cfg.GeneralSettings.BalancedFullHistoryNodes {
 		nodesProviderHandler, err := NewCircularQueueNodesProvider(
 			npf.cfg.FullHistoryNodes,
 			npf.configurationFilePath,
-			npf.numberOfShards)
+			npf.numberOfShards,
+			nodesCircuitBreaker)
 		if err != nil {
 			return getDisabledFullHistoryNodesProviderIfNeeded(err)
 		}
@@ -55,7 +92,8 @@ func (npf *nodesProviderFactory) CreateFullHistoryNodes() (NodesProviderHandler,
 	nodesProviderHandler, err := NewSimpleNodesProvider(
 		npf.cfg.FullHistoryNodes,
 		npf.configurationFilePath,
-		npf.numberOfShards)
+		npf.numberOfShards,
+		nodesCircuitBreaker)
 	if err != nil {
 		return getDisabledFullHistoryNodesProviderIfNeeded(err)
 	}
@@ -63,6 +101,17 @@ func (npf *nodesProviderFactory) CreateFullHistoryNodes() (NodesProviderHandler,
 	return nodesProviderHandler, nil
 }
 
+func (npf *nodesProviderFactory) createNodesCircuitBreaker() (NodesCircuitBreaker, error) {
+	if !npf.cfg.CircuitBreaker.Enabled {
+		return circuitBreaker.NewDisabledNodesCircuitBreaker(), nil
+	}
+
+	return circuitBreaker.NewNodesCircuitBreaker(circuitBreaker.ArgsNodesCircuitBreaker{
+		FailureThreshold: npf.cfg.CircuitBreaker.FailureThreshold,
+		CoolDown:         time.Duration(npf.cfg.CircuitBreaker.CoolDownSec) * time.Second,
+	})
+}
+
 func getDisabledFullHistoryNodesProviderIfNeeded(err error) (NodesProviderHandler, error) {
 	if err == ErrEmptyObserversList {
 		log.Warn("no configuration found for full history nodes. Calls to endpoints specific to full history nodes " +
@@ -40,3 +40,33 @@ func TestObserversProviderFactory_CreateShouldReturnCircularQueue(t *testing.T)
 	_, ok := op.(*circularQueueNodesProvider)
 	assert.True(t, ok)
 }
+
+func TestObserversProviderFactory_CreateShouldReturnLatencyAware(t *testing.T) {
+	t.Parallel()
+
+	cfg := getDummyConfig()
+	cfg.GeneralSettings.BalancedObservers = true
+	cfg.LatencyAwareNodes = getLatencyAwareNodesConfig()
+	cfg.LatencyAwareNodes.EnabledForObservers = true
+
+	opf, _ := NewNodesProviderFactory(cfg, "path", 2)
+	op, err := opf.CreateObservers()
+	assert.Nil(t, err)
+	_, ok := op.(*latencyAwareNodesProvider)
+	assert.True(t, ok)
+}
+
+func TestObserversProviderFactory_CreateFullHistoryNodesShouldReturnLatencyAware(t *testing.T) {
+	t.Parallel()
+
+	cfg := getDummyConfig()
+	cfg.FullHistoryNodes = cfg.Observers
+	cfg.LatencyAwareNodes = getLatencyAwareNodesConfig()
+	cfg.LatencyAwareNodes.EnabledForFullHistoryNodes = true
+
+	opf, _ := NewNodesProviderFactory(cfg, "path", 2)
+	op, err := opf.CreateFullHistoryNodes()
+	assert.Nil(t, err)
+	_, ok := op.(*latencyAwareNodesProvider)
+	assert.True(t, ok)
+}
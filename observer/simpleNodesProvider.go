@@ -1,6 +1,7 @@
 package observer
 
 import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
@@ -15,10 +16,16 @@ func NewSimpleNodesProvider(
 	observers []*data.NodeData,
 	configurationFilePath string,
 	numberOfShards uint32,
+	nodesCircuitBreaker NodesCircuitBreaker,
 ) (*simpleNodesProvider, error) {
+	if check.IfNil(nodesCircuitBreaker) {
+		return nil, ErrNilNodesCircuitBreaker
+	}
+
 	bop := &baseNodeProvider{
 		configurationFilePath: configurationFilePath,
 		numOfShards:           numberOfShards,
+		circuitBreaker:        nodesCircuitBreaker,
 	}
 
 	err := bop.initNodes(observers)
@@ -36,7 +43,12 @@ func (snp *simpleNodesProvider) GetNodesByShardId(shardId uint32, dataAvailabili
 	snp.mutNodes.RLock()
 	defer snp.mutNodes.RUnlock()
 
-	return snp.getSyncedNodesForShardUnprotected(shardId, dataAvailability)
+	syncedNodesForShard, err := snp.getSyncedNodesForShardUnprotected(shardId, dataAvailability)
+	if err != nil {
+		return nil, err
+	}
+
+	return snp.filterAvailableNodes(syncedNodesForShard), nil
 }
 
 // GetAllNodes will return a slice containing all the nodes
@@ -44,7 +56,12 @@ func (snp *simpleNodesProvider) GetAllNodes(dataAvailability data.ObserverDataAv
 	snp.mutNodes.RLock()
 	defer snp.mutNodes.RUnlock()
 
-	return snp.getSyncedNodesUnprotected(dataAvailability)
+	allNodes, err := snp.getSyncedNodesUnprotected(dataAvailability)
+	if err != nil {
+		return nil, err
+	}
+
+	return snp.filterAvailableNodes(allNodes), nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
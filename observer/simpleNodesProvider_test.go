@@ -8,6 +8,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/observer/circuitBreaker"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -16,16 +17,25 @@ func TestNewSimpleObserversProvider_EmptyObserversListShouldErr(t *testing.T) {
 
 	cfg := getDummyConfig()
 	cfg.Observers = make([]*data.NodeData, 0)
-	sop, err := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	sop, err := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), circuitBreaker.NewDisabledNodesCircuitBreaker())
 	assert.Nil(t, sop)
 	assert.Equal(t, ErrEmptyObserversList, err)
 }
 
+func TestNewSimpleObserversProvider_NilCircuitBreakerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := getDummyConfig()
+	sop, err := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), nil)
+	assert.Nil(t, sop)
+	assert.Equal(t, ErrNilNodesCircuitBreaker, err)
+}
+
 func TestNewSimpleObserversProvider_ShouldWork(t *testing.T) {
 	t.Parallel()
 
 	cfg := getDummyConfig()
-	sop, err := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	sop, err := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), circuitBreaker.NewDisabledNodesCircuitBreaker())
 	assert.Nil(t, err)
 	assert.False(t, check.IfNil(sop))
 }
@@ -35,7 +45,7 @@ func TestSimpleObserversProvider_GetObserversByShardIdShouldErrBecauseInvalidSha
 
 	invalidShardId := uint32(37)
 	cfg := getDummyConfig()
-	cqop, _ := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	cqop, _ := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), circuitBreaker.NewDisabledNodesCircuitBreaker())
 
 	res, err := cqop.GetNodesByShardId(invalidShardId, "")
 	assert.Nil(t, res)
@@ -47,7 +57,7 @@ func TestSimpleObserversProvider_GetObserversByShardIdShouldWork(t *testing.T) {
 
 	shardId := uint32(0)
 	cfg := getDummyConfig()
-	cqop, _ := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	cqop, _ := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), circuitBreaker.NewDisabledNodesCircuitBreaker())
 
 	res, err := cqop.GetNodesByShardId(shardId, "")
 	assert.Nil(t, err)
@@ -58,7 +68,7 @@ func TestSimpleObserversProvider_GetAllObserversShouldWork(t *testing.T) {
 	t.Parallel()
 
 	cfg := getDummyConfig()
-	cqop, _ := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	cqop, _ := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), circuitBreaker.NewDisabledNodesCircuitBreaker())
 
 	res, _ := cqop.GetAllNodes("")
 	assert.Equal(t, 2, len(res))
@@ -105,7 +115,7 @@ func TestSimpleObserversProvider_GetObserversByShardId_ConcurrentSafe(t *testing
 	// will be called
 	expectedNumOfTimesAnObserverIsCalled := numOfTimesToCallForEachRoutine * numOfGoRoutinesToStart
 
-	sop, _ := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	sop, _ := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), circuitBreaker.NewDisabledNodesCircuitBreaker())
 
 	for i := 0; i < numOfGoRoutinesToStart; i++ {
 		for j := 0; j < numOfTimesToCallForEachRoutine; j++ {
@@ -168,7 +178,7 @@ func TestSimpleObserversProvider_GetAllObservers_ConcurrentSafe(t *testing.T) {
 	// will be called
 	expectedNumOfTimesAnObserverIsCalled := numOfTimesToCallForEachRoutine * numOfGoRoutinesToStart
 
-	sop, _ := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)))
+	sop, _ := NewSimpleNodesProvider(cfg.Observers, "path", uint32(len(cfg.Observers)), circuitBreaker.NewDisabledNodesCircuitBreaker())
 
 	for i := 0; i < numOfGoRoutinesToStart; i++ {
 		for j := 0; j < numOfTimesToCallForEachRoutine; j++ {
@@ -187,3 +197,42 @@ func TestSimpleObserversProvider_GetAllObservers_ConcurrentSafe(t *testing.T) {
 	}
 	mutMap.RUnlock()
 }
+
+func TestSimpleObserversProvider_ShouldSkipNodesWithOpenCircuit(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "addr0", ShardId: 0},
+		{Address: "addr1", ShardId: 0},
+		{Address: "addr2", ShardId: 1},
+	}
+	nodesCircuitBreaker, _ := circuitBreaker.NewNodesCircuitBreaker(circuitBreaker.ArgsNodesCircuitBreaker{
+		FailureThreshold: 1,
+		CoolDown:         time.Hour,
+	})
+	sop, _ := NewSimpleNodesProvider(observers, "path", 2, nodesCircuitBreaker)
+
+	sop.RecordNodeResponse("addr0", time.Second, false)
+
+	res, err := sop.GetNodesByShardId(0, data.AvailabilityAll)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"addr1"}, getAddresses(res))
+
+	res, err = sop.GetAllNodes(data.AvailabilityAll)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"addr1", "addr2"}, getAddresses(res))
+
+	// all the circuits in the shard are open, so all the nodes should be returned
+	sop.RecordNodeResponse("addr1", time.Second, false)
+	res, err = sop.GetNodesByShardId(0, data.AvailabilityAll)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"addr0", "addr1"}, getAddresses(res))
+
+	nodesStatus := sop.GetCircuitBreakerStatus()
+	expectedStatus := []*data.NodeCircuitBreakerStatus{
+		{Address: "addr0", ShardId: 0, IsSynced: true, State: data.CircuitBreakerOpen, ConsecutiveFailures: 1},
+		{Address: "addr1", ShardId: 0, IsSynced: true, State: data.CircuitBreakerOpen, ConsecutiveFailures: 1},
+		{Address: "addr2", ShardId: 1, IsSynced: true, State: data.CircuitBreakerClosed, ConsecutiveFailures: 0},
+	}
+	assert.Equal(t, expectedStatus, nodesStatus)
+}
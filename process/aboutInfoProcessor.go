@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -60,7 +61,7 @@ func (ap *aboutProcessor) GetAboutInfo() *data.GenericAPIResponse {
 }
 
 // GetNodesVersions will return the versions of the nodes behind proxy
-func (ap *aboutProcessor) GetNodesVersions() (*data.GenericAPIResponse, error) {
+func (ap *aboutProcessor) GetNodesVersions(ctx context.Context) (*data.GenericAPIResponse, error) {
 	versionsMap := make(map[uint32][]string)
 	allObservers, err := ap.baseProc.GetAllObservers(data.AvailabilityRecent)
 	if err != nil {
@@ -68,7 +69,7 @@ func (ap *aboutProcessor) GetNodesVersions() (*data.GenericAPIResponse, error) {
 	}
 
 	for _, observer := range allObservers {
-		nodeVersion, err := ap.getNodeAppVersion(observer.Address)
+		nodeVersion, err := ap.getNodeAppVersion(ctx, observer.Address)
 		if err != nil {
 			return nil, err
 		}
@@ -85,9 +86,9 @@ func (ap *aboutProcessor) GetNodesVersions() (*data.GenericAPIResponse, error) {
 	}, nil
 }
 
-func (ap *aboutProcessor) getNodeAppVersion(observerAddress string) (string, error) {
+func (ap *aboutProcessor) getNodeAppVersion(ctx context.Context, observerAddress string) (string, error) {
 	var versionResponse data.NodeVersionAPIResponse
-	code, err := ap.baseProc.CallGetRestEndPoint(observerAddress, NodeStatusPath, &versionResponse)
+	code, err := ap.baseProc.CallGetRestEndPointWithContext(ctx, observerAddress, NodeStatusPath, &versionResponse)
 	if code != http.StatusOK {
 		return "", fmt.Errorf("invalid return code %d", code)
 	}
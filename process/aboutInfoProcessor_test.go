@@ -1,6 +1,7 @@
 package process_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"strings"
@@ -99,7 +100,7 @@ func TestAboutProcessor_GetNodesVersions(t *testing.T) {
 		ap, err := process.NewAboutProcessor(proc, "app", "hash")
 		require.Nil(t, err)
 
-		res, err := ap.GetNodesVersions()
+		res, err := ap.GetNodesVersions(context.Background())
 		require.Empty(t, res)
 		require.Equal(t, "invalid return code 37", err.Error())
 	})
@@ -125,7 +126,7 @@ func TestAboutProcessor_GetNodesVersions(t *testing.T) {
 		ap, err := process.NewAboutProcessor(proc, "app", "hash")
 		require.Nil(t, err)
 
-		res, err := ap.GetNodesVersions()
+		res, err := ap.GetNodesVersions(context.Background())
 		require.Empty(t, res)
 		require.Contains(t, err.Error(), expectedErr.Error())
 	})
@@ -170,7 +171,7 @@ func TestAboutProcessor_GetNodesVersions(t *testing.T) {
 		ap, err := process.NewAboutProcessor(proc, "app", "hash")
 		require.Nil(t, err)
 
-		res, err := ap.GetNodesVersions()
+		res, err := ap.GetNodesVersions(context.Background())
 		require.NoError(t, err)
 
 		expectedResponse := &data.GenericAPIResponse{
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -16,25 +17,49 @@ import (
 // addressPath defines the address path at which the nodes answer
 const addressPath = "/address/"
 
+// accountCoalescingOperation defines the name of the coalesced account requests
+const accountCoalescingOperation = "account"
+
 // AccountProcessor is able to process account requests
 type AccountProcessor struct {
 	proc                 Processor
 	pubKeyConverter      core.PubkeyConverter
+	connector            DatabaseConnectorHandler
+	hedgedRequests       HedgedRequestsHandler
+	coalescer            RequestsCoalescer
 	availabilityProvider availabilityCommon.AvailabilityProvider
 }
 
 // NewAccountProcessor creates a new instance of AccountProcessor
-func NewAccountProcessor(proc Processor, pubKeyConverter core.PubkeyConverter) (*AccountProcessor, error) {
+func NewAccountProcessor(
+	proc Processor,
+	pubKeyConverter core.PubkeyConverter,
+	connector DatabaseConnectorHandler,
+	hedgedRequests HedgedRequestsHandler,
+	coalescer RequestsCoalescer,
+) (*AccountProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
 	if check.IfNil(pubKeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
+	if check.IfNil(connector) {
+		return nil, ErrNilDatabaseConnector
+	}
+	if check.IfNil(hedgedRequests) {
+		return nil, ErrNilHedgedRequestsHandler
+	}
+	if check.IfNil(coalescer) {
+		return nil, ErrNilRequestsCoalescer
+	}
 
 	return &AccountProcessor{
 		proc:                 proc,
 		pubKeyConverter:      pubKeyConverter,
+		connector:            connector,
+		hedgedRequests:       hedgedRequests,
+		coalescer:            coalescer,
 		availabilityProvider: availabilityCommon.AvailabilityProvider{},
 	}, nil
 }
@@ -49,32 +74,64 @@ func (ap *AccountProcessor) GetShardIDForAddress(address string) (uint32, error)
 	return ap.proc.ComputeShardId(addressBytes)
 }
 
+// GetTransactions resolves the request by fetching a page of the transactions of the given address from the database
+func (ap *AccountProcessor) GetTransactions(ctx context.Context, address string, options common.TransactionsHistoryOptions) (*data.DatabaseTransactionsPage, error) {
+	_, err := ap.pubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %v", ErrInvalidAddress, err)
+	}
+
+	return ap.connector.GetTransactionsByAddress(ctx, address, options)
+}
+
 // GetAccount resolves the request by sending the request to the right observer and returns the response
-func (ap *AccountProcessor) GetAccount(address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+func (ap *AccountProcessor) GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
 	if err != nil {
 		return nil, err
 	}
 
-	responseAccount := data.AccountApiResponse{}
-	for _, observer := range observers {
+	url := common.BuildUrlWithAccountQueryOptions(addressPath+address, options)
+	response, err := ap.coalescer.Do(ctx, accountCoalescingOperation, createAccountCoalescingKey(url, options), func(coalescedCtx context.Context) (interface{}, error) {
+		return ap.getAccountFromObservers(coalescedCtx, observers, address, url)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*data.AccountModel), nil
+}
+
+// createAccountCoalescingKey returns the key of the identical account requests. The forced shard is not part of the
+// url, but it selects the observers
+func createAccountCoalescingKey(url string, options common.AccountQueryOptions) string {
+	if !options.ForcedShardID.HasValue {
+		return url
+	}
+
+	return fmt.Sprintf("%s_shard_%d", url, options.ForcedShardID.Value)
+}
 
-		url := common.BuildUrlWithAccountQueryOptions(addressPath+address, options)
-		_, err = ap.proc.CallGetRestEndPoint(observer.Address, url, &responseAccount)
-		if err == nil {
+func (ap *AccountProcessor) getAccountFromObservers(ctx context.Context, observers []*data.NodeData, address string, url string) (interface{}, error) {
+	return ap.hedgedRequests.Execute(ctx, observers, func(requestCtx context.Context, observer *data.NodeData) (interface{}, bool, error) {
+		responseAccount := data.AccountApiResponse{}
+		_, errGet := ap.proc.CallGetRestEndPointWithContext(requestCtx, observer.Address, url, &responseAccount)
+		if errGet == nil {
 			log.Info("account request", "address", address, "shard ID", observer.ShardId, "observer", observer.Address)
-			return &responseAccount.Data, nil
+			return &responseAccount.Data, false, nil
 		}
 
-		log.Error("account request", "observer", observer.Address, "address", address, "error", err.Error())
-	}
+		if requestCtx.Err() == nil {
+			log.Error("account request", "observer", observer.Address, "address", address, "error", errGet.Error())
+		}
 
-	return nil, WrapObserversError(responseAccount.Error)
+		return nil, true, WrapObserversError(responseAccount.Error)
+	})
 }
 
 // GetAccounts will return data about the provided accounts
-func (ap *AccountProcessor) GetAccounts(addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
+func (ap *AccountProcessor) GetAccounts(ctx context.Context, addresses []string, options common.AccountQueryOptions) (*data.AccountsModel, error) {
 	addressesInShards := make(map[uint32][]string)
 	var shardID uint32
 	var err error
@@ -97,7 +154,7 @@ func (ap *AccountProcessor) GetAccounts(addresses []string, options common.Accou
 	for shID, accounts := range addressesInShards {
 		go func(shID uint32, accounts []string) {
 			defer wg.Done()
-			accountsInShard, errGetAccounts := ap.getAccountsInShard(accounts, shID, options)
+			accountsInShard, errGetAccounts := ap.getAccountsInShard(ctx, accounts, shID, options)
 
 			mut.Lock()
 			defer mut.Unlock()
@@ -124,7 +181,7 @@ func (ap *AccountProcessor) GetAccounts(addresses []string, options common.Accou
 	}, nil
 }
 
-func (ap *AccountProcessor) getAccountsInShard(addresses []string, shardID uint32, options common.AccountQueryOptions) (map[string]*data.Account, error) {
+func (ap *AccountProcessor) getAccountsInShard(ctx context.Context, addresses []string, shardID uint32, options common.AccountQueryOptions) (map[string]*data.Account, error) {
 	observers, err := ap.proc.GetObservers(shardID, data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -134,7 +191,7 @@ func (ap *AccountProcessor) getAccountsInShard(addresses []string, shardID uint3
 	apiPath := addressPath + "bulk"
 	apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
 	for _, observer := range observers {
-		respCode, err := ap.proc.CallPostRestEndPoint(observer.Address, apiPath, addresses, &apiResponse)
+		respCode, err := ap.proc.CallPostRestEndPointWithContext(ctx, observer.Address, apiPath, addresses, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("bulk accounts request",
 				"shard ID", observer.ShardId,
@@ -147,6 +204,10 @@ func (ap *AccountProcessor) getAccountsInShard(addresses []string, shardID uint3
 			return apiResponse.Data.Accounts, nil
 		}
 
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
 		log.Error("bulk accounts request", "observer", observer.Address, "error", err.Error())
 	}
 
@@ -154,7 +215,7 @@ func (ap *AccountProcessor) getAccountsInShard(addresses []string, shardID uint3
 }
 
 // GetValueForKey returns the value for the given address and key
-func (ap *AccountProcessor) GetValueForKey(address string, key string, options common.AccountQueryOptions) (string, error) {
+func (ap *AccountProcessor) GetValueForKey(ctx context.Context, address string, key string, options common.AccountQueryOptions) (string, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
 	if err != nil {
@@ -165,7 +226,7 @@ func (ap *AccountProcessor) GetValueForKey(address string, key string, options c
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/key/" + key
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPointWithContext(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account value for key request",
 				"address", address,
@@ -179,6 +240,10 @@ func (ap *AccountProcessor) GetValueForKey(address string, key string, options c
 			return apiResponse.Data.Value, nil
 		}
 
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+
 		log.Error("account value for key request", "observer", observer.Address, "address", address, "error", err.Error())
 	}
 
@@ -186,7 +251,7 @@ func (ap *AccountProcessor) GetValueForKey(address string, key string, options c
 }
 
 // GetESDTTokenData returns the token data for a token with the given name
-func (ap *AccountProcessor) GetESDTTokenData(address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetESDTTokenData(ctx context.Context, address string, key string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
 	if err != nil {
@@ -197,7 +262,7 @@ func (ap *AccountProcessor) GetESDTTokenData(address string, key string, options
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/esdt/" + key
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPointWithContext(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account ESDT token data",
 				"address", address,
@@ -212,6 +277,10 @@ func (ap *AccountProcessor) GetESDTTokenData(address string, key string, options
 			return &apiResponse, nil
 		}
 
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
 		log.Error("account get ESDT token data", "observer", observer.Address, "address", address, "error", err.Error())
 	}
 
@@ -219,7 +288,7 @@ func (ap *AccountProcessor) GetESDTTokenData(address string, key string, options
 }
 
 // GetESDTsWithRole returns the token identifiers where the given address has the given role assigned
-func (ap *AccountProcessor) GetESDTsWithRole(address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetESDTsWithRole(ctx context.Context, address string, role string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 	observers, err := ap.proc.GetObservers(core.MetachainShardId, availability)
 	if err != nil {
@@ -230,7 +299,7 @@ func (ap *AccountProcessor) GetESDTsWithRole(address string, role string, option
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/esdts-with-role/" + role
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPointWithContext(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account ESDTs with role",
 				"address", address,
@@ -245,6 +314,10 @@ func (ap *AccountProcessor) GetESDTsWithRole(address string, role string, option
 			return &apiResponse, nil
 		}
 
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
 		log.Error("account get ESDTs with role", "observer", observer.Address, "address", address, "role", role, "error", err.Error())
 	}
 
@@ -252,7 +325,7 @@ func (ap *AccountProcessor) GetESDTsWithRole(address string, role string, option
 }
 
 // GetESDTsRoles returns all the tokens and their roles for a given address
-func (ap *AccountProcessor) GetESDTsRoles(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetESDTsRoles(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 	observers, err := ap.proc.GetObservers(core.MetachainShardId, availability)
 	if err != nil {
@@ -263,7 +336,7 @@ func (ap *AccountProcessor) GetESDTsRoles(address string, options common.Account
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/esdts/roles"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, errGet := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, errGet := ap.proc.CallGetRestEndPointWithContext(ctx, observer.Address, apiPath, &apiResponse)
 		if errGet == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account ESDTs roles",
 				"address", address,
@@ -277,6 +350,10 @@ func (ap *AccountProcessor) GetESDTsRoles(address string, options common.Account
 			return &apiResponse, nil
 		}
 
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
 		log.Error("account get ESDTs roles", "observer", observer.Address, "address", address, "error", errGet.Error())
 	}
 
@@ -284,7 +361,7 @@ func (ap *AccountProcessor) GetESDTsRoles(address string, options common.Account
 }
 
 // GetNFTTokenIDsRegisteredByAddress returns the token identifiers of the NFTs registered by the address
-func (ap *AccountProcessor) GetNFTTokenIDsRegisteredByAddress(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetNFTTokenIDsRegisteredByAddress(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	//TODO: refactor the entire proxy so endpoints like this which simply forward the response will use a common
 	// component, as described in task EN-9857.
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
@@ -297,7 +374,7 @@ func (ap *AccountProcessor) GetNFTTokenIDsRegisteredByAddress(address string, op
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/registered-nfts/"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPointWithContext(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account get owned NFTs",
 				"address", address,
@@ -311,6 +388,10 @@ func (ap *AccountProcessor) GetNFTTokenIDsRegisteredByAddress(address string, op
 			return &apiResponse, nil
 		}
 
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
 		log.Error("account get owned NFTs", "observer", observer.Address, "address", address, "error", err.Error())
 	}
 
@@ -318,7 +399,7 @@ func (ap *AccountProcessor) GetNFTTokenIDsRegisteredByAddress(address string, op
 }
 
 // GetESDTNftTokenData returns the nft token data for a token with the given identifier and nonce
-func (ap *AccountProcessor) GetESDTNftTokenData(address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetESDTNftTokenData(ctx context.Context, address string, key string, nonce uint64, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
 	if err != nil {
@@ -330,7 +411,7 @@ func (ap *AccountProcessor) GetESDTNftTokenData(address string, key string, nonc
 		nonceAsString := fmt.Sprintf("%d", nonce)
 		apiPath := addressPath + address + "/nft/" + key + "/nonce/" + nonceAsString
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPointWithContext(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account ESDT NFT token data",
 				"address", address,
@@ -345,6 +426,10 @@ func (ap *AccountProcessor) GetESDTNftTokenData(address string, key string, nonc
 			return &apiResponse, nil
 		}
 
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
 		log.Error("account get ESDT nft token data", "observer", observer.Address, "address", address, "error", err.Error())
 	}
 
@@ -352,7 +437,7 @@ func (ap *AccountProcessor) GetESDTNftTokenData(address string, key string, nonc
 }
 
 // GetAllESDTTokens returns all the tokens for a given address
-func (ap *AccountProcessor) GetAllESDTTokens(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetAllESDTTokens(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
 	if err != nil {
@@ -363,7 +448,7 @@ func (ap *AccountProcessor) GetAllESDTTokens(address string, options common.Acco
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/esdt"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPointWithContext(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account all ESDT tokens",
 				"address", address,
@@ -377,6 +462,10 @@ func (ap *AccountProcessor) GetAllESDTTokens(address string, options common.Acco
 			return &apiResponse, nil
 		}
 
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
 		log.Error("account get all ESDT tokens", "observer", observer.Address, "address", address, "error", err.Error())
 	}
 
@@ -384,7 +473,7 @@ func (ap *AccountProcessor) GetAllESDTTokens(address string, options common.Acco
 }
 
 // GetKeyValuePairs returns all the key-value pairs for a given address
-func (ap *AccountProcessor) GetKeyValuePairs(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetKeyValuePairs(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
 	if err != nil {
@@ -395,7 +484,7 @@ func (ap *AccountProcessor) GetKeyValuePairs(address string, options common.Acco
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/keys"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPointWithContext(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account get all key-value pairs",
 				"address", address,
@@ -409,6 +498,10 @@ func (ap *AccountProcessor) GetKeyValuePairs(address string, options common.Acco
 			return &apiResponse, nil
 		}
 
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
 		log.Error("account get all key-value pairs error", "observer", observer.Address, "address", address, "error", err.Error())
 	}
 
@@ -416,7 +509,7 @@ func (ap *AccountProcessor) GetKeyValuePairs(address string, options common.Acco
 }
 
 // GetGuardianData returns the guardian data for the given address
-func (ap *AccountProcessor) GetGuardianData(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetGuardianData(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
 	if err != nil {
@@ -427,7 +520,7 @@ func (ap *AccountProcessor) GetGuardianData(address string, options common.Accou
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/guardian-data"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPointWithContext(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account get guardian data",
 				"address", address,
@@ -441,6 +534,10 @@ func (ap *AccountProcessor) GetGuardianData(address string, options common.Accou
 			return &apiResponse, nil
 		}
 
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
 		log.Error("account get guardian data", "observer", observer.Address, "address", address, "error", err.Error())
 	}
 
@@ -448,7 +545,7 @@ func (ap *AccountProcessor) GetGuardianData(address string, options common.Accou
 }
 
 // GetCodeHash returns the code hash for a given address
-func (ap *AccountProcessor) GetCodeHash(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) GetCodeHash(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	availability := ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 	observers, err := ap.getObserversForAddress(address, availability, options.ForcedShardID)
 	if err != nil {
@@ -459,7 +556,7 @@ func (ap *AccountProcessor) GetCodeHash(address string, options common.AccountQu
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/code-hash"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPointWithContext(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account get code hash",
 				"address", address,
@@ -473,6 +570,10 @@ func (ap *AccountProcessor) GetCodeHash(address string, options common.AccountQu
 			return &apiResponse, nil
 		}
 
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
 		log.Error("account get code hash error", "observer", observer.Address, "address", address, "error", err.Error())
 	}
 
@@ -512,7 +613,7 @@ func (ap *AccountProcessor) GetBaseProcessor() Processor {
 }
 
 // IsDataTrieMigrated returns true if the data trie for the given address is migrated
-func (ap *AccountProcessor) IsDataTrieMigrated(address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+func (ap *AccountProcessor) IsDataTrieMigrated(ctx context.Context, address string, options common.AccountQueryOptions) (*data.GenericAPIResponse, error) {
 	observers, err := ap.getObserversForAddress(address, data.AvailabilityRecent, options.ForcedShardID)
 	if err != nil {
 		return nil, err
@@ -522,7 +623,7 @@ func (ap *AccountProcessor) IsDataTrieMigrated(address string, options common.Ac
 	for _, observer := range observers {
 		apiPath := addressPath + address + "/is-data-trie-migrated"
 		apiPath = common.BuildUrlWithAccountQueryOptions(apiPath, options)
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ap.proc.CallGetRestEndPointWithContext(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("is data trie migrated",
 				"address", address,
@@ -536,6 +637,10 @@ func (ap *AccountProcessor) IsDataTrieMigrated(address string, options common.Ac
 			return &apiResponse, nil
 		}
 
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
 		log.Error("account is data trie migrated", "observer", observer.Address, "address", address, "error", err.Error())
 	}
 
@@ -554,3 +659,8 @@ func WrapObserversError(responseError string) error {
 func (ap *AccountProcessor) getAvailabilityBasedOnAccountQueryOptions(options common.AccountQueryOptions) data.ObserverDataAvailabilityType {
 	return ap.availabilityProvider.AvailabilityForAccountQueryOptions(options)
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ap *AccountProcessor) IsInterfaceNil() bool {
+	return ap == nil
+}
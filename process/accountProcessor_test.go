@@ -1,10 +1,15 @@
 package process_test
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
+	"net/http"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
@@ -17,10 +22,28 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestNewAccountProcessor_NilHedgedRequestsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, &mock.DatabaseConnectorStub{}, nil, process.NewDisabledRequestsCoalescer())
+
+	assert.Nil(t, ap)
+	assert.Equal(t, process.ErrNilHedgedRequestsHandler, err)
+}
+
+func TestNewAccountProcessor_NilRequestsCoalescerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, &mock.DatabaseConnectorStub{}, process.NewDisabledHedgedRequestsHandler(), nil)
+
+	assert.Nil(t, ap)
+	assert.Equal(t, process.ErrNilRequestsCoalescer, err)
+}
+
 func TestNewAccountProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ap, err := process.NewAccountProcessor(nil, &mock.PubKeyConverterMock{})
+	ap, err := process.NewAccountProcessor(nil, &mock.PubKeyConverterMock{}, &mock.DatabaseConnectorStub{}, process.NewDisabledHedgedRequestsHandler(), process.NewDisabledRequestsCoalescer())
 
 	assert.Nil(t, ap)
 	assert.Equal(t, process.ErrNilCoreProcessor, err)
@@ -29,16 +52,25 @@ func TestNewAccountProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 func TestNewAccountProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, nil)
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, nil, &mock.DatabaseConnectorStub{}, process.NewDisabledHedgedRequestsHandler(), process.NewDisabledRequestsCoalescer())
 
 	assert.Nil(t, ap)
 	assert.Equal(t, process.ErrNilPubKeyConverter, err)
 }
 
+func TestNewAccountProcessor_NilDatabaseConnectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, nil, process.NewDisabledHedgedRequestsHandler(), process.NewDisabledRequestsCoalescer())
+
+	assert.Nil(t, ap)
+	assert.Equal(t, process.ErrNilDatabaseConnector, err)
+}
+
 func TestNewAccountProcessor_WithCoreProcessorShouldWork(t *testing.T) {
 	t.Parallel()
 
-	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{})
+	ap, err := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, &mock.DatabaseConnectorStub{}, process.NewDisabledHedgedRequestsHandler(), process.NewDisabledRequestsCoalescer())
 
 	assert.NotNil(t, ap)
 	assert.Nil(t, err)
@@ -49,8 +81,8 @@ func TestNewAccountProcessor_WithCoreProcessorShouldWork(t *testing.T) {
 func TestAccountProcessor_GetAccountInvalidHexAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{})
-	accnt, err := ap.GetAccount("invalid hex number", common.AccountQueryOptions{})
+	ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, &mock.DatabaseConnectorStub{}, process.NewDisabledHedgedRequestsHandler(), process.NewDisabledRequestsCoalescer())
+	accnt, err := ap.GetAccount(context.Background(), "invalid hex number", common.AccountQueryOptions{})
 
 	assert.Nil(t, accnt)
 	assert.NotNil(t, err)
@@ -68,9 +100,12 @@ func TestAccountProcessor_GetAccountComputeShardIdFailsShouldErr(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.DatabaseConnectorStub{},
+		process.NewDisabledHedgedRequestsHandler(),
+		process.NewDisabledRequestsCoalescer(),
 	)
 	address := "DEADBEEF"
-	accnt, err := ap.GetAccount(address, common.AccountQueryOptions{})
+	accnt, err := ap.GetAccount(context.Background(), address, common.AccountQueryOptions{})
 
 	assert.Nil(t, accnt)
 	assert.Equal(t, errExpected, err)
@@ -90,9 +125,12 @@ func TestAccountProcessor_GetAccountGetObserversFailsShouldErr(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.DatabaseConnectorStub{},
+		process.NewDisabledHedgedRequestsHandler(),
+		process.NewDisabledRequestsCoalescer(),
 	)
 	address := "DEADBEEF"
-	accnt, err := ap.GetAccount(address, common.AccountQueryOptions{})
+	accnt, err := ap.GetAccount(context.Background(), address, common.AccountQueryOptions{})
 
 	assert.Nil(t, accnt)
 	assert.Equal(t, errExpected, err)
@@ -118,14 +156,113 @@ func TestAccountProcessor_GetAccountSendingFailsOnAllObserversShouldErr(t *testi
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.DatabaseConnectorStub{},
+		process.NewDisabledHedgedRequestsHandler(),
+		process.NewDisabledRequestsCoalescer(),
 	)
 	address := "DEADBEEF"
-	accnt, err := ap.GetAccount(address, common.AccountQueryOptions{})
+	accnt, err := ap.GetAccount(context.Background(), address, common.AccountQueryOptions{})
 
 	assert.Nil(t, accnt)
 	assert.True(t, errors.Is(err, process.ErrSendingRequest))
 }
 
+func TestAccountProcessor_GetAccountWithHedgedRequestsShouldReturnTheFirstAnswer(t *testing.T) {
+	t.Parallel()
+
+	slowObserver := "address1"
+	hedgedRequestsHandler, _ := process.NewHedgedRequestsHandler(10 * time.Millisecond)
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{
+					{Address: slowObserver, ShardId: 0},
+					{Address: "address2", ShardId: 0},
+				}, nil
+			},
+			CallGetRestEndPointWithContextCalled: func(ctx context.Context, address string, path string, value interface{}) (int, error) {
+				if address == slowObserver {
+					<-ctx.Done()
+					return http.StatusRequestTimeout, ctx.Err()
+				}
+
+				valRespond := value.(*data.AccountApiResponse)
+				valRespond.Data.Account.Address = address
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		&mock.DatabaseConnectorStub{},
+		hedgedRequestsHandler,
+		process.NewDisabledRequestsCoalescer(),
+	)
+
+	accountModel, err := ap.GetAccount(context.Background(), "DEADBEEF", common.AccountQueryOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "address2", accountModel.Account.Address)
+}
+
+func TestAccountProcessor_GetAccountShouldCoalesceIdenticalRequests(t *testing.T) {
+	t.Parallel()
+
+	numRequests := 5
+	numCoalesced := uint32(0)
+	numObserverCalls := uint32(0)
+	releaseObserver := make(chan struct{})
+	coalescer, _ := process.NewRequestsCoalescer(&mock.CoalescingMetricsHandlerStub{
+		AddCoalescingRequestDataCalled: func(operation string, isCoalesced bool) {
+			if isCoalesced {
+				atomic.AddUint32(&numCoalesced, 1)
+			}
+		},
+	})
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{{Address: "address1", ShardId: 0}}, nil
+			},
+			CallGetRestEndPointWithContextCalled: func(ctx context.Context, address string, path string, value interface{}) (int, error) {
+				atomic.AddUint32(&numObserverCalls, 1)
+				<-releaseObserver
+
+				valRespond := value.(*data.AccountApiResponse)
+				valRespond.Data.Account.Nonce = 37
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		&mock.DatabaseConnectorStub{},
+		process.NewDisabledHedgedRequestsHandler(),
+		coalescer,
+	)
+
+	var wg sync.WaitGroup
+	wg.Add(numRequests)
+	for i := 0; i < numRequests; i++ {
+		go func() {
+			defer wg.Done()
+
+			accountModel, err := ap.GetAccount(context.Background(), "DEADBEEF", common.AccountQueryOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, uint64(37), accountModel.Account.Nonce)
+		}()
+	}
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadUint32(&numCoalesced) == uint32(numRequests-1)
+	}, time.Second, time.Millisecond)
+	close(releaseObserver)
+	wg.Wait()
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numObserverCalls))
+}
+
 func TestAccountProcessor_GetAccountSendingFailsOnFirstObserverShouldStillSend(t *testing.T) {
 	t.Parallel()
 
@@ -158,9 +295,12 @@ func TestAccountProcessor_GetAccountSendingFailsOnFirstObserverShouldStillSend(t
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.DatabaseConnectorStub{},
+		process.NewDisabledHedgedRequestsHandler(),
+		process.NewDisabledRequestsCoalescer(),
 	)
 	address := "DEADBEEF"
-	accountModel, err := ap.GetAccount(address, common.AccountQueryOptions{})
+	accountModel, err := ap.GetAccount(context.Background(), address, common.AccountQueryOptions{})
 
 	assert.Equal(t, respondedAccount.Account, accountModel.Account)
 	assert.Nil(t, err)
@@ -187,11 +327,14 @@ func TestAccountProcessor_GetValueForAKeyShouldWork(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.DatabaseConnectorStub{},
+		process.NewDisabledHedgedRequestsHandler(),
+		process.NewDisabledRequestsCoalescer(),
 	)
 
 	key := "key"
 	addr1 := "DEADBEEF"
-	value, err := ap.GetValueForKey(addr1, key, common.AccountQueryOptions{})
+	value, err := ap.GetValueForKey(context.Background(), addr1, key, common.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, expectedValue, value)
 }
@@ -215,11 +358,14 @@ func TestAccountProcessor_GetValueForAKeyShouldError(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.DatabaseConnectorStub{},
+		process.NewDisabledHedgedRequestsHandler(),
+		process.NewDisabledRequestsCoalescer(),
 	)
 
 	key := "key"
 	addr1 := "DEADBEEF"
-	value, err := ap.GetValueForKey(addr1, key, common.AccountQueryOptions{})
+	value, err := ap.GetValueForKey(context.Background(), addr1, key, common.AccountQueryOptions{})
 	assert.Equal(t, "", value)
 	assert.True(t, errors.Is(err, process.ErrSendingRequest))
 }
@@ -248,6 +394,9 @@ func TestAccountProcessor_GetShardIForAddressShouldWork(t *testing.T) {
 			},
 		},
 		bech32C,
+		&mock.DatabaseConnectorStub{},
+		process.NewDisabledHedgedRequestsHandler(),
+		process.NewDisabledRequestsCoalescer(),
 	)
 
 	shardID, err := ap.GetShardIDForAddress(addressShard1)
@@ -270,6 +419,9 @@ func TestAccountProcessor_GetShardIDForAddressShouldError(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.DatabaseConnectorStub{},
+		process.NewDisabledHedgedRequestsHandler(),
+		process.NewDisabledRequestsCoalescer(),
 	)
 
 	shardID, err := ap.GetShardIDForAddress("aaaa")
@@ -288,9 +440,12 @@ func TestAccountProcessor_GetESDTsWithRoleGetObserversFails(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.DatabaseConnectorStub{},
+		process.NewDisabledHedgedRequestsHandler(),
+		process.NewDisabledRequestsCoalescer(),
 	)
 
-	result, err := ap.GetESDTsWithRole("address", "role", common.AccountQueryOptions{})
+	result, err := ap.GetESDTsWithRole(context.Background(), "address", "role", common.AccountQueryOptions{})
 	require.Equal(t, expectedErr, err)
 	require.Nil(t, result)
 }
@@ -315,9 +470,12 @@ func TestAccountProcessor_GetESDTsWithRoleApiCallFails(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.DatabaseConnectorStub{},
+		process.NewDisabledHedgedRequestsHandler(),
+		process.NewDisabledRequestsCoalescer(),
 	)
 
-	result, err := ap.GetESDTsWithRole("address", "role", common.AccountQueryOptions{})
+	result, err := ap.GetESDTsWithRole(context.Background(), "address", "role", common.AccountQueryOptions{})
 	require.Error(t, err)
 	require.True(t, strings.Contains(err.Error(), "sending request error"))
 	require.Nil(t, result)
@@ -343,9 +501,12 @@ func TestAccountProcessor_GetESDTsWithRoleShouldWork(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.DatabaseConnectorStub{},
+		process.NewDisabledHedgedRequestsHandler(),
+		process.NewDisabledRequestsCoalescer(),
 	)
 	address := "DEADBEEF"
-	response, err := ap.GetESDTsWithRole(address, "role", common.AccountQueryOptions{})
+	response, err := ap.GetESDTsWithRole(context.Background(), address, "role", common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Equal(t, "token0", response.Data.([]string)[0])
 }
@@ -361,9 +522,12 @@ func TestAccountProcessor_GetESDTsRolesGetObserversFails(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.DatabaseConnectorStub{},
+		process.NewDisabledHedgedRequestsHandler(),
+		process.NewDisabledRequestsCoalescer(),
 	)
 
-	result, err := ap.GetESDTsRoles("address", common.AccountQueryOptions{})
+	result, err := ap.GetESDTsRoles(context.Background(), "address", common.AccountQueryOptions{})
 	require.Equal(t, expectedErr, err)
 	require.Nil(t, result)
 }
@@ -388,9 +552,12 @@ func TestAccountProcessor_GetESDTsRolesApiCallFails(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.DatabaseConnectorStub{},
+		process.NewDisabledHedgedRequestsHandler(),
+		process.NewDisabledRequestsCoalescer(),
 	)
 
-	result, err := ap.GetESDTsRoles("address", common.AccountQueryOptions{})
+	result, err := ap.GetESDTsRoles(context.Background(), "address", common.AccountQueryOptions{})
 	require.Error(t, err)
 	require.True(t, strings.Contains(err.Error(), "sending request error"))
 	require.Nil(t, result)
@@ -416,9 +583,12 @@ func TestAccountProcessor_GetESDTsRolesShouldWork(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.DatabaseConnectorStub{},
+		process.NewDisabledHedgedRequestsHandler(),
+		process.NewDisabledRequestsCoalescer(),
 	)
 	address := "DEADBEEF"
-	response, err := ap.GetESDTsRoles(address, common.AccountQueryOptions{})
+	response, err := ap.GetESDTsRoles(context.Background(), address, common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Equal(t, "token0", response.Data.([]string)[0])
 }
@@ -443,9 +613,12 @@ func TestAccountProcessor_GetCodeHash(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.DatabaseConnectorStub{},
+		process.NewDisabledHedgedRequestsHandler(),
+		process.NewDisabledRequestsCoalescer(),
 	)
 	address := "DEADBEEF"
-	response, err := ap.GetCodeHash(address, common.AccountQueryOptions{})
+	response, err := ap.GetCodeHash(context.Background(), address, common.AccountQueryOptions{})
 	require.NoError(t, err)
 	require.Equal(t, "code-hash", response.Data.([]string)[0])
 }
@@ -461,9 +634,12 @@ func TestAccountProcessor_IsDataTrieMigrated(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			&mock.DatabaseConnectorStub{},
+			process.NewDisabledHedgedRequestsHandler(),
+			process.NewDisabledRequestsCoalescer(),
 		)
 
-		result, err := ap.IsDataTrieMigrated("address", common.AccountQueryOptions{})
+		result, err := ap.IsDataTrieMigrated(context.Background(), "address", common.AccountQueryOptions{})
 		require.Error(t, err)
 		require.Nil(t, result)
 	})
@@ -488,9 +664,12 @@ func TestAccountProcessor_IsDataTrieMigrated(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			&mock.DatabaseConnectorStub{},
+			process.NewDisabledHedgedRequestsHandler(),
+			process.NewDisabledRequestsCoalescer(),
 		)
 
-		result, err := ap.IsDataTrieMigrated("DEADBEEF", common.AccountQueryOptions{})
+		result, err := ap.IsDataTrieMigrated(context.Background(), "DEADBEEF", common.AccountQueryOptions{})
 		require.Error(t, err)
 		require.Nil(t, result)
 	})
@@ -517,9 +696,12 @@ func TestAccountProcessor_IsDataTrieMigrated(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			&mock.DatabaseConnectorStub{},
+			process.NewDisabledHedgedRequestsHandler(),
+			process.NewDisabledRequestsCoalescer(),
 		)
 
-		result, err := ap.IsDataTrieMigrated("DEADBEEF", common.AccountQueryOptions{})
+		result, err := ap.IsDataTrieMigrated(context.Background(), "DEADBEEF", common.AccountQueryOptions{})
 		require.NoError(t, err)
 		require.True(t, result.Data.(bool))
 	})
@@ -563,9 +745,12 @@ func TestAccountProcessor_GetAccounts(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			&mock.DatabaseConnectorStub{},
+			process.NewDisabledHedgedRequestsHandler(),
+			process.NewDisabledRequestsCoalescer(),
 		)
 
-		result, err := ap.GetAccounts([]string{"aabb", "bbaa"}, common.AccountQueryOptions{})
+		result, err := ap.GetAccounts(context.Background(), []string{"aabb", "bbaa"}, common.AccountQueryOptions{})
 		require.Equal(t, expectedError, err.Error())
 		require.Empty(t, result)
 	})
@@ -608,9 +793,12 @@ func TestAccountProcessor_GetAccounts(t *testing.T) {
 				},
 			},
 			&mock.PubKeyConverterMock{},
+			&mock.DatabaseConnectorStub{},
+			process.NewDisabledHedgedRequestsHandler(),
+			process.NewDisabledRequestsCoalescer(),
 		)
 
-		result, err := ap.GetAccounts([]string{"aabb", "bbaa"}, common.AccountQueryOptions{})
+		result, err := ap.GetAccounts(context.Background(), []string{"aabb", "bbaa"}, common.AccountQueryOptions{})
 		require.NoError(t, err)
 
 		require.Equal(t, map[string]*data.Account{
@@ -625,3 +813,67 @@ func TestAccountProcessor_GetAccounts(t *testing.T) {
 		}, result.Accounts)
 	})
 }
+
+func TestAccountProcessor_GetAccountsShouldPropagateTheContext(t *testing.T) {
+	t.Parallel()
+
+	type contextKey string
+	key := contextKey("key")
+	ctx := context.WithValue(context.Background(), key, "value")
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			GetObserversCalled: func(shardID uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{
+					{Address: "observer0", ShardId: shardID},
+				}, nil
+			},
+			CallPostRestEndPointWithContextCalled: func(requestCtx context.Context, _ string, _ string, _ interface{}, _ interface{}) (int, error) {
+				require.Equal(t, "value", requestCtx.Value(key))
+				return http.StatusOK, nil
+			},
+			ComputeShardIdCalled: func(addr []byte) (uint32, error) {
+				return 0, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		&mock.DatabaseConnectorStub{},
+		process.NewDisabledHedgedRequestsHandler(),
+		process.NewDisabledRequestsCoalescer(),
+	)
+
+	_, err := ap.GetAccounts(ctx, []string{"aabb"}, common.AccountQueryOptions{})
+	require.NoError(t, err)
+}
+
+func TestAccountProcessor_GetTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, &mock.DatabaseConnectorStub{}, process.NewDisabledHedgedRequestsHandler(), process.NewDisabledRequestsCoalescer())
+		page, err := ap.GetTransactions(context.Background(), "invalid hex number", common.TransactionsHistoryOptions{})
+		require.Nil(t, page)
+		require.True(t, errors.Is(err, process.ErrInvalidAddress))
+	})
+	t.Run("should return the transactions from the database", func(t *testing.T) {
+		t.Parallel()
+
+		providedOptions := common.TransactionsHistoryOptions{Token: "TKN-abcdef", Cursor: "cursor", Size: 5}
+		providedPage := &data.DatabaseTransactionsPage{
+			Transactions: []data.DatabaseTransaction{{Hash: "hash0"}, {Hash: "hash1"}},
+			NextCursor:   "next",
+		}
+		connector := &mock.DatabaseConnectorStub{
+			GetTransactionsByAddressCalled: func(address string, options common.TransactionsHistoryOptions) (*data.DatabaseTransactionsPage, error) {
+				require.Equal(t, "aabb", address)
+				require.Equal(t, providedOptions, options)
+				return providedPage, nil
+			},
+		}
+		ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, connector, process.NewDisabledHedgedRequestsHandler(), process.NewDisabledRequestsCoalescer())
+		page, err := ap.GetTransactions(context.Background(), "aabb", providedOptions)
+		require.NoError(t, err)
+		require.Equal(t, providedPage, page)
+	})
+}
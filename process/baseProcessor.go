@@ -19,10 +19,17 @@ import (
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	proxyData "github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/observer"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var log = logger.GetOrCreate("process")
-var mutHttpClient sync.RWMutex
+
+const tracerName = "process"
 
 const (
 	nodeSyncedNonceDifferenceThreshold = 10
@@ -30,6 +37,12 @@ const (
 	timeoutDurationForNodeStatus       = 2 * time.Second
 )
 
+type nodeInfo struct {
+	shardID           uint32
+	isObserver        bool
+	isFullHistoryNode bool
+}
+
 // BaseProcessor represents an implementation of CoreProcessor that helps to process requests
 type BaseProcessor struct {
 	mutState                       sync.RWMutex
@@ -43,24 +56,32 @@ type BaseProcessor struct {
 	delayForCheckingNodesSyncState time.Duration
 	cancelFunc                     func()
 	noStatusCheck                  bool
+	observersMetricsHandler        ObserversMetricsHandler
+
+	mutNodesInfo sync.RWMutex
+	nodesInfo    map[string]*nodeInfo
+
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
 
-	httpClient *http.Client
+	httpClient ObserversHttpClientHandler
 }
 
 // NewBaseProcessor creates a new instance of BaseProcessor struct
 func NewBaseProcessor(
-	requestTimeoutSec int,
+	httpClient ObserversHttpClientHandler,
 	shardCoord common.Coordinator,
 	observersProvider observer.NodesProviderHandler,
 	fullHistoryNodesProvider observer.NodesProviderHandler,
 	pubKeyConverter core.PubkeyConverter,
+	observersMetricsHandler ObserversMetricsHandler,
 	noStatusCheck bool,
 ) (*BaseProcessor, error) {
 	if check.IfNil(shardCoord) {
 		return nil, ErrNilShardCoordinator
 	}
-	if requestTimeoutSec <= 0 {
-		return nil, ErrInvalidRequestTimeout
+	if check.IfNil(httpClient) {
+		return nil, ErrNilObserversHttpClient
 	}
 	if check.IfNil(observersProvider) {
 		return nil, fmt.Errorf("%w for observers", ErrNilNodesProvider)
@@ -71,11 +92,9 @@ func NewBaseProcessor(
 	if check.IfNil(pubKeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
-
-	httpClient := http.DefaultClient
-	mutHttpClient.Lock()
-	httpClient.Timeout = time.Duration(requestTimeoutSec) * time.Second
-	mutHttpClient.Unlock()
+	if check.IfNil(observersMetricsHandler) {
+		return nil, ErrNilObserversMetricsHandler
+	}
 
 	bp := &BaseProcessor{
 		shardCoordinator:               shardCoord,
@@ -87,6 +106,10 @@ func NewBaseProcessor(
 		delayForCheckingNodesSyncState: stepDelayForCheckingNodesSyncState,
 		chanTriggerNodesState:          make(chan struct{}),
 		noStatusCheck:                  noStatusCheck,
+		observersMetricsHandler:        observersMetricsHandler,
+		nodesInfo:                      make(map[string]*nodeInfo),
+		tracer:                         otel.Tracer(tracerName),
+		propagator:                     otel.GetTextMapPropagator(),
 	}
 	bp.nodeStatusFetcher = bp.getNodeStatusResponseFromAPI
 
@@ -125,6 +148,104 @@ func (bp *BaseProcessor) ReloadFullHistoryObservers() proxyData.NodesReloadRespo
 	return bp.fullHistoryNodesProvider.ReloadNodes(proxyData.FullHistoryNode)
 }
 
+// SetNodesReloadHandler sets the component the reloaded observers and full history nodes are applied through, such as
+// the nodes discoverer, which keeps the discovered nodes
+func (bp *BaseProcessor) SetNodesReloadHandler(handler observer.NodesReloadHandler) error {
+	err := bp.observersProvider.SetNodesReloadHandler(handler)
+	if err != nil {
+		return err
+	}
+
+	return bp.fullHistoryNodesProvider.SetNodesReloadHandler(handler)
+}
+
+// SetObservers replaces the observers with the provided ones, if they are valid
+func (bp *BaseProcessor) SetObservers(nodes []*proxyData.NodeData) error {
+	return bp.setNodes(bp.observersProvider, nodes)
+}
+
+// SetFullHistoryNodes replaces the full history nodes with the provided ones, if they are valid
+func (bp *BaseProcessor) SetFullHistoryNodes(nodes []*proxyData.NodeData) error {
+	return bp.setNodes(bp.fullHistoryNodesProvider, nodes)
+}
+
+func (bp *BaseProcessor) setNodes(nodesProvider observer.NodesProviderHandler, nodes []*proxyData.NodeData) error {
+	err := nodesProvider.SetNodes(nodes)
+	if err != nil {
+		return err
+	}
+
+	bp.resetNodesInfo()
+
+	return nil
+}
+
+// resetNodesInfo drops the cached details of the nodes, so the details of the removed nodes are not kept
+func (bp *BaseProcessor) resetNodesInfo() {
+	bp.mutNodesInfo.Lock()
+	bp.nodesInfo = make(map[string]*nodeInfo)
+	bp.mutNodesInfo.Unlock()
+}
+
+// AddNode adds the provided node to the observers or to the full history nodes, if the resulting nodes are valid
+func (bp *BaseProcessor) AddNode(nodesType proxyData.NodeType, node *proxyData.NodeData) error {
+	err := bp.getNodesProvider(nodesType).AddNode(node)
+	if err != nil {
+		return err
+	}
+
+	bp.resetNodesInfo()
+
+	return nil
+}
+
+// RemoveNode removes the observers or the full history nodes with the provided address
+func (bp *BaseProcessor) RemoveNode(nodesType proxyData.NodeType, address string) error {
+	err := bp.getNodesProvider(nodesType).RemoveNode(address)
+	if err != nil {
+		return err
+	}
+
+	bp.resetNodesInfo()
+
+	return nil
+}
+
+// DrainNode stops sending new requests to the observers or to the full history nodes with the provided address
+func (bp *BaseProcessor) DrainNode(nodesType proxyData.NodeType, address string) error {
+	return bp.getNodesProvider(nodesType).DrainNode(address)
+}
+
+// UndrainNode resumes sending new requests to the observers or to the full history nodes with the provided address
+func (bp *BaseProcessor) UndrainNode(nodesType proxyData.NodeType, address string) error {
+	return bp.getNodesProvider(nodesType).UndrainNode(address)
+}
+
+// GetNodesOverlay returns the changes made at runtime over the configured observers or full history nodes
+func (bp *BaseProcessor) GetNodesOverlay(nodesType proxyData.NodeType) proxyData.NodesOverlay {
+	return bp.getNodesProvider(nodesType).GetOverlay()
+}
+
+// SetNodesOverlay replaces the changes made at runtime over the configured observers or full history nodes
+func (bp *BaseProcessor) SetNodesOverlay(nodesType proxyData.NodeType, overlay proxyData.NodesOverlay) error {
+	err := bp.getNodesProvider(nodesType).SetOverlay(overlay)
+	if err != nil {
+		return err
+	}
+
+	bp.resetNodesInfo()
+
+	return nil
+}
+
+func (bp *BaseProcessor) getNodesProvider(nodesType proxyData.NodeType) observer.NodesProviderHandler {
+	if nodesType == proxyData.FullHistoryNode {
+		return bp.fullHistoryNodesProvider
+	}
+
+	return bp.observersProvider
+}
+
 // GetObservers returns the registered observers on a shard
 func (bp *BaseProcessor) GetObservers(shardID uint32, dataAvailability proxyData.ObserverDataAvailabilityType) ([]*proxyData.NodeData, error) {
 	return bp.observersProvider.GetNodesByShardId(shardID, dataAvailability)
@@ -197,8 +318,32 @@ func (bp *BaseProcessor) CallGetRestEndPoint(
 	path string,
 	value interface{},
 ) (int, error) {
+	return bp.CallGetRestEndPointWithContext(context.Background(), address, path, value)
+}
+
+// CallGetRestEndPointWithContext calls an external end point (sends a request on a node). The request is aborted when
+// the provided context is done
+func (bp *BaseProcessor) CallGetRestEndPointWithContext(
+	ctx context.Context,
+	address string,
+	path string,
+	value interface{},
+) (int, error) {
+	ctx, span := bp.startNodeRequestSpan(ctx, http.MethodGet, address, path)
+	responseStatusCode, err := bp.callGetRestEndPoint(ctx, address, path, value)
+	endNodeRequestSpan(span, responseStatusCode, err)
+
+	return responseStatusCode, err
+}
+
+func (bp *BaseProcessor) callGetRestEndPoint(
+	ctx context.Context,
+	address string,
+	path string,
+	value interface{},
+) (int, error) {
 
-	req, err := http.NewRequest("GET", address+path, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", address+path, nil)
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
@@ -206,9 +351,19 @@ func (bp *BaseProcessor) CallGetRestEndPoint(
 	userAgent := "Multiversx Proxy / 1.0.0 <Requesting data from nodes>"
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", userAgent)
+	bp.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+	forwardRequestInfo(ctx, address, req.Header)
 
+	bp.recordNodeRequest(address)
+	requestStartTime := time.Now()
 	resp, err := bp.httpClient.Do(req)
 	if err != nil {
+		if ctx.Err() != nil {
+			// the request was aborted by the caller, so the node should not be blamed
+			return http.StatusRequestTimeout, err
+		}
+
+		bp.recordNodeResponse(address, time.Since(requestStartTime), false, isTimeoutError(err))
 		bp.triggerNodesSyncCheck(address)
 		if isTimeoutError(err) {
 			return http.StatusRequestTimeout, err
@@ -225,6 +380,9 @@ func (bp *BaseProcessor) CallGetRestEndPoint(
 	}()
 
 	responseBodyBytes, err := io.ReadAll(resp.Body)
+	if ctx.Err() == nil {
+		bp.recordNodeResponse(address, time.Since(requestStartTime), err == nil && !isNodeFailureStatusCode(resp.StatusCode), false)
+	}
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
@@ -250,13 +408,51 @@ func (bp *BaseProcessor) CallPostRestEndPoint(
 	data interface{},
 	response interface{},
 ) (int, error) {
+	return bp.CallPostRestEndPointWithContext(context.Background(), address, path, data, response)
+}
+
+// CallPostRestEndPointWithContext calls an external end point (sends a request on a node). The request is aborted
+// when the provided context is done
+func (bp *BaseProcessor) CallPostRestEndPointWithContext(
+	ctx context.Context,
+	address string,
+	path string,
+	data interface{},
+	response interface{},
+) (int, error) {
+	ctx, span := bp.startNodeRequestSpan(ctx, http.MethodPost, address, path)
+	responseStatusCode, err := bp.callPostRestEndPoint(ctx, address, path, data, response)
+	endNodeRequestSpan(span, responseStatusCode, err)
+
+	return responseStatusCode, err
+}
+
+// forwardRequestInfo passes the ID of the API request being served to the observer and records the observer as
+// contacted, if the request info is carried by the context
+func forwardRequestInfo(ctx context.Context, address string, header http.Header) {
+	requestInfo := common.RequestInfoFromContext(ctx)
+	if requestInfo == nil {
+		return
+	}
+
+	header.Set(common.RequestIDHeader, requestInfo.ID)
+	requestInfo.AddObserver(address)
+}
+
+func (bp *BaseProcessor) callPostRestEndPoint(
+	ctx context.Context,
+	address string,
+	path string,
+	data interface{},
+	response interface{},
+) (int, error) {
 
 	buff, err := json.Marshal(data)
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
 
-	req, err := http.NewRequest("POST", address+path, bytes.NewReader(buff))
+	req, err := http.NewRequestWithContext(ctx, "POST", address+path, bytes.NewReader(buff))
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
@@ -265,9 +461,19 @@ func (bp *BaseProcessor) CallPostRestEndPoint(
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("User-Agent", userAgent)
+	bp.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+	forwardRequestInfo(ctx, address, req.Header)
 
+	bp.recordNodeRequest(address)
+	requestStartTime := time.Now()
 	resp, err := bp.httpClient.Do(req)
 	if err != nil {
+		if ctx.Err() != nil {
+			// the request was aborted by the caller, so the node should not be blamed
+			return http.StatusRequestTimeout, err
+		}
+
+		bp.recordNodeResponse(address, time.Since(requestStartTime), false, isTimeoutError(err))
 		bp.triggerNodesSyncCheck(address)
 		if isTimeoutError(err) {
 			return http.StatusRequestTimeout, err
@@ -284,6 +490,9 @@ func (bp *BaseProcessor) CallPostRestEndPoint(
 	}()
 
 	responseBodyBytes, err := io.ReadAll(resp.Body)
+	if ctx.Err() == nil {
+		bp.recordNodeResponse(address, time.Since(requestStartTime), err == nil && !isNodeFailureStatusCode(resp.StatusCode), false)
+	}
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
@@ -303,6 +512,104 @@ func (bp *BaseProcessor) CallPostRestEndPoint(
 	return responseStatusCode, errors.New(genericApiResponse.Error)
 }
 
+// recordNodeRequest will notify the nodes providers that a request is sent to the node with the provided address, so
+// that the circuit breaker of the node can start a probe if its cool-down period passed
+func (bp *BaseProcessor) recordNodeRequest(address string) {
+	info, ok := bp.getNodeInfo(address)
+	if !ok {
+		return
+	}
+
+	if info.isObserver {
+		bp.observersProvider.RecordNodeRequest(address)
+	}
+	if info.isFullHistoryNode {
+		bp.fullHistoryNodesProvider.RecordNodeRequest(address)
+	}
+}
+
+// recordNodeResponse will feed the outcome of a request to the nodes providers, so that the ones that select the nodes
+// based on their performance can update their statistics. The address is only tracked by the provider that holds it
+func (bp *BaseProcessor) recordNodeResponse(address string, responseTime time.Duration, isSuccessful bool, isTimeout bool) {
+	info, ok := bp.getNodeInfo(address)
+	if !ok {
+		log.Trace("response of an unknown node, not recorded", "address", address)
+		return
+	}
+
+	if info.isObserver {
+		bp.observersProvider.RecordNodeResponse(address, responseTime, isSuccessful)
+	}
+	if info.isFullHistoryNode {
+		bp.fullHistoryNodesProvider.RecordNodeResponse(address, responseTime, isSuccessful)
+	}
+
+	bp.observersMetricsHandler.AddObserverRequestData(address, info.shardID, responseTime, isSuccessful, isTimeout)
+}
+
+// getNodeInfo returns the shard of the node with the provided address and the providers holding it. The details of the
+// nodes are cached, the cache being rebuilt from the nodes providers when an address is not found, as the nodes can be
+// reloaded
+func (bp *BaseProcessor) getNodeInfo(address string) (*nodeInfo, bool) {
+	bp.mutNodesInfo.RLock()
+	info, ok := bp.nodesInfo[address]
+	bp.mutNodesInfo.RUnlock()
+	if ok {
+		return info, true
+	}
+
+	nodesInfo := make(map[string]*nodeInfo)
+	for _, node := range bp.observersProvider.GetAllNodesWithSyncState() {
+		nodesInfo[node.Address] = &nodeInfo{
+			shardID:    node.ShardId,
+			isObserver: true,
+		}
+	}
+	for _, node := range bp.fullHistoryNodesProvider.GetAllNodesWithSyncState() {
+		existingInfo, found := nodesInfo[node.Address]
+		if found {
+			existingInfo.isFullHistoryNode = true
+			continue
+		}
+
+		nodesInfo[node.Address] = &nodeInfo{
+			shardID:           node.ShardId,
+			isFullHistoryNode: true,
+		}
+	}
+
+	bp.mutNodesInfo.Lock()
+	bp.nodesInfo = nodesInfo
+	bp.mutNodesInfo.Unlock()
+
+	info, ok = nodesInfo[address]
+	return info, ok
+}
+
+// startNodeRequestSpan starts the span of a request sent to a node, as a child of the span carried by the context
+func (bp *BaseProcessor) startNodeRequestSpan(ctx context.Context, method string, address string, path string) (context.Context, trace.Span) {
+	attributes := []attribute.KeyValue{
+		semconv.HTTPMethod(method),
+		semconv.HTTPURL(address + path),
+		attribute.String("observer.address", address),
+	}
+	info, ok := bp.getNodeInfo(address)
+	if ok {
+		attributes = append(attributes, attribute.String("observer.shard", core.GetShardIDString(info.shardID)))
+	}
+
+	return bp.tracer.Start(ctx, "HTTP "+method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))
+}
+
+func endNodeRequestSpan(span trace.Span, responseStatusCode int, err error) {
+	span.SetAttributes(semconv.HTTPStatusCode(responseStatusCode))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
 func (bp *BaseProcessor) triggerNodesSyncCheck(address string) {
 	log.Info("triggering nodes state checks because of an offline node", "address of offline node", address)
 	select {
@@ -311,6 +618,18 @@ func (bp *BaseProcessor) triggerNodesSyncCheck(address string) {
 	}
 }
 
+// isNodeFailureStatusCode returns true if the status code shows that the node itself is not able to serve requests. The
+// other error status codes are part of the normal responses of the nodes (for example, a transaction which is not found
+// is reported with 500), so they do not count as node failures
+func isNodeFailureStatusCode(statusCode int) bool {
+	switch statusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
 func isTimeoutError(err error) bool {
 	if err, ok := err.(net.Error); ok && err.Timeout() {
 		return true
@@ -339,6 +658,11 @@ func (bp *BaseProcessor) GetFullHistoryNodesProvider() observer.NodesProviderHan
 	return bp.fullHistoryNodesProvider
 }
 
+// GetConnectionPoolMetrics returns the metrics of the connections opened towards the nodes
+func (bp *BaseProcessor) GetConnectionPoolMetrics() *proxyData.ConnectionPoolMetrics {
+	return bp.httpClient.GetConnectionPoolMetrics()
+}
+
 func computeShardIDs(shardCoordinator common.Coordinator) []uint32 {
 	shardIDs := make([]uint32, 0)
 	for i := uint32(0); i < shardCoordinator.NumberOfShards(); i++ {
@@ -386,10 +710,18 @@ func (bp *BaseProcessor) updateNodesWithSync() {
 	observers := bp.observersProvider.GetAllNodesWithSyncState()
 	observersWithSyncStatus := bp.getNodesWithSyncStatus(observers)
 	bp.observersProvider.UpdateNodesBasedOnSyncState(observersWithSyncStatus)
+	bp.recordNodesSyncState(observersWithSyncStatus)
 
 	fullHistoryNodes := bp.fullHistoryNodesProvider.GetAllNodesWithSyncState()
 	fullHistoryNodesWithSyncStatus := bp.getNodesWithSyncStatus(fullHistoryNodes)
 	bp.fullHistoryNodesProvider.UpdateNodesBasedOnSyncState(fullHistoryNodesWithSyncStatus)
+	bp.recordNodesSyncState(fullHistoryNodesWithSyncStatus)
+}
+
+func (bp *BaseProcessor) recordNodesSyncState(nodes []*proxyData.NodeData) {
+	for _, node := range nodes {
+		bp.observersMetricsHandler.SetObserverSyncState(node.Address, node.ShardId, node.IsSynced)
+	}
 }
 
 func (bp *BaseProcessor) getNodesWithSyncStatus(nodes []*proxyData.NodeData) []*proxyData.NodeData {
@@ -2,22 +2,34 @@ package process_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/sharding"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/observer/circuitBreaker"
 	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/httpclient"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type testStruct struct {
@@ -44,31 +56,44 @@ func createTestHttpServer(
 	}))
 }
 
-func TestNewBaseProcessor_WithInvalidRequestTimeoutShouldErr(t *testing.T) {
+func createObserversHttpClient(requestTimeoutSec int) process.ObserversHttpClientHandler {
+	observersHttpClient, _ := httpclient.NewObserversHttpClient(httpclient.ArgsObserversHttpClient{
+		Config: config.ObserverHttpClientConfig{
+			MaxIdleConnsPerHost: 10,
+		},
+		RequestTimeout: time.Duration(requestTimeoutSec) * time.Second,
+	})
+
+	return observersHttpClient
+}
+
+func TestNewBaseProcessor_WithNilObserversHttpClientShouldErr(t *testing.T) {
 	t.Parallel()
 
 	bp, err := process.NewBaseProcessor(
-		-5,
+		nil,
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		false,
 	)
 
 	assert.Nil(t, bp)
-	assert.Equal(t, process.ErrInvalidRequestTimeout, err)
+	assert.Equal(t, process.ErrNilObserversHttpClient, err)
 }
 
 func TestNewBaseProcessor_WithNilShardCoordinatorShouldErr(t *testing.T) {
 	t.Parallel()
 
 	bp, err := process.NewBaseProcessor(
-		5,
+		createObserversHttpClient(5),
 		nil,
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		false,
 	)
 
@@ -80,11 +105,12 @@ func TestNewBaseProcessor_WithNilObserversProviderShouldErr(t *testing.T) {
 	t.Parallel()
 
 	bp, err := process.NewBaseProcessor(
-		5,
+		createObserversHttpClient(5),
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{},
 		nil,
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		false,
 	)
 
@@ -96,11 +122,12 @@ func TestNewBaseProcessor_WithNilFullHistoryNodesProviderShouldErr(t *testing.T)
 	t.Parallel()
 
 	bp, err := process.NewBaseProcessor(
-		5,
+		createObserversHttpClient(5),
 		&mock.ShardCoordinatorMock{},
 		nil,
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		false,
 	)
 
@@ -108,15 +135,33 @@ func TestNewBaseProcessor_WithNilFullHistoryNodesProviderShouldErr(t *testing.T)
 	assert.True(t, errors.Is(err, process.ErrNilNodesProvider))
 }
 
+func TestNewBaseProcessor_WithNilObserversMetricsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBaseProcessor(
+		createObserversHttpClient(5),
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		nil,
+		false,
+	)
+
+	assert.Nil(t, bp)
+	assert.Equal(t, process.ErrNilObserversMetricsHandler, err)
+}
+
 func TestNewBaseProcessor_WithOkValuesShouldWork(t *testing.T) {
 	t.Parallel()
 
 	bp, err := process.NewBaseProcessor(
-		5,
+		createObserversHttpClient(5),
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		false,
 	)
 
@@ -131,7 +176,7 @@ func TestBaseProcessor_GetObserversEmptyListShouldWork(t *testing.T) {
 
 	observersSlice := []*data.NodeData{{Address: "addr1"}}
 	bp, _ := process.NewBaseProcessor(
-		5,
+		createObserversHttpClient(5),
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{
 			GetNodesByShardIdCalled: func(_ uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
@@ -140,6 +185,7 @@ func TestBaseProcessor_GetObserversEmptyListShouldWork(t *testing.T) {
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		false,
 	)
 	observers, err := bp.GetObservers(0, data.AvailabilityAll)
@@ -166,7 +212,7 @@ func TestBaseProcessor_ComputeShardId(t *testing.T) {
 
 	msc, _ := sharding.NewMultiShardCoordinator(3, 0)
 	bp, _ := process.NewBaseProcessor(
-		5,
+		createObserversHttpClient(5),
 		msc,
 		&mock.ObserversProviderStub{
 			GetNodesByShardIdCalled: func(_ uint32, _ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
@@ -175,6 +221,7 @@ func TestBaseProcessor_ComputeShardId(t *testing.T) {
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		false,
 	)
 
@@ -205,11 +252,12 @@ func TestBaseProcessor_CallGetRestEndPoint(t *testing.T) {
 
 	tsRecovered := &testStruct{}
 	bp, _ := process.NewBaseProcessor(
-		5,
+		createObserversHttpClient(5),
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		false,
 	)
 	_, err := bp.CallGetRestEndPoint(server.URL, "/some/path", tsRecovered)
@@ -234,11 +282,12 @@ func TestBaseProcessor_CallGetRestEndPointShouldTimeout(t *testing.T) {
 
 	tsRecovered := &testStruct{}
 	bp, _ := process.NewBaseProcessor(
-		1,
+		createObserversHttpClient(1),
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		false,
 	)
 	_, err := bp.CallGetRestEndPoint(testServer.URL, "/some/path", tsRecovered)
@@ -247,6 +296,354 @@ func TestBaseProcessor_CallGetRestEndPointShouldTimeout(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestBaseProcessor_CallRestEndPointShouldRecordNodesResponses(t *testing.T) {
+	t.Parallel()
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/ok":
+			_, _ = rw.Write([]byte("{}"))
+		case "/bad-request":
+			rw.WriteHeader(http.StatusBadRequest)
+			_, _ = rw.Write([]byte("{}"))
+		case "/internal-error":
+			rw.WriteHeader(http.StatusInternalServerError)
+			_, _ = rw.Write([]byte("{}"))
+		default:
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = rw.Write([]byte("{}"))
+		}
+	}))
+	defer testServer.Close()
+
+	type recordedResponse struct {
+		address      string
+		isSuccessful bool
+	}
+	mutRecorded := sync.Mutex{}
+	recordedObservers := make([]recordedResponse, 0)
+	recordedFullHistoryNodes := make([]recordedResponse, 0)
+	bp, _ := process.NewBaseProcessor(
+		createObserversHttpClient(5),
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return []*data.NodeData{{Address: testServer.URL, ShardId: 0}}
+			},
+			RecordNodeResponseCalled: func(address string, responseTime time.Duration, isSuccessful bool) {
+				mutRecorded.Lock()
+				recordedObservers = append(recordedObservers, recordedResponse{address: address, isSuccessful: isSuccessful})
+				mutRecorded.Unlock()
+			},
+		},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return []*data.NodeData{{Address: "http://127.0.0.1:0", ShardId: 0}}
+			},
+			RecordNodeResponseCalled: func(address string, responseTime time.Duration, isSuccessful bool) {
+				mutRecorded.Lock()
+				recordedFullHistoryNodes = append(recordedFullHistoryNodes, recordedResponse{address: address, isSuccessful: isSuccessful})
+				mutRecorded.Unlock()
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
+		false,
+	)
+
+	_, _ = bp.CallGetRestEndPoint(testServer.URL, "/ok", &testStruct{})
+	_, _ = bp.CallGetRestEndPoint(testServer.URL, "/bad-request", &testStruct{})
+	_, _ = bp.CallPostRestEndPoint(testServer.URL, "/internal-error", &testStruct{}, &testStruct{})
+	_, _ = bp.CallPostRestEndPoint(testServer.URL, "/unavailable", &testStruct{}, &testStruct{})
+	_, _ = bp.CallGetRestEndPoint("http://127.0.0.1:0", "/offline", &testStruct{})
+	_, _ = bp.CallGetRestEndPoint("http://127.0.0.1:1", "/unknown-node", &testStruct{})
+
+	mutRecorded.Lock()
+	defer mutRecorded.Unlock()
+
+	expectedObserversRecords := []recordedResponse{
+		{address: testServer.URL, isSuccessful: true},
+		{address: testServer.URL, isSuccessful: true},
+		{address: testServer.URL, isSuccessful: true},
+		{address: testServer.URL, isSuccessful: false},
+	}
+	assert.Equal(t, expectedObserversRecords, recordedObservers)
+	expectedFullHistoryNodesRecords := []recordedResponse{
+		{address: "http://127.0.0.1:0", isSuccessful: false},
+	}
+	assert.Equal(t, expectedFullHistoryNodesRecords, recordedFullHistoryNodes)
+}
+
+func TestBaseProcessor_CallRestEndPointShouldRecordNodesRequestsBeforeSending(t *testing.T) {
+	t.Parallel()
+
+	mutRecorded := sync.Mutex{}
+	recordedRequests := make([]string, 0)
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mutRecorded.Lock()
+		recordedRequests = append(recordedRequests, "sent "+req.URL.Path)
+		mutRecorded.Unlock()
+
+		rw.WriteHeader(http.StatusOK)
+		_, _ = rw.Write([]byte("{}"))
+	}))
+	defer testServer.Close()
+
+	bp, _ := process.NewBaseProcessor(
+		createObserversHttpClient(5),
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return []*data.NodeData{{Address: testServer.URL, ShardId: 0}}
+			},
+			RecordNodeRequestCalled: func(address string) {
+				mutRecorded.Lock()
+				recordedRequests = append(recordedRequests, "recorded "+address)
+				mutRecorded.Unlock()
+			},
+		},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
+		false,
+	)
+
+	_, _ = bp.CallGetRestEndPoint(testServer.URL, "/get", &testStruct{})
+	_, _ = bp.CallPostRestEndPoint(testServer.URL, "/post", &testStruct{}, &testStruct{})
+	_, _ = bp.CallGetRestEndPoint("http://127.0.0.1:1", "/unknown-node", &testStruct{})
+
+	mutRecorded.Lock()
+	defer mutRecorded.Unlock()
+
+	expectedRecords := []string{
+		"recorded " + testServer.URL,
+		"sent /get",
+		"recorded " + testServer.URL,
+		"sent /post",
+	}
+	assert.Equal(t, expectedRecords, recordedRequests)
+}
+
+func TestBaseProcessor_TransactionNotFoundResponsesShouldNotOpenTheCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusInternalServerError)
+		_, _ = rw.Write([]byte(`{"data":null,"error":"transaction not found","code":"internal_issue"}`))
+	}))
+	defer testServer.Close()
+
+	nodesCircuitBreaker, _ := circuitBreaker.NewNodesCircuitBreaker(circuitBreaker.ArgsNodesCircuitBreaker{
+		FailureThreshold: 3,
+		CoolDown:         10 * time.Second,
+	})
+	bp, _ := process.NewBaseProcessor(
+		createObserversHttpClient(5),
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return []*data.NodeData{{Address: testServer.URL, ShardId: 0}}
+			},
+			RecordNodeResponseCalled: func(address string, responseTime time.Duration, isSuccessful bool) {
+				nodesCircuitBreaker.RecordResult(address, isSuccessful)
+			},
+		},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
+		false,
+	)
+
+	for i := 0; i < 10; i++ {
+		statusCode, err := bp.CallGetRestEndPoint(testServer.URL, "/transaction/aaaa", &data.GetTransactionResponse{})
+		require.Equal(t, http.StatusInternalServerError, statusCode)
+		require.NotNil(t, err)
+	}
+
+	state, consecutiveFailures := nodesCircuitBreaker.GetState(testServer.URL)
+	assert.Equal(t, data.CircuitBreakerClosed, state)
+	assert.Zero(t, consecutiveFailures)
+	assert.True(t, nodesCircuitBreaker.IsAvailable(testServer.URL))
+}
+
+func TestBaseProcessor_CallRestEndPointShouldRecordObserversMetrics(t *testing.T) {
+	t.Parallel()
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/ok":
+			_, _ = rw.Write([]byte("{}"))
+		case "/slow":
+			time.Sleep(1200 * time.Millisecond)
+			_, _ = rw.Write([]byte("{}"))
+		default:
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = rw.Write([]byte("{}"))
+		}
+	}))
+	defer testServer.Close()
+
+	type recordedRequest struct {
+		address      string
+		shardID      uint32
+		isSuccessful bool
+		isTimeout    bool
+	}
+	mutRecorded := sync.Mutex{}
+	recorded := make([]recordedRequest, 0)
+	bp, _ := process.NewBaseProcessor(
+		createObserversHttpClient(1),
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return []*data.NodeData{{Address: testServer.URL, ShardId: 1}}
+			},
+		},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return []*data.NodeData{{Address: "http://127.0.0.1:0", ShardId: core.MetachainShardId}}
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{
+			AddObserverRequestDataCalled: func(address string, shardID uint32, _ time.Duration, isSuccessful bool, isTimeout bool) {
+				mutRecorded.Lock()
+				recorded = append(recorded, recordedRequest{address: address, shardID: shardID, isSuccessful: isSuccessful, isTimeout: isTimeout})
+				mutRecorded.Unlock()
+			},
+		},
+		false,
+	)
+
+	_, _ = bp.CallGetRestEndPoint(testServer.URL, "/ok", &testStruct{})
+	_, _ = bp.CallPostRestEndPoint(testServer.URL, "/unavailable", &testStruct{}, &testStruct{})
+	_, _ = bp.CallGetRestEndPoint(testServer.URL, "/slow", &testStruct{})
+	_, _ = bp.CallGetRestEndPoint("http://127.0.0.1:0", "/offline", &testStruct{})
+	_, _ = bp.CallGetRestEndPoint("http://127.0.0.1:1", "/unknown-node", &testStruct{})
+
+	mutRecorded.Lock()
+	defer mutRecorded.Unlock()
+
+	expectedRecords := []recordedRequest{
+		{address: testServer.URL, shardID: 1, isSuccessful: true},
+		{address: testServer.URL, shardID: 1, isSuccessful: false},
+		{address: testServer.URL, shardID: 1, isSuccessful: false, isTimeout: true},
+		{address: "http://127.0.0.1:0", shardID: core.MetachainShardId, isSuccessful: false},
+	}
+	assert.Equal(t, expectedRecords, recorded)
+}
+
+func TestBaseProcessor_CallRestEndPointShouldTraceTheRequests(t *testing.T) {
+	t.Parallel()
+
+	mutTraceParents := sync.Mutex{}
+	traceParents := make([]string, 0)
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mutTraceParents.Lock()
+		traceParents = append(traceParents, req.Header.Get("traceparent"))
+		mutTraceParents.Unlock()
+
+		if req.URL.Path == "/ok" {
+			_, _ = rw.Write([]byte("{}"))
+			return
+		}
+
+		rw.WriteHeader(http.StatusInternalServerError)
+		_, _ = rw.Write([]byte(`{"error":"internal error"}`))
+	}))
+	defer testServer.Close()
+
+	bp, _ := process.NewBaseProcessor(
+		createObserversHttpClient(5),
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return []*data.NodeData{{Address: testServer.URL, ShardId: core.MetachainShardId}}
+			},
+		},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
+		false,
+	)
+	spanRecorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder))
+	bp.SetTracing(tracerProvider, propagation.TraceContext{})
+
+	ctx, parentSpan := tracerProvider.Tracer("test").Start(context.Background(), "parent")
+	_, _ = bp.CallGetRestEndPointWithContext(ctx, testServer.URL, "/ok", &testStruct{})
+	_, _ = bp.CallPostRestEndPointWithContext(ctx, testServer.URL, "/internal-error", &testStruct{}, &testStruct{})
+	parentSpan.End()
+
+	spans := spanRecorder.Ended()
+	require.Len(t, spans, 3)
+
+	expectedMethods := []string{http.MethodGet, http.MethodPost}
+	expectedStatusCodes := []int64{http.StatusOK, http.StatusInternalServerError}
+	for idx, span := range spans[:2] {
+		require.Equal(t, "HTTP "+expectedMethods[idx], span.Name())
+		require.Equal(t, trace.SpanKindClient, span.SpanKind())
+		require.Equal(t, parentSpan.SpanContext().SpanID(), span.Parent().SpanID())
+
+		attributes := attribute.NewSet(span.Attributes()...)
+		address, _ := attributes.Value("observer.address")
+		require.Equal(t, testServer.URL, address.AsString())
+		shard, _ := attributes.Value("observer.shard")
+		require.Equal(t, "metachain", shard.AsString())
+		statusCode, _ := attributes.Value("http.status_code")
+		require.Equal(t, expectedStatusCodes[idx], statusCode.AsInt64())
+
+		// the observer receives the span of the request as parent
+		expectedTraceParent := fmt.Sprintf("00-%s-%s-01", span.SpanContext().TraceID(), span.SpanContext().SpanID())
+		mutTraceParents.Lock()
+		require.Equal(t, expectedTraceParent, traceParents[idx])
+		mutTraceParents.Unlock()
+	}
+	require.Equal(t, otelCodes.Unset, spans[0].Status().Code)
+	require.Equal(t, otelCodes.Error, spans[1].Status().Code)
+	require.Equal(t, "internal error", spans[1].Status().Description)
+}
+
+func TestBaseProcessor_CallRestEndPointWithCanceledContextShouldNotRecordNodesResponses(t *testing.T) {
+	t.Parallel()
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		<-req.Context().Done()
+	}))
+	defer testServer.Close()
+
+	numRecords := uint32(0)
+	bp, _ := process.NewBaseProcessor(
+		createObserversHttpClient(5),
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{
+			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
+				return []*data.NodeData{{Address: testServer.URL, ShardId: 0}}
+			},
+			RecordNodeResponseCalled: func(address string, responseTime time.Duration, isSuccessful bool) {
+				atomic.AddUint32(&numRecords, 1)
+			},
+		},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
+		true,
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	statusCode, err := bp.CallGetRestEndPointWithContext(ctx, testServer.URL, "/slow", &testStruct{})
+	assert.Equal(t, http.StatusRequestTimeout, statusCode)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+	statusCode, err = bp.CallPostRestEndPointWithContext(ctx, testServer.URL, "/slow", &testStruct{}, &testStruct{})
+	assert.Equal(t, http.StatusRequestTimeout, statusCode)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+	assert.Zero(t, atomic.LoadUint32(&numRecords))
+}
+
 func TestBaseProcessor_CallPostRestEndPoint(t *testing.T) {
 	ts := &testStruct{
 		Nonce: 10000,
@@ -259,11 +656,12 @@ func TestBaseProcessor_CallPostRestEndPoint(t *testing.T) {
 	defer server.Close()
 
 	bp, _ := process.NewBaseProcessor(
-		5,
+		createObserversHttpClient(5),
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		false,
 	)
 	rc, err := bp.CallPostRestEndPoint(server.URL, "/some/path", ts, tsRecv)
@@ -290,11 +688,12 @@ func TestBaseProcessor_CallPostRestEndPointShouldTimeout(t *testing.T) {
 	defer testServer.Close()
 
 	bp, _ := process.NewBaseProcessor(
-		1,
+		createObserversHttpClient(1),
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		false,
 	)
 	rc, err := bp.CallPostRestEndPoint(testServer.URL, "/some/path", ts, tsRecv)
@@ -327,7 +726,7 @@ func TestBaseProcessor_GetAllObserversWithOkValuesShouldPass(t *testing.T) {
 	})
 
 	bp, _ := process.NewBaseProcessor(
-		5,
+		createObserversHttpClient(5),
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{
 			GetAllNodesCalled: func(_ data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
@@ -336,6 +735,7 @@ func TestBaseProcessor_GetAllObserversWithOkValuesShouldPass(t *testing.T) {
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		false,
 	)
 
@@ -369,7 +769,7 @@ func TestBaseProcessor_GetObserversOnePerShardShouldWork(t *testing.T) {
 	}
 
 	bp, _ := process.NewBaseProcessor(
-		5,
+		createObserversHttpClient(5),
 		&mock.ShardCoordinatorMock{NumShards: 2},
 		&mock.ObserversProviderStub{
 			GetNodesByShardIdCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
@@ -387,6 +787,7 @@ func TestBaseProcessor_GetObserversOnePerShardShouldWork(t *testing.T) {
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		false,
 	)
 
@@ -418,7 +819,7 @@ func TestBaseProcessor_GetObserversOnePerShardOneShardHasNoObserverShouldWork(t
 	}
 
 	bp, _ := process.NewBaseProcessor(
-		5,
+		createObserversHttpClient(5),
 		&mock.ShardCoordinatorMock{NumShards: 2},
 		&mock.ObserversProviderStub{
 			GetNodesByShardIdCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
@@ -436,6 +837,7 @@ func TestBaseProcessor_GetObserversOnePerShardOneShardHasNoObserverShouldWork(t
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		false,
 	)
 
@@ -467,7 +869,7 @@ func TestBaseProcessor_GetObserversOnePerShardMetachainHasNoObserverShouldWork(t
 	var observersListShardMeta []*data.NodeData
 
 	bp, _ := process.NewBaseProcessor(
-		5,
+		createObserversHttpClient(5),
 		&mock.ShardCoordinatorMock{NumShards: 2},
 		&mock.ObserversProviderStub{
 			GetNodesByShardIdCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
@@ -485,6 +887,7 @@ func TestBaseProcessor_GetObserversOnePerShardMetachainHasNoObserverShouldWork(t
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		false,
 	)
 
@@ -520,7 +923,7 @@ func TestBaseProcessor_GetFullHistoryNodesOnePerShardShouldWork(t *testing.T) {
 	}
 
 	bp, _ := process.NewBaseProcessor(
-		5,
+		createObserversHttpClient(5),
 		&mock.ShardCoordinatorMock{NumShards: 2},
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{
@@ -538,6 +941,7 @@ func TestBaseProcessor_GetFullHistoryNodesOnePerShardShouldWork(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		false,
 	)
 
@@ -554,11 +958,12 @@ func TestBaseProcessor_GetShardIDs(t *testing.T) {
 	t.Parallel()
 
 	bp, _ := process.NewBaseProcessor(
-		5,
+		createObserversHttpClient(5),
 		&mock.ShardCoordinatorMock{NumShards: 3},
 		&mock.ObserversProviderStub{},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		false,
 	)
 
@@ -566,11 +971,103 @@ func TestBaseProcessor_GetShardIDs(t *testing.T) {
 	require.Equal(t, expected, bp.GetShardIDs())
 }
 
+func TestBaseProcessor_SetNodes(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	observers := []*data.NodeData{{Address: "observer", ShardId: 0}}
+	fullHistoryNodes := []*data.NodeData{{Address: "full history node", ShardId: 0}}
+	var setObservers, setFullHistoryNodes []*data.NodeData
+	bp, _ := process.NewBaseProcessor(
+		createObserversHttpClient(5),
+		&mock.ShardCoordinatorMock{NumShards: 1},
+		&mock.ObserversProviderStub{
+			SetNodesCalled: func(nodes []*data.NodeData) error {
+				setObservers = nodes
+				return nil
+			},
+		},
+		&mock.ObserversProviderStub{
+			SetNodesCalled: func(nodes []*data.NodeData) error {
+				if len(nodes) == 0 {
+					return expectedErr
+				}
+
+				setFullHistoryNodes = nodes
+				return nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
+		false,
+	)
+
+	require.NoError(t, bp.SetObservers(observers))
+	require.Equal(t, observers, setObservers)
+	require.NoError(t, bp.SetFullHistoryNodes(fullHistoryNodes))
+	require.Equal(t, fullHistoryNodes, setFullHistoryNodes)
+	require.Equal(t, expectedErr, bp.SetFullHistoryNodes(nil))
+}
+
+func TestBaseProcessor_NodesChanges(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	calledObservers := make(map[string]string)
+	calledFullHistoryNodes := make(map[string]string)
+	createProvider := func(called map[string]string) *mock.ObserversProviderStub {
+		return &mock.ObserversProviderStub{
+			AddNodeCalled: func(node *data.NodeData) error {
+				called["add"] = node.Address
+				return nil
+			},
+			RemoveNodeCalled: func(address string) error {
+				called["remove"] = address
+				return nil
+			},
+			DrainNodeCalled: func(address string) error {
+				called["drain"] = address
+				return nil
+			},
+			UndrainNodeCalled: func(address string) error {
+				called["undrain"] = address
+				return expectedErr
+			},
+			GetOverlayCalled: func() data.NodesOverlay {
+				return data.NodesOverlay{Removed: []string{"removed"}}
+			},
+			SetOverlayCalled: func(overlay data.NodesOverlay) error {
+				called["set"] = overlay.Removed[0]
+				return nil
+			},
+		}
+	}
+	bp, _ := process.NewBaseProcessor(
+		createObserversHttpClient(5),
+		&mock.ShardCoordinatorMock{NumShards: 1},
+		createProvider(calledObservers),
+		createProvider(calledFullHistoryNodes),
+		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
+		false,
+	)
+
+	require.NoError(t, bp.AddNode(data.Observer, &data.NodeData{Address: "a"}))
+	require.NoError(t, bp.RemoveNode(data.Observer, "b"))
+	require.NoError(t, bp.DrainNode(data.FullHistoryNode, "c"))
+	require.Equal(t, expectedErr, bp.UndrainNode(data.FullHistoryNode, "d"))
+	require.NoError(t, bp.SetNodesOverlay(data.FullHistoryNode, data.NodesOverlay{Removed: []string{"e"}}))
+	require.Equal(t, []string{"removed"}, bp.GetNodesOverlay(data.Observer).Removed)
+
+	require.Equal(t, map[string]string{"add": "a", "remove": "b"}, calledObservers)
+	require.Equal(t, map[string]string{"drain": "c", "undrain": "d", "set": "e"}, calledFullHistoryNodes)
+}
+
 func TestBaseProcessor_HandleNodesSyncStateShouldSetNodeOutOfSyncIfVMQueriesNotReady(t *testing.T) {
 	numTimesUpdateNodesWasCalled := uint32(0)
 
 	bp, _ := process.NewBaseProcessor(
-		5,
+		createObserversHttpClient(5),
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{
 			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
@@ -587,6 +1084,7 @@ func TestBaseProcessor_HandleNodesSyncStateShouldSetNodeOutOfSyncIfVMQueriesNotR
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		false,
 	)
 
@@ -616,7 +1114,7 @@ func TestBaseProcessor_HandleNodesSyncStateShouldTreatObserverThatWasDown(t *tes
 	numTimesGetStatusWasCalled := uint32(0)
 
 	bp, _ := process.NewBaseProcessor(
-		5,
+		createObserversHttpClient(5),
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{
 			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
@@ -648,6 +1146,7 @@ func TestBaseProcessor_HandleNodesSyncStateShouldTreatObserverThatWasDown(t *tes
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		false,
 	)
 
@@ -690,7 +1189,7 @@ func TestBaseProcessor_HandleNodesSyncStateShouldBeTriggeredEarlierIfANodeIsOffl
 	numTimesGetStatusWasCalled := uint32(0)
 
 	bp, _ := process.NewBaseProcessor(
-		5,
+		createObserversHttpClient(5),
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{
 			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
@@ -710,6 +1209,7 @@ func TestBaseProcessor_HandleNodesSyncStateShouldBeTriggeredEarlierIfANodeIsOffl
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		false,
 	)
 
@@ -744,7 +1244,7 @@ func TestBaseProcessor_HandleNodesSyncStateShouldConsiderNodeAsOnlineIfProbableN
 	numTimesUpdateNodesWasCalled := uint32(0)
 
 	bp, _ := process.NewBaseProcessor(
-		5,
+		createObserversHttpClient(5),
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{
 			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
@@ -759,6 +1259,7 @@ func TestBaseProcessor_HandleNodesSyncStateShouldConsiderNodeAsOnlineIfProbableN
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		false,
 	)
 
@@ -788,9 +1289,11 @@ func TestBaseProcessor_HandleNodesSyncStateShouldConsiderNodeAsOnlineIfProbableN
 func TestBaseProcessor_HandleNodesSyncState(t *testing.T) {
 
 	numTimesUpdateNodesWasCalled := uint32(0)
+	mutSyncStates := sync.Mutex{}
+	syncStates := make(map[string]bool)
 
 	bp, _ := process.NewBaseProcessor(
-		5,
+		createObserversHttpClient(5),
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{
 			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
@@ -819,6 +1322,13 @@ func TestBaseProcessor_HandleNodesSyncState(t *testing.T) {
 			},
 		},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{
+			SetObserverSyncStateCalled: func(address string, _ uint32, isSynced bool) {
+				mutSyncStates.Lock()
+				syncStates[address] = isSynced
+				mutSyncStates.Unlock()
+			},
+		},
 		false,
 	)
 
@@ -845,6 +1355,11 @@ func TestBaseProcessor_HandleNodesSyncState(t *testing.T) {
 
 	require.GreaterOrEqual(t, atomic.LoadUint32(&numTimesUpdateNodesWasCalled), uint32(2))
 
+	mutSyncStates.Lock()
+	expectedSyncStates := map[string]bool{"address0": true, "address1": false, "fhaddress0": true, "fhaddress1": false}
+	require.Equal(t, expectedSyncStates, syncStates)
+	mutSyncStates.Unlock()
+
 	_ = bp.Close()
 	time.Sleep(50 * time.Millisecond)
 }
@@ -853,7 +1368,7 @@ func TestBaseProcessor_NoStatusCheck(t *testing.T) {
 
 	numPrintNodesInShardsCalled := uint32(0)
 	bp, _ := process.NewBaseProcessor(
-		5,
+		createObserversHttpClient(5),
 		&mock.ShardCoordinatorMock{},
 		&mock.ObserversProviderStub{
 			GetAllNodesWithSyncStateCalled: func() []*data.NodeData {
@@ -869,6 +1384,7 @@ func TestBaseProcessor_NoStatusCheck(t *testing.T) {
 		},
 		&mock.ObserversProviderStub{},
 		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
 		true,
 	)
 
@@ -906,3 +1422,37 @@ func getResponseForNodeStatus(synced bool, vmQueriesReadyStr string) *data.NodeS
 
 	return &obj
 }
+
+func TestBaseProcessor_CallRestEndPointShouldForwardTheRequestID(t *testing.T) {
+	t.Parallel()
+
+	mutRequestIDs := sync.Mutex{}
+	requestIDs := make([]string, 0)
+	testServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mutRequestIDs.Lock()
+		requestIDs = append(requestIDs, req.Header.Get(common.RequestIDHeader))
+		mutRequestIDs.Unlock()
+
+		_, _ = rw.Write([]byte("{}"))
+	}))
+	defer testServer.Close()
+
+	bp, _ := process.NewBaseProcessor(
+		createObserversHttpClient(5),
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+		&mock.ObserversMetricsHandlerStub{},
+		false,
+	)
+
+	requestInfo := common.NewRequestInfo("request-id")
+	ctx := common.ContextWithRequestInfo(context.Background(), requestInfo)
+	_, _ = bp.CallGetRestEndPointWithContext(ctx, testServer.URL, "/get", &testStruct{})
+	_, _ = bp.CallPostRestEndPointWithContext(ctx, testServer.URL, "/post", &testStruct{}, &testStruct{})
+	_, _ = bp.CallGetRestEndPoint(testServer.URL, "/get", &testStruct{})
+
+	require.Equal(t, []string{"request-id", "request-id", ""}, requestIDs)
+	require.Equal(t, []string{testServer.URL}, requestInfo.GetObservers())
+}
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -37,22 +38,61 @@ const (
 
 // BlockProcessor handles blocks retrieving
 type BlockProcessor struct {
-	proc Processor
+	proc            Processor
+	responseCacher  ResponseCacheHandler
+	finalityHandler FinalityHandler
+	connector       DatabaseConnectorHandler
 }
 
 // NewBlockProcessor will create a new block processor
-func NewBlockProcessor(proc Processor) (*BlockProcessor, error) {
+func NewBlockProcessor(
+	proc Processor,
+	responseCacher ResponseCacheHandler,
+	finalityHandler FinalityHandler,
+	connector DatabaseConnectorHandler,
+) (*BlockProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
+	if check.IfNil(responseCacher) {
+		return nil, ErrNilResponseCacher
+	}
+	if check.IfNil(finalityHandler) {
+		return nil, ErrNilFinalityHandler
+	}
+	if check.IfNil(connector) {
+		return nil, ErrNilDatabaseConnector
+	}
 
 	return &BlockProcessor{
-		proc: proc,
+		proc:            proc,
+		responseCacher:  responseCacher,
+		finalityHandler: finalityHandler,
+		connector:       connector,
 	}, nil
 }
 
 // GetBlockByHash will return the block based on its hash
-func (bp *BlockProcessor) GetBlockByHash(shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+func (bp *BlockProcessor) GetBlockByHash(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	cacheKey := fmt.Sprintf("block_%d_hash_%s_%+v", shardID, hash, options)
+	cachedResponse := &data.BlockApiResponse{}
+	if bp.responseCacher.Get(cacheKey, cachedResponse) {
+		return cachedResponse, nil
+	}
+
+	response, err := bp.getBlockByHashFromObservers(ctx, shardID, hash, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if bp.finalityHandler.IsBlockFinal(shardID, response.Data.Block.Nonce) {
+		bp.responseCacher.Put(cacheKey, response)
+	}
+
+	return response, nil
+}
+
+func (bp *BlockProcessor) getBlockByHashFromObservers(ctx context.Context, shardID uint32, hash string, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
 	if err != nil {
 		return nil, err
@@ -63,8 +103,12 @@ func (bp *BlockProcessor) GetBlockByHash(shardID uint32, hash string, options co
 	response := data.BlockApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, path, &response)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("block request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
@@ -78,7 +122,26 @@ func (bp *BlockProcessor) GetBlockByHash(shardID uint32, hash string, options co
 }
 
 // GetBlockByNonce will return the block based on the nonce
-func (bp *BlockProcessor) GetBlockByNonce(shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+func (bp *BlockProcessor) GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
+	cacheKey := fmt.Sprintf("block_%d_nonce_%d_%+v", shardID, nonce, options)
+	cachedResponse := &data.BlockApiResponse{}
+	if bp.responseCacher.Get(cacheKey, cachedResponse) {
+		return cachedResponse, nil
+	}
+
+	response, err := bp.getBlockByNonceFromObservers(ctx, shardID, nonce, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if bp.finalityHandler.IsBlockFinal(shardID, nonce) {
+		bp.responseCacher.Put(cacheKey, response)
+	}
+
+	return response, nil
+}
+
+func (bp *BlockProcessor) getBlockByNonceFromObservers(ctx context.Context, shardID uint32, nonce uint64, options common.BlockQueryOptions) (*data.BlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
 	if err != nil {
 		return nil, err
@@ -89,8 +152,12 @@ func (bp *BlockProcessor) GetBlockByNonce(shardID uint32, nonce uint64, options
 	response := data.BlockApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, path, &response)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("block request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
@@ -103,6 +170,16 @@ func (bp *BlockProcessor) GetBlockByNonce(shardID uint32, nonce uint64, options
 	return nil, WrapObserversError(response.Error)
 }
 
+// GetDatabaseBlockByNonce will return the block, along with its transactions, from the database
+func (bp *BlockProcessor) GetDatabaseBlockByNonce(ctx context.Context, shardID uint32, nonce uint64) (*data.DatabaseBlock, error) {
+	return bp.connector.GetBlockByShardIDAndNonce(ctx, shardID, nonce)
+}
+
+// GetTransactionsByMiniblockHash will return the transactions included in the given miniblock from the database
+func (bp *BlockProcessor) GetTransactionsByMiniblockHash(ctx context.Context, hash string) ([]data.DatabaseTransaction, error) {
+	return bp.connector.GetTransactionsByMiniblockHash(ctx, hash)
+}
+
 func (bp *BlockProcessor) getObserversOrFullHistoryNodes(shardID uint32) ([]*data.NodeData, error) {
 	fullHistoryNodes, err := bp.proc.GetFullHistoryNodes(shardID, data.AvailabilityAll)
 	if err == nil {
@@ -113,7 +190,26 @@ func (bp *BlockProcessor) getObserversOrFullHistoryNodes(shardID uint32) ([]*dat
 }
 
 // GetHyperBlockByHash returns the hyperblock by hash
-func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+func (bp *BlockProcessor) GetHyperBlockByHash(ctx context.Context, hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	cacheKey := fmt.Sprintf("hyperblock_hash_%s_%+v", hash, options)
+	cachedResponse := &data.HyperblockApiResponse{}
+	if bp.responseCacher.Get(cacheKey, cachedResponse) {
+		return cachedResponse, nil
+	}
+
+	response, err := bp.buildHyperBlockByHash(ctx, hash, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if bp.finalityHandler.IsHyperblockFinal(response.Data.Hyperblock.Nonce) {
+		bp.responseCacher.Put(cacheKey, response)
+	}
+
+	return response, nil
+}
+
+func (bp *BlockProcessor) buildHyperBlockByHash(ctx context.Context, hash string, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
 	builder := &hyperblockBuilder{}
 
 	blockQueryOptions := common.BlockQueryOptions{
@@ -122,7 +218,7 @@ func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.Hyperb
 		ForHyperblock:    true,
 	}
 
-	metaBlockResponse, err := bp.GetBlockByHash(core.MetachainShardId, hash, blockQueryOptions)
+	metaBlockResponse, err := bp.GetBlockByHash(ctx, core.MetachainShardId, hash, blockQueryOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +226,7 @@ func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.Hyperb
 	metaBlock := metaBlockResponse.Data.Block
 	builder.addMetaBlock(&metaBlock)
 
-	err = bp.addShardBlocks(metaBlock, builder, options, blockQueryOptions)
+	err = bp.addShardBlocks(ctx, metaBlock, builder, options, blockQueryOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -140,18 +236,19 @@ func (bp *BlockProcessor) GetHyperBlockByHash(hash string, options common.Hyperb
 }
 
 func (bp *BlockProcessor) addShardBlocks(
+	ctx context.Context,
 	metaBlock api.Block,
 	builder *hyperblockBuilder,
 	options common.HyperblockQueryOptions,
 	blockQueryOptions common.BlockQueryOptions,
 ) error {
 	for _, notarizedBlock := range metaBlock.NotarizedBlocks {
-		shardBlockResponse, err := bp.GetBlockByHash(notarizedBlock.Shard, notarizedBlock.Hash, blockQueryOptions)
+		shardBlockResponse, err := bp.GetBlockByHash(ctx, notarizedBlock.Shard, notarizedBlock.Hash, blockQueryOptions)
 		if err != nil {
 			return err
 		}
 
-		alteredAccounts, err := bp.getAlteredAccountsIfNeeded(options, notarizedBlock)
+		alteredAccounts, err := bp.getAlteredAccountsIfNeeded(ctx, options, notarizedBlock)
 		if err != nil {
 			return err
 		}
@@ -165,13 +262,13 @@ func (bp *BlockProcessor) addShardBlocks(
 	return nil
 }
 
-func (bp *BlockProcessor) getAlteredAccountsIfNeeded(options common.HyperblockQueryOptions, notarizedBlock *api.NotarizedBlock) ([]*alteredAccount.AlteredAccount, error) {
+func (bp *BlockProcessor) getAlteredAccountsIfNeeded(ctx context.Context, options common.HyperblockQueryOptions, notarizedBlock *api.NotarizedBlock) ([]*alteredAccount.AlteredAccount, error) {
 	ret := make([]*alteredAccount.AlteredAccount, 0)
 	if !options.WithAlteredAccounts {
 		return ret, nil
 	}
 
-	alteredAccountsApiResponse, err := bp.GetAlteredAccountsByHash(notarizedBlock.Shard, notarizedBlock.Hash, options.AlteredAccountsOptions)
+	alteredAccountsApiResponse, err := bp.GetAlteredAccountsByHash(ctx, notarizedBlock.Shard, notarizedBlock.Hash, options.AlteredAccountsOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -180,7 +277,26 @@ func (bp *BlockProcessor) getAlteredAccountsIfNeeded(options common.HyperblockQu
 }
 
 // GetHyperBlockByNonce returns the hyperblock by nonce
-func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+func (bp *BlockProcessor) GetHyperBlockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	cacheKey := fmt.Sprintf("hyperblock_nonce_%d_%+v", nonce, options)
+	cachedResponse := &data.HyperblockApiResponse{}
+	if bp.responseCacher.Get(cacheKey, cachedResponse) {
+		return cachedResponse, nil
+	}
+
+	response, err := bp.buildHyperBlockByNonce(ctx, nonce, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if bp.finalityHandler.IsHyperblockFinal(nonce) {
+		bp.responseCacher.Put(cacheKey, response)
+	}
+
+	return response, nil
+}
+
+func (bp *BlockProcessor) buildHyperBlockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
 	builder := &hyperblockBuilder{}
 
 	blockQueryOptions := common.BlockQueryOptions{
@@ -189,7 +305,7 @@ func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64, options common.Hype
 		ForHyperblock:    true,
 	}
 
-	metaBlockResponse, err := bp.GetBlockByNonce(core.MetachainShardId, nonce, blockQueryOptions)
+	metaBlockResponse, err := bp.GetBlockByNonce(ctx, core.MetachainShardId, nonce, blockQueryOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -197,7 +313,7 @@ func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64, options common.Hype
 	metaBlock := metaBlockResponse.Data.Block
 	builder.addMetaBlock(&metaBlock)
 
-	err = bp.addShardBlocks(metaBlock, builder, options, blockQueryOptions)
+	err = bp.addShardBlocks(ctx, metaBlock, builder, options, blockQueryOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -207,7 +323,7 @@ func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64, options common.Hype
 }
 
 // GetInternalBlockByHash will return the internal block based on its hash
-func (bp *BlockProcessor) GetInternalBlockByHash(shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (bp *BlockProcessor) GetInternalBlockByHash(ctx context.Context, shardID uint32, hash string, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
 	if err != nil {
 		return nil, err
@@ -221,8 +337,12 @@ func (bp *BlockProcessor) GetInternalBlockByHash(shardID uint32, hash string, fo
 	response := data.InternalBlockApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, path, &response)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("internal block request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
@@ -253,7 +373,7 @@ func getInternalBlockByHashPath(shardID uint32, format common.OutputFormat, hash
 }
 
 // GetInternalBlockByNonce will return the internal block based on its nonce
-func (bp *BlockProcessor) GetInternalBlockByNonce(shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (bp *BlockProcessor) GetInternalBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
 	if err != nil {
 		return nil, err
@@ -267,8 +387,12 @@ func (bp *BlockProcessor) GetInternalBlockByNonce(shardID uint32, nonce uint64,
 	response := data.InternalBlockApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, path, &response)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("internal block request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
@@ -299,7 +423,7 @@ func getInternalBlockByNoncePath(shardID uint32, format common.OutputFormat, non
 }
 
 // GetInternalMiniBlockByHash will return the miniblock based on its hash
-func (bp *BlockProcessor) GetInternalMiniBlockByHash(shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
+func (bp *BlockProcessor) GetInternalMiniBlockByHash(ctx context.Context, shardID uint32, hash string, epoch uint32, format common.OutputFormat) (*data.InternalMiniBlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
 	if err != nil {
 		return nil, err
@@ -314,8 +438,12 @@ func (bp *BlockProcessor) GetInternalMiniBlockByHash(shardID uint32, hash string
 	response := data.InternalMiniBlockApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, path, &response)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("miniblock request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
@@ -344,7 +472,7 @@ func getOutputFormat(format common.OutputFormat) (string, error) {
 }
 
 // GetInternalStartOfEpochMetaBlock will return the internal start of epoch meta block based on epoch
-func (bp *BlockProcessor) GetInternalStartOfEpochMetaBlock(epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
+func (bp *BlockProcessor) GetInternalStartOfEpochMetaBlock(ctx context.Context, epoch uint32, format common.OutputFormat) (*data.InternalBlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(core.MetachainShardId)
 	if err != nil {
 		return nil, err
@@ -360,8 +488,12 @@ func (bp *BlockProcessor) GetInternalStartOfEpochMetaBlock(epoch uint32, format
 	response := data.InternalBlockApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, path, &response)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("internal block request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
@@ -375,7 +507,7 @@ func (bp *BlockProcessor) GetInternalStartOfEpochMetaBlock(epoch uint32, format
 }
 
 // GetInternalStartOfEpochValidatorsInfo will return the internal start of epoch validators info based on epoch
-func (bp *BlockProcessor) GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
+func (bp *BlockProcessor) GetInternalStartOfEpochValidatorsInfo(ctx context.Context, epoch uint32) (*data.ValidatorsInfoApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(core.MetachainShardId)
 	if err != nil {
 		return nil, err
@@ -386,8 +518,12 @@ func (bp *BlockProcessor) GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*
 	response := data.ValidatorsInfoApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, path, &response)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("internal validators info request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
@@ -401,7 +537,7 @@ func (bp *BlockProcessor) GetInternalStartOfEpochValidatorsInfo(epoch uint32) (*
 }
 
 // GetAlteredAccountsByNonce will return altered accounts by block nonce
-func (bp *BlockProcessor) GetAlteredAccountsByNonce(shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
+func (bp *BlockProcessor) GetAlteredAccountsByNonce(ctx context.Context, shardID uint32, nonce uint64, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
 	observers, err := bp.proc.GetObservers(shardID, data.AvailabilityAll)
 	if err != nil {
 		return nil, err
@@ -411,8 +547,12 @@ func (bp *BlockProcessor) GetAlteredAccountsByNonce(shardID uint32, nonce uint64
 	response := data.AlteredAccountsApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, path, &response)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("altered accounts request by nonce", "observer", observer.Address, "error", err.Error())
 			continue
 		}
@@ -426,7 +566,7 @@ func (bp *BlockProcessor) GetAlteredAccountsByNonce(shardID uint32, nonce uint64
 }
 
 // GetAlteredAccountsByHash will return altered accounts by block hash
-func (bp *BlockProcessor) GetAlteredAccountsByHash(shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
+func (bp *BlockProcessor) GetAlteredAccountsByHash(ctx context.Context, shardID uint32, hash string, options common.GetAlteredAccountsForBlockOptions) (*data.AlteredAccountsApiResponse, error) {
 	observers, err := bp.proc.GetObservers(shardID, data.AvailabilityAll)
 	if err != nil {
 		return nil, err
@@ -436,8 +576,12 @@ func (bp *BlockProcessor) GetAlteredAccountsByHash(shardID uint32, hash string,
 	response := data.AlteredAccountsApiResponse{}
 	for _, observer := range observers {
 
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
+		_, err := bp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, path, &response)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("altered accounts request by hash", "observer", observer.Address, "hash", hash, "error", err.Error())
 			continue
 		}
@@ -449,3 +593,8 @@ func (bp *BlockProcessor) GetAlteredAccountsByHash(shardID uint32, hash string,
 
 	return nil, WrapObserversError(response.Error)
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bp *BlockProcessor) IsInterfaceNil() bool {
+	return bp == nil
+}
@@ -1,6 +1,7 @@
 package process_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -21,15 +22,31 @@ import (
 func TestNewBlockProcessor_NilProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBlockProcessor(nil)
+	bp, err := process.NewBlockProcessor(nil, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.Nil(t, bp)
 	require.Equal(t, process.ErrNilCoreProcessor, err)
 }
 
+func TestNewBlockProcessor_NilResponseCacherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBlockProcessor(&mock.ProcessorStub{}, nil, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
+	require.Nil(t, bp)
+	require.Equal(t, process.ErrNilResponseCacher, err)
+}
+
+func TestNewBlockProcessor_NilFinalityHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBlockProcessor(&mock.ProcessorStub{}, &mock.ResponseCacherStub{}, nil, &mock.DatabaseConnectorStub{})
+	require.Nil(t, bp)
+	require.Equal(t, process.ErrNilFinalityHandler, err)
+}
+
 func TestNewBlockProcessor_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBlockProcessor(&mock.ProcessorStub{})
+	bp, err := process.NewBlockProcessor(&mock.ProcessorStub{}, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 	require.NoError(t, err)
 }
@@ -51,10 +68,10 @@ func TestBlockProcessor_GetBlockByHashShouldGetFullHistoryNodes(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	_, _ = bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -77,10 +94,10 @@ func TestBlockProcessor_GetBlockByHashShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	_, _ = bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -99,10 +116,10 @@ func TestBlockProcessor_GetBlockByHashNoFullNodesOrObserversShouldErr(t *testing
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -120,10 +137,10 @@ func TestBlockProcessor_GetBlockByHashCallGetFailsShouldErr(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 	require.True(t, errors.Is(err, process.ErrSendingRequest))
 	require.Nil(t, res)
 }
@@ -143,10 +160,10 @@ func TestBlockProcessor_GetBlockByHashShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{})
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -171,10 +188,10 @@ func TestBlockProcessor_GetBlockByHashShouldWorkAndIncludeAlsoTxs(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", common.BlockQueryOptions{WithTransactions: true})
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", common.BlockQueryOptions{WithTransactions: true})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -200,10 +217,10 @@ func TestBlockProcessor_GetBlockByNonceShouldGetFullHistoryNodes(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByNonce(0, 0, common.BlockQueryOptions{})
+	_, _ = bp.GetBlockByNonce(context.Background(), 0, 0, common.BlockQueryOptions{})
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -226,10 +243,10 @@ func TestBlockProcessor_GetBlockByNonceShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByNonce(0, 1, common.BlockQueryOptions{})
+	_, _ = bp.GetBlockByNonce(context.Background(), 0, 1, common.BlockQueryOptions{})
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -248,10 +265,10 @@ func TestBlockProcessor_GetBlockByNonceNoFullNodesOrObserversShouldErr(t *testin
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, 1, common.BlockQueryOptions{})
+	res, err := bp.GetBlockByNonce(context.Background(), 0, 1, common.BlockQueryOptions{})
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -269,10 +286,10 @@ func TestBlockProcessor_GetBlockByNonceCallGetFailsShouldErr(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, 0, common.BlockQueryOptions{})
+	res, err := bp.GetBlockByNonce(context.Background(), 0, 0, common.BlockQueryOptions{})
 	require.True(t, errors.Is(err, process.ErrSendingRequest))
 	require.Nil(t, res)
 }
@@ -292,10 +309,10 @@ func TestBlockProcessor_GetBlockByNonceShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, nonce, common.BlockQueryOptions{})
+	res, err := bp.GetBlockByNonce(context.Background(), 0, nonce, common.BlockQueryOptions{})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -320,10 +337,10 @@ func TestBlockProcessor_GetBlockByNonceShouldWorkAndIncludeAlsoTxs(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, 3, common.BlockQueryOptions{WithTransactions: true})
+	res, err := bp.GetBlockByNonce(context.Background(), 0, 3, common.BlockQueryOptions{WithTransactions: true})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -359,12 +376,12 @@ func TestBlockProcessor_GetHyperBlock(t *testing.T) {
 		},
 	}
 
-	processor, err := process.NewBlockProcessor(proc)
+	processor, err := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.Nil(t, err)
 	require.NotNil(t, processor)
 
 	numGetBlockCalled = 0
-	response, err := processor.GetHyperBlockByHash("abcd", common.HyperblockQueryOptions{})
+	response, err := processor.GetHyperBlockByHash(context.Background(), "abcd", common.HyperblockQueryOptions{})
 	require.Nil(t, err)
 	require.NotNil(t, response)
 	require.Equal(t, 4, numGetBlockCalled, "get block should be called for metablock and for all notarized shard blocks")
@@ -372,7 +389,7 @@ func TestBlockProcessor_GetHyperBlock(t *testing.T) {
 	require.Equal(t, "abcd", response.Data.Hyperblock.Hash)
 
 	numGetBlockCalled = 0
-	response, err = processor.GetHyperBlockByNonce(42, common.HyperblockQueryOptions{})
+	response, err = processor.GetHyperBlockByNonce(context.Background(), 42, common.HyperblockQueryOptions{})
 	require.Nil(t, err)
 	require.NotNil(t, response)
 	require.Equal(t, 4, numGetBlockCalled, "get block should be called for metablock and for all notarized shard blocks")
@@ -391,10 +408,10 @@ func TestBlockProcessor_GetInternalBlockByNonceInvalidOutputFormat_ShouldFail(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	blk, err := bp.GetInternalBlockByNonce(0, 0, 2)
+	blk, err := bp.GetInternalBlockByNonce(context.Background(), 0, 0, 2)
 	require.Nil(t, blk)
 	assert.Equal(t, process.ErrInvalidOutputFormat, err)
 }
@@ -416,10 +433,10 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldGetFullHistoryNodes(t *test
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalBlockByNonce(0, 0, common.Internal)
+	_, _ = bp.GetInternalBlockByNonce(context.Background(), 0, 0, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -442,10 +459,10 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldGetObservers(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalBlockByNonce(0, 1, common.Internal)
+	_, _ = bp.GetInternalBlockByNonce(context.Background(), 0, 1, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -464,10 +481,10 @@ func TestBlockProcessor_GetInternalBlockByNonceNoFullNodesOrObserversShouldErr(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByNonce(0, 1, common.Internal)
+	res, err := bp.GetInternalBlockByNonce(context.Background(), 0, 1, common.Internal)
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -485,10 +502,10 @@ func TestBlockProcessor_GetInternalBlockByNonceCallGetFailsShouldErr(t *testing.
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByNonce(0, 0, common.Internal)
+	res, err := bp.GetInternalBlockByNonce(context.Background(), 0, 0, common.Internal)
 	require.True(t, errors.Is(err, process.ErrSendingRequest))
 	require.Nil(t, res)
 }
@@ -514,15 +531,15 @@ func TestBlockProcessor_GetInternalBlockByNonceShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByNonce(0, nonce, common.Internal)
+	res, err := bp.GetInternalBlockByNonce(context.Background(), 0, nonce, common.Internal)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 
-	res, err = bp.GetInternalBlockByNonce(core.MetachainShardId, nonce, common.Proto)
+	res, err = bp.GetInternalBlockByNonce(context.Background(), core.MetachainShardId, nonce, common.Proto)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
@@ -539,10 +556,10 @@ func TestBlockProcessor_GetInternalBlockByHashInvalidOutputFormat_ShouldFail(t *
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	blk, err := bp.GetInternalBlockByHash(0, "aaaa", 2)
+	blk, err := bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", 2)
 	require.Nil(t, blk)
 	assert.Equal(t, process.ErrInvalidOutputFormat, err)
 }
@@ -564,10 +581,10 @@ func TestBlockProcessor_GetInternalBlockByHashShouldGetFullHistoryNodes(t *testi
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	_, _ = bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -590,10 +607,10 @@ func TestBlockProcessor_GetInternalBlockByHashShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	_, _ = bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -612,10 +629,10 @@ func TestBlockProcessor_GetInternalBlockByHashNoFullNodesOrObserversShouldErr(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	res, err := bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -633,10 +650,10 @@ func TestBlockProcessor_GetInternalBlockByHashCallGetFailsShouldErr(t *testing.T
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	res, err := bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 	require.True(t, errors.Is(err, process.ErrSendingRequest))
 	require.Nil(t, res)
 }
@@ -661,15 +678,15 @@ func TestBlockProcessor_GetInternalBlockByHashShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalBlockByHash(0, "aaaa", common.Internal)
+	res, err := bp.GetInternalBlockByHash(context.Background(), 0, "aaaa", common.Internal)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 
-	res, err = bp.GetInternalBlockByHash(core.MetachainShardId, "aaaa", common.Proto)
+	res, err = bp.GetInternalBlockByHash(context.Background(), core.MetachainShardId, "aaaa", common.Proto)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
@@ -686,10 +703,10 @@ func TestBlockProcessor_GetInternalMiniBlockByHashInvalidOutputFormat_ShouldFail
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	blk, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, 2)
+	blk, err := bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, 2)
 	require.Nil(t, blk)
 	assert.Equal(t, process.ErrInvalidOutputFormat, err)
 }
@@ -711,10 +728,10 @@ func TestBlockProcessor_GetInternalMiniBlockByHashShouldGetFullHistoryNodes(t *t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	_, _ = bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -737,10 +754,10 @@ func TestBlockProcessor_GetInternalMiniBlockByHashShouldGetObservers(t *testing.
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	_, _ = bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -759,10 +776,10 @@ func TestBlockProcessor_GetInternalMiniBlockByHashNoFullNodesOrObserversShouldEr
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	res, err := bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -780,10 +797,10 @@ func TestBlockProcessor_GetInternalMiniBlockByHashCallGetFailsShouldErr(t *testi
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	res, err := bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 	require.True(t, errors.Is(err, process.ErrSendingRequest))
 	require.Nil(t, res)
 }
@@ -808,15 +825,15 @@ func TestBlockProcessor_GetInternalMiniBlockByHashShouldWork(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalMiniBlockByHash(0, "aaaa", 1, common.Internal)
+	res, err := bp.GetInternalMiniBlockByHash(context.Background(), 0, "aaaa", 1, common.Internal)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 
-	res, err = bp.GetInternalMiniBlockByHash(core.MetachainShardId, "aaaa", 1, common.Proto)
+	res, err = bp.GetInternalMiniBlockByHash(context.Background(), core.MetachainShardId, "aaaa", 1, common.Proto)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
@@ -833,10 +850,10 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockInvalidOutputFormat_Shou
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	blk, err := bp.GetInternalStartOfEpochMetaBlock(0, 2)
+	blk, err := bp.GetInternalStartOfEpochMetaBlock(context.Background(), 0, 2)
 	require.Nil(t, blk)
 	assert.Equal(t, process.ErrInvalidOutputFormat, err)
 }
@@ -858,10 +875,10 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldGetFullHistoryNode
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
+	_, _ = bp.GetInternalStartOfEpochMetaBlock(context.Background(), 0, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.False(t, getObserversCalled)
@@ -884,10 +901,10 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldGetObservers(t *te
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
+	_, _ = bp.GetInternalStartOfEpochMetaBlock(context.Background(), 0, common.Internal)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -906,10 +923,10 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockNoFullNodesOrObserversSh
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
+	res, err := bp.GetInternalStartOfEpochMetaBlock(context.Background(), 0, common.Internal)
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -928,10 +945,10 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockCallGetFailsShouldErr(t
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalStartOfEpochMetaBlock(0, common.Internal)
+	res, err := bp.GetInternalStartOfEpochMetaBlock(context.Background(), 0, common.Internal)
 	require.True(t, errors.Is(err, process.ErrSendingRequest))
 	require.Nil(t, res)
 }
@@ -956,15 +973,15 @@ func TestBlockProcessor_GetInternalStartOfEpochMetaBlockShouldWork(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalStartOfEpochMetaBlock(1, common.Internal)
+	res, err := bp.GetInternalStartOfEpochMetaBlock(context.Background(), 1, common.Internal)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 
-	res, err = bp.GetInternalStartOfEpochMetaBlock(1, common.Proto)
+	res, err = bp.GetInternalStartOfEpochMetaBlock(context.Background(), 1, common.Proto)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
@@ -986,8 +1003,8 @@ func TestBlockProcessor_GetAlteredAccountsByNonce(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
-		res, err := bp.GetAlteredAccountsByNonce(requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
+		bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
+		res, err := bp.GetAlteredAccountsByNonce(context.Background(), requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, expectedErr, err)
 		require.Nil(t, res)
 	})
@@ -1015,8 +1032,8 @@ func TestBlockProcessor_GetAlteredAccountsByNonce(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
-		res, err := bp.GetAlteredAccountsByNonce(requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
+		bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
+		res, err := bp.GetAlteredAccountsByNonce(context.Background(), requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, 2, callGetEndpointCt)
 		require.True(t, errors.Is(err, process.ErrSendingRequest))
 		require.Nil(t, res)
@@ -1044,8 +1061,8 @@ func TestBlockProcessor_GetAlteredAccountsByNonce(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
-		res, err := bp.GetAlteredAccountsByNonce(requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
+		bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
+		res, err := bp.GetAlteredAccountsByNonce(context.Background(), requestedShardID, 4, common.GetAlteredAccountsForBlockOptions{})
 		require.Nil(t, err)
 		require.Equal(t, &data.AlteredAccountsApiResponse{
 			Data: data.AlteredAccountsPayload{
@@ -1073,8 +1090,8 @@ func TestBlockProcessor_GetAlteredAccountsByHash(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
-		res, err := bp.GetAlteredAccountsByHash(requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
+		bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
+		res, err := bp.GetAlteredAccountsByHash(context.Background(), requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, expectedErr, err)
 		require.Nil(t, res)
 	})
@@ -1102,8 +1119,8 @@ func TestBlockProcessor_GetAlteredAccountsByHash(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
-		res, err := bp.GetAlteredAccountsByHash(requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
+		bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
+		res, err := bp.GetAlteredAccountsByHash(context.Background(), requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
 		require.Equal(t, 2, callGetEndpointCt)
 		require.True(t, errors.Is(err, process.ErrSendingRequest))
 		require.Nil(t, res)
@@ -1131,8 +1148,8 @@ func TestBlockProcessor_GetAlteredAccountsByHash(t *testing.T) {
 			},
 		}
 
-		bp, _ := process.NewBlockProcessor(proc)
-		res, err := bp.GetAlteredAccountsByHash(requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
+		bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
+		res, err := bp.GetAlteredAccountsByHash(context.Background(), requestedShardID, "hash", common.GetAlteredAccountsForBlockOptions{})
 		require.Nil(t, err)
 		require.Equal(t, &data.AlteredAccountsApiResponse{
 			Data: data.AlteredAccountsPayload{
@@ -1226,9 +1243,9 @@ func TestBlockProcessor_GetHyperBlockByNonceWithAlteredAccounts(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 
-	res, err := bp.GetHyperBlockByNonce(4, common.HyperblockQueryOptions{WithAlteredAccounts: true})
+	res, err := bp.GetHyperBlockByNonce(context.Background(), 4, common.HyperblockQueryOptions{WithAlteredAccounts: true})
 	require.Nil(t, err)
 
 	expectedHyperBlock := api.Hyperblock{
@@ -1343,9 +1360,9 @@ func TestBlockProcessor_GetHyperBlockByHashWithAlteredAccounts(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 
-	res, err := bp.GetHyperBlockByHash("abcdef", common.HyperblockQueryOptions{WithAlteredAccounts: true})
+	res, err := bp.GetHyperBlockByHash(context.Background(), "abcdef", common.HyperblockQueryOptions{WithAlteredAccounts: true})
 	require.Nil(t, err)
 
 	expectedHyperBlock := api.Hyperblock{
@@ -1400,11 +1417,107 @@ func TestBlockProcessor_GetInternalStartOfEpochValidatorsInfo(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(proc)
+	bp, _ := process.NewBlockProcessor(proc, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &mock.DatabaseConnectorStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetInternalStartOfEpochValidatorsInfo(1)
+	res, err := bp.GetInternalStartOfEpochValidatorsInfo(context.Background(), 1)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedData, res.Data)
 }
+
+func TestBlockProcessor_GetBlockByNonceShouldUseResponseCache(t *testing.T) {
+	t.Parallel()
+
+	numObserverCalls := 0
+	proc := &mock.ProcessorStub{
+		GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			numObserverCalls++
+			response := value.(*data.BlockApiResponse)
+			response.Data.Block = api.Block{Nonce: 37, Hash: "hash"}
+			return 200, nil
+		},
+	}
+
+	cachedResponses := make(map[string]*data.BlockApiResponse)
+	responseCacher := &mock.ResponseCacherStub{
+		GetCalled: func(key string, value interface{}) bool {
+			cachedResponse, ok := cachedResponses[key]
+			if ok {
+				*value.(*data.BlockApiResponse) = *cachedResponse
+			}
+			return ok
+		},
+		PutCalled: func(key string, value interface{}) {
+			cachedResponses[key] = value.(*data.BlockApiResponse)
+		},
+	}
+	finalityHandler := &mock.FinalityHandlerStub{
+		IsBlockFinalCalled: func(shardID uint32, nonce uint64) bool {
+			return nonce <= 37
+		},
+	}
+	bp, _ := process.NewBlockProcessor(proc, responseCacher, finalityHandler, &mock.DatabaseConnectorStub{})
+
+	for i := 0; i < 3; i++ {
+		res, err := bp.GetBlockByNonce(context.Background(), 0, 37, common.BlockQueryOptions{WithTransactions: true})
+		require.NoError(t, err)
+		require.Equal(t, "hash", res.Data.Block.Hash)
+	}
+	require.Equal(t, 1, numObserverCalls)
+
+	// different options should not reuse the cached response
+	_, _ = bp.GetBlockByNonce(context.Background(), 0, 37, common.BlockQueryOptions{})
+	require.Equal(t, 2, numObserverCalls)
+
+	// non-final blocks should not be cached
+	for i := 0; i < 2; i++ {
+		_, _ = bp.GetBlockByNonce(context.Background(), 0, 38, common.BlockQueryOptions{})
+	}
+	require.Equal(t, 4, numObserverCalls)
+}
+
+func TestBlockProcessor_GetHyperBlockByNonceShouldCacheOnlyFinalHyperblocks(t *testing.T) {
+	t.Parallel()
+
+	numObserverCalls := 0
+	proc := &mock.ProcessorStub{
+		GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			numObserverCalls++
+			response := value.(*data.BlockApiResponse)
+			response.Data.Block = api.Block{Nonce: 10, Hash: "meta hash"}
+			return 200, nil
+		},
+	}
+
+	numPutCalls := 0
+	responseCacher := &mock.ResponseCacherStub{
+		PutCalled: func(key string, value interface{}) {
+			_, isHyperblock := value.(*data.HyperblockApiResponse)
+			if isHyperblock {
+				numPutCalls++
+			}
+		},
+	}
+	finalityHandler := &mock.FinalityHandlerStub{
+		IsHyperblockFinalCalled: func(nonce uint64) bool {
+			return nonce == 10
+		},
+	}
+	bp, _ := process.NewBlockProcessor(proc, responseCacher, finalityHandler, &mock.DatabaseConnectorStub{})
+
+	_, err := bp.GetHyperBlockByNonce(context.Background(), 10, common.HyperblockQueryOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 1, numPutCalls)
+
+	_, err = bp.GetHyperBlockByNonce(context.Background(), 11, common.HyperblockQueryOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 1, numPutCalls)
+	require.Equal(t, 2, numObserverCalls)
+}
@@ -19,3 +19,15 @@ var ErrNilGenericApiResponseInCache = errors.New("nil generic api response in ca
 
 // ErrNilGenericApiResponseToStoreInCache signals that the provided generic api response is nil
 var ErrNilGenericApiResponseToStoreInCache = errors.New("nil generic api response to store in cache")
+
+// ErrEmptyCacheName signals that an empty cache name has been provided
+var ErrEmptyCacheName = errors.New("empty cache name")
+
+// ErrInvalidCacheSize signals that an invalid cache size has been provided
+var ErrInvalidCacheSize = errors.New("invalid cache size")
+
+// ErrInvalidCacheNumEntries signals that an invalid maximum number of cache entries has been provided
+var ErrInvalidCacheNumEntries = errors.New("invalid maximum number of cache entries")
+
+// ErrNilCacheMetricsHandler signals that a nil cache metrics handler has been provided
+var ErrNilCacheMetricsHandler = errors.New("nil cache metrics handler")
@@ -0,0 +1,7 @@
+package cache
+
+// CacheMetricsHandler defines what a component able to record cache hits and misses should do
+type CacheMetricsHandler interface {
+	AddCacheRequestData(cacheName string, isHit bool)
+	IsInterfaceNil() bool
+}
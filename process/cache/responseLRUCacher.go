@@ -0,0 +1,191 @@
+package cache
+
+import (
+	"container/list"
+	"encoding/json"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("process/cache")
+
+// ArgsResponseLRUCacher holds the arguments needed to create a new responseLRUCacher
+type ArgsResponseLRUCacher struct {
+	Name           string
+	MaxSizeInBytes uint64
+	MaxNumEntries  int
+	MetricsHandler CacheMetricsHandler
+}
+
+type cacheEntry struct {
+	key   string
+	value []byte
+}
+
+// responseLRUCacher is a bounded LRU cache that stores the serialized form of the responses. Both the number of
+// entries and the total number of bytes (keys + values) are capped
+type responseLRUCacher struct {
+	name           string
+	maxSizeInBytes uint64
+	maxNumEntries  int
+	metricsHandler CacheMetricsHandler
+
+	mutCache    sync.Mutex
+	sizeInBytes uint64
+	evictList   *list.List
+	items       map[string]*list.Element
+}
+
+// NewResponseLRUCacher will return a new instance of responseLRUCacher
+func NewResponseLRUCacher(args ArgsResponseLRUCacher) (*responseLRUCacher, error) {
+	if len(args.Name) == 0 {
+		return nil, ErrEmptyCacheName
+	}
+	if args.MaxSizeInBytes == 0 {
+		return nil, ErrInvalidCacheSize
+	}
+	if args.MaxNumEntries <= 0 {
+		return nil, ErrInvalidCacheNumEntries
+	}
+	if check.IfNil(args.MetricsHandler) {
+		return nil, ErrNilCacheMetricsHandler
+	}
+
+	return &responseLRUCacher{
+		name:           args.Name,
+		maxSizeInBytes: args.MaxSizeInBytes,
+		maxNumEntries:  args.MaxNumEntries,
+		metricsHandler: args.MetricsHandler,
+		evictList:      list.New(),
+		items:          make(map[string]*list.Element),
+	}, nil
+}
+
+// Get will try to load the response stored at the given key into the provided value. Returns true on a cache hit
+func (rlc *responseLRUCacher) Get(key string, value interface{}) bool {
+	rlc.mutCache.Lock()
+	element, found := rlc.items[key]
+	var buff []byte
+	if found {
+		rlc.evictList.MoveToFront(element)
+		buff = element.Value.(*cacheEntry).value
+	}
+	rlc.mutCache.Unlock()
+
+	if !found {
+		rlc.metricsHandler.AddCacheRequestData(rlc.name, false)
+		return false
+	}
+
+	err := json.Unmarshal(buff, value)
+	if err != nil {
+		log.Warn("responseLRUCacher.Get: cannot unmarshal cached response", "cache", rlc.name, "key", key, "error", err)
+		rlc.Remove(key)
+		rlc.metricsHandler.AddCacheRequestData(rlc.name, false)
+		return false
+	}
+
+	rlc.metricsHandler.AddCacheRequestData(rlc.name, true)
+	return true
+}
+
+// Put will store the serialized form of the provided value at the given key, evicting the least recently used
+// entries if the capacity is exceeded
+func (rlc *responseLRUCacher) Put(key string, value interface{}) {
+	buff, err := json.Marshal(value)
+	if err != nil {
+		log.Warn("responseLRUCacher.Put: cannot marshal response", "cache", rlc.name, "key", key, "error", err)
+		return
+	}
+
+	entrySize := computeEntrySize(key, buff)
+	if entrySize > rlc.maxSizeInBytes {
+		log.Debug("responseLRUCacher.Put: response too large to be cached", "cache", rlc.name, "key", key, "size", entrySize)
+		return
+	}
+
+	rlc.mutCache.Lock()
+	defer rlc.mutCache.Unlock()
+
+	element, found := rlc.items[key]
+	if found {
+		entry := element.Value.(*cacheEntry)
+		rlc.sizeInBytes -= computeEntrySize(entry.key, entry.value)
+		entry.value = buff
+		rlc.sizeInBytes += entrySize
+		rlc.evictList.MoveToFront(element)
+	} else {
+		element = rlc.evictList.PushFront(&cacheEntry{key: key, value: buff})
+		rlc.items[key] = element
+		rlc.sizeInBytes += entrySize
+	}
+
+	for rlc.sizeInBytes > rlc.maxSizeInBytes || rlc.evictList.Len() > rlc.maxNumEntries {
+		rlc.removeOldestUnprotected()
+	}
+}
+
+// Remove will remove the entry stored at the given key, if existing
+func (rlc *responseLRUCacher) Remove(key string) {
+	rlc.mutCache.Lock()
+	defer rlc.mutCache.Unlock()
+
+	element, found := rlc.items[key]
+	if !found {
+		return
+	}
+
+	rlc.removeElementUnprotected(element)
+}
+
+// Len returns the number of stored entries
+func (rlc *responseLRUCacher) Len() int {
+	rlc.mutCache.Lock()
+	defer rlc.mutCache.Unlock()
+
+	return rlc.evictList.Len()
+}
+
+// SizeInBytes returns the total size of the stored entries
+func (rlc *responseLRUCacher) SizeInBytes() uint64 {
+	rlc.mutCache.Lock()
+	defer rlc.mutCache.Unlock()
+
+	return rlc.sizeInBytes
+}
+
+// Clear will remove all the stored entries
+func (rlc *responseLRUCacher) Clear() {
+	rlc.mutCache.Lock()
+	rlc.evictList.Init()
+	rlc.items = make(map[string]*list.Element)
+	rlc.sizeInBytes = 0
+	rlc.mutCache.Unlock()
+}
+
+func (rlc *responseLRUCacher) removeOldestUnprotected() {
+	element := rlc.evictList.Back()
+	if element == nil {
+		return
+	}
+
+	rlc.removeElementUnprotected(element)
+}
+
+func (rlc *responseLRUCacher) removeElementUnprotected(element *list.Element) {
+	entry := element.Value.(*cacheEntry)
+	rlc.evictList.Remove(element)
+	delete(rlc.items, entry.key)
+	rlc.sizeInBytes -= computeEntrySize(entry.key, entry.value)
+}
+
+func computeEntrySize(key string, value []byte) uint64 {
+	return uint64(len(key) + len(value))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rlc *responseLRUCacher) IsInterfaceNil() bool {
+	return rlc == nil
+}
@@ -0,0 +1,215 @@
+package cache_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/process/cache"
+	"github.com/stretchr/testify/require"
+)
+
+type cacheMetricsHandlerStub struct {
+	mut       sync.Mutex
+	numHits   int
+	numMisses int
+}
+
+func (stub *cacheMetricsHandlerStub) AddCacheRequestData(_ string, isHit bool) {
+	stub.mut.Lock()
+	defer stub.mut.Unlock()
+
+	if isHit {
+		stub.numHits++
+		return
+	}
+	stub.numMisses++
+}
+
+func (stub *cacheMetricsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+type testResponse struct {
+	Value string `json:"value"`
+}
+
+func createMockArgsResponseLRUCacher() cache.ArgsResponseLRUCacher {
+	return cache.ArgsResponseLRUCacher{
+		Name:           "test",
+		MaxSizeInBytes: 1024,
+		MaxNumEntries:  10,
+		MetricsHandler: &cacheMetricsHandlerStub{},
+	}
+}
+
+func TestNewResponseLRUCacher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsResponseLRUCacher()
+		args.Name = ""
+		rlc, err := cache.NewResponseLRUCacher(args)
+		require.Nil(t, rlc)
+		require.Equal(t, cache.ErrEmptyCacheName, err)
+	})
+	t.Run("invalid size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsResponseLRUCacher()
+		args.MaxSizeInBytes = 0
+		rlc, err := cache.NewResponseLRUCacher(args)
+		require.Nil(t, rlc)
+		require.Equal(t, cache.ErrInvalidCacheSize, err)
+	})
+	t.Run("invalid num entries should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsResponseLRUCacher()
+		args.MaxNumEntries = 0
+		rlc, err := cache.NewResponseLRUCacher(args)
+		require.Nil(t, rlc)
+		require.Equal(t, cache.ErrInvalidCacheNumEntries, err)
+	})
+	t.Run("nil metrics handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsResponseLRUCacher()
+		args.MetricsHandler = nil
+		rlc, err := cache.NewResponseLRUCacher(args)
+		require.Nil(t, rlc)
+		require.Equal(t, cache.ErrNilCacheMetricsHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rlc, err := cache.NewResponseLRUCacher(createMockArgsResponseLRUCacher())
+		require.NoError(t, err)
+		require.False(t, check.IfNil(rlc))
+	})
+}
+
+func TestResponseLRUCacher_PutGet(t *testing.T) {
+	t.Parallel()
+
+	metricsHandler := &cacheMetricsHandlerStub{}
+	args := createMockArgsResponseLRUCacher()
+	args.MetricsHandler = metricsHandler
+	rlc, _ := cache.NewResponseLRUCacher(args)
+
+	response := &testResponse{}
+	require.False(t, rlc.Get("key", response))
+
+	rlc.Put("key", &testResponse{Value: "value"})
+	require.True(t, rlc.Get("key", response))
+	require.Equal(t, "value", response.Value)
+	require.Equal(t, 1, rlc.Len())
+	require.Equal(t, 1, metricsHandler.numHits)
+	require.Equal(t, 1, metricsHandler.numMisses)
+
+	rlc.Put("key", &testResponse{Value: "new value"})
+	require.True(t, rlc.Get("key", response))
+	require.Equal(t, "new value", response.Value)
+	require.Equal(t, 1, rlc.Len())
+	require.Equal(t, uint64(len("key")+len(`{"value":"new value"}`)), rlc.SizeInBytes())
+}
+
+func TestResponseLRUCacher_ShouldEvictWhenMaxNumEntriesExceeded(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsResponseLRUCacher()
+	args.MaxNumEntries = 2
+	rlc, _ := cache.NewResponseLRUCacher(args)
+
+	rlc.Put("key0", &testResponse{Value: "value0"})
+	rlc.Put("key1", &testResponse{Value: "value1"})
+
+	// key0 becomes the most recently used one
+	require.True(t, rlc.Get("key0", &testResponse{}))
+
+	rlc.Put("key2", &testResponse{Value: "value2"})
+	require.Equal(t, 2, rlc.Len())
+	require.True(t, rlc.Get("key0", &testResponse{}))
+	require.False(t, rlc.Get("key1", &testResponse{}))
+	require.True(t, rlc.Get("key2", &testResponse{}))
+}
+
+func TestResponseLRUCacher_ShouldEvictWhenMaxSizeExceeded(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsResponseLRUCacher()
+	args.MaxSizeInBytes = 60
+	rlc, _ := cache.NewResponseLRUCacher(args)
+
+	// each entry has 4 bytes for key and 18 bytes for value
+	rlc.Put("key0", &testResponse{Value: "value0"})
+	rlc.Put("key1", &testResponse{Value: "value1"})
+	rlc.Put("key2", &testResponse{Value: "value2"})
+
+	require.Equal(t, 2, rlc.Len())
+	require.Equal(t, uint64(44), rlc.SizeInBytes())
+	require.False(t, rlc.Get("key0", &testResponse{}))
+}
+
+func TestResponseLRUCacher_TooLargeEntryShouldNotBeStored(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsResponseLRUCacher()
+	args.MaxSizeInBytes = 10
+	rlc, _ := cache.NewResponseLRUCacher(args)
+
+	rlc.Put("key", &testResponse{Value: "a value too large"})
+	require.Equal(t, 0, rlc.Len())
+	require.Equal(t, uint64(0), rlc.SizeInBytes())
+}
+
+func TestResponseLRUCacher_RemoveAndClear(t *testing.T) {
+	t.Parallel()
+
+	rlc, _ := cache.NewResponseLRUCacher(createMockArgsResponseLRUCacher())
+	rlc.Put("key0", &testResponse{Value: "value0"})
+	rlc.Put("key1", &testResponse{Value: "value1"})
+
+	rlc.Remove("key0")
+	require.Equal(t, 1, rlc.Len())
+	require.False(t, rlc.Get("key0", &testResponse{}))
+
+	rlc.Clear()
+	require.Equal(t, 0, rlc.Len())
+	require.Equal(t, uint64(0), rlc.SizeInBytes())
+}
+
+func TestResponseLRUCacher_ConcurrentOperationsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		require.Nil(t, r)
+	}()
+
+	args := createMockArgsResponseLRUCacher()
+	args.MaxNumEntries = 5
+	rlc, _ := cache.NewResponseLRUCacher(args)
+
+	numOperations := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numOperations)
+	for i := 0; i < numOperations; i++ {
+		go func(idx int) {
+			key := fmt.Sprintf("key%d", idx%10)
+			switch idx % 3 {
+			case 0:
+				rlc.Put(key, &testResponse{Value: key})
+			case 1:
+				_ = rlc.Get(key, &testResponse{})
+			default:
+				rlc.Remove(key)
+			}
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+}
@@ -9,13 +9,21 @@ import (
 )
 
 func convertObjectToBlock(obj object) (*dataIndexer.Block, string, error) {
-	h1 := obj["hits"].(object)["hits"].([]interface{})
-	if len(h1) == 0 {
+	hits, ok := obj["hits"].(object)
+	if !ok {
+		return nil, "", errCannotFindBlockInDb
+	}
+	h1, ok := hits["hits"].([]interface{})
+	if !ok || len(h1) == 0 {
 		return nil, "", errCannotFindBlockInDb
 	}
-	h2 := h1[0].(object)["_source"]
+	firstHit, ok := h1[0].(object)
+	if !ok {
+		return nil, "", errCannotUnmarshalBlock
+	}
+	h2 := firstHit["_source"]
 
-	h3 := h1[0].(object)["_id"]
+	h3 := firstHit["_id"]
 	blockHash := fmt.Sprint(h3)
 
 	marshalizedBlock, _ := json.Marshal(h2)
@@ -29,27 +37,92 @@ func convertObjectToBlock(obj object) (*dataIndexer.Block, string, error) {
 }
 
 func convertObjectToTransactions(obj object) ([]data.DatabaseTransaction, error) {
-	hits, ok := obj["hits"].(object)
-	if !ok {
-		return nil, errCannotGetTxsFromBody
+	hitsSlice, err := getHits(obj)
+	if err != nil {
+		return nil, err
 	}
 
 	txs := make([]data.DatabaseTransaction, 0)
-	for _, h1 := range hits["hits"].([]interface{}) {
-		h2 := h1.(object)["_source"]
-
-		var tx data.DatabaseTransaction
-		marshalizedTx, _ := json.Marshal(h2)
-		err := json.Unmarshal(marshalizedTx, &tx)
-		if err != nil {
+	for _, h := range hitsSlice {
+		tx, ok := convertHitToTransaction(h)
+		if !ok {
 			continue
 		}
 
-		h3 := h1.(object)["_id"]
-		txHash := fmt.Sprint(h3)
-		tx.Hash = txHash
-		tx.Fee = tx.CalculateFee()
 		txs = append(txs, tx)
 	}
 	return txs, nil
 }
+
+// convertObjectToTransactionsPage converts at most pageSize hits into transactions. If there are more hits than the
+// page size, the sort values of the last converted hit are returned, so that the next page can be requested
+func convertObjectToTransactionsPage(obj object, pageSize int) ([]data.DatabaseTransaction, []interface{}, error) {
+	hitsSlice, err := getHits(obj)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hasMoreHits := len(hitsSlice) > pageSize
+	if hasMoreHits {
+		hitsSlice = hitsSlice[:pageSize]
+	}
+
+	txs := make([]data.DatabaseTransaction, 0, len(hitsSlice))
+	for _, h := range hitsSlice {
+		tx, ok := convertHitToTransaction(h)
+		if !ok {
+			continue
+		}
+
+		txs = append(txs, tx)
+	}
+	if !hasMoreHits || len(hitsSlice) == 0 {
+		return txs, nil, nil
+	}
+
+	lastHit, ok := hitsSlice[len(hitsSlice)-1].(object)
+	if !ok {
+		return nil, nil, errCannotGetTxsFromBody
+	}
+	sortValues, ok := lastHit["sort"].([]interface{})
+	if !ok || len(sortValues) == 0 {
+		return nil, nil, errMissingSortValues
+	}
+
+	return txs, sortValues, nil
+}
+
+func getHits(obj object) ([]interface{}, error) {
+	hits, ok := obj["hits"].(object)
+	if !ok {
+		return nil, errCannotGetTxsFromBody
+	}
+
+	hitsSlice, ok := hits["hits"].([]interface{})
+	if !ok {
+		return nil, errCannotGetTxsFromBody
+	}
+
+	return hitsSlice, nil
+}
+
+func convertHitToTransaction(hit interface{}) (data.DatabaseTransaction, bool) {
+	h1, isObject := hit.(object)
+	if !isObject {
+		return data.DatabaseTransaction{}, false
+	}
+	h2 := h1["_source"]
+
+	var tx data.DatabaseTransaction
+	marshalizedTx, _ := json.Marshal(h2)
+	err := json.Unmarshal(marshalizedTx, &tx)
+	if err != nil {
+		return data.DatabaseTransaction{}, false
+	}
+
+	h3 := h1["_id"]
+	tx.Hash = fmt.Sprint(h3)
+	tx.Fee = tx.CalculateFee()
+
+	return tx, true
+}
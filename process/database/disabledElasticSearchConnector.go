@@ -0,0 +1,35 @@
+package database
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type disabledElasticSearchConnector struct {
+}
+
+// NewDisabledElasticSearchConnector will create a new instance of disabledElasticSearchConnector
+func NewDisabledElasticSearchConnector() *disabledElasticSearchConnector {
+	return &disabledElasticSearchConnector{}
+}
+
+// GetTransactionsByAddress returns an error as this is a disabled component
+func (desc *disabledElasticSearchConnector) GetTransactionsByAddress(_ context.Context, _ string, _ common.TransactionsHistoryOptions) (*data.DatabaseTransactionsPage, error) {
+	return nil, ErrDatabaseConnectorDisabled
+}
+
+// GetTransactionsByMiniblockHash returns an error as this is a disabled component
+func (desc *disabledElasticSearchConnector) GetTransactionsByMiniblockHash(_ context.Context, _ string) ([]data.DatabaseTransaction, error) {
+	return nil, ErrDatabaseConnectorDisabled
+}
+
+// GetBlockByShardIDAndNonce returns an error as this is a disabled component
+func (desc *disabledElasticSearchConnector) GetBlockByShardIDAndNonce(_ context.Context, _ uint32, _ uint64) (*data.DatabaseBlock, error) {
+	return nil, ErrDatabaseConnectorDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (desc *disabledElasticSearchConnector) IsInterfaceNil() bool {
+	return desc == nil
+}
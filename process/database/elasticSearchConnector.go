@@ -0,0 +1,231 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("process/database")
+
+const (
+	blocksIndex       = "blocks"
+	transactionsIndex = "transactions"
+
+	maxNumTransactionsPerMb = 10000
+	// maxNumTransactionsPerBlock is the default maximum number of hits that can be returned by a search request
+	maxNumTransactionsPerBlock = 10000
+)
+
+// ArgsElasticSearchConnector holds the arguments needed to create a new elasticSearchConnector
+type ArgsElasticSearchConnector struct {
+	URL            string
+	Username       string
+	Password       string
+	RequestTimeout time.Duration
+}
+
+type elasticSearchConnector struct {
+	url        string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+// NewElasticSearchConnector will create a new instance of elasticSearchConnector
+func NewElasticSearchConnector(args ArgsElasticSearchConnector) (*elasticSearchConnector, error) {
+	if len(args.URL) == 0 {
+		return nil, ErrEmptyDatabaseURL
+	}
+	if args.RequestTimeout <= 0 {
+		return nil, ErrInvalidRequestTimeout
+	}
+
+	return &elasticSearchConnector{
+		url:      strings.TrimSuffix(args.URL, "/"),
+		username: args.Username,
+		password: args.Password,
+		httpClient: &http.Client{
+			Timeout: args.RequestTimeout,
+		},
+	}, nil
+}
+
+// GetTransactionsByAddress gets a page of the transactions sent or received by the provided address, newest first,
+// matching the provided filters. The page following the one identified by the options cursor is returned
+func (esc *elasticSearchConnector) GetTransactionsByAddress(ctx context.Context, address string, options common.TransactionsHistoryOptions) (*data.DatabaseTransactionsPage, error) {
+	pageSize := int(options.Size)
+	if pageSize == 0 {
+		pageSize = common.DefaultTransactionsHistoryPageSize
+	}
+	if pageSize > common.MaxTransactionsHistoryPageSize {
+		pageSize = common.MaxTransactionsHistoryPageSize
+	}
+
+	var searchAfter []interface{}
+	if len(options.Cursor) > 0 {
+		var err error
+		searchAfter, err = common.DecodeCursor(options.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if len(searchAfter) != numTxsByAddrSortValues {
+			return nil, fmt.Errorf("%w: expected %d sort values, got %d", common.ErrInvalidCursor, numTxsByAddrSortValues, len(searchAfter))
+		}
+	}
+
+	// one more transaction is requested so that we know if there is a next page or not
+	query := txsByAddrQuery(address, options, pageSize+1, searchAfter)
+	decodedBody, err := esc.doSearchRequest(ctx, transactionsIndex, query)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, lastSortValues, err := convertObjectToTransactionsPage(decodedBody, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	nextCursor := ""
+	if len(lastSortValues) > 0 {
+		nextCursor, err = common.EncodeCursor(lastSortValues)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &data.DatabaseTransactionsPage{
+		Transactions: txs,
+		NextCursor:   nextCursor,
+	}, nil
+}
+
+// GetTransactionsByMiniblockHash gets all the transactions included in the miniblock with the provided hash
+func (esc *elasticSearchConnector) GetTransactionsByMiniblockHash(ctx context.Context, hash string) ([]data.DatabaseTransaction, error) {
+	query := txsByMiniblockHashQuery(hash)
+	query["size"] = maxNumTransactionsPerMb
+
+	decodedBody, err := esc.doSearchRequest(ctx, transactionsIndex, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertObjectToTransactions(decodedBody)
+}
+
+// GetBlockByShardIDAndNonce gets the block with the provided shard ID and nonce along with all its transactions
+func (esc *elasticSearchConnector) GetBlockByShardIDAndNonce(ctx context.Context, shardID uint32, nonce uint64) (*data.DatabaseBlock, error) {
+	decodedBody, err := esc.doSearchRequest(ctx, blocksIndex, blockByNonceAndShardIDQuery(nonce, shardID))
+	if err != nil {
+		return nil, err
+	}
+
+	block, blockHash, err := convertObjectToBlock(decodedBody)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := esc.getTransactionsByMiniblocksHashes(ctx, block.MiniBlocksHashes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.DatabaseBlock{
+		Hash:         blockHash,
+		Block:        *block,
+		Transactions: txs,
+	}, nil
+}
+
+// getTransactionsByMiniblocksHashes gets the transactions of all the provided miniblocks with a single request. The
+// transactions are grouped in the order of the miniblocks
+func (esc *elasticSearchConnector) getTransactionsByMiniblocksHashes(ctx context.Context, hashes []string) ([]data.DatabaseTransaction, error) {
+	if len(hashes) == 0 {
+		return make([]data.DatabaseTransaction, 0), nil
+	}
+
+	query := txsByMiniblocksHashesQuery(hashes)
+	query["size"] = maxNumTransactionsPerBlock
+
+	decodedBody, err := esc.doSearchRequest(ctx, transactionsIndex, query)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := convertObjectToTransactions(decodedBody)
+	if err != nil {
+		return nil, err
+	}
+
+	txsByMiniblock := make(map[string][]data.DatabaseTransaction, len(hashes))
+	for _, tx := range txs {
+		txsByMiniblock[tx.MBHash] = append(txsByMiniblock[tx.MBHash], tx)
+	}
+
+	sortedTxs := make([]data.DatabaseTransaction, 0, len(txs))
+	for _, hash := range hashes {
+		sortedTxs = append(sortedTxs, txsByMiniblock[hash]...)
+	}
+
+	return sortedTxs, nil
+}
+
+func (esc *elasticSearchConnector) doSearchRequest(ctx context.Context, index string, query object) (object, error) {
+	buff, err := encodeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/%s/_search", esc.url, index)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, &buff)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if len(esc.username) > 0 {
+		req.SetBasicAuth(esc.username, esc.password)
+	}
+
+	resp, err := esc.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		errClose := resp.Body.Close()
+		if errClose != nil {
+			log.Warn("elasticSearchConnector: cannot close response body", "error", errClose)
+		}
+	}()
+
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w, status code: %d, response: %s", errDatabaseRequestFailed, resp.StatusCode, string(bytes.TrimSpace(responseBody)))
+	}
+
+	decodedBody := make(object)
+	err = json.Unmarshal(responseBody, &decodedBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodedBody, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (esc *elasticSearchConnector) IsInterfaceNil() bool {
+	return esc == nil
+}
@@ -0,0 +1,411 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/stretchr/testify/require"
+)
+
+type searchRequest struct {
+	index string
+	query object
+}
+
+func createStubElasticSearchServer(t *testing.T, responder func(req searchRequest) (int, object)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.True(t, strings.HasSuffix(r.URL.Path, "/_search"))
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		query := make(object)
+		err = json.Unmarshal(body, &query)
+		require.NoError(t, err)
+
+		index := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/"), "/_search")
+		statusCode, response := responder(searchRequest{index: index, query: query})
+
+		w.WriteHeader(statusCode)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+}
+
+func createSearchResponse(hits ...object) object {
+	hitsSlice := make([]interface{}, 0, len(hits))
+	for _, hit := range hits {
+		hitsSlice = append(hitsSlice, hit)
+	}
+
+	return object{
+		"hits": object{
+			"hits": hitsSlice,
+		},
+	}
+}
+
+func createArgs(url string) ArgsElasticSearchConnector {
+	return ArgsElasticSearchConnector{
+		URL:            url,
+		RequestTimeout: time.Second,
+	}
+}
+
+func TestNewElasticSearchConnector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty url should error", func(t *testing.T) {
+		t.Parallel()
+
+		esc, err := NewElasticSearchConnector(createArgs(""))
+		require.Nil(t, esc)
+		require.Equal(t, ErrEmptyDatabaseURL, err)
+	})
+	t.Run("invalid request timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs("http://localhost:9200")
+		args.RequestTimeout = 0
+		esc, err := NewElasticSearchConnector(args)
+		require.Nil(t, esc)
+		require.Equal(t, ErrInvalidRequestTimeout, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		esc, err := NewElasticSearchConnector(createArgs("http://localhost:9200"))
+		require.NoError(t, err)
+		require.False(t, check.IfNil(esc))
+	})
+}
+
+func createTransactionHit(hash string, timestamp uint64, nonce uint64) object {
+	return object{
+		"_id": hash,
+		"_source": object{
+			"sender":    "erd1sender",
+			"receiver":  "erd1receiver",
+			"nonce":     nonce,
+			"timestamp": timestamp,
+			"gasPrice":  1000000000,
+			"gasUsed":   50000,
+		},
+		"sort": []interface{}{timestamp, nonce, "erd1sender", hash},
+	}
+}
+
+// isTransactionHitAfter emulates the search_after of the transactions by address query, for the hits of the same sender
+func isTransactionHitAfter(hit object, searchAfter []interface{}) bool {
+	sortValues := hit["sort"].([]interface{})
+	timestamp, nonce, hash := float64(sortValues[0].(uint64)), float64(sortValues[1].(uint64)), sortValues[3].(string)
+	afterTimestamp, afterNonce, afterHash := searchAfter[0].(float64), searchAfter[1].(float64), searchAfter[3].(string)
+
+	if timestamp != afterTimestamp {
+		return timestamp < afterTimestamp
+	}
+	if nonce != afterNonce {
+		return nonce < afterNonce
+	}
+
+	return hash > afterHash
+}
+
+func TestElasticSearchConnector_GetTransactionsByAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid cursor should error", func(t *testing.T) {
+		t.Parallel()
+
+		esc, _ := NewElasticSearchConnector(createArgs("http://localhost:9200"))
+		page, err := esc.GetTransactionsByAddress(context.Background(), "erd1sender", common.TransactionsHistoryOptions{Cursor: "invalid cursor"})
+		require.True(t, errors.Is(err, common.ErrInvalidCursor))
+		require.Nil(t, page)
+	})
+	t.Run("last page should not return a cursor", func(t *testing.T) {
+		t.Parallel()
+
+		server := createStubElasticSearchServer(t, func(req searchRequest) (int, object) {
+			require.Equal(t, transactionsIndex, req.index)
+			require.Equal(t, float64(common.DefaultTransactionsHistoryPageSize+1), req.query["size"])
+			require.Nil(t, req.query["search_after"])
+
+			return http.StatusOK, createSearchResponse(createTransactionHit("hash0", 1700000000, 7))
+		})
+		defer server.Close()
+
+		esc, _ := NewElasticSearchConnector(createArgs(server.URL))
+		page, err := esc.GetTransactionsByAddress(context.Background(), "erd1sender", common.TransactionsHistoryOptions{})
+		require.NoError(t, err)
+		require.Len(t, page.Transactions, 1)
+		require.Equal(t, "hash0", page.Transactions[0].Hash)
+		require.Equal(t, "erd1receiver", page.Transactions[0].Receiver)
+		require.Equal(t, uint64(7), page.Transactions[0].Nonce)
+		require.Equal(t, "50000000000000", page.Transactions[0].Fee)
+		require.Empty(t, page.NextCursor)
+	})
+	t.Run("should paginate using the returned cursor", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := 0
+		server := createStubElasticSearchServer(t, func(req searchRequest) (int, object) {
+			numRequests++
+			require.Equal(t, float64(3), req.query["size"])
+
+			if numRequests == 1 {
+				require.Nil(t, req.query["search_after"])
+				return http.StatusOK, createSearchResponse(
+					createTransactionHit("hash0", 1700000002, 9),
+					createTransactionHit("hash1", 1700000001, 8),
+					createTransactionHit("hash2", 1700000000, 7),
+				)
+			}
+
+			require.Equal(t, []interface{}{float64(1700000001), float64(8), "erd1sender", "hash1"}, req.query["search_after"])
+			return http.StatusOK, createSearchResponse(createTransactionHit("hash2", 1700000000, 7))
+		})
+		defer server.Close()
+
+		esc, _ := NewElasticSearchConnector(createArgs(server.URL))
+		options := common.TransactionsHistoryOptions{Size: 2}
+		page, err := esc.GetTransactionsByAddress(context.Background(), "erd1sender", options)
+		require.NoError(t, err)
+		require.Len(t, page.Transactions, 2)
+		require.Equal(t, "hash0", page.Transactions[0].Hash)
+		require.Equal(t, "hash1", page.Transactions[1].Hash)
+		require.NotEmpty(t, page.NextCursor)
+
+		options.Cursor = page.NextCursor
+		page, err = esc.GetTransactionsByAddress(context.Background(), "erd1sender", options)
+		require.NoError(t, err)
+		require.Len(t, page.Transactions, 1)
+		require.Equal(t, "hash2", page.Transactions[0].Hash)
+		require.Empty(t, page.NextCursor)
+		require.Equal(t, 2, numRequests)
+	})
+	t.Run("cursor with missing sort values should error", func(t *testing.T) {
+		t.Parallel()
+
+		cursor, _ := common.EncodeCursor([]interface{}{1700000001, 8, "erd1sender"})
+		esc, _ := NewElasticSearchConnector(createArgs("http://localhost:9200"))
+		page, err := esc.GetTransactionsByAddress(context.Background(), "erd1sender", common.TransactionsHistoryOptions{Cursor: cursor})
+		require.True(t, errors.Is(err, common.ErrInvalidCursor))
+		require.Nil(t, page)
+	})
+	t.Run("tied transactions crossing a page boundary should not be skipped or repeated", func(t *testing.T) {
+		t.Parallel()
+
+		// all the hits are sorted as returned by the database and have the same timestamp, nonce and sender, apart
+		// from the last one
+		hits := []object{
+			createTransactionHit("hash0", 1700000001, 8),
+			createTransactionHit("hash1", 1700000001, 8),
+			createTransactionHit("hash2", 1700000001, 8),
+			createTransactionHit("hash3", 1700000001, 8),
+			createTransactionHit("hash4", 1700000000, 7),
+		}
+		server := createStubElasticSearchServer(t, func(req searchRequest) (int, object) {
+			size := int(req.query["size"].(float64))
+			searchAfter, _ := req.query["search_after"].([]interface{})
+
+			pageHits := make([]object, 0, size)
+			for _, hit := range hits {
+				if len(pageHits) == size {
+					break
+				}
+				if searchAfter == nil || isTransactionHitAfter(hit, searchAfter) {
+					pageHits = append(pageHits, hit)
+				}
+			}
+
+			return http.StatusOK, createSearchResponse(pageHits...)
+		})
+		defer server.Close()
+
+		esc, _ := NewElasticSearchConnector(createArgs(server.URL))
+		options := common.TransactionsHistoryOptions{Size: 2}
+		hashes := make([]string, 0, len(hits))
+		for numPages := 0; numPages < len(hits); numPages++ {
+			page, err := esc.GetTransactionsByAddress(context.Background(), "erd1sender", options)
+			require.NoError(t, err)
+			for _, tx := range page.Transactions {
+				hashes = append(hashes, tx.Hash)
+			}
+
+			if len(page.NextCursor) == 0 {
+				break
+			}
+			options.Cursor = page.NextCursor
+		}
+
+		require.Equal(t, []string{"hash0", "hash1", "hash2", "hash3", "hash4"}, hashes)
+	})
+	t.Run("hits without sort values should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := createStubElasticSearchServer(t, func(req searchRequest) (int, object) {
+			hit0 := createTransactionHit("hash0", 1700000001, 8)
+			hit1 := createTransactionHit("hash1", 1700000000, 7)
+			delete(hit0, "sort")
+
+			return http.StatusOK, createSearchResponse(hit0, hit1)
+		})
+		defer server.Close()
+
+		esc, _ := NewElasticSearchConnector(createArgs(server.URL))
+		page, err := esc.GetTransactionsByAddress(context.Background(), "erd1sender", common.TransactionsHistoryOptions{Size: 1})
+		require.Equal(t, errMissingSortValues, err)
+		require.Nil(t, page)
+	})
+}
+
+func TestElasticSearchConnector_GetBlockByShardIDAndNonce(t *testing.T) {
+	t.Parallel()
+
+	numTxsRequests := 0
+	server := createStubElasticSearchServer(t, func(req searchRequest) (int, object) {
+		switch req.index {
+		case blocksIndex:
+			return http.StatusOK, createSearchResponse(
+				object{
+					"_id": "blockHash",
+					"_source": object{
+						"nonce":            37,
+						"shardId":          1,
+						"miniBlocksHashes": []interface{}{"mb0", "mb1"},
+					},
+				},
+			)
+		case transactionsIndex:
+			numTxsRequests++
+			mbHashes := req.query["query"].(object)["terms"].(object)["miniBlockHash"].([]interface{})
+			require.Equal(t, []interface{}{"mb0", "mb1"}, mbHashes)
+			// the hits are not sorted by the miniblocks
+			return http.StatusOK, createSearchResponse(
+				object{
+					"_id": "tx_mb1",
+					"_source": object{
+						"miniBlockHash": "mb1",
+					},
+				},
+				object{
+					"_id": "tx_mb0",
+					"_source": object{
+						"miniBlockHash": "mb0",
+					},
+				},
+			)
+		default:
+			return http.StatusNotFound, object{}
+		}
+	})
+	defer server.Close()
+
+	esc, _ := NewElasticSearchConnector(createArgs(server.URL))
+	block, err := esc.GetBlockByShardIDAndNonce(context.Background(), 1, 37)
+	require.NoError(t, err)
+	require.Equal(t, "blockHash", block.Hash)
+	require.Equal(t, uint64(37), block.Nonce)
+	require.Equal(t, uint32(1), block.ShardID)
+	require.Len(t, block.Transactions, 2)
+	require.Equal(t, "tx_mb0", block.Transactions[0].Hash)
+	require.Equal(t, "tx_mb1", block.Transactions[1].Hash)
+	require.Equal(t, 1, numTxsRequests)
+}
+
+func TestElasticSearchConnector_GetBlockByShardIDAndNonceNotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	server := createStubElasticSearchServer(t, func(req searchRequest) (int, object) {
+		return http.StatusOK, createSearchResponse()
+	})
+	defer server.Close()
+
+	esc, _ := NewElasticSearchConnector(createArgs(server.URL))
+	block, err := esc.GetBlockByShardIDAndNonce(context.Background(), 0, 1)
+	require.Nil(t, block)
+	require.Equal(t, errCannotFindBlockInDb, err)
+}
+
+func TestElasticSearchConnector_RequestFailedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	server := createStubElasticSearchServer(t, func(req searchRequest) (int, object) {
+		return http.StatusNotFound, object{"error": "index_not_found_exception"}
+	})
+	defer server.Close()
+
+	esc, _ := NewElasticSearchConnector(createArgs(server.URL))
+	txs, err := esc.GetTransactionsByMiniblockHash(context.Background(), "mb")
+	require.Nil(t, txs)
+	require.ErrorIs(t, err, errDatabaseRequestFailed)
+	require.Contains(t, err.Error(), "index_not_found_exception")
+}
+
+func TestElasticSearchConnector_ShouldUseBasicAuth(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, "user", username)
+		require.Equal(t, "pass", password)
+
+		_ = json.NewEncoder(w).Encode(createSearchResponse())
+	}))
+	defer server.Close()
+
+	args := createArgs(server.URL)
+	args.Username = "user"
+	args.Password = "pass"
+	esc, _ := NewElasticSearchConnector(args)
+	txs, err := esc.GetTransactionsByMiniblockHash(context.Background(), "mb")
+	require.NoError(t, err)
+	require.Empty(t, txs)
+}
+
+func TestDisabledElasticSearchConnector(t *testing.T) {
+	t.Parallel()
+
+	desc := NewDisabledElasticSearchConnector()
+	require.False(t, check.IfNil(desc))
+
+	page, err := desc.GetTransactionsByAddress(context.Background(), "addr", common.TransactionsHistoryOptions{})
+	require.Nil(t, page)
+	require.Equal(t, ErrDatabaseConnectorDisabled, err)
+
+	txs, err := desc.GetTransactionsByMiniblockHash(context.Background(), "hash")
+	require.Nil(t, txs)
+	require.Equal(t, ErrDatabaseConnectorDisabled, err)
+
+	block, err := desc.GetBlockByShardIDAndNonce(context.Background(), 0, 0)
+	require.Nil(t, block)
+	require.Equal(t, ErrDatabaseConnectorDisabled, err)
+}
+
+func TestElasticSearchConnector_CanceledContextShouldNotSendTheRequest(t *testing.T) {
+	t.Parallel()
+
+	server := createStubElasticSearchServer(t, func(req searchRequest) (int, object) {
+		require.Fail(t, "should have not sent the request")
+		return http.StatusOK, createSearchResponse()
+	})
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	esc, _ := NewElasticSearchConnector(createArgs(server.URL))
+	txs, err := esc.GetTransactionsByMiniblockHash(ctx, "mb")
+	require.Nil(t, txs)
+	require.True(t, errors.Is(err, context.Canceled))
+}
@@ -5,3 +5,15 @@ import "errors"
 var errCannotFindBlockInDb = errors.New("cannot find blocks in database")
 var errCannotUnmarshalBlock = errors.New("cannot unmarshal block")
 var errCannotGetTxsFromBody = errors.New("cannot get transactions from decoded body")
+var errMissingSortValues = errors.New("missing sort values in database response")
+
+// ErrDatabaseConnectorDisabled signals that the database connector is disabled
+var ErrDatabaseConnectorDisabled = errors.New("database connector is disabled")
+
+// ErrEmptyDatabaseURL signals that an empty database URL has been provided
+var ErrEmptyDatabaseURL = errors.New("empty database URL")
+
+// ErrInvalidRequestTimeout signals that an invalid request timeout has been provided
+var ErrInvalidRequestTimeout = errors.New("invalid request timeout")
+
+var errDatabaseRequestFailed = errors.New("database request failed")
@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 )
 
 type object = map[string]interface{}
@@ -57,3 +60,86 @@ func txsByMiniblockHashQuery(hash string) object {
 		},
 	}
 }
+
+func txsByMiniblocksHashesQuery(hashes []string) object {
+	return object{
+		"query": object{
+			"terms": object{
+				"miniBlockHash": hashes,
+			},
+		},
+	}
+}
+
+// numTxsByAddrSortValues is the number of sort values of the transactions by address query, held by each cursor
+const numTxsByAddrSortValues = 4
+
+func txsByAddrQuery(addr string, options common.TransactionsHistoryOptions, size int, searchAfter []interface{}) object {
+	filters := make([]interface{}, 0)
+	if len(options.Sender) > 0 {
+		filters = append(filters, termQuery("sender", options.Sender))
+	}
+	if len(options.Receiver) > 0 {
+		filters = append(filters, termQuery("receiver", options.Receiver))
+	}
+	if len(options.Token) > 0 {
+		filters = append(filters, termQuery("tokens", options.Token))
+	}
+	if options.FromTimestamp.HasValue || options.ToTimestamp.HasValue {
+		filters = append(filters, rangeQuery("timestamp", options.FromTimestamp, options.ToTimestamp))
+	}
+	if options.FromNonce.HasValue || options.ToNonce.HasValue {
+		filters = append(filters, rangeQuery("nonce", options.FromNonce, options.ToNonce))
+	}
+
+	query := object{
+		"query": object{
+			"bool": object{
+				"should": []interface{}{
+					termQuery("sender", addr),
+					termQuery("receiver", addr),
+				},
+				"minimum_should_match": 1,
+				"filter":               filters,
+			},
+		},
+		// the transaction hash, which is the document id, is the last and unique tie-breaker so that the order is
+		// total and search_after does not skip or repeat transactions with the same timestamp, nonce and sender
+		"sort": []interface{}{
+			object{"timestamp": object{"order": "desc"}},
+			object{"nonce": object{"order": "desc"}},
+			object{"sender": object{"order": "asc"}},
+			object{"_id": object{"order": "asc"}},
+		},
+		"size": size,
+	}
+	if len(searchAfter) > 0 {
+		query["search_after"] = searchAfter
+	}
+
+	return query
+}
+
+func termQuery(field string, value string) object {
+	return object{
+		"term": object{
+			field: value,
+		},
+	}
+}
+
+func rangeQuery(field string, from core.OptionalUint64, to core.OptionalUint64) object {
+	bounds := object{}
+	if from.HasValue {
+		bounds["gte"] = from.Value
+	}
+	if to.HasValue {
+		bounds["lte"] = to.Value
+	}
+
+	return object{
+		"range": object{
+			field: bounds,
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxsByAddrQuery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no filters", func(t *testing.T) {
+		t.Parallel()
+
+		query := txsByAddrQuery("erd1addr", common.TransactionsHistoryOptions{}, 21, nil)
+
+		boolQuery := query["query"].(object)["bool"].(object)
+		require.Equal(t, []interface{}{termQuery("sender", "erd1addr"), termQuery("receiver", "erd1addr")}, boolQuery["should"])
+		require.Equal(t, 1, boolQuery["minimum_should_match"])
+		require.Empty(t, boolQuery["filter"])
+		require.Equal(t, 21, query["size"])
+		require.Len(t, query["sort"], numTxsByAddrSortValues)
+		_, hasSearchAfter := query["search_after"]
+		require.False(t, hasSearchAfter)
+	})
+	t.Run("all filters", func(t *testing.T) {
+		t.Parallel()
+
+		options := common.TransactionsHistoryOptions{
+			Sender:        "erd1sender",
+			Receiver:      "erd1receiver",
+			Token:         "TKN-abcdef",
+			FromTimestamp: core.OptionalUint64{Value: 100, HasValue: true},
+			ToTimestamp:   core.OptionalUint64{Value: 200, HasValue: true},
+			ToNonce:       core.OptionalUint64{Value: 5, HasValue: true},
+		}
+		searchAfter := []interface{}{150, 4, "erd1sender", "hash"}
+		query := txsByAddrQuery("erd1addr", options, 11, searchAfter)
+
+		expectedFilters := []interface{}{
+			termQuery("sender", "erd1sender"),
+			termQuery("receiver", "erd1receiver"),
+			termQuery("tokens", "TKN-abcdef"),
+			object{"range": object{"timestamp": object{"gte": uint64(100), "lte": uint64(200)}}},
+			object{"range": object{"nonce": object{"lte": uint64(5)}}},
+		}
+		boolQuery := query["query"].(object)["bool"].(object)
+		require.Equal(t, expectedFilters, boolQuery["filter"])
+		require.Equal(t, searchAfter, query["search_after"])
+	})
+}
@@ -0,0 +1,6 @@
+package disabled
+
+import "errors"
+
+// ErrHyperblockStreamDisabled signals that the hyperblock stream is disabled
+var ErrHyperblockStreamDisabled = errors.New("hyperblock stream is disabled")
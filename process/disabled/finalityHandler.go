@@ -0,0 +1,20 @@
+package disabled
+
+// FinalityHandler represents a disabled struct that implements the FinalityHandler interface
+type FinalityHandler struct {
+}
+
+// IsBlockFinal returns false as this is a disabled component
+func (fh *FinalityHandler) IsBlockFinal(_ uint32, _ uint64) bool {
+	return false
+}
+
+// IsHyperblockFinal returns false as this is a disabled component
+func (fh *FinalityHandler) IsHyperblockFinal(_ uint64) bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fh *FinalityHandler) IsInterfaceNil() bool {
+	return fh == nil
+}
@@ -0,0 +1,20 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// HyperblockStreamer represents a disabled struct that implements the HyperblockStreamer interface
+type HyperblockStreamer struct {
+}
+
+// Subscribe returns ErrHyperblockStreamDisabled as this is a disabled component
+func (hs *HyperblockStreamer) Subscribe(_ core.OptionalUint64) (data.HyperblockSubscriptionHandler, error) {
+	return nil, ErrHyperblockStreamDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hs *HyperblockStreamer) IsInterfaceNil() bool {
+	return hs == nil
+}
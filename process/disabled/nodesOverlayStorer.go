@@ -0,0 +1,23 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// NodesOverlayStorer represents a disabled struct that implements the NodesOverlayStorer interface. It is used when
+// the runtime changes of the nodes are only kept in memory
+type NodesOverlayStorer struct {
+}
+
+// Load returns empty overlays as this is a disabled component
+func (nos *NodesOverlayStorer) Load() (*data.NodesOverlays, error) {
+	return &data.NodesOverlays{}, nil
+}
+
+// Save won't do anything as this is a disabled component
+func (nos *NodesOverlayStorer) Save(_ data.NodesOverlays) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nos *NodesOverlayStorer) IsInterfaceNil() bool {
+	return nos == nil
+}
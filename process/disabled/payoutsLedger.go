@@ -0,0 +1,27 @@
+package disabled
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// PayoutsLedger represents a disabled struct that implements the PayoutsLedger interface. It is used when the faucet
+// payouts are kept only in memory
+type PayoutsLedger struct {
+}
+
+// LoadPayouts returns an empty slice as this is a disabled component
+func (pl *PayoutsLedger) LoadPayouts(_ time.Time) ([]*data.FaucetPayout, error) {
+	return make([]*data.FaucetPayout, 0), nil
+}
+
+// Append won't do anything as this is a disabled component
+func (pl *PayoutsLedger) Append(_ *data.FaucetPayout, _ time.Time) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pl *PayoutsLedger) IsInterfaceNil() bool {
+	return pl == nil
+}
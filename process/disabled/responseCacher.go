@@ -0,0 +1,19 @@
+package disabled
+
+// ResponseCacher represents a disabled struct that implements the ResponseCacheHandler interface
+type ResponseCacher struct {
+}
+
+// Get returns false as this is a disabled component
+func (rc *ResponseCacher) Get(_ string, _ interface{}) bool {
+	return false
+}
+
+// Put won't do anything as this is a disabled component
+func (rc *ResponseCacher) Put(_ string, _ interface{}) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *ResponseCacher) IsInterfaceNil() bool {
+	return rc == nil
+}
@@ -0,0 +1,27 @@
+package disabled
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+)
+
+// TransactionPreflightChecker represents a disabled struct that implements the TransactionPreflightHandler interface
+type TransactionPreflightChecker struct {
+}
+
+// CheckTransaction returns nil as this is a disabled component
+func (tpc *TransactionPreflightChecker) CheckTransaction(_ context.Context, _ *data.Transaction, _ process.PoolNonceProvider) error {
+	return nil
+}
+
+// CheckTransactions returns no error for any of the transactions as this is a disabled component
+func (tpc *TransactionPreflightChecker) CheckTransactions(_ context.Context, txs []*data.Transaction, _ process.PoolNonceProvider) []error {
+	return make([]error, len(txs))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tpc *TransactionPreflightChecker) IsInterfaceNil() bool {
+	return tpc == nil
+}
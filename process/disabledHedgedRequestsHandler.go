@@ -0,0 +1,47 @@
+package process
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type disabledHedgedRequestsHandler struct {
+}
+
+// NewDisabledHedgedRequestsHandler returns a new instance of disabledHedgedRequestsHandler, which sends the request to
+// the observers one after another, only moving to the next one after the previous one failed
+func NewDisabledHedgedRequestsHandler() *disabledHedgedRequestsHandler {
+	return &disabledHedgedRequestsHandler{}
+}
+
+// Execute will send the request to the provided observers, in order, until one of them answers successfully
+func (dhrh *disabledHedgedRequestsHandler) Execute(
+	ctx context.Context,
+	observers []*data.NodeData,
+	requestHandler ObserverRequestHandler,
+) (interface{}, error) {
+	lastErr := ErrSendingRequest
+	for _, observer := range observers {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		response, canRetry, err := requestHandler(ctx, observer)
+		if err == nil {
+			return response, nil
+		}
+		if !canRetry {
+			return nil, err
+		}
+
+		lastErr = err
+	}
+
+	return nil, lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dhrh *disabledHedgedRequestsHandler) IsInterfaceNil() bool {
+	return dhrh == nil
+}
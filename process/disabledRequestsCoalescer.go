@@ -0,0 +1,21 @@
+package process
+
+import "context"
+
+type disabledRequestsCoalescer struct {
+}
+
+// NewDisabledRequestsCoalescer returns a new instance of disabledRequestsCoalescer, which sends each request separately
+func NewDisabledRequestsCoalescer() *disabledRequestsCoalescer {
+	return &disabledRequestsCoalescer{}
+}
+
+// Do will directly send the request
+func (drc *disabledRequestsCoalescer) Do(ctx context.Context, _ string, _ string, requestHandler CoalescedRequestHandler) (interface{}, error) {
+	return requestHandler(ctx)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (drc *disabledRequestsCoalescer) IsInterfaceNil() bool {
+	return drc == nil
+}
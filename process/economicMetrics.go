@@ -18,21 +18,25 @@ func (nsp *NodeStatusProcessor) GetEconomicsDataMetrics() (*data.GenericAPIRespo
 	return nsp.economicMetricsCacher.Load()
 }
 
-func (nsp *NodeStatusProcessor) getEconomicsDataMetricsFromApi() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) getEconomicsDataMetricsFromApi(ctx context.Context) (*data.GenericAPIResponse, error) {
 	metaObservers, err := nsp.proc.GetObservers(core.MetachainShardId, data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
 	}
 
-	return nsp.getEconomicsDataMetrics(metaObservers)
+	return nsp.getEconomicsDataMetrics(ctx, metaObservers)
 }
 
-func (nsp *NodeStatusProcessor) getEconomicsDataMetrics(observers []*data.NodeData) (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) getEconomicsDataMetrics(ctx context.Context, observers []*data.NodeData) (*data.GenericAPIResponse, error) {
 	responseNetworkMetrics := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, EconomicsDataPath, &responseNetworkMetrics)
+		_, err := nsp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, EconomicsDataPath, &responseNetworkMetrics)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("economics data request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
@@ -59,14 +63,14 @@ func (nsp *NodeStatusProcessor) StartCacheUpdate() {
 		defer timer.Stop()
 
 		countConsecutiveFails := 0
-		nsp.handleCacheUpdate(&countConsecutiveFails)
+		nsp.handleCacheUpdate(ctx, &countConsecutiveFails)
 
 		for {
 			timer.Reset(nsp.cacheValidityDuration)
 
 			select {
 			case <-timer.C:
-				nsp.handleCacheUpdate(&countConsecutiveFails)
+				nsp.handleCacheUpdate(ctx, &countConsecutiveFails)
 
 			case <-ctx.Done():
 				log.Debug("finishing NodeStatusProcessor cache update...")
@@ -76,8 +80,8 @@ func (nsp *NodeStatusProcessor) StartCacheUpdate() {
 	}(ctx)
 }
 
-func (nsp *NodeStatusProcessor) handleCacheUpdate(countConsecutiveFails *int) {
-	economicMetrics, err := nsp.getEconomicsDataMetricsFromApi()
+func (nsp *NodeStatusProcessor) handleCacheUpdate(ctx context.Context, countConsecutiveFails *int) {
+	economicMetrics, err := nsp.getEconomicsDataMetricsFromApi(ctx)
 	if err != nil {
 		*countConsecutiveFails++
 		log.Warn("economic metrics: get from API", "error", err.Error())
@@ -11,8 +11,8 @@ var ErrSendingRequest = errors.New("sending request error")
 // ErrNilShardCoordinator signals that a nil shard coordinator has been provided
 var ErrNilShardCoordinator = errors.New("nil shard coordinator")
 
-// ErrInvalidRequestTimeout signals that the provided number of seconds before timeout is invalid
-var ErrInvalidRequestTimeout = errors.New("invalid duration until timeout for requests")
+// ErrNilObserversHttpClient signals that a nil observers http client has been provided
+var ErrNilObserversHttpClient = errors.New("nil observers http client")
 
 // ErrNilCoreProcessor signals that a nil core processor has been provided
 var ErrNilCoreProcessor = errors.New("nil core processor")
@@ -44,12 +44,25 @@ var ErrAuctionListNotAvailable = errors.New("auction list data not found on any
 // ErrInvalidCacheValidityDuration signals that the given validity duration for cache data is invalid
 var ErrInvalidCacheValidityDuration = errors.New("invalid cache validity duration")
 
+// ErrInvalidFinalityRefreshInterval signals that the given interval between two refreshes of the finality information
+// is invalid
+var ErrInvalidFinalityRefreshInterval = errors.New("invalid finality refresh interval")
+
 // ErrNilDefaultFaucetValue signals that a nil default faucet value has been provided
 var ErrNilDefaultFaucetValue = errors.New("nil default faucet value provided")
 
 // ErrInvalidDefaultFaucetValue signals that the provided faucet value is not strictly positive
 var ErrInvalidDefaultFaucetValue = errors.New("default faucet value is not strictly positive")
 
+// ErrNilFaucetPayoutsLimiter signals that a nil faucet payouts limiter has been provided
+var ErrNilFaucetPayoutsLimiter = errors.New("nil faucet payouts limiter")
+
+// ErrInvalidFaucetToken signals that an invalid faucet token has been provided
+var ErrInvalidFaucetToken = errors.New("invalid faucet token")
+
+// ErrFaucetValueTooHigh signals that the requested faucet value is higher than the default one
+var ErrFaucetValueTooHigh = errors.New("requested faucet value is too high")
+
 // ErrNoFaucetAccountForGivenShard signals that no account was found for the shard of the given address
 var ErrNoFaucetAccountForGivenShard = errors.New("no faucet account found for the given shard")
 
@@ -98,6 +111,9 @@ var ErrNilSCQueryService = errors.New("nil smart contracts query service provide
 // ErrInvalidOutputFormat signals that the output format type is not valid
 var ErrInvalidOutputFormat = errors.New("the output format type is invalid")
 
+// ErrNilObserversMetricsHandler signals that a nil observers metrics handler has been provided
+var ErrNilObserversMetricsHandler = errors.New("nil observers metrics handler")
+
 // ErrNilStatusMetricsProvider signals that a nil status metrics provider has been given
 var ErrNilStatusMetricsProvider = errors.New("nil status metrics provider")
 
@@ -112,3 +128,102 @@ var ErrEmptyPubKey = errors.New("public key is empty")
 
 // ErrNilHttpClient signals that a nil http client has been provided
 var ErrNilHttpClient = errors.New("nil http client")
+
+// ErrNilResponseCacher signals that a nil response cacher has been provided
+var ErrNilResponseCacher = errors.New("nil response cacher")
+
+// ErrNilFinalityHandler signals that a nil finality handler has been provided
+var ErrNilFinalityHandler = errors.New("nil finality handler")
+
+// ErrNilDatabaseConnector signals that a nil database connector has been provided
+var ErrNilDatabaseConnector = errors.New("nil database connector")
+
+// ErrInvalidHedgingDelay signals that an invalid hedging delay has been provided
+var ErrInvalidHedgingDelay = errors.New("invalid hedging delay")
+
+// ErrNilHedgedRequestsHandler signals that a nil hedged requests handler has been provided
+var ErrNilHedgedRequestsHandler = errors.New("nil hedged requests handler")
+
+// ErrNilRequestsCoalescer signals that a nil requests coalescer has been provided
+var ErrNilRequestsCoalescer = errors.New("nil requests coalescer")
+
+// ErrNilCoalescingMetricsHandler signals that a nil coalescing metrics handler has been provided
+var ErrNilCoalescingMetricsHandler = errors.New("nil coalescing metrics handler")
+
+// ErrNilHyperblockBuilder signals that a nil hyperblock builder has been provided
+var ErrNilHyperblockBuilder = errors.New("nil hyperblock builder")
+
+// ErrNilHyperblockNonceProvider signals that a nil hyperblock nonce provider has been provided
+var ErrNilHyperblockNonceProvider = errors.New("nil hyperblock nonce provider")
+
+// ErrInvalidHyperblockStreamConfig signals that an invalid hyperblock stream config value has been provided
+var ErrInvalidHyperblockStreamConfig = errors.New("invalid hyperblock stream config")
+
+// ErrHyperblockStreamNotReady signals that the hyperblock stream did not fetch any hyperblock yet
+var ErrHyperblockStreamNotReady = errors.New("hyperblock stream is not ready")
+
+// ErrHyperblockStreamClosed signals that the hyperblock stream has been closed
+var ErrHyperblockStreamClosed = errors.New("hyperblock stream is closed")
+
+// ErrTooManyHyperblockSubscribers signals that the maximum number of hyperblock stream subscribers has been reached
+var ErrTooManyHyperblockSubscribers = errors.New("too many hyperblock stream subscribers")
+
+// ErrResumeNonceTooOld signals that the nonce to resume the hyperblock stream from is too far behind
+var ErrResumeNonceTooOld = errors.New("resume nonce is too old")
+
+// ErrNilTransactionStatusProvider signals that a nil transaction status provider has been provided
+var ErrNilTransactionStatusProvider = errors.New("nil transaction status provider")
+
+// ErrInvalidTransactionStatusWatcherConfig signals that an invalid transaction status watcher config value has been provided
+var ErrInvalidTransactionStatusWatcherConfig = errors.New("invalid transaction status watcher config")
+
+// ErrTransactionWatchExpired signals that the transaction did not reach a final status in the allowed time
+var ErrTransactionWatchExpired = errors.New("transaction status watch expired")
+
+// ErrTransactionWatchFinished signals that the final status of the watched transaction was already emitted
+var ErrTransactionWatchFinished = errors.New("transaction status watch finished")
+
+// ErrTransactionNotAccepted signals that the observer did not accept a transaction of a bulk
+var ErrTransactionNotAccepted = errors.New("transaction not accepted by the observer")
+
+// ErrNilAccountProvider signals that a nil account provider has been provided
+var ErrNilAccountProvider = errors.New("nil account provider")
+
+// ErrNilPoolNonceProvider signals that a nil pool nonce provider has been provided
+var ErrNilPoolNonceProvider = errors.New("nil pool nonce provider")
+
+// ErrNilNetworkConfigProvider signals that a nil network config provider has been provided
+var ErrNilNetworkConfigProvider = errors.New("nil network config provider")
+
+// ErrNilTransactionPreflightChecker signals that a nil transaction pre-flight checker has been provided
+var ErrNilTransactionPreflightChecker = errors.New("nil transaction pre-flight checker")
+
+// ErrNilTransactionSender signals that a nil transaction sender has been provided
+var ErrNilTransactionSender = errors.New("nil transaction sender")
+
+// ErrNoRelayerAccountForGivenShard signals that no relayer account was found for the shard of the given address
+var ErrNoRelayerAccountForGivenShard = errors.New("no relayer account found for the given shard")
+
+// ErrMissingRelayerAddress signals that the transaction to be relayed does not have a relayer address
+var ErrMissingRelayerAddress = errors.New("missing relayer address")
+
+// ErrInvalidRelayerAddress signals that the relayer address of the transaction is not the relayer of the sender's shard
+var ErrInvalidRelayerAddress = errors.New("the relayer address is not the relayer of the sender's shard")
+
+// ErrInvalidTransactionSignature signals that the signature of the transaction does not match its sender
+var ErrInvalidTransactionSignature = errors.New("the transaction signature does not match the sender")
+
+// ErrRelayerPolicyViolation signals that the transaction to be relayed is not allowed by the relayer policies
+var ErrRelayerPolicyViolation = errors.New("transaction not allowed by the relayer policies")
+
+// ErrRelayerGasBudgetExceeded signals that the sender has exhausted its daily gas budget sponsored by the relayer
+var ErrRelayerGasBudgetExceeded = errors.New("the daily relayed gas budget of the sender is exceeded")
+
+// ErrNilNodesAdminHandler signals that a nil nodes admin handler has been provided
+var ErrNilNodesAdminHandler = errors.New("nil nodes admin handler")
+
+// ErrNilNodesOverlayStorer signals that a nil nodes overlay storer has been provided
+var ErrNilNodesOverlayStorer = errors.New("nil nodes overlay storer")
+
+// ErrInvalidNodeAddress signals that the address of the node is not a valid http or https URL
+var ErrInvalidNodeAddress = errors.New("invalid node address, an http or https URL is expected")
@@ -1,10 +1,13 @@
 package process
 
 import (
+	"context"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	proxyData "github.com/multiversx/mx-chain-proxy-go/data"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // SetDelayForCheckingNodesSyncState -
@@ -17,6 +20,12 @@ func (bp *BaseProcessor) SetNodeStatusFetcher(fetcher func(url string) (*proxyDa
 	bp.nodeStatusFetcher = fetcher
 }
 
+// SetTracing -
+func (bp *BaseProcessor) SetTracing(tracerProvider trace.TracerProvider, propagator propagation.TextMapPropagator) {
+	bp.tracer = tracerProvider.Tracer(tracerName)
+	bp.propagator = propagator
+}
+
 // ComputeTokenStorageKey -
 func ComputeTokenStorageKey(tokenID string, nonce uint64) string {
 	return computeTokenStorageKey(tokenID, nonce)
@@ -29,10 +38,30 @@ func GetShortHashSize() int {
 
 // ComputeTransactionStatus -
 func (tp *TransactionProcessor) ComputeTransactionStatus(tx *transaction.ApiTransactionResult, withResults bool) *proxyData.ProcessStatusResponse {
-	return tp.computeTransactionStatus(tx, withResults)
+	return tp.computeTransactionStatus(context.Background(), tx, withResults)
 }
 
 // CheckIfFailed -
 func CheckIfFailed(logs []*transaction.ApiLogs) (bool, string) {
 	return checkIfFailed(logs)
 }
+
+// UpdateFinalityInfo -
+func (ft *FinalityTracker) UpdateFinalityInfo() {
+	ft.updateFinalityInfo(context.Background())
+}
+
+// FetchNewHyperblocks -
+func (hs *HyperblockStreamer) FetchNewHyperblocks() {
+	hs.fetchNewHyperblocks(context.Background())
+}
+
+// SetTimeHandler -
+func (rp *RelayerProcessor) SetTimeHandler(handler func() time.Time) {
+	rp.getTimeHandler = handler
+}
+
+// SetNonceValidity -
+func (fp *FaucetProcessor) SetNonceValidity(nonceValidity time.Duration) {
+	fp.nonceValidity = nonceValidity
+}
@@ -1,11 +1,9 @@
 package factory
 
 import (
+	"context"
 	"errors"
 	"math/big"
-
-	"github.com/multiversx/mx-chain-crypto-go"
-	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 var errNotEnabled = errors.New("faucet not enabled")
@@ -18,19 +16,7 @@ func (d *disabledFaucetProcessor) IsEnabled() bool {
 	return false
 }
 
-// SenderDetailsFromPem will return an error that signals that faucet is not enabled
-func (d *disabledFaucetProcessor) SenderDetailsFromPem(_ string) (crypto.PrivateKey, string, error) {
-	return nil, "", errNotEnabled
-}
-
-// GenerateTxForSendUserFunds will return an error that signals that faucet is not enabled
-func (d *disabledFaucetProcessor) GenerateTxForSendUserFunds(
-	_ crypto.PrivateKey,
-	_ string,
-	_ uint64,
-	_ string,
-	_ *big.Int,
-	_ *data.NetworkConfig,
-) (*data.Transaction, error) {
+// SendUserFunds will return an error that signals that faucet is not enabled
+func (d *disabledFaucetProcessor) SendUserFunds(_ context.Context, _ string, _ *big.Int, _ string) ([]string, error) {
 	return nil, errNotEnabled
 }
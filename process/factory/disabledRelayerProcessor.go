@@ -0,0 +1,29 @@
+package factory
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var errRelayerNotEnabled = errors.New("relayer not enabled")
+
+type disabledRelayerProcessor struct {
+}
+
+// IsEnabled will return false
+func (d *disabledRelayerProcessor) IsEnabled() bool {
+	return false
+}
+
+// GetRelayerAddress will return an error that signals that the relayer is not enabled
+func (d *disabledRelayerProcessor) GetRelayerAddress(_ string) (string, error) {
+	return "", errRelayerNotEnabled
+}
+
+// RelayTransaction will return an error that signals that the relayer is not enabled
+func (d *disabledRelayerProcessor) RelayTransaction(_ context.Context, _ *data.Transaction) (int, string, error) {
+	return http.StatusBadRequest, "", errRelayerNotEnabled
+}
@@ -1,18 +1,26 @@
 package factory
 
 import (
+	"errors"
+	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/facade"
 	"github.com/multiversx/mx-chain-proxy-go/faucet"
 	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
 )
 
 var log = logger.GetOrCreate("process/factory")
 
+var errInvalidFaucetAmount = errors.New("invalid faucet amount")
+
 // CreateFaucetProcessor will return the faucet processor needed for current settings
 func CreateFaucetProcessor(
 	baseProc Processor,
@@ -20,17 +28,90 @@ func CreateFaucetProcessor(
 	defaultFaucetValue *big.Int,
 	pubKeyConverter core.PubkeyConverter,
 	pemFileLocation string,
+	faucetConfig config.FaucetConfig,
+	accountProvider process.AccountProvider,
+	networkConfigProvider process.NetworkConfigProvider,
+	txSender process.TransactionSender,
+	poolNonceProvider process.PoolNonceProvider,
 ) (facade.FaucetProcessor, error) {
 	if defaultFaucetValue.Cmp(big.NewInt(0)) == 0 {
 		log.Info("faucet is disabled")
 		return &disabledFaucetProcessor{}, nil
 	}
 
-	log.Info("faucet is enabled", "pem file location", pemFileLocation)
+	log.Info("faucet is enabled", "pem file location", pemFileLocation, "ledger file", faucetConfig.LedgerFile)
 	privKeysLoader, err := faucet.NewPrivateKeysLoader(shardCoordinator, pemFileLocation, pubKeyConverter)
 	if err != nil {
 		return nil, err
 	}
 
-	return process.NewFaucetProcessor(baseProc, privKeysLoader, defaultFaucetValue, pubKeyConverter)
+	payoutsLimiter, err := createFaucetPayoutsLimiter(faucetConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]*data.FaucetToken, 0, len(faucetConfig.Tokens))
+	for _, tokenConfig := range faucetConfig.Tokens {
+		value, errParse := parseFaucetAmount(tokenConfig)
+		if errParse != nil {
+			return nil, errParse
+		}
+
+		tokens = append(tokens, &data.FaucetToken{
+			Identifier: tokenConfig.Token,
+			Value:      value,
+		})
+	}
+
+	return process.NewFaucetProcessor(
+		baseProc,
+		privKeysLoader,
+		defaultFaucetValue,
+		pubKeyConverter,
+		payoutsLimiter,
+		tokens,
+		faucetConfig.ESDTTransferGasLimit,
+		accountProvider,
+		networkConfigProvider,
+		txSender,
+		poolNonceProvider,
+	)
+}
+
+func createFaucetPayoutsLimiter(faucetConfig config.FaucetConfig) (process.FaucetPayoutsLimiter, error) {
+	var ledger faucet.PayoutsLedgerHandler = &disabled.PayoutsLedger{}
+	if len(faucetConfig.LedgerFile) > 0 {
+		fileLedger, err := faucet.NewPayoutsLedger(faucetConfig.LedgerFile)
+		if err != nil {
+			return nil, err
+		}
+
+		ledger = fileLedger
+	}
+
+	dailyBudgets := make(map[string]*big.Int, len(faucetConfig.DailyBudgets))
+	for _, budgetConfig := range faucetConfig.DailyBudgets {
+		value, err := parseFaucetAmount(budgetConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		dailyBudgets[budgetConfig.Token] = value
+	}
+
+	return faucet.NewPayoutsLimiter(faucet.ArgsPayoutsLimiter{
+		Ledger:          ledger,
+		AddressCooldown: time.Duration(faucetConfig.AddressCooldownSec) * time.Second,
+		IPCooldown:      time.Duration(faucetConfig.IPCooldownSec) * time.Second,
+		DailyBudgets:    dailyBudgets,
+	})
+}
+
+func parseFaucetAmount(amountConfig config.FaucetAmountConfig) (*big.Int, error) {
+	value, ok := big.NewInt(0).SetString(amountConfig.Value, 10)
+	if !ok || value.Sign() < 0 || len(amountConfig.Token) == 0 {
+		return nil, fmt.Errorf("%w: token %q, value %q", errInvalidFaucetAmount, amountConfig.Token, amountConfig.Value)
+	}
+
+	return value, nil
 }
@@ -1,6 +1,8 @@
 package factory
 
 import (
+	"context"
+
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-proxy-go/common"
@@ -13,6 +15,8 @@ type Processor interface {
 	ComputeShardId(addressBuff []byte) (uint32, error)
 	CallGetRestEndPoint(address string, path string, value interface{}) (int, error)
 	CallPostRestEndPoint(address string, path string, data interface{}, response interface{}) (int, error)
+	CallGetRestEndPointWithContext(ctx context.Context, address string, path string, value interface{}) (int, error)
+	CallPostRestEndPointWithContext(ctx context.Context, address string, path string, data interface{}, response interface{}) (int, error)
 	GetObserversOnePerShard(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
 	GetShardIDs() []uint32
 	GetFullHistoryNodesOnePerShard(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
@@ -24,6 +28,7 @@ type Processor interface {
 	GetPubKeyConverter() core.PubkeyConverter
 	GetObserverProvider() observer.NodesProviderHandler
 	GetFullHistoryNodesProvider() observer.NodesProviderHandler
+	GetConnectionPoolMetrics() *data.ConnectionPoolMetrics
 	IsInterfaceNil() bool
 }
 
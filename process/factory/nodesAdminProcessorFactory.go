@@ -0,0 +1,29 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/facade"
+	"github.com/multiversx/mx-chain-proxy-go/observer"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
+)
+
+// CreateNodesAdminProcessor will return the processor of the nodes changed at runtime, which persists the changes only
+// if an overlay file is configured
+func CreateNodesAdminProcessor(
+	nodesAdminConfig config.NodesAdminConfig,
+	nodesHandler process.NodesAdminHandler,
+) (facade.ActionsProcessor, error) {
+	var overlayStorer process.NodesOverlayStorer = &disabled.NodesOverlayStorer{}
+	if len(nodesAdminConfig.OverlayFile) > 0 {
+		fileStorer, err := observer.NewNodesOverlayStorer(nodesAdminConfig.OverlayFile)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Info("the runtime changes of the nodes are persisted", "file", nodesAdminConfig.OverlayFile)
+		overlayStorer = fileStorer
+	}
+
+	return process.NewNodesAdminProcessor(nodesHandler, overlayStorer)
+}
@@ -0,0 +1,41 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/facade"
+	"github.com/multiversx/mx-chain-proxy-go/faucet"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+)
+
+// CreateRelayerProcessor will return the relayer processor needed for current settings
+func CreateRelayerProcessor(
+	relayerConfig config.RelayerConfig,
+	baseProc Processor,
+	shardCoordinator common.Coordinator,
+	pubKeyConverter core.PubkeyConverter,
+	txSender process.TransactionSender,
+) (facade.RelayerProcessor, error) {
+	if !relayerConfig.Enabled {
+		log.Info("relayer is disabled")
+		return &disabledRelayerProcessor{}, nil
+	}
+
+	log.Info("relayer is enabled", "pem file location", relayerConfig.PemFile)
+	privKeysLoader, err := faucet.NewPrivateKeysLoader(shardCoordinator, relayerConfig.PemFile, pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	return process.NewRelayerProcessor(process.ArgsRelayerProcessor{
+		BaseProc:                baseProc,
+		PrivKeysLoader:          privKeysLoader,
+		PubKeyConverter:         pubKeyConverter,
+		TxSender:                txSender,
+		AllowedReceivers:        relayerConfig.AllowedReceivers,
+		AllowedFunctions:        relayerConfig.AllowedFunctions,
+		DailyGasBudgetPerSender: relayerConfig.DailyGasBudgetPerSender,
+		MaxGasPrice:             relayerConfig.MaxGasPrice,
+	})
+}
@@ -16,6 +16,9 @@ func CreateTransactionProcessor(
 	pubKeyConverter core.PubkeyConverter,
 	hasher hashing.Hasher,
 	marshalizer marshal.Marshalizer,
+	responseCacher process.ResponseCacheHandler,
+	finalityHandler process.FinalityHandler,
+	preflightChecker process.TransactionPreflightHandler,
 	allowEntireTxPoolFetch bool,
 ) (facade.TransactionProcessor, error) {
 	newTxCostProcessor := func() (process.TransactionCostHandler, error) {
@@ -37,6 +40,9 @@ func CreateTransactionProcessor(
 		marshalizer,
 		newTxCostProcessor,
 		logsMerger,
+		responseCacher,
+		finalityHandler,
+		preflightChecker,
 		allowEntireTxPoolFetch,
 	)
 }
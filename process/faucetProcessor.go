@@ -1,19 +1,27 @@
 package process
 
 import (
+	"context"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"math/big"
 	"math/rand"
 	"sync"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	ed25519SingleSigner "github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
+// faucetSenderNonceValidity is the duration, since its last payout, for which the nonce tracked for a faucet sender is
+// preferred over a lower one reported by the observers, as the sent transactions take a while to reach the pool
+const faucetSenderNonceValidity = 30 * time.Second
+
 func getSingleSigner() crypto.SingleSigner {
 	return &ed25519SingleSigner.Ed25519Signer{}
 }
@@ -26,6 +34,25 @@ type FaucetProcessor struct {
 	singleSigner       crypto.SingleSigner
 	defaultFaucetValue *big.Int
 	pubKeyConverter    core.PubkeyConverter
+	payoutsLimiter     FaucetPayoutsLimiter
+	tokens             []*data.FaucetToken
+	esdtTransferGas    uint64
+
+	accountProvider       AccountProvider
+	networkConfigProvider NetworkConfigProvider
+	txSender              TransactionSender
+	poolNonceProvider     PoolNonceProvider
+	nonceValidity         time.Duration
+	mutSenders            sync.Mutex
+	senders               map[string]*faucetSender
+}
+
+// faucetSender serializes the payouts of a faucet sender and tracks its next nonce, as the account nonce only advances
+// once the transactions of the previous payouts are executed
+type faucetSender struct {
+	mutPayout           sync.Mutex
+	nextNonce           uint64
+	lastPayoutTimestamp time.Time
 }
 
 // NewFaucetProcessor will return a new instance of FaucetProcessor
@@ -34,6 +61,13 @@ func NewFaucetProcessor(
 	privKeysLoader PrivateKeysLoaderHandler,
 	defaultFaucetValue *big.Int,
 	pubKeyConverter core.PubkeyConverter,
+	payoutsLimiter FaucetPayoutsLimiter,
+	tokens []*data.FaucetToken,
+	esdtTransferGasLimit uint64,
+	accountProvider AccountProvider,
+	networkConfigProvider NetworkConfigProvider,
+	txSender TransactionSender,
+	poolNonceProvider PoolNonceProvider,
 ) (*FaucetProcessor, error) {
 	if baseProc == nil {
 		return nil, ErrNilCoreProcessor
@@ -50,6 +84,26 @@ func NewFaucetProcessor(
 	if check.IfNil(pubKeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
+	if check.IfNil(payoutsLimiter) {
+		return nil, ErrNilFaucetPayoutsLimiter
+	}
+	if check.IfNil(accountProvider) {
+		return nil, ErrNilAccountProvider
+	}
+	if check.IfNil(networkConfigProvider) {
+		return nil, ErrNilNetworkConfigProvider
+	}
+	if check.IfNil(txSender) {
+		return nil, ErrNilTransactionSender
+	}
+	if poolNonceProvider == nil {
+		return nil, ErrNilPoolNonceProvider
+	}
+	for _, token := range tokens {
+		if len(token.Identifier) == 0 || token.Value == nil || token.Value.Cmp(big.NewInt(0)) <= 0 {
+			return nil, fmt.Errorf("%w for token %q", ErrInvalidFaucetToken, token.Identifier)
+		}
+	}
 
 	accMap, err := privKeysLoader.PrivateKeysByShard()
 	if err != nil {
@@ -68,6 +122,16 @@ func NewFaucetProcessor(
 		singleSigner:       singleSigner,
 		defaultFaucetValue: defaultFaucetValue,
 		pubKeyConverter:    pubKeyConverter,
+		payoutsLimiter:     payoutsLimiter,
+		tokens:             tokens,
+		esdtTransferGas:    esdtTransferGasLimit,
+
+		accountProvider:       accountProvider,
+		networkConfigProvider: networkConfigProvider,
+		txSender:              txSender,
+		poolNonceProvider:     poolNonceProvider,
+		nonceValidity:         faucetSenderNonceValidity,
+		senders:               make(map[string]*faucetSender),
 	}, nil
 }
 
@@ -138,6 +202,207 @@ func (fp *FaucetProcessor) GenerateTxForSendUserFunds(
 	return signedTx, nil
 }
 
+// SendUserFunds sends the transactions loading one user's account with extra funds, in EGLD and in the configured ESDT
+// tokens, from an account in the pem file. The payout is refused if a faucet limit is reached. The hashes of the sent
+// transactions are returned along with the error, if any
+func (fp *FaucetProcessor) SendUserFunds(ctx context.Context, receiver string, value *big.Int, clientIP string) ([]string, error) {
+	payout, err := fp.ReservePayout(receiver, clientIP, value)
+	if err != nil {
+		return nil, err
+	}
+
+	txHashes, err := fp.sendPayoutTransactions(ctx, payout)
+	if len(txHashes) == 0 {
+		fp.CancelPayout(payout)
+		return nil, err
+	}
+
+	payout.TxHashes = txHashes
+	errConfirm := fp.ConfirmPayout(payout)
+	if errConfirm != nil {
+		log.Warn("cannot record faucet payout", "receiver", receiver, "error", errConfirm.Error())
+	}
+
+	return txHashes, err
+}
+
+// sendPayoutTransactions sends the EGLD transaction of the payout followed by the ESDT ones, stopping at the first
+// failure. The hashes of the sent transactions are returned along with the error, if any. The payouts of a sender are
+// sent one at a time, so their nonces do not collide
+func (fp *FaucetProcessor) sendPayoutTransactions(ctx context.Context, payout *data.FaucetPayout) ([]string, error) {
+	senderSk, senderPk, err := fp.SenderDetailsFromPem(payout.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	sender := fp.getFaucetSender(senderPk)
+	sender.mutPayout.Lock()
+	defer sender.mutPayout.Unlock()
+
+	nonce, err := fp.getSenderNonce(ctx, senderPk, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	networkConfig, err := getNetworkConfig(ctx, fp.networkConfigProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := fp.GenerateTxForSendUserFunds(senderSk, senderPk, nonce, payout.Receiver, payout.Amounts[data.EGLDTokenIdentifier], networkConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtTxs, err := fp.GenerateESDTTxsForSendUserFunds(senderSk, senderPk, nonce+1, payout.Receiver, networkConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	txHashes := make([]string, 0, len(esdtTxs)+1)
+	for _, txToSend := range append([]*data.Transaction{tx}, esdtTxs...) {
+		var txHash string
+		_, txHash, err = fp.txSender.SendTransaction(ctx, txToSend)
+		if err != nil {
+			break
+		}
+
+		txHashes = append(txHashes, txHash)
+	}
+
+	if err != nil {
+		// the nonce of the failed transaction might have been used or not, so the next payout resyncs the nonce
+		sender.nextNonce = 0
+		return txHashes, err
+	}
+
+	sender.nextNonce = nonce + uint64(len(txHashes))
+	sender.lastPayoutTimestamp = time.Now()
+
+	return txHashes, nil
+}
+
+// getSenderNonce returns the nonce of the next transaction of a faucet sender: the nonce tracked for the sender, as long
+// as it is not behind the nonce reported by the observers and the sender had a payout recently. Otherwise, the tracked
+// nonce is resynced from the account nonce and the sender's transactions in pool, so a dropped transaction does not
+// stall the faucet
+func (fp *FaucetProcessor) getSenderNonce(ctx context.Context, senderPk string, sender *faucetSender) (uint64, error) {
+	senderAccount, err := fp.accountProvider.GetAccount(ctx, senderPk, common.AccountQueryOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	networkNonce := senderAccount.Account.Nonce
+	lastPoolNonce, err := fp.poolNonceProvider.GetLastPoolNonceForSender(ctx, senderPk)
+	if err == nil && lastPoolNonce >= networkNonce {
+		networkNonce = lastPoolNonce + 1
+	}
+
+	isTrackedNonceValid := time.Since(sender.lastPayoutTimestamp) < fp.nonceValidity
+	if sender.nextNonce > networkNonce && isTrackedNonceValid {
+		return sender.nextNonce, nil
+	}
+	if sender.nextNonce > networkNonce {
+		log.Debug("faucet sender nonce resynced", "sender", senderPk, "tracked nonce", sender.nextNonce, "nonce", networkNonce)
+	}
+
+	return networkNonce, nil
+}
+
+func (fp *FaucetProcessor) getFaucetSender(senderPk string) *faucetSender {
+	fp.mutSenders.Lock()
+	defer fp.mutSenders.Unlock()
+
+	sender, found := fp.senders[senderPk]
+	if !found {
+		sender = &faucetSender{}
+		fp.senders[senderPk] = sender
+	}
+
+	return sender
+}
+
+// ReservePayout checks the limits of the faucet for the provided receiver and client IP and, if they are not reached,
+// reserves a payout of the provided EGLD value, or of the default one if nil, alongside the configured ESDT tokens.
+// The payout should be either confirmed or cancelled after sending its transactions
+func (fp *FaucetProcessor) ReservePayout(receiver string, clientIP string, value *big.Int) (*data.FaucetPayout, error) {
+	if value == nil {
+		value = fp.defaultFaucetValue
+	}
+	if value.Cmp(fp.defaultFaucetValue) > 0 {
+		return nil, fmt.Errorf("%w: maximum value is %s", ErrFaucetValueTooHigh, fp.defaultFaucetValue.String())
+	}
+
+	amounts := map[string]*big.Int{
+		data.EGLDTokenIdentifier: value,
+	}
+	for _, token := range fp.tokens {
+		amounts[token.Identifier] = token.Value
+	}
+
+	payout := &data.FaucetPayout{
+		Receiver: receiver,
+		ClientIP: clientIP,
+		Amounts:  amounts,
+	}
+	err := fp.payoutsLimiter.Reserve(payout)
+	if err != nil {
+		return nil, err
+	}
+
+	return payout, nil
+}
+
+// ConfirmPayout records the provided payout, after its transactions were sent
+func (fp *FaucetProcessor) ConfirmPayout(payout *data.FaucetPayout) error {
+	return fp.payoutsLimiter.Confirm(payout)
+}
+
+// CancelPayout releases the provided payout, if none of its transactions could be sent
+func (fp *FaucetProcessor) CancelPayout(payout *data.FaucetPayout) {
+	fp.payoutsLimiter.Cancel(payout)
+}
+
+// GenerateESDTTxsForSendUserFunds generates a signed ESDTTransfer transaction for each of the configured tokens, with
+// consecutive nonces starting from the provided one
+func (fp *FaucetProcessor) GenerateESDTTxsForSendUserFunds(
+	senderSk crypto.PrivateKey,
+	senderPk string,
+	firstNonce uint64,
+	receiver string,
+	networkConfig *data.NetworkConfig,
+) ([]*data.Transaction, error) {
+	txs := make([]*data.Transaction, 0, len(fp.tokens))
+	for idx, token := range fp.tokens {
+		txData := fmt.Sprintf("%s@%s@%s",
+			core.BuiltInFunctionESDTTransfer,
+			hex.EncodeToString([]byte(token.Identifier)),
+			hex.EncodeToString(token.Value.Bytes()),
+		)
+
+		genTx := &data.Transaction{
+			Nonce:    firstNonce + uint64(idx),
+			Value:    "0",
+			Receiver: receiver,
+			Sender:   senderPk,
+			Data:     []byte(txData),
+			ChainID:  networkConfig.Config.ChainID,
+			Version:  networkConfig.Config.MinTransactionVersion,
+			GasPrice: networkConfig.Config.MinGasPrice,
+			GasLimit: fp.esdtTransferGas,
+		}
+
+		signedTx, err := fp.getSignedTx(genTx, senderSk)
+		if err != nil {
+			return nil, err
+		}
+
+		txs = append(txs, signedTx)
+	}
+
+	return txs, nil
+}
+
 func (fp *FaucetProcessor) getSignedTx(tx *data.Transaction, privKey crypto.PrivateKey) (*data.Transaction, error) {
 	marshalizedTxBeforeSigning, err := fp.marshalTxForSigning(tx)
 	if err != nil {
@@ -1,14 +1,18 @@
 package process_test
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"math/big"
+	"sync"
 	"testing"
 
 	"github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
@@ -24,6 +28,13 @@ func TestNewFaucetProcessor_NilBaseProcessorShouldErr(t *testing.T) {
 		&mock.PrivateKeysLoaderStub{},
 		big.NewInt(1),
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetPayoutsLimiterStub{},
+		nil,
+		0,
+		&mock.AccountProviderStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionStatusProviderStub{},
+		&mock.PoolNonceProviderStub{},
 	)
 
 	assert.Nil(t, fp)
@@ -38,6 +49,13 @@ func TestNewFaucetProcessor_NilPrivateKeysLoaderShouldErr(t *testing.T) {
 		nil,
 		big.NewInt(1),
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetPayoutsLimiterStub{},
+		nil,
+		0,
+		&mock.AccountProviderStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionStatusProviderStub{},
+		&mock.PoolNonceProviderStub{},
 	)
 
 	assert.Nil(t, fp)
@@ -52,6 +70,13 @@ func TestNewFaucetProcessor_NilDefaultFaucetValueShouldErr(t *testing.T) {
 		&mock.PrivateKeysLoaderStub{},
 		nil,
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetPayoutsLimiterStub{},
+		nil,
+		0,
+		&mock.AccountProviderStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionStatusProviderStub{},
+		&mock.PoolNonceProviderStub{},
 	)
 
 	assert.Nil(t, fp)
@@ -66,6 +91,13 @@ func TestNewFaucetProcessor_ZeroDefaultFaucetValueShouldErr(t *testing.T) {
 		&mock.PrivateKeysLoaderStub{},
 		big.NewInt(0),
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetPayoutsLimiterStub{},
+		nil,
+		0,
+		&mock.AccountProviderStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionStatusProviderStub{},
+		&mock.PoolNonceProviderStub{},
 	)
 
 	assert.Nil(t, fp)
@@ -80,6 +112,13 @@ func TestNewFaucetProcessor_NegativeDefaultFaucetValueShouldErr(t *testing.T) {
 		&mock.PrivateKeysLoaderStub{},
 		big.NewInt(-1),
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetPayoutsLimiterStub{},
+		nil,
+		0,
+		&mock.AccountProviderStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionStatusProviderStub{},
+		&mock.PoolNonceProviderStub{},
 	)
 
 	assert.Nil(t, fp)
@@ -94,6 +133,13 @@ func TestNewFaucetProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 		&mock.PrivateKeysLoaderStub{},
 		big.NewInt(10),
 		nil,
+		&mock.FaucetPayoutsLimiterStub{},
+		nil,
+		0,
+		&mock.AccountProviderStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionStatusProviderStub{},
+		&mock.PoolNonceProviderStub{},
 	)
 
 	assert.Nil(t, fp)
@@ -112,6 +158,13 @@ func TestNewFaucetProcessor_EmptyAccMapShouldErr(t *testing.T) {
 		},
 		big.NewInt(1),
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetPayoutsLimiterStub{},
+		nil,
+		0,
+		&mock.AccountProviderStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionStatusProviderStub{},
+		&mock.PoolNonceProviderStub{},
 	)
 
 	assert.Nil(t, fp)
@@ -133,6 +186,13 @@ func TestNewFaucetProcessor_OkValsShouldWork(t *testing.T) {
 		},
 		big.NewInt(1),
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetPayoutsLimiterStub{},
+		nil,
+		0,
+		&mock.AccountProviderStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionStatusProviderStub{},
+		&mock.PoolNonceProviderStub{},
 	)
 
 	assert.NotNil(t, fp)
@@ -159,6 +219,13 @@ func TestFaucetProcessor_SenderDetailsFromPemWrongReceiverHexShouldErr(t *testin
 		},
 		big.NewInt(1),
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetPayoutsLimiterStub{},
+		nil,
+		0,
+		&mock.AccountProviderStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionStatusProviderStub{},
+		&mock.PoolNonceProviderStub{},
 	)
 
 	sk, pkHex, err := fp.SenderDetailsFromPem(receiver)
@@ -188,6 +255,13 @@ func TestFaucetProcessor_SenderDetailsFromPemShardIdComputationWrongShouldErr(t
 		},
 		big.NewInt(1),
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetPayoutsLimiterStub{},
+		nil,
+		0,
+		&mock.AccountProviderStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionStatusProviderStub{},
+		&mock.PoolNonceProviderStub{},
 	)
 
 	sk, pkHex, err := fp.SenderDetailsFromPem(receiver)
@@ -216,6 +290,13 @@ func TestFaucetProcessor_SenderDetailsFromPemComputedShardIdNotFoundInAccountsSh
 		},
 		big.NewInt(1),
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetPayoutsLimiterStub{},
+		nil,
+		0,
+		&mock.AccountProviderStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionStatusProviderStub{},
+		&mock.PoolNonceProviderStub{},
 	)
 
 	sk, pkHex, err := fp.SenderDetailsFromPem(receiver)
@@ -245,6 +326,13 @@ func TestFaucetProcessor_SenderDetailsFromPemShouldWork(t *testing.T) {
 		},
 		big.NewInt(1),
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetPayoutsLimiterStub{},
+		nil,
+		0,
+		&mock.AccountProviderStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionStatusProviderStub{},
+		&mock.PoolNonceProviderStub{},
 	)
 
 	sk, pkHex, err := fp.SenderDetailsFromPem(receiver)
@@ -278,6 +366,13 @@ func TestFaucetProcessor_GenerateTxForSendUserFundsNilFaucetValueShouldUseDefaul
 		},
 		defaultFaucetValue,
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetPayoutsLimiterStub{},
+		nil,
+		0,
+		&mock.AccountProviderStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionStatusProviderStub{},
+		&mock.PoolNonceProviderStub{},
 	)
 
 	tx, err := fp.GenerateTxForSendUserFunds(senderSk, senderHexPk, senderNonce, receiver, nil, &data.NetworkConfig{})
@@ -313,6 +408,13 @@ func TestFaucetProcessor_GenerateTxForSendUserFundsShouldWork(t *testing.T) {
 		},
 		defaultFaucetValue,
 		&mock.PubKeyConverterMock{},
+		&mock.FaucetPayoutsLimiterStub{},
+		nil,
+		0,
+		&mock.AccountProviderStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionStatusProviderStub{},
+		&mock.PoolNonceProviderStub{},
 	)
 
 	tx, err := fp.GenerateTxForSendUserFunds(senderSk, senderHexPk, senderNonce, receiver, faucetValue, &data.NetworkConfig{})
@@ -322,6 +424,197 @@ func TestFaucetProcessor_GenerateTxForSendUserFundsShouldWork(t *testing.T) {
 	assert.Equal(t, faucetValue.String(), tx.Value)
 }
 
+func TestNewFaucetProcessor_NilPayoutsLimiterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fp, err := process.NewFaucetProcessor(
+		&mock.ProcessorStub{},
+		&mock.PrivateKeysLoaderStub{},
+		big.NewInt(1),
+		&mock.PubKeyConverterMock{},
+		nil,
+		nil,
+		0,
+		&mock.AccountProviderStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionStatusProviderStub{},
+		&mock.PoolNonceProviderStub{},
+	)
+
+	assert.Nil(t, fp)
+	assert.Equal(t, process.ErrNilFaucetPayoutsLimiter, err)
+}
+
+func TestNewFaucetProcessor_InvalidTokenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fp, err := process.NewFaucetProcessor(
+		&mock.ProcessorStub{},
+		&mock.PrivateKeysLoaderStub{},
+		big.NewInt(1),
+		&mock.PubKeyConverterMock{},
+		&mock.FaucetPayoutsLimiterStub{},
+		[]*data.FaucetToken{{Identifier: "TKN-abcdef", Value: big.NewInt(0)}},
+		0,
+		&mock.AccountProviderStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionStatusProviderStub{},
+		&mock.PoolNonceProviderStub{},
+	)
+
+	assert.Nil(t, fp)
+	assert.True(t, errors.Is(err, process.ErrInvalidFaucetToken))
+}
+
+func TestNewFaucetProcessor_NilDependenciesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	createFaucetProcessor := func(
+		accountProvider process.AccountProvider,
+		networkConfigProvider process.NetworkConfigProvider,
+		txSender process.TransactionSender,
+		poolNonceProvider process.PoolNonceProvider,
+	) (*process.FaucetProcessor, error) {
+		return process.NewFaucetProcessor(
+			&mock.ProcessorStub{},
+			&mock.PrivateKeysLoaderStub{},
+			big.NewInt(1),
+			&mock.PubKeyConverterMock{},
+			&mock.FaucetPayoutsLimiterStub{},
+			nil,
+			0,
+			accountProvider,
+			networkConfigProvider,
+			txSender,
+			poolNonceProvider,
+		)
+	}
+
+	fp, err := createFaucetProcessor(nil, &mock.NetworkConfigProviderStub{}, &mock.TransactionStatusProviderStub{}, &mock.PoolNonceProviderStub{})
+	assert.Nil(t, fp)
+	assert.Equal(t, process.ErrNilAccountProvider, err)
+
+	fp, err = createFaucetProcessor(&mock.AccountProviderStub{}, nil, &mock.TransactionStatusProviderStub{}, &mock.PoolNonceProviderStub{})
+	assert.Nil(t, fp)
+	assert.Equal(t, process.ErrNilNetworkConfigProvider, err)
+
+	fp, err = createFaucetProcessor(&mock.AccountProviderStub{}, &mock.NetworkConfigProviderStub{}, nil, &mock.PoolNonceProviderStub{})
+	assert.Nil(t, fp)
+	assert.Equal(t, process.ErrNilTransactionSender, err)
+
+	fp, err = createFaucetProcessor(&mock.AccountProviderStub{}, &mock.NetworkConfigProviderStub{}, &mock.TransactionStatusProviderStub{}, nil)
+	assert.Nil(t, fp)
+	assert.Equal(t, process.ErrNilPoolNonceProvider, err)
+}
+
+func createFaucetProcessorWithTokens(
+	payoutsLimiter process.FaucetPayoutsLimiter,
+	defaultFaucetValue *big.Int,
+	tokens []*data.FaucetToken,
+) *process.FaucetProcessor {
+	fp, _ := process.NewFaucetProcessor(
+		&mock.ProcessorStub{},
+		&mock.PrivateKeysLoaderStub{
+			PrivateKeysByShardCalled: func() (map[uint32][]crypto.PrivateKey, error) {
+				return map[uint32][]crypto.PrivateKey{0: {getPrivKey()}}, nil
+			},
+		},
+		defaultFaucetValue,
+		&mock.PubKeyConverterMock{},
+		payoutsLimiter,
+		tokens,
+		500000,
+		&mock.AccountProviderStub{},
+		&mock.NetworkConfigProviderStub{},
+		&mock.TransactionStatusProviderStub{},
+		&mock.PoolNonceProviderStub{},
+	)
+
+	return fp
+}
+
+func TestFaucetProcessor_ReservePayout(t *testing.T) {
+	t.Parallel()
+
+	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+	tokens := []*data.FaucetToken{{Identifier: "TKN-abcdef", Value: big.NewInt(500)}}
+
+	t.Run("value higher than the default one should error", func(t *testing.T) {
+		t.Parallel()
+
+		fp := createFaucetProcessorWithTokens(&mock.FaucetPayoutsLimiterStub{}, big.NewInt(100), tokens)
+		payout, err := fp.ReservePayout(receiver, "1.1.1.1", big.NewInt(101))
+		assert.Nil(t, payout)
+		assert.True(t, errors.Is(err, process.ErrFaucetValueTooHigh))
+	})
+	t.Run("limiter error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		fp := createFaucetProcessorWithTokens(&mock.FaucetPayoutsLimiterStub{
+			ReserveCalled: func(payout *data.FaucetPayout) error {
+				return expectedErr
+			},
+		}, big.NewInt(100), tokens)
+		payout, err := fp.ReservePayout(receiver, "1.1.1.1", nil)
+		assert.Nil(t, payout)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should reserve the default EGLD value and the configured tokens", func(t *testing.T) {
+		t.Parallel()
+
+		var reservedPayout *data.FaucetPayout
+		fp := createFaucetProcessorWithTokens(&mock.FaucetPayoutsLimiterStub{
+			ReserveCalled: func(payout *data.FaucetPayout) error {
+				reservedPayout = payout
+				return nil
+			},
+		}, big.NewInt(100), tokens)
+		payout, err := fp.ReservePayout(receiver, "1.1.1.1", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, reservedPayout, payout)
+		assert.Equal(t, receiver, payout.Receiver)
+		assert.Equal(t, "1.1.1.1", payout.ClientIP)
+		assert.Equal(t, map[string]*big.Int{
+			data.EGLDTokenIdentifier: big.NewInt(100),
+			"TKN-abcdef":             big.NewInt(500),
+		}, payout.Amounts)
+	})
+}
+
+func TestFaucetProcessor_GenerateESDTTxsForSendUserFunds(t *testing.T) {
+	t.Parallel()
+
+	senderSk := getPrivKey()
+	senderHexPk := hexPubKeyFromSk(senderSk)
+	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+	tokens := []*data.FaucetToken{
+		{Identifier: "TKN-abcdef", Value: big.NewInt(500)},
+		{Identifier: "USDC-123456", Value: big.NewInt(1000000)},
+	}
+	networkConfig := &data.NetworkConfig{}
+	networkConfig.Config.ChainID = "D"
+	networkConfig.Config.MinGasPrice = 1000000000
+
+	fp := createFaucetProcessorWithTokens(&mock.FaucetPayoutsLimiterStub{}, big.NewInt(100), tokens)
+	txs, err := fp.GenerateESDTTxsForSendUserFunds(senderSk, senderHexPk, 7, receiver, networkConfig)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(txs))
+
+	assert.Equal(t, uint64(7), txs[0].Nonce)
+	assert.Equal(t, "ESDTTransfer@544b4e2d616263646566@01f4", string(txs[0].Data))
+	assert.Equal(t, uint64(8), txs[1].Nonce)
+	assert.Equal(t, "ESDTTransfer@555344432d313233343536@0f4240", string(txs[1].Data))
+	for _, tx := range txs {
+		assert.Equal(t, "0", tx.Value)
+		assert.Equal(t, senderHexPk, tx.Sender)
+		assert.Equal(t, receiver, tx.Receiver)
+		assert.Equal(t, uint64(500000), tx.GasLimit)
+		assert.Equal(t, "D", tx.ChainID)
+		assert.NotEmpty(t, tx.Signature)
+	}
+}
+
 func getPrivKey() crypto.PrivateKey {
 	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
 	sk, _ := keyGen.GeneratePair()
@@ -336,3 +629,270 @@ func hexPubKeyFromSk(sk crypto.PrivateKey) string {
 
 	return senderPkHex
 }
+
+func createFaucetProcessorForSendUserFunds(
+	payoutsLimiter process.FaucetPayoutsLimiter,
+	txSender process.TransactionSender,
+	poolNonceProvider process.PoolNonceProvider,
+) *process.FaucetProcessor {
+	fp, _ := process.NewFaucetProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return 0, nil
+			},
+		},
+		&mock.PrivateKeysLoaderStub{
+			PrivateKeysByShardCalled: func() (map[uint32][]crypto.PrivateKey, error) {
+				return map[uint32][]crypto.PrivateKey{0: {getPrivKey()}}, nil
+			},
+		},
+		big.NewInt(100),
+		&mock.PubKeyConverterMock{},
+		payoutsLimiter,
+		[]*data.FaucetToken{{Identifier: "TKN-abcdef", Value: big.NewInt(500)}},
+		500000,
+		&mock.AccountProviderStub{
+			GetAccountCalled: func(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+				return &data.AccountModel{Account: data.Account{Nonce: 5}}, nil
+			},
+		},
+		&mock.NetworkConfigProviderStub{
+			GetNetworkConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+				return &data.GenericAPIResponse{
+					Data: map[string]interface{}{
+						"config": map[string]interface{}{
+							"erd_chain_id":                "chainID",
+							"erd_min_transaction_version": 1,
+						},
+					},
+				}, nil
+			},
+		},
+		txSender,
+		poolNonceProvider,
+	)
+
+	return fp
+}
+
+func TestFaucetProcessor_SendUserFunds(t *testing.T) {
+	t.Parallel()
+
+	receiver := "05702a5fd947a9ddb861ce7ffebfea86c2ca8906df3065ae295f283477ae4e43"
+	notInPoolProvider := &mock.PoolNonceProviderStub{
+		GetLastPoolNonceForSenderCalled: func(sender string) (uint64, error) {
+			return 0, errors.New("not in pool")
+		},
+	}
+
+	t.Run("should send the EGLD and ESDT transactions and confirm the payout", func(t *testing.T) {
+		t.Parallel()
+
+		var confirmedPayout *data.FaucetPayout
+		payoutsLimiter := &mock.FaucetPayoutsLimiterStub{
+			ConfirmCalled: func(payout *data.FaucetPayout) error {
+				confirmedPayout = payout
+				return nil
+			},
+			CancelCalled: func(payout *data.FaucetPayout) {
+				assert.Fail(t, "should have not cancelled the payout")
+			},
+		}
+		sentNonces := make([]uint64, 0)
+		txSender := &mock.TransactionStatusProviderStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				sentNonces = append(sentNonces, tx.Nonce)
+				return 0, fmt.Sprintf("hash%d", tx.Nonce), nil
+			},
+		}
+		fp := createFaucetProcessorForSendUserFunds(payoutsLimiter, txSender, notInPoolProvider)
+
+		txHashes, err := fp.SendUserFunds(context.Background(), receiver, nil, "1.1.1.1")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"hash5", "hash6"}, txHashes)
+		assert.Equal(t, []uint64{5, 6}, sentNonces)
+		assert.Equal(t, txHashes, confirmedPayout.TxHashes)
+		assert.Equal(t, "1.1.1.1", confirmedPayout.ClientIP)
+	})
+	t.Run("reserve error should not send transactions", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		payoutsLimiter := &mock.FaucetPayoutsLimiterStub{
+			ReserveCalled: func(payout *data.FaucetPayout) error {
+				return expectedErr
+			},
+		}
+		txSender := &mock.TransactionStatusProviderStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				assert.Fail(t, "should have not been called")
+				return 0, "", nil
+			},
+		}
+		fp := createFaucetProcessorForSendUserFunds(payoutsLimiter, txSender, notInPoolProvider)
+
+		txHashes, err := fp.SendUserFunds(context.Background(), receiver, nil, "1.1.1.1")
+		assert.Nil(t, txHashes)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("send error of the first transaction should cancel the payout", func(t *testing.T) {
+		t.Parallel()
+
+		numCancelled := 0
+		payoutsLimiter := &mock.FaucetPayoutsLimiterStub{
+			ConfirmCalled: func(payout *data.FaucetPayout) error {
+				assert.Fail(t, "should have not confirmed the payout")
+				return nil
+			},
+			CancelCalled: func(payout *data.FaucetPayout) {
+				numCancelled++
+			},
+		}
+		expectedErr := errors.New("expected error")
+		txSender := &mock.TransactionStatusProviderStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				return 0, "", expectedErr
+			},
+		}
+		fp := createFaucetProcessorForSendUserFunds(payoutsLimiter, txSender, notInPoolProvider)
+
+		txHashes, err := fp.SendUserFunds(context.Background(), receiver, nil, "1.1.1.1")
+		assert.Nil(t, txHashes)
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, numCancelled)
+	})
+	t.Run("send error of an ESDT transaction should confirm the sent ones", func(t *testing.T) {
+		t.Parallel()
+
+		var confirmedPayout *data.FaucetPayout
+		payoutsLimiter := &mock.FaucetPayoutsLimiterStub{
+			ConfirmCalled: func(payout *data.FaucetPayout) error {
+				confirmedPayout = payout
+				return nil
+			},
+		}
+		expectedErr := errors.New("expected error")
+		txSender := &mock.TransactionStatusProviderStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				if tx.Nonce > 5 {
+					return 0, "", expectedErr
+				}
+				return 0, "hash", nil
+			},
+		}
+		fp := createFaucetProcessorForSendUserFunds(payoutsLimiter, txSender, notInPoolProvider)
+
+		txHashes, err := fp.SendUserFunds(context.Background(), receiver, nil, "1.1.1.1")
+		assert.Equal(t, []string{"hash"}, txHashes)
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, txHashes, confirmedPayout.TxHashes)
+	})
+	t.Run("consecutive payouts should not reuse the nonces of the sender", func(t *testing.T) {
+		t.Parallel()
+
+		mutSentNonces := sync.Mutex{}
+		sentNonces := make(map[uint64]struct{})
+		txSender := &mock.TransactionStatusProviderStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				mutSentNonces.Lock()
+				defer mutSentNonces.Unlock()
+
+				_, isUsed := sentNonces[tx.Nonce]
+				assert.False(t, isUsed)
+				sentNonces[tx.Nonce] = struct{}{}
+
+				return 0, fmt.Sprintf("hash%d", tx.Nonce), nil
+			},
+		}
+		fp := createFaucetProcessorForSendUserFunds(&mock.FaucetPayoutsLimiterStub{}, txSender, notInPoolProvider)
+
+		numPayouts := 5
+		wg := sync.WaitGroup{}
+		wg.Add(numPayouts)
+		for i := 0; i < numPayouts; i++ {
+			go func() {
+				defer wg.Done()
+
+				_, err := fp.SendUserFunds(context.Background(), receiver, nil, "1.1.1.1")
+				assert.Nil(t, err)
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, 2*numPayouts, len(sentNonces))
+		for nonce := uint64(5); nonce < uint64(5+2*numPayouts); nonce++ {
+			assert.Contains(t, sentNonces, nonce)
+		}
+	})
+	t.Run("dropped transactions should resync the nonce of the sender", func(t *testing.T) {
+		t.Parallel()
+
+		sentNonces := make([]uint64, 0)
+		txSender := &mock.TransactionStatusProviderStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				sentNonces = append(sentNonces, tx.Nonce)
+				return 0, "hash", nil
+			},
+		}
+		fp := createFaucetProcessorForSendUserFunds(&mock.FaucetPayoutsLimiterStub{}, txSender, notInPoolProvider)
+		fp.SetNonceValidity(0)
+
+		// the transactions of the first payout are neither executed, nor in pool
+		_, err := fp.SendUserFunds(context.Background(), receiver, nil, "1.1.1.1")
+		assert.Nil(t, err)
+		_, err = fp.SendUserFunds(context.Background(), receiver, nil, "1.1.1.1")
+		assert.Nil(t, err)
+		assert.Equal(t, []uint64{5, 6, 5, 6}, sentNonces)
+	})
+	t.Run("transactions in pool should advance the nonce of the sender", func(t *testing.T) {
+		t.Parallel()
+
+		sentNonces := make([]uint64, 0)
+		txSender := &mock.TransactionStatusProviderStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				sentNonces = append(sentNonces, tx.Nonce)
+				return 0, "hash", nil
+			},
+		}
+		poolNonceProvider := &mock.PoolNonceProviderStub{
+			GetLastPoolNonceForSenderCalled: func(sender string) (uint64, error) {
+				return 9, nil
+			},
+		}
+		fp := createFaucetProcessorForSendUserFunds(&mock.FaucetPayoutsLimiterStub{}, txSender, poolNonceProvider)
+
+		_, err := fp.SendUserFunds(context.Background(), receiver, nil, "1.1.1.1")
+		assert.Nil(t, err)
+		assert.Equal(t, []uint64{10, 11}, sentNonces)
+	})
+	t.Run("send error should resync the nonce of the sender", func(t *testing.T) {
+		t.Parallel()
+
+		sentNonces := make([]uint64, 0)
+		txSender := &mock.TransactionStatusProviderStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				sentNonces = append(sentNonces, tx.Nonce)
+				if len(sentNonces) == 2 {
+					return 0, "", errors.New("expected error")
+				}
+				return 0, "hash", nil
+			},
+		}
+		lastPoolNonce := uint64(4)
+		poolNonceProvider := &mock.PoolNonceProviderStub{
+			GetLastPoolNonceForSenderCalled: func(sender string) (uint64, error) {
+				return lastPoolNonce, nil
+			},
+		}
+		fp := createFaucetProcessorForSendUserFunds(&mock.FaucetPayoutsLimiterStub{}, txSender, poolNonceProvider)
+
+		_, err := fp.SendUserFunds(context.Background(), receiver, nil, "1.1.1.1")
+		assert.NotNil(t, err)
+
+		// only the EGLD transaction of the first payout reached the pool
+		lastPoolNonce = 5
+		_, err = fp.SendUserFunds(context.Background(), receiver, nil, "1.1.1.1")
+		assert.Nil(t, err)
+		assert.Equal(t, []uint64{5, 6, 6, 7}, sentNonces)
+	})
+}
@@ -0,0 +1,167 @@
+package process
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// FinalityTracker periodically fetches the node status metrics from the observers and keeps track of the highest
+// final nonces, so that responses for finalized data can be safely cached
+type FinalityTracker struct {
+	proc            Processor
+	refreshInterval time.Duration
+	cancelFunc      func()
+
+	mutNonces              sync.RWMutex
+	highestFinalNonces     map[uint32]uint64
+	latestHyperblockNonce  uint64
+	hasHyperblockNonceInfo bool
+}
+
+// NewFinalityTracker creates a new instance of FinalityTracker
+func NewFinalityTracker(proc Processor, refreshInterval time.Duration) (*FinalityTracker, error) {
+	if check.IfNil(proc) {
+		return nil, ErrNilCoreProcessor
+	}
+	if refreshInterval <= 0 {
+		return nil, ErrInvalidFinalityRefreshInterval
+	}
+
+	return &FinalityTracker{
+		proc:               proc,
+		refreshInterval:    refreshInterval,
+		highestFinalNonces: make(map[uint32]uint64),
+	}, nil
+}
+
+// StartFinalityChecks will start the periodic refresh of the finality information
+func (ft *FinalityTracker) StartFinalityChecks() {
+	if ft.cancelFunc != nil {
+		log.Error("FinalityTracker - finality checks already started")
+		return
+	}
+
+	var ctx context.Context
+	ctx, ft.cancelFunc = context.WithCancel(context.Background())
+
+	go func(ctx context.Context) {
+		timer := time.NewTimer(ft.refreshInterval)
+		defer timer.Stop()
+
+		ft.updateFinalityInfo(ctx)
+
+		for {
+			timer.Reset(ft.refreshInterval)
+
+			select {
+			case <-timer.C:
+				ft.updateFinalityInfo(ctx)
+			case <-ctx.Done():
+				log.Debug("finishing FinalityTracker checks...")
+				return
+			}
+		}
+	}(ctx)
+}
+
+func (ft *FinalityTracker) updateFinalityInfo(ctx context.Context) {
+	highestFinalNonces := make(map[uint32]uint64)
+	hyperblockNonces := make([]uint64, 0)
+	hasHyperblockNonceInfo := true
+	for _, shardID := range ft.proc.GetShardIDs() {
+		finalNonce, syncedNonce, err := ft.getNoncesForShard(ctx, shardID)
+		if err != nil {
+			log.Debug("FinalityTracker: cannot fetch finality info", "shard ID", shardID, "error", err.Error())
+			hasHyperblockNonceInfo = false
+			continue
+		}
+
+		highestFinalNonces[shardID] = finalNonce
+		hyperblockNonces = append(hyperblockNonces, syncedNonce)
+	}
+
+	ft.mutNonces.Lock()
+	ft.highestFinalNonces = highestFinalNonces
+	ft.hasHyperblockNonceInfo = hasHyperblockNonceInfo && len(hyperblockNonces) > 0
+	ft.latestHyperblockNonce = 0
+	if ft.hasHyperblockNonceInfo {
+		ft.latestHyperblockNonce = getMinNonce(hyperblockNonces)
+	}
+	ft.mutNonces.Unlock()
+}
+
+func (ft *FinalityTracker) getNoncesForShard(ctx context.Context, shardID uint32) (uint64, uint64, error) {
+	nodeStatusResponse, err := getNodeStatusMetricsFromObservers(ctx, ft.proc, shardID)
+	if err != nil {
+		return 0, 0, err
+	}
+	if nodeStatusResponse.Error != "" {
+		return 0, 0, errors.New(nodeStatusResponse.Error)
+	}
+
+	finalNonceMetric, ok := getMetric(nodeStatusResponse.Data, MetricHighestFinalNonce)
+	if !ok {
+		return 0, 0, ErrCannotParseNodeStatusMetrics
+	}
+
+	var syncedNonce uint64
+	if shardID == core.MetachainShardId {
+		syncedNonce, ok = getNonceFromMetachainStatus(nodeStatusResponse.Data)
+	} else {
+		syncedNonce, ok = getNonceFromShardStatus(nodeStatusResponse.Data)
+	}
+	if !ok {
+		return 0, 0, ErrCannotParseNodeStatusMetrics
+	}
+
+	return getUint(finalNonceMetric), syncedNonce, nil
+}
+
+// IsBlockFinal returns true if the block with the provided nonce is final in the given shard
+func (ft *FinalityTracker) IsBlockFinal(shardID uint32, nonce uint64) bool {
+	ft.mutNonces.RLock()
+	defer ft.mutNonces.RUnlock()
+
+	highestFinalNonce, ok := ft.highestFinalNonces[shardID]
+	if !ok {
+		return false
+	}
+
+	return nonce <= highestFinalNonce
+}
+
+// IsHyperblockFinal returns true if the hyperblock with the provided nonce is final and was notarized by all shards
+func (ft *FinalityTracker) IsHyperblockFinal(nonce uint64) bool {
+	ft.mutNonces.RLock()
+	defer ft.mutNonces.RUnlock()
+
+	if !ft.hasHyperblockNonceInfo {
+		return false
+	}
+
+	highestFinalMetaNonce, ok := ft.highestFinalNonces[core.MetachainShardId]
+	if !ok {
+		return false
+	}
+
+	return nonce <= ft.latestHyperblockNonce && nonce <= highestFinalMetaNonce
+}
+
+// Close will handle the closing of the finality checks
+func (ft *FinalityTracker) Close() error {
+	if ft.cancelFunc != nil {
+		ft.cancelFunc()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ft *FinalityTracker) IsInterfaceNil() bool {
+	return ft == nil
+}
@@ -0,0 +1,120 @@
+package process_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createProcessorStubForFinality(metricsPerShard map[uint32]map[string]interface{}) *mock.ProcessorStub {
+	return &mock.ProcessorStub{
+		GetShardIDsCalled: func() []uint32 {
+			return []uint32{0, core.MetachainShardId}
+		},
+		GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: fmt.Sprintf("%d", shardId), ShardId: shardId}}, nil
+		},
+		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			shardID, _ := strconv.ParseUint(address, 10, 32)
+			metrics, ok := metricsPerShard[uint32(shardID)]
+			if !ok {
+				return http.StatusInternalServerError, errors.New("observer offline")
+			}
+
+			response := value.(*data.GenericAPIResponse)
+			response.Data = map[string]interface{}{
+				"metrics": metrics,
+			}
+
+			return http.StatusOK, nil
+		},
+	}
+}
+
+func TestNewFinalityTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil processor should error", func(t *testing.T) {
+		t.Parallel()
+
+		ft, err := process.NewFinalityTracker(nil, time.Second)
+		require.Nil(t, ft)
+		require.Equal(t, process.ErrNilCoreProcessor, err)
+	})
+	t.Run("invalid refresh interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		ft, err := process.NewFinalityTracker(&mock.ProcessorStub{}, 0)
+		require.Nil(t, ft)
+		require.Equal(t, process.ErrInvalidFinalityRefreshInterval, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ft, err := process.NewFinalityTracker(&mock.ProcessorStub{}, time.Second)
+		require.NoError(t, err)
+		require.False(t, check.IfNil(ft))
+		require.Nil(t, ft.Close())
+	})
+}
+
+func TestFinalityTracker_NoInfoShouldNotConsiderAnythingFinal(t *testing.T) {
+	t.Parallel()
+
+	ft, _ := process.NewFinalityTracker(&mock.ProcessorStub{}, time.Second)
+	require.False(t, ft.IsBlockFinal(0, 0))
+	require.False(t, ft.IsHyperblockFinal(0))
+}
+
+func TestFinalityTracker_UpdateFinalityInfo(t *testing.T) {
+	t.Parallel()
+
+	metricsPerShard := map[uint32]map[string]interface{}{
+		0: {
+			process.MetricHighestFinalNonce:     float64(100),
+			process.MetricCrossCheckBlockHeight: "meta 48",
+		},
+		core.MetachainShardId: {
+			process.MetricHighestFinalNonce: float64(49),
+			process.MetricNonce:             float64(51),
+		},
+	}
+	ft, _ := process.NewFinalityTracker(createProcessorStubForFinality(metricsPerShard), time.Second)
+	ft.UpdateFinalityInfo()
+
+	require.True(t, ft.IsBlockFinal(0, 100))
+	require.False(t, ft.IsBlockFinal(0, 101))
+	require.True(t, ft.IsBlockFinal(core.MetachainShardId, 49))
+	require.False(t, ft.IsBlockFinal(core.MetachainShardId, 50))
+	require.False(t, ft.IsBlockFinal(1, 1))
+
+	require.True(t, ft.IsHyperblockFinal(48))
+	require.False(t, ft.IsHyperblockFinal(49))
+}
+
+func TestFinalityTracker_UpdateFinalityInfoWithMissingShardShouldNotConsiderHyperblocksFinal(t *testing.T) {
+	t.Parallel()
+
+	metricsPerShard := map[uint32]map[string]interface{}{
+		core.MetachainShardId: {
+			process.MetricHighestFinalNonce: float64(49),
+			process.MetricNonce:             float64(51),
+		},
+	}
+	ft, _ := process.NewFinalityTracker(createProcessorStubForFinality(metricsPerShard), time.Second)
+	ft.UpdateFinalityInfo()
+
+	require.True(t, ft.IsBlockFinal(core.MetachainShardId, 49))
+	require.False(t, ft.IsBlockFinal(0, 1))
+	require.False(t, ft.IsHyperblockFinal(1))
+}
@@ -0,0 +1,113 @@
+package process
+
+import (
+	"context"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type hedgedRequestResult struct {
+	response interface{}
+	canRetry bool
+	err      error
+}
+
+// hedgedRequestsHandler sends a request to the first observer and, if no answer is received within the configured
+// delay, sends the same request to the next observer. The first successful answer is used and the requests still in
+// flight are cancelled. A failed request triggers the next observer immediately
+type hedgedRequestsHandler struct {
+	delay time.Duration
+}
+
+// NewHedgedRequestsHandler returns a new instance of hedgedRequestsHandler
+func NewHedgedRequestsHandler(delay time.Duration) (*hedgedRequestsHandler, error) {
+	if delay <= 0 {
+		return nil, ErrInvalidHedgingDelay
+	}
+
+	return &hedgedRequestsHandler{
+		delay: delay,
+	}, nil
+}
+
+// Execute will send the request to the provided observers, in order, returning the first successful response. The
+// context provided to the request handler is derived from the provided one and is cancelled as soon as the result is known
+func (hrh *hedgedRequestsHandler) Execute(
+	ctx context.Context,
+	observers []*data.NodeData,
+	requestHandler ObserverRequestHandler,
+) (interface{}, error) {
+	if len(observers) == 0 {
+		return nil, ErrSendingRequest
+	}
+
+	requestsCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// buffered so that the requests that are still in flight when returning do not block
+	chResults := make(chan *hedgedRequestResult, len(observers))
+	nextObserverIndex := 0
+	numRequestsInFlight := 0
+	sendNextRequest := func() {
+		observer := observers[nextObserverIndex]
+		nextObserverIndex++
+		numRequestsInFlight++
+
+		go func() {
+			response, canRetry, err := requestHandler(requestsCtx, observer)
+			chResults <- &hedgedRequestResult{
+				response: response,
+				canRetry: canRetry,
+				err:      err,
+			}
+		}()
+	}
+
+	timer := time.NewTimer(hrh.delay)
+	defer timer.Stop()
+
+	sendNextRequest()
+	var lastErr error
+	for numRequestsInFlight > 0 {
+		select {
+		case <-timer.C:
+			if nextObserverIndex < len(observers) {
+				sendNextRequest()
+				timer.Reset(hrh.delay)
+			}
+		case result := <-chResults:
+			numRequestsInFlight--
+			if result.err == nil {
+				return result.response, nil
+			}
+			if !result.canRetry {
+				return nil, result.err
+			}
+
+			lastErr = result.err
+			if nextObserverIndex < len(observers) {
+				sendNextRequest()
+				resetTimer(timer, hrh.delay)
+			}
+		}
+	}
+
+	return nil, lastErr
+}
+
+func resetTimer(timer *time.Timer, duration time.Duration) {
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
+		}
+	}
+
+	timer.Reset(duration)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hrh *hedgedRequestsHandler) IsInterfaceNil() bool {
+	return hrh == nil
+}
@@ -0,0 +1,171 @@
+package process
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func createHedgingTestObservers() []*data.NodeData {
+	return []*data.NodeData{
+		{Address: "observer0", ShardId: 0},
+		{Address: "observer1", ShardId: 0},
+		{Address: "observer2", ShardId: 0},
+	}
+}
+
+func TestNewHedgedRequestsHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid delay should error", func(t *testing.T) {
+		t.Parallel()
+
+		hrh, err := NewHedgedRequestsHandler(0)
+		require.True(t, check.IfNil(hrh))
+		require.Equal(t, ErrInvalidHedgingDelay, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hrh, err := NewHedgedRequestsHandler(time.Millisecond)
+		require.NoError(t, err)
+		require.False(t, check.IfNil(hrh))
+	})
+}
+
+func TestHedgedRequestsHandler_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no observers should error", func(t *testing.T) {
+		t.Parallel()
+
+		hrh, _ := NewHedgedRequestsHandler(time.Millisecond)
+		response, err := hrh.Execute(context.Background(), nil, func(ctx context.Context, observer *data.NodeData) (interface{}, bool, error) {
+			require.Fail(t, "should have not been called")
+			return nil, false, nil
+		})
+		require.Nil(t, response)
+		require.Equal(t, ErrSendingRequest, err)
+	})
+	t.Run("fast observer should not trigger hedged requests", func(t *testing.T) {
+		t.Parallel()
+
+		hrh, _ := NewHedgedRequestsHandler(time.Second)
+		mutCalled := sync.Mutex{}
+		calledObservers := make([]string, 0)
+		response, err := hrh.Execute(context.Background(), createHedgingTestObservers(), func(ctx context.Context, observer *data.NodeData) (interface{}, bool, error) {
+			mutCalled.Lock()
+			calledObservers = append(calledObservers, observer.Address)
+			mutCalled.Unlock()
+
+			return observer.Address, false, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "observer0", response)
+
+		mutCalled.Lock()
+		require.Equal(t, []string{"observer0"}, calledObservers)
+		mutCalled.Unlock()
+	})
+	t.Run("slow observer should trigger a hedged request and be canceled", func(t *testing.T) {
+		t.Parallel()
+
+		hrh, _ := NewHedgedRequestsHandler(10 * time.Millisecond)
+		chSlowRequestCanceled := make(chan struct{})
+		response, err := hrh.Execute(context.Background(), createHedgingTestObservers(), func(ctx context.Context, observer *data.NodeData) (interface{}, bool, error) {
+			if observer.Address == "observer0" {
+				<-ctx.Done()
+				close(chSlowRequestCanceled)
+				return nil, true, ctx.Err()
+			}
+
+			return observer.Address, false, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "observer1", response)
+
+		select {
+		case <-chSlowRequestCanceled:
+		case <-time.After(time.Second):
+			require.Fail(t, "the slow request should have been canceled")
+		}
+	})
+	t.Run("failed request should immediately trigger the next observer", func(t *testing.T) {
+		t.Parallel()
+
+		hrh, _ := NewHedgedRequestsHandler(time.Hour)
+		response, err := hrh.Execute(context.Background(), createHedgingTestObservers(), func(ctx context.Context, observer *data.NodeData) (interface{}, bool, error) {
+			if observer.Address == "observer2" {
+				return observer.Address, false, nil
+			}
+
+			return nil, true, errors.New("observer down")
+		})
+		require.NoError(t, err)
+		require.Equal(t, "observer2", response)
+	})
+	t.Run("all observers failing should return the last error", func(t *testing.T) {
+		t.Parallel()
+
+		hrh, _ := NewHedgedRequestsHandler(time.Millisecond)
+		expectedErr := errors.New("observer down")
+		response, err := hrh.Execute(context.Background(), createHedgingTestObservers(), func(ctx context.Context, observer *data.NodeData) (interface{}, bool, error) {
+			return nil, true, expectedErr
+		})
+		require.Nil(t, response)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("non-retryable error should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		hrh, _ := NewHedgedRequestsHandler(time.Hour)
+		expectedErr := errors.New("bad request")
+		numCalls := 0
+		response, err := hrh.Execute(context.Background(), createHedgingTestObservers(), func(ctx context.Context, observer *data.NodeData) (interface{}, bool, error) {
+			numCalls++
+			return nil, false, expectedErr
+		})
+		require.Nil(t, response)
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, 1, numCalls)
+	})
+}
+
+func TestDisabledHedgedRequestsHandler_Execute(t *testing.T) {
+	t.Parallel()
+
+	dhrh := NewDisabledHedgedRequestsHandler()
+	require.False(t, check.IfNil(dhrh))
+
+	calledObservers := make([]string, 0)
+	response, err := dhrh.Execute(context.Background(), createHedgingTestObservers(), func(ctx context.Context, observer *data.NodeData) (interface{}, bool, error) {
+		calledObservers = append(calledObservers, observer.Address)
+		if observer.Address == "observer1" {
+			return observer.Address, false, nil
+		}
+
+		return nil, true, errors.New("observer down")
+	})
+	require.NoError(t, err)
+	require.Equal(t, "observer1", response)
+	require.Equal(t, []string{"observer0", "observer1"}, calledObservers)
+
+	response, err = dhrh.Execute(context.Background(), nil, nil)
+	require.Nil(t, response)
+	require.Equal(t, ErrSendingRequest, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	response, err = dhrh.Execute(ctx, createHedgingTestObservers(), func(ctx context.Context, observer *data.NodeData) (interface{}, bool, error) {
+		require.Fail(t, "should have not been called")
+		return nil, false, nil
+	})
+	require.Nil(t, response)
+	require.Equal(t, context.Canceled, err)
+}
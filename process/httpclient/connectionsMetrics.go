@@ -0,0 +1,120 @@
+package httpclient
+
+import (
+	"context"
+	"net"
+	"net/http/httptrace"
+	"sync"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+type dialContextHandler func(ctx context.Context, network string, address string) (net.Conn, error)
+
+// connectionsMetrics counts the connections opened by the http transports and the way they are used by the requests.
+// The connections are counted by the dialed address, while the requests are counted by the requested host
+type connectionsMetrics struct {
+	mutMetrics sync.RWMutex
+	hosts      map[string]*data.HostConnectionsMetrics
+}
+
+func newConnectionsMetrics() *connectionsMetrics {
+	return &connectionsMetrics{
+		hosts: make(map[string]*data.HostConnectionsMetrics),
+	}
+}
+
+func (cm *connectionsMetrics) update(host string, handler func(metrics *data.HostConnectionsMetrics)) {
+	cm.mutMetrics.Lock()
+	defer cm.mutMetrics.Unlock()
+
+	metrics, found := cm.hosts[host]
+	if !found {
+		metrics = &data.HostConnectionsMetrics{}
+		cm.hosts[host] = metrics
+	}
+
+	handler(metrics)
+}
+
+// wrapDialContext returns a dial handler that counts the dialed connections and keeps track of the open ones
+func (cm *connectionsMetrics) wrapDialContext(dialContext dialContextHandler) dialContextHandler {
+	return func(ctx context.Context, network string, address string) (net.Conn, error) {
+		conn, err := dialContext(ctx, network, address)
+		if err != nil {
+			cm.update(address, func(metrics *data.HostConnectionsMetrics) {
+				metrics.DialErrors++
+			})
+			return nil, err
+		}
+
+		cm.update(address, func(metrics *data.HostConnectionsMetrics) {
+			metrics.DialedConnections++
+			metrics.OpenConnections++
+		})
+
+		return &countedConn{
+			Conn: conn,
+			onClose: func() {
+				cm.update(address, func(metrics *data.HostConnectionsMetrics) {
+					metrics.OpenConnections--
+				})
+			},
+		}, nil
+	}
+}
+
+// createClientTrace returns a client trace that counts the connections reused by the requests sent to the provided host
+func (cm *connectionsMetrics) createClientTrace(host string) *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			if !info.Reused {
+				return
+			}
+
+			cm.update(host, func(metrics *data.HostConnectionsMetrics) {
+				metrics.ReusedConnections++
+			})
+		},
+	}
+}
+
+func (cm *connectionsMetrics) addInFlightRequests(host string, delta int64) {
+	cm.update(host, func(metrics *data.HostConnectionsMetrics) {
+		metrics.InFlightRequests += delta
+	})
+}
+
+func (cm *connectionsMetrics) getAll() *data.ConnectionPoolMetrics {
+	cm.mutMetrics.RLock()
+	defer cm.mutMetrics.RUnlock()
+
+	poolMetrics := &data.ConnectionPoolMetrics{
+		Hosts: make(map[string]*data.HostConnectionsMetrics, len(cm.hosts)),
+	}
+	for host, metrics := range cm.hosts {
+		metricsCopy := *metrics
+		poolMetrics.Hosts[host] = &metricsCopy
+
+		poolMetrics.Total.OpenConnections += metrics.OpenConnections
+		poolMetrics.Total.DialedConnections += metrics.DialedConnections
+		poolMetrics.Total.DialErrors += metrics.DialErrors
+		poolMetrics.Total.ReusedConnections += metrics.ReusedConnections
+		poolMetrics.Total.InFlightRequests += metrics.InFlightRequests
+	}
+
+	return poolMetrics
+}
+
+type countedConn struct {
+	net.Conn
+	closeOnce sync.Once
+	onClose   func()
+}
+
+// Close closes the underlying connection and marks it as closed, only once
+func (conn *countedConn) Close() error {
+	conn.closeOnce.Do(conn.onClose)
+
+	return conn.Conn.Close()
+}
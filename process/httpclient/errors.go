@@ -0,0 +1,18 @@
+package httpclient
+
+import "errors"
+
+// ErrInvalidRequestTimeout signals that an invalid request timeout has been provided
+var ErrInvalidRequestTimeout = errors.New("invalid request timeout")
+
+// ErrInvalidConfigValue signals that an invalid configuration value has been provided
+var ErrInvalidConfigValue = errors.New("invalid configuration value")
+
+// ErrInvalidObserverAddress signals that an invalid observer address has been provided
+var ErrInvalidObserverAddress = errors.New("invalid observer address")
+
+// ErrDuplicatedClientCertificate signals that more client certificates have been provided for the same observer
+var ErrDuplicatedClientCertificate = errors.New("duplicated client certificate")
+
+// ErrInvalidCACertificate signals that the provided CA certificate file does not contain any valid certificate
+var ErrInvalidCACertificate = errors.New("invalid CA certificate")
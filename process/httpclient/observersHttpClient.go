@@ -0,0 +1,212 @@
+package httpclient
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"sync"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+var log = logger.GetOrCreate("process/httpclient")
+
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+}
+
+// ArgsObserversHttpClient holds the arguments needed to create a new observersHttpClient
+type ArgsObserversHttpClient struct {
+	Config         config.ObserverHttpClientConfig
+	RequestTimeout time.Duration
+}
+
+// observersHttpClient is the http client used for all the requests sent to the nodes. It holds its own pool of
+// connections, so it does not alter the default http client of the process. The nodes that require a client
+// certificate get a dedicated transport, while all the other nodes share the default one
+type observersHttpClient struct {
+	client            *http.Client
+	defaultTransport  *http.Transport
+	transportsPerHost map[string]*http.Transport
+	metrics           *connectionsMetrics
+}
+
+// NewObserversHttpClient returns a new instance of observersHttpClient
+func NewObserversHttpClient(args ArgsObserversHttpClient) (*observersHttpClient, error) {
+	if args.RequestTimeout <= 0 {
+		return nil, ErrInvalidRequestTimeout
+	}
+	err := checkConfig(args.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultTLSConfig, tlsConfigsPerHost, err := createTLSConfigs(args.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	ohc := &observersHttpClient{
+		transportsPerHost: make(map[string]*http.Transport, len(tlsConfigsPerHost)),
+		metrics:           newConnectionsMetrics(),
+	}
+	ohc.defaultTransport = ohc.createTransport(args.Config, defaultTLSConfig)
+	for host, tlsConfig := range tlsConfigsPerHost {
+		ohc.transportsPerHost[host] = ohc.createTransport(args.Config, tlsConfig)
+	}
+
+	ohc.client = &http.Client{
+		Transport: &hostsRoundTripper{
+			observersHttpClient: ohc,
+		},
+		Timeout: args.RequestTimeout,
+	}
+
+	log.Debug("created observers http client",
+		"max idle conns", args.Config.MaxIdleConns,
+		"max idle conns per host", args.Config.MaxIdleConnsPerHost,
+		"max conns per host", args.Config.MaxConnsPerHost,
+		"HTTP/2 enabled", args.Config.EnableHTTP2,
+		"num client certificates", len(tlsConfigsPerHost),
+	)
+
+	return ohc, nil
+}
+
+func checkConfig(cfg config.ObserverHttpClientConfig) error {
+	values := map[string]int{
+		"MaxIdleConns":             cfg.MaxIdleConns,
+		"MaxIdleConnsPerHost":      cfg.MaxIdleConnsPerHost,
+		"MaxConnsPerHost":          cfg.MaxConnsPerHost,
+		"IdleConnTimeoutSec":       cfg.IdleConnTimeoutSec,
+		"DialTimeoutSec":           cfg.DialTimeoutSec,
+		"KeepAliveSec":             cfg.KeepAliveSec,
+		"TLSHandshakeTimeoutSec":   cfg.TLSHandshakeTimeoutSec,
+		"ResponseHeaderTimeoutSec": cfg.ResponseHeaderTimeoutSec,
+	}
+	for name, value := range values {
+		if value < 0 {
+			return fmt.Errorf("%w for %s: %d", ErrInvalidConfigValue, name, value)
+		}
+	}
+
+	return nil
+}
+
+func (ohc *observersHttpClient) createTransport(cfg config.ObserverHttpClientConfig, tlsConfig *tls.Config) *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   time.Duration(cfg.DialTimeoutSec) * time.Second,
+		KeepAlive: time.Duration(cfg.KeepAliveSec) * time.Second,
+	}
+
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           ohc.metrics.wrapDialContext(dialer.DialContext),
+		ForceAttemptHTTP2:     cfg.EnableHTTP2,
+		MaxIdleConns:          cfg.MaxIdleConns,
+		MaxIdleConnsPerHost:   cfg.MaxIdleConnsPerHost,
+		MaxConnsPerHost:       cfg.MaxConnsPerHost,
+		IdleConnTimeout:       time.Duration(cfg.IdleConnTimeoutSec) * time.Second,
+		TLSHandshakeTimeout:   time.Duration(cfg.TLSHandshakeTimeoutSec) * time.Second,
+		ResponseHeaderTimeout: time.Duration(cfg.ResponseHeaderTimeoutSec) * time.Second,
+		TLSClientConfig:       tlsConfig,
+	}
+	if !cfg.EnableHTTP2 {
+		// a non-nil empty map disables the HTTP/2 upgrade
+		transport.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+	}
+
+	return transport
+}
+
+// Do sends the request using the pooled connections
+func (ohc *observersHttpClient) Do(req *http.Request) (*http.Response, error) {
+	return ohc.client.Do(req)
+}
+
+// GetConnectionPoolMetrics returns the metrics of the connections opened towards the nodes
+func (ohc *observersHttpClient) GetConnectionPoolMetrics() *data.ConnectionPoolMetrics {
+	return ohc.metrics.getAll()
+}
+
+// Close closes all the idle connections
+func (ohc *observersHttpClient) Close() error {
+	ohc.defaultTransport.CloseIdleConnections()
+	for _, transport := range ohc.transportsPerHost {
+		transport.CloseIdleConnections()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ohc *observersHttpClient) IsInterfaceNil() bool {
+	return ohc == nil
+}
+
+func (ohc *observersHttpClient) getTransport(host string) *http.Transport {
+	transport, found := ohc.transportsPerHost[host]
+	if found {
+		return transport
+	}
+
+	return ohc.defaultTransport
+}
+
+type hostsRoundTripper struct {
+	*observersHttpClient
+}
+
+// RoundTrip sends the request on the transport of the requested host
+func (hrt *hostsRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := canonicalHost(req.URL)
+	trace := hrt.metrics.createClientTrace(host)
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), trace))
+
+	hrt.metrics.addInFlightRequests(host, 1)
+	resp, err := hrt.getTransport(host).RoundTrip(req)
+	if err != nil {
+		hrt.metrics.addInFlightRequests(host, -1)
+		return nil, err
+	}
+
+	resp.Body = &trackedBody{
+		ReadCloser: resp.Body,
+		onClose: func() {
+			hrt.metrics.addInFlightRequests(host, -1)
+		},
+	}
+
+	return resp, nil
+}
+
+type trackedBody struct {
+	io.ReadCloser
+	closeOnce sync.Once
+	onClose   func()
+}
+
+// Close closes the underlying body and marks the request as finished, only once
+func (body *trackedBody) Close() error {
+	body.closeOnce.Do(body.onClose)
+
+	return body.ReadCloser.Close()
+}
+
+// canonicalHost returns the host:port pair of the provided URL, using the default port of the scheme if missing
+func canonicalHost(u *url.URL) string {
+	port := u.Port()
+	if len(port) == 0 {
+		port = defaultPorts[u.Scheme]
+	}
+
+	return net.JoinHostPort(u.Hostname(), port)
+}
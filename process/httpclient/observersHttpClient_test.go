@@ -0,0 +1,305 @@
+package httpclient
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgs() ArgsObserversHttpClient {
+	return ArgsObserversHttpClient{
+		Config: config.ObserverHttpClientConfig{
+			MaxIdleConns:           10,
+			MaxIdleConnsPerHost:    5,
+			IdleConnTimeoutSec:     30,
+			DialTimeoutSec:         5,
+			TLSHandshakeTimeoutSec: 5,
+			EnableHTTP2:            true,
+		},
+		RequestTimeout: 5 * time.Second,
+	}
+}
+
+func writePEMFile(t *testing.T, filePath string, blockType string, bytes []byte) {
+	err := os.WriteFile(filePath, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bytes}), 0600)
+	require.Nil(t, err)
+}
+
+// createClientCertificate generates a self-signed client certificate and returns the paths of the certificate and
+// key files
+func createClientCertificate(t *testing.T) (string, string) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "proxy"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	certificateBytes, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.Nil(t, err)
+	privateKeyBytes, err := x509.MarshalECPrivateKey(privateKey)
+	require.Nil(t, err)
+
+	directory := t.TempDir()
+	certificateFile := filepath.Join(directory, "client.pem")
+	keyFile := filepath.Join(directory, "client.key")
+	writePEMFile(t, certificateFile, "CERTIFICATE", certificateBytes)
+	writePEMFile(t, keyFile, "EC PRIVATE KEY", privateKeyBytes)
+
+	return certificateFile, keyFile
+}
+
+// createMutualTLSServer starts a TLS server that requires the provided client certificate and returns it along with
+// the path of its CA certificate file
+func createMutualTLSServer(t *testing.T, clientCertificateFile string) (*httptest.Server, string) {
+	clientCertificatePEM, err := os.ReadFile(clientCertificateFile)
+	require.Nil(t, err)
+	clientCAs := x509.NewCertPool()
+	require.True(t, clientCAs.AppendCertsFromPEM(clientCertificatePEM))
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(req.Proto))
+	}))
+	server.EnableHTTP2 = true
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+
+	caCertificateFile := filepath.Join(t.TempDir(), "ca.pem")
+	writePEMFile(t, caCertificateFile, "CERTIFICATE", server.Certificate().Raw)
+
+	return server, caCertificateFile
+}
+
+func sendRequest(t *testing.T, ohc *observersHttpClient, address string) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, address, nil)
+	require.Nil(t, err)
+
+	resp, err := ohc.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	require.Nil(t, err)
+
+	return string(body), nil
+}
+
+func TestNewObserversHttpClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.RequestTimeout = 0
+		ohc, err := NewObserversHttpClient(args)
+		assert.Nil(t, ohc)
+		assert.Equal(t, ErrInvalidRequestTimeout, err)
+	})
+	t.Run("negative config value should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.Config.DialTimeoutSec = -1
+		ohc, err := NewObserversHttpClient(args)
+		assert.Nil(t, ohc)
+		assert.True(t, errors.Is(err, ErrInvalidConfigValue))
+		assert.Contains(t, err.Error(), "DialTimeoutSec")
+	})
+	t.Run("missing CA certificate file should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.Config.CACertificateFile = filepath.Join(t.TempDir(), "missing.pem")
+		ohc, err := NewObserversHttpClient(args)
+		assert.Nil(t, ohc)
+		assert.NotNil(t, err)
+	})
+	t.Run("invalid CA certificate file should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.Config.CACertificateFile = filepath.Join(t.TempDir(), "ca.pem")
+		require.Nil(t, os.WriteFile(args.Config.CACertificateFile, []byte("not a certificate"), 0600))
+		ohc, err := NewObserversHttpClient(args)
+		assert.Nil(t, ohc)
+		assert.True(t, errors.Is(err, ErrInvalidCACertificate))
+	})
+	t.Run("invalid observer address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.Config.ClientCertificates = []config.ObserverClientCertificateConfig{
+			{Address: "observer:8080"},
+		}
+		ohc, err := NewObserversHttpClient(args)
+		assert.Nil(t, ohc)
+		assert.True(t, errors.Is(err, ErrInvalidObserverAddress))
+	})
+	t.Run("duplicated client certificate should error", func(t *testing.T) {
+		t.Parallel()
+
+		certificateFile, keyFile := createClientCertificate(t)
+		args := createArgs()
+		args.Config.ClientCertificates = []config.ObserverClientCertificateConfig{
+			{Address: "https://observer:443", CertificateFile: certificateFile, KeyFile: keyFile},
+			{Address: "https://observer", CertificateFile: certificateFile, KeyFile: keyFile},
+		}
+		ohc, err := NewObserversHttpClient(args)
+		assert.Nil(t, ohc)
+		assert.True(t, errors.Is(err, ErrDuplicatedClientCertificate))
+	})
+	t.Run("invalid client certificate should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.Config.ClientCertificates = []config.ObserverClientCertificateConfig{
+			{Address: "https://observer:8080", CertificateFile: "missing.pem", KeyFile: "missing.key"},
+		}
+		ohc, err := NewObserversHttpClient(args)
+		assert.Nil(t, ohc)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		certificateFile, keyFile := createClientCertificate(t)
+		args := createArgs()
+		args.Config.ClientCertificates = []config.ObserverClientCertificateConfig{
+			{Address: "https://observer:8080", CertificateFile: certificateFile, KeyFile: keyFile},
+		}
+		ohc, err := NewObserversHttpClient(args)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(ohc))
+		assert.Equal(t, 1, len(ohc.transportsPerHost))
+		assert.NotNil(t, ohc.transportsPerHost["observer:8080"])
+	})
+}
+
+func TestObserversHttpClient_DoShouldReuseTheConnections(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	ohc, _ := NewObserversHttpClient(createArgs())
+	numRequests := 3
+	for i := 0; i < numRequests; i++ {
+		response, err := sendRequest(t, ohc, server.URL)
+		require.Nil(t, err)
+		assert.Equal(t, "ok", response)
+	}
+
+	serverURL, _ := url.Parse(server.URL)
+	poolMetrics := ohc.GetConnectionPoolMetrics()
+	hostMetrics := poolMetrics.Hosts[serverURL.Host]
+	require.NotNil(t, hostMetrics)
+	assert.Equal(t, uint64(1), hostMetrics.DialedConnections)
+	assert.Equal(t, int64(1), hostMetrics.OpenConnections)
+	assert.Equal(t, uint64(numRequests-1), hostMetrics.ReusedConnections)
+	assert.Equal(t, int64(0), hostMetrics.InFlightRequests)
+	assert.Equal(t, *hostMetrics, poolMetrics.Total)
+
+	_ = ohc.Close()
+	poolMetrics = ohc.GetConnectionPoolMetrics()
+	assert.Equal(t, int64(0), poolMetrics.Total.OpenConnections)
+}
+
+func TestObserversHttpClient_DoShouldCountTheDialErrors(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {}))
+	serverURL := server.URL
+	server.Close()
+
+	ohc, _ := NewObserversHttpClient(createArgs())
+	_, err := sendRequest(t, ohc, serverURL)
+	require.NotNil(t, err)
+
+	poolMetrics := ohc.GetConnectionPoolMetrics()
+	assert.Equal(t, uint64(1), poolMetrics.Total.DialErrors)
+	assert.Equal(t, uint64(0), poolMetrics.Total.DialedConnections)
+	assert.Equal(t, int64(0), poolMetrics.Total.InFlightRequests)
+}
+
+func TestObserversHttpClient_DoWithMutualTLS(t *testing.T) {
+	t.Parallel()
+
+	certificateFile, keyFile := createClientCertificate(t)
+	server, caCertificateFile := createMutualTLSServer(t, certificateFile)
+	t.Cleanup(server.Close)
+
+	t.Run("without client certificate should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.Config.CACertificateFile = caCertificateFile
+		ohc, _ := NewObserversHttpClient(args)
+
+		_, err := sendRequest(t, ohc, server.URL)
+		assert.NotNil(t, err)
+	})
+	t.Run("with client certificate should work over HTTP/2", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.Config.CACertificateFile = caCertificateFile
+		args.Config.ClientCertificates = []config.ObserverClientCertificateConfig{
+			{Address: server.URL, CertificateFile: certificateFile, KeyFile: keyFile},
+		}
+		ohc, _ := NewObserversHttpClient(args)
+
+		response, err := sendRequest(t, ohc, server.URL)
+		assert.Nil(t, err)
+		assert.Equal(t, "HTTP/2.0", response)
+	})
+	t.Run("with client certificate and HTTP/2 disabled should work over HTTP/1.1", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.Config.EnableHTTP2 = false
+		args.Config.CACertificateFile = caCertificateFile
+		args.Config.ClientCertificates = []config.ObserverClientCertificateConfig{
+			{Address: server.URL, CertificateFile: certificateFile, KeyFile: keyFile},
+		}
+		ohc, _ := NewObserversHttpClient(args)
+
+		response, err := sendRequest(t, ohc, server.URL)
+		assert.Nil(t, err)
+		assert.Equal(t, "HTTP/1.1", response)
+	})
+}
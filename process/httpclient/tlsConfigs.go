@@ -0,0 +1,67 @@
+package httpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+)
+
+// createTLSConfigs returns the TLS configuration shared by all the nodes and the dedicated TLS configurations of the
+// nodes that require a client certificate, mapped by their host
+func createTLSConfigs(cfg config.ObserverHttpClientConfig) (*tls.Config, map[string]*tls.Config, error) {
+	defaultTLSConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if len(cfg.CACertificateFile) > 0 {
+		rootCAs, err := loadCACertificates(cfg.CACertificateFile)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		defaultTLSConfig.RootCAs = rootCAs
+	}
+
+	tlsConfigsPerHost := make(map[string]*tls.Config, len(cfg.ClientCertificates))
+	for _, clientCertificate := range cfg.ClientCertificates {
+		observerURL, err := url.Parse(clientCertificate.Address)
+		if err != nil || len(observerURL.Host) == 0 {
+			return nil, nil, fmt.Errorf("%w: %s", ErrInvalidObserverAddress, clientCertificate.Address)
+		}
+
+		host := canonicalHost(observerURL)
+		_, exists := tlsConfigsPerHost[host]
+		if exists {
+			return nil, nil, fmt.Errorf("%w for %s", ErrDuplicatedClientCertificate, clientCertificate.Address)
+		}
+
+		certificate, err := tls.LoadX509KeyPair(clientCertificate.CertificateFile, clientCertificate.KeyFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w while loading the client certificate for %s", err, clientCertificate.Address)
+		}
+
+		tlsConfig := defaultTLSConfig.Clone()
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+		tlsConfigsPerHost[host] = tlsConfig
+	}
+
+	return defaultTLSConfig, tlsConfigsPerHost, nil
+}
+
+func loadCACertificates(filePath string) (*x509.CertPool, error) {
+	pemBytes, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(pemBytes) {
+		return nil, fmt.Errorf("%w in %s", ErrInvalidCACertificate, filePath)
+	}
+
+	return rootCAs, nil
+}
@@ -0,0 +1,292 @@
+package process
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ArgsHyperblockStreamer holds the arguments needed to create a new HyperblockStreamer
+type ArgsHyperblockStreamer struct {
+	HyperblockBuilder HyperblockBuilder
+	NonceProvider     HyperblockNonceProvider
+	PollInterval      time.Duration
+	BufferSize        int
+	MaxResumeGap      uint64
+	MaxSubscribers    int
+}
+
+// HyperblockStreamer tracks the latest fully synchronized hyperblock nonce in the background and builds each new
+// hyperblock once, keeping the most recent ones in a buffer shared by all the subscribers. Each subscriber reads the
+// hyperblocks in order, starting from the nonce it resumes from, so the hyperblocks missed while reconnecting are
+// replayed: from the buffer if still there, or built on demand otherwise
+type HyperblockStreamer struct {
+	hyperblockBuilder HyperblockBuilder
+	nonceProvider     HyperblockNonceProvider
+	pollInterval      time.Duration
+	bufferSize        uint64
+	maxResumeGap      uint64
+	maxSubscribers    int
+	cancelFunc        func()
+	closeOnce         sync.Once
+	closeChan         chan struct{}
+
+	mutState          sync.RWMutex
+	hyperblocks       map[uint64]*data.HyperblockApiResponse
+	latestNonce       uint64
+	isReady           bool
+	newHyperblockChan chan struct{}
+	numSubscribers    int
+}
+
+// NewHyperblockStreamer creates a new instance of HyperblockStreamer
+func NewHyperblockStreamer(args ArgsHyperblockStreamer) (*HyperblockStreamer, error) {
+	if check.IfNil(args.HyperblockBuilder) {
+		return nil, ErrNilHyperblockBuilder
+	}
+	if check.IfNil(args.NonceProvider) {
+		return nil, ErrNilHyperblockNonceProvider
+	}
+	if args.PollInterval <= 0 {
+		return nil, fmt.Errorf("%w for PollInterval", ErrInvalidHyperblockStreamConfig)
+	}
+	if args.BufferSize <= 0 {
+		return nil, fmt.Errorf("%w for BufferSize", ErrInvalidHyperblockStreamConfig)
+	}
+	if args.MaxSubscribers <= 0 {
+		return nil, fmt.Errorf("%w for MaxSubscribers", ErrInvalidHyperblockStreamConfig)
+	}
+
+	return &HyperblockStreamer{
+		hyperblockBuilder: args.HyperblockBuilder,
+		nonceProvider:     args.NonceProvider,
+		pollInterval:      args.PollInterval,
+		bufferSize:        uint64(args.BufferSize),
+		maxResumeGap:      args.MaxResumeGap,
+		maxSubscribers:    args.MaxSubscribers,
+		closeChan:         make(chan struct{}),
+		hyperblocks:       make(map[uint64]*data.HyperblockApiResponse),
+		newHyperblockChan: make(chan struct{}),
+	}, nil
+}
+
+// StartStreaming will start tracking the new hyperblocks
+func (hs *HyperblockStreamer) StartStreaming() {
+	if hs.cancelFunc != nil {
+		log.Error("HyperblockStreamer - streaming already started")
+		return
+	}
+
+	var ctx context.Context
+	ctx, hs.cancelFunc = context.WithCancel(context.Background())
+
+	go func(ctx context.Context) {
+		timer := time.NewTimer(hs.pollInterval)
+		defer timer.Stop()
+
+		hs.fetchNewHyperblocks(ctx)
+
+		for {
+			timer.Reset(hs.pollInterval)
+
+			select {
+			case <-timer.C:
+				hs.fetchNewHyperblocks(ctx)
+			case <-ctx.Done():
+				log.Debug("finishing HyperblockStreamer...")
+				return
+			}
+		}
+	}(ctx)
+}
+
+func (hs *HyperblockStreamer) fetchNewHyperblocks(ctx context.Context) {
+	latestNonce, err := hs.nonceProvider.GetLatestFullySynchronizedHyperblockNonce(ctx)
+	if err != nil {
+		log.Debug("HyperblockStreamer: cannot fetch the latest hyperblock nonce", "error", err.Error())
+		return
+	}
+
+	hs.mutState.RLock()
+	startNonce := hs.latestNonce + 1
+	if !hs.isReady {
+		startNonce = latestNonce
+	}
+	hs.mutState.RUnlock()
+
+	if latestNonce < startNonce {
+		return
+	}
+	// only the hyperblocks fitting in the buffer are built in advance, the older ones are built on demand
+	if latestNonce-startNonce >= hs.bufferSize {
+		startNonce = latestNonce - hs.bufferSize + 1
+	}
+
+	for nonce := startNonce; nonce <= latestNonce; nonce++ {
+		if ctx.Err() != nil {
+			return
+		}
+
+		response, err := hs.buildHyperblock(ctx, nonce)
+		if err != nil {
+			log.Debug("HyperblockStreamer: cannot build hyperblock", "nonce", nonce, "error", err.Error())
+			return
+		}
+
+		hs.addHyperblock(nonce, response)
+	}
+}
+
+func (hs *HyperblockStreamer) buildHyperblock(ctx context.Context, nonce uint64) (*data.HyperblockApiResponse, error) {
+	response, err := hs.hyperblockBuilder.GetHyperBlockByNonce(ctx, nonce, common.HyperblockQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	return response, nil
+}
+
+func (hs *HyperblockStreamer) addHyperblock(nonce uint64, response *data.HyperblockApiResponse) {
+	hs.mutState.Lock()
+	defer hs.mutState.Unlock()
+
+	hs.hyperblocks[nonce] = response
+	for bufferedNonce := range hs.hyperblocks {
+		if bufferedNonce+hs.bufferSize <= nonce {
+			delete(hs.hyperblocks, bufferedNonce)
+		}
+	}
+
+	hs.latestNonce = nonce
+	hs.isReady = true
+
+	// wake up all the subscribers waiting for a new hyperblock
+	close(hs.newHyperblockChan)
+	hs.newHyperblockChan = make(chan struct{})
+}
+
+// Subscribe returns a new subscription to the hyperblocks stream. If a nonce is provided, the subscription starts
+// with the hyperblock having that nonce, otherwise it starts with the latest hyperblock
+func (hs *HyperblockStreamer) Subscribe(fromNonce core.OptionalUint64) (data.HyperblockSubscriptionHandler, error) {
+	hs.mutState.Lock()
+	defer hs.mutState.Unlock()
+
+	if hs.isClosed() {
+		return nil, ErrHyperblockStreamClosed
+	}
+	if !hs.isReady {
+		return nil, ErrHyperblockStreamNotReady
+	}
+	if hs.numSubscribers >= hs.maxSubscribers {
+		return nil, ErrTooManyHyperblockSubscribers
+	}
+
+	nextNonce := hs.latestNonce
+	if fromNonce.HasValue {
+		if hs.latestNonce > fromNonce.Value && hs.latestNonce-fromNonce.Value > hs.maxResumeGap {
+			return nil, fmt.Errorf("%w: latest nonce is %d, while at most %d hyperblocks can be replayed",
+				ErrResumeNonceTooOld, hs.latestNonce, hs.maxResumeGap)
+		}
+
+		nextNonce = fromNonce.Value
+	}
+
+	hs.numSubscribers++
+
+	return &hyperblockSubscription{
+		streamer:  hs,
+		nextNonce: nextNonce,
+	}, nil
+}
+
+// getHyperblock returns the hyperblock with the provided nonce, waiting for it if it was not built yet
+func (hs *HyperblockStreamer) getHyperblock(ctx context.Context, nonce uint64) (*data.HyperblockApiResponse, error) {
+	for {
+		hs.mutState.RLock()
+		response, isBuffered := hs.hyperblocks[nonce]
+		isAlreadySynchronized := nonce <= hs.latestNonce
+		newHyperblockChan := hs.newHyperblockChan
+		hs.mutState.RUnlock()
+
+		if isBuffered {
+			return response, nil
+		}
+		if isAlreadySynchronized {
+			// the hyperblock is older than the buffered ones, so it is built only for the current subscriber
+			return hs.buildHyperblock(ctx, nonce)
+		}
+
+		select {
+		case <-newHyperblockChan:
+		case <-hs.closeChan:
+			return nil, ErrHyperblockStreamClosed
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (hs *HyperblockStreamer) removeSubscriber() {
+	hs.mutState.Lock()
+	hs.numSubscribers--
+	hs.mutState.Unlock()
+}
+
+func (hs *HyperblockStreamer) isClosed() bool {
+	select {
+	case <-hs.closeChan:
+		return true
+	default:
+		return false
+	}
+}
+
+// Close stops tracking the new hyperblocks and ends all the subscriptions
+func (hs *HyperblockStreamer) Close() error {
+	hs.closeOnce.Do(func() {
+		if hs.cancelFunc != nil {
+			hs.cancelFunc()
+		}
+		close(hs.closeChan)
+	})
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hs *HyperblockStreamer) IsInterfaceNil() bool {
+	return hs == nil
+}
+
+type hyperblockSubscription struct {
+	streamer  *HyperblockStreamer
+	nextNonce uint64
+	closeOnce sync.Once
+}
+
+// Next returns the next hyperblock of the stream, waiting for it if needed
+func (sub *hyperblockSubscription) Next(ctx context.Context) (*data.HyperblockApiResponse, error) {
+	response, err := sub.streamer.getHyperblock(ctx, sub.nextNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	sub.nextNonce++
+
+	return response, nil
+}
+
+// Close ends the subscription
+func (sub *hyperblockSubscription) Close() {
+	sub.closeOnce.Do(sub.streamer.removeSubscriber)
+}
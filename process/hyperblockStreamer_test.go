@@ -0,0 +1,306 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type hyperblocksSource struct {
+	latestNonce      uint64
+	mutBuiltNonces   sync.Mutex
+	numBuildsByNonce map[uint64]int
+}
+
+func newHyperblocksSource(latestNonce uint64) *hyperblocksSource {
+	return &hyperblocksSource{
+		latestNonce:      latestNonce,
+		numBuildsByNonce: make(map[uint64]int),
+	}
+}
+
+func (source *hyperblocksSource) setLatestNonce(nonce uint64) {
+	atomic.StoreUint64(&source.latestNonce, nonce)
+}
+
+func (source *hyperblocksSource) getNumBuilds(nonce uint64) int {
+	source.mutBuiltNonces.Lock()
+	defer source.mutBuiltNonces.Unlock()
+
+	return source.numBuildsByNonce[nonce]
+}
+
+func createHyperblockStreamerArgs(source *hyperblocksSource) process.ArgsHyperblockStreamer {
+	return process.ArgsHyperblockStreamer{
+		HyperblockBuilder: &mock.HyperblockBuilderStub{
+			GetHyperBlockByNonceCalled: func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+				source.mutBuiltNonces.Lock()
+				source.numBuildsByNonce[nonce]++
+				source.mutBuiltNonces.Unlock()
+
+				return data.NewHyperblockApiResponse(api.Hyperblock{Nonce: nonce}), nil
+			},
+		},
+		NonceProvider: &mock.HyperblockNonceProviderStub{
+			GetLatestFullySynchronizedHyperblockNonceCalled: func() (uint64, error) {
+				return atomic.LoadUint64(&source.latestNonce), nil
+			},
+		},
+		PollInterval:   time.Millisecond,
+		BufferSize:     3,
+		MaxResumeGap:   10,
+		MaxSubscribers: 2,
+	}
+}
+
+func requireNextHyperblockNonce(t *testing.T, subscription data.HyperblockSubscriptionHandler, expectedNonce uint64) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	response, err := subscription.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expectedNonce, response.Data.Hyperblock.Nonce)
+}
+
+func TestNewHyperblockStreamer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil hyperblock builder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createHyperblockStreamerArgs(newHyperblocksSource(0))
+		args.HyperblockBuilder = nil
+		hs, err := process.NewHyperblockStreamer(args)
+		require.True(t, check.IfNil(hs))
+		require.Equal(t, process.ErrNilHyperblockBuilder, err)
+	})
+	t.Run("nil nonce provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createHyperblockStreamerArgs(newHyperblocksSource(0))
+		args.NonceProvider = nil
+		hs, err := process.NewHyperblockStreamer(args)
+		require.True(t, check.IfNil(hs))
+		require.Equal(t, process.ErrNilHyperblockNonceProvider, err)
+	})
+	t.Run("invalid poll interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createHyperblockStreamerArgs(newHyperblocksSource(0))
+		args.PollInterval = 0
+		hs, err := process.NewHyperblockStreamer(args)
+		require.True(t, check.IfNil(hs))
+		require.True(t, errors.Is(err, process.ErrInvalidHyperblockStreamConfig))
+	})
+	t.Run("invalid buffer size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createHyperblockStreamerArgs(newHyperblocksSource(0))
+		args.BufferSize = 0
+		hs, err := process.NewHyperblockStreamer(args)
+		require.True(t, check.IfNil(hs))
+		require.True(t, errors.Is(err, process.ErrInvalidHyperblockStreamConfig))
+	})
+	t.Run("invalid max subscribers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createHyperblockStreamerArgs(newHyperblocksSource(0))
+		args.MaxSubscribers = 0
+		hs, err := process.NewHyperblockStreamer(args)
+		require.True(t, check.IfNil(hs))
+		require.True(t, errors.Is(err, process.ErrInvalidHyperblockStreamConfig))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hs, err := process.NewHyperblockStreamer(createHyperblockStreamerArgs(newHyperblocksSource(0)))
+		require.False(t, check.IfNil(hs))
+		require.NoError(t, err)
+	})
+}
+
+func TestHyperblockStreamer_SubscribeBeforeTheFirstHyperblockShouldError(t *testing.T) {
+	t.Parallel()
+
+	hs, _ := process.NewHyperblockStreamer(createHyperblockStreamerArgs(newHyperblocksSource(10)))
+
+	subscription, err := hs.Subscribe(core.OptionalUint64{})
+	require.Nil(t, subscription)
+	require.Equal(t, process.ErrHyperblockStreamNotReady, err)
+}
+
+func TestHyperblockStreamer_ShouldStreamTheNewHyperblocksInOrder(t *testing.T) {
+	t.Parallel()
+
+	source := newHyperblocksSource(10)
+	hs, _ := process.NewHyperblockStreamer(createHyperblockStreamerArgs(source))
+	hs.FetchNewHyperblocks()
+
+	firstSubscription, err := hs.Subscribe(core.OptionalUint64{})
+	require.NoError(t, err)
+	secondSubscription, err := hs.Subscribe(core.OptionalUint64{})
+	require.NoError(t, err)
+
+	requireNextHyperblockNonce(t, firstSubscription, 10)
+	requireNextHyperblockNonce(t, secondSubscription, 10)
+
+	source.setLatestNonce(12)
+	hs.FetchNewHyperblocks()
+
+	for _, subscription := range []data.HyperblockSubscriptionHandler{firstSubscription, secondSubscription} {
+		requireNextHyperblockNonce(t, subscription, 11)
+		requireNextHyperblockNonce(t, subscription, 12)
+	}
+
+	// each hyperblock is built once, for all the subscribers
+	for nonce := uint64(10); nonce <= 12; nonce++ {
+		require.Equal(t, 1, source.getNumBuilds(nonce))
+	}
+}
+
+func TestHyperblockStreamer_SubscriberShouldWaitForTheNextHyperblock(t *testing.T) {
+	t.Parallel()
+
+	source := newHyperblocksSource(10)
+	args := createHyperblockStreamerArgs(source)
+	hs, _ := process.NewHyperblockStreamer(args)
+	hs.StartStreaming()
+	defer func() {
+		_ = hs.Close()
+	}()
+
+	require.Eventually(t, func() bool {
+		return source.getNumBuilds(10) == 1
+	}, time.Second, time.Millisecond)
+
+	subscription, err := hs.Subscribe(core.OptionalUint64{Value: 11, HasValue: true})
+	require.NoError(t, err)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		source.setLatestNonce(11)
+	}()
+
+	requireNextHyperblockNonce(t, subscription, 11)
+}
+
+func TestHyperblockStreamer_ResumeShouldReplayTheMissedHyperblocks(t *testing.T) {
+	t.Parallel()
+
+	source := newHyperblocksSource(10)
+	hs, _ := process.NewHyperblockStreamer(createHyperblockStreamerArgs(source))
+	hs.FetchNewHyperblocks()
+	source.setLatestNonce(15)
+	hs.FetchNewHyperblocks()
+
+	// the buffer holds the hyperblocks 13, 14 and 15, as the hyperblocks 11 and 12 were skipped
+	require.Equal(t, 0, source.getNumBuilds(11))
+	require.Equal(t, 1, source.getNumBuilds(13))
+
+	subscription, err := hs.Subscribe(core.OptionalUint64{Value: 9, HasValue: true})
+	require.NoError(t, err)
+
+	for nonce := uint64(9); nonce <= 15; nonce++ {
+		requireNextHyperblockNonce(t, subscription, nonce)
+	}
+
+	// the hyperblocks older than the buffered ones were built on demand
+	require.Equal(t, 1, source.getNumBuilds(9))
+	require.Equal(t, 2, source.getNumBuilds(10))
+	require.Equal(t, 1, source.getNumBuilds(11))
+	require.Equal(t, 1, source.getNumBuilds(13))
+}
+
+func TestHyperblockStreamer_ResumeFromATooOldNonceShouldError(t *testing.T) {
+	t.Parallel()
+
+	hs, _ := process.NewHyperblockStreamer(createHyperblockStreamerArgs(newHyperblocksSource(20)))
+	hs.FetchNewHyperblocks()
+
+	subscription, err := hs.Subscribe(core.OptionalUint64{Value: 9, HasValue: true})
+	require.Nil(t, subscription)
+	require.True(t, errors.Is(err, process.ErrResumeNonceTooOld))
+
+	subscription, err = hs.Subscribe(core.OptionalUint64{Value: 10, HasValue: true})
+	require.NoError(t, err)
+	requireNextHyperblockNonce(t, subscription, 10)
+	subscription.Close()
+
+	// a nonce ahead of the latest one should not overflow the resume gap check
+	subscription, err = hs.Subscribe(core.OptionalUint64{Value: math.MaxUint64 - 5, HasValue: true})
+	require.NoError(t, err)
+	require.NotNil(t, subscription)
+}
+
+func TestHyperblockStreamer_MaxSubscribers(t *testing.T) {
+	t.Parallel()
+
+	hs, _ := process.NewHyperblockStreamer(createHyperblockStreamerArgs(newHyperblocksSource(10)))
+	hs.FetchNewHyperblocks()
+
+	firstSubscription, _ := hs.Subscribe(core.OptionalUint64{})
+	_, _ = hs.Subscribe(core.OptionalUint64{})
+
+	subscription, err := hs.Subscribe(core.OptionalUint64{})
+	require.Nil(t, subscription)
+	require.Equal(t, process.ErrTooManyHyperblockSubscribers, err)
+
+	// closing a subscription twice should release a single slot
+	firstSubscription.Close()
+	firstSubscription.Close()
+
+	_, err = hs.Subscribe(core.OptionalUint64{})
+	require.NoError(t, err)
+	_, err = hs.Subscribe(core.OptionalUint64{})
+	require.Equal(t, process.ErrTooManyHyperblockSubscribers, err)
+}
+
+func TestHyperblockStreamer_CloseShouldEndTheSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	hs, _ := process.NewHyperblockStreamer(createHyperblockStreamerArgs(newHyperblocksSource(10)))
+	hs.FetchNewHyperblocks()
+
+	subscription, _ := hs.Subscribe(core.OptionalUint64{Value: 11, HasValue: true})
+
+	errChan := make(chan error, 1)
+	go func() {
+		_, err := subscription.Next(context.Background())
+		errChan <- err
+	}()
+
+	_ = hs.Close()
+	require.Equal(t, process.ErrHyperblockStreamClosed, <-errChan)
+
+	_, err := hs.Subscribe(core.OptionalUint64{})
+	require.Equal(t, process.ErrHyperblockStreamClosed, err)
+}
+
+func TestHyperblockStreamer_NextShouldStopWhenTheContextIsDone(t *testing.T) {
+	t.Parallel()
+
+	hs, _ := process.NewHyperblockStreamer(createHyperblockStreamerArgs(newHyperblocksSource(10)))
+	hs.FetchNewHyperblocks()
+
+	subscription, _ := hs.Subscribe(core.OptionalUint64{Value: 11, HasValue: true})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	response, err := subscription.Next(ctx)
+	require.Nil(t, response)
+	require.Equal(t, context.DeadlineExceeded, err)
+}
@@ -1,7 +1,9 @@
 package process
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -24,10 +26,45 @@ type Processor interface {
 	ComputeShardId(addressBuff []byte) (uint32, error)
 	CallGetRestEndPoint(address string, path string, value interface{}) (int, error)
 	CallPostRestEndPoint(address string, path string, data interface{}, response interface{}) (int, error)
+	CallGetRestEndPointWithContext(ctx context.Context, address string, path string, value interface{}) (int, error)
+	CallPostRestEndPointWithContext(ctx context.Context, address string, path string, data interface{}, response interface{}) (int, error)
 	GetShardCoordinator() common.Coordinator
 	GetPubKeyConverter() core.PubkeyConverter
 	GetObserverProvider() observer.NodesProviderHandler
 	GetFullHistoryNodesProvider() observer.NodesProviderHandler
+	GetConnectionPoolMetrics() *data.ConnectionPoolMetrics
+	IsInterfaceNil() bool
+}
+
+// ObserverRequestHandler sends a request to the provided observer. If the request fails, the returned flag tells if the
+// request can be sent to another observer
+type ObserverRequestHandler func(ctx context.Context, observer *data.NodeData) (response interface{}, canRetry bool, err error)
+
+// HedgedRequestsHandler defines what a component able to send the same request to more observers should do
+type HedgedRequestsHandler interface {
+	Execute(ctx context.Context, observers []*data.NodeData, requestHandler ObserverRequestHandler) (interface{}, error)
+	IsInterfaceNil() bool
+}
+
+// CoalescedRequestHandler sends a request whose response can be shared by more callers
+type CoalescedRequestHandler func(ctx context.Context) (interface{}, error)
+
+// RequestsCoalescer defines what a component able to merge the identical concurrent requests should do
+type RequestsCoalescer interface {
+	Do(ctx context.Context, operation string, key string, requestHandler CoalescedRequestHandler) (interface{}, error)
+	IsInterfaceNil() bool
+}
+
+// CoalescingMetricsHandler defines what a component able to record the coalesced requests should do
+type CoalescingMetricsHandler interface {
+	AddCoalescingRequestData(operation string, isCoalesced bool)
+	IsInterfaceNil() bool
+}
+
+// ObserversMetricsHandler defines what a component able to record the metrics of the observers should do
+type ObserversMetricsHandler interface {
+	AddObserverRequestData(address string, shardID uint32, duration time.Duration, isSuccessful bool, isTimeout bool)
+	SetObserverSyncState(address string, shardID uint32, isSynced bool)
 	IsInterfaceNil() bool
 }
 
@@ -36,6 +73,14 @@ type PrivateKeysLoaderHandler interface {
 	PrivateKeysByShard() (map[uint32][]crypto.PrivateKey, error)
 }
 
+// FaucetPayoutsLimiter defines what a component which enforces the limits of the faucet payouts should do
+type FaucetPayoutsLimiter interface {
+	Reserve(payout *data.FaucetPayout) error
+	Confirm(payout *data.FaucetPayout) error
+	Cancel(payout *data.FaucetPayout)
+	IsInterfaceNil() bool
+}
+
 // HeartbeatCacheHandler will define what a real heartbeat cacher should do
 type HeartbeatCacheHandler interface {
 	LoadHeartbeats() (*data.HeartbeatResponse, error)
@@ -59,7 +104,7 @@ type GenericApiResponseCacheHandler interface {
 
 // TransactionCostHandler will define what a real transaction cost handler should do
 type TransactionCostHandler interface {
-	ResolveCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error)
+	ResolveCostRequest(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error)
 }
 
 // LogsMergerHandler will define what a real merge logs handler should do
@@ -70,7 +115,7 @@ type LogsMergerHandler interface {
 
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
-	ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
 	IsInterfaceNil() bool
 }
 
@@ -85,3 +130,103 @@ type StatusMetricsProvider interface {
 type HttpClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
+
+// ObserversHttpClientHandler defines what the http client used for the requests sent to the nodes should be able to do
+type ObserversHttpClientHandler interface {
+	Do(req *http.Request) (*http.Response, error)
+	GetConnectionPoolMetrics() *data.ConnectionPoolMetrics
+	IsInterfaceNil() bool
+}
+
+// ResponseCacheHandler defines what a cache able to store serializable responses should do
+type ResponseCacheHandler interface {
+	Get(key string, value interface{}) bool
+	Put(key string, value interface{})
+	IsInterfaceNil() bool
+}
+
+// FinalityHandler defines what a component able to tell if blocks are final should do
+type FinalityHandler interface {
+	IsBlockFinal(shardID uint32, nonce uint64) bool
+	IsHyperblockFinal(nonce uint64) bool
+	IsInterfaceNil() bool
+}
+
+// DatabaseConnectorHandler defines what a component able to fetch historical data from a database should do
+type DatabaseConnectorHandler interface {
+	GetTransactionsByAddress(ctx context.Context, address string, options common.TransactionsHistoryOptions) (*data.DatabaseTransactionsPage, error)
+	GetTransactionsByMiniblockHash(ctx context.Context, hash string) ([]data.DatabaseTransaction, error)
+	GetBlockByShardIDAndNonce(ctx context.Context, shardID uint32, nonce uint64) (*data.DatabaseBlock, error)
+	IsInterfaceNil() bool
+}
+
+// HyperblockBuilder defines what a component able to build the hyperblocks should do
+type HyperblockBuilder interface {
+	GetHyperBlockByNonce(ctx context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+	IsInterfaceNil() bool
+}
+
+// HyperblockNonceProvider defines what a component able to tell the latest fully synchronized hyperblock should do
+type HyperblockNonceProvider interface {
+	GetLatestFullySynchronizedHyperblockNonce(ctx context.Context) (uint64, error)
+	IsInterfaceNil() bool
+}
+
+// TransactionStatusProvider defines what a component able to send transactions and to compute their status should do
+type TransactionStatusProvider interface {
+	SendTransaction(ctx context.Context, tx *data.Transaction) (int, string, error)
+	GetTransactionProgressStatus(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error)
+	IsInterfaceNil() bool
+}
+
+// AccountProvider defines what a component able to fetch accounts should do
+type AccountProvider interface {
+	GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+	IsInterfaceNil() bool
+}
+
+// NetworkConfigProvider defines what a component able to fetch the network config should do
+type NetworkConfigProvider interface {
+	GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error)
+	IsInterfaceNil() bool
+}
+
+// PoolNonceProvider defines what a component able to tell the last nonce of a sender in the transactions pool should do
+type PoolNonceProvider interface {
+	GetLastPoolNonceForSender(ctx context.Context, sender string) (uint64, error)
+}
+
+// TransactionPreflightHandler defines what a component able to check the transactions locally, before sending them to
+// the observers, should do
+type TransactionPreflightHandler interface {
+	CheckTransaction(ctx context.Context, tx *data.Transaction, poolNonceProvider PoolNonceProvider) error
+	CheckTransactions(ctx context.Context, txs []*data.Transaction, poolNonceProvider PoolNonceProvider) []error
+	IsInterfaceNil() bool
+}
+
+// TransactionSender defines what a component able to send transactions to the observers should do
+type TransactionSender interface {
+	SendTransaction(ctx context.Context, tx *data.Transaction) (int, string, error)
+	IsInterfaceNil() bool
+}
+
+// NodesAdminHandler defines what a component able to change the observers and the full history nodes at runtime
+// should do
+type NodesAdminHandler interface {
+	ReloadObservers() data.NodesReloadResponse
+	ReloadFullHistoryObservers() data.NodesReloadResponse
+	AddNode(nodesType data.NodeType, node *data.NodeData) error
+	RemoveNode(nodesType data.NodeType, address string) error
+	DrainNode(nodesType data.NodeType, address string) error
+	UndrainNode(nodesType data.NodeType, address string) error
+	GetNodesOverlay(nodesType data.NodeType) data.NodesOverlay
+	SetNodesOverlay(nodesType data.NodeType, overlay data.NodesOverlay) error
+	IsInterfaceNil() bool
+}
+
+// NodesOverlayStorer defines what a component able to persist the runtime changes of the nodes should do
+type NodesOverlayStorer interface {
+	Load() (*data.NodesOverlays, error)
+	Save(overlays data.NodesOverlays) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,27 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// AccountProviderStub -
+type AccountProviderStub struct {
+	GetAccountCalled func(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error)
+}
+
+// GetAccount -
+func (s *AccountProviderStub) GetAccount(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+	if s.GetAccountCalled != nil {
+		return s.GetAccountCalled(ctx, address, options)
+	}
+
+	return &data.AccountModel{}, nil
+}
+
+// IsInterfaceNil -
+func (s *AccountProviderStub) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,18 @@
+package mock
+
+// CoalescingMetricsHandlerStub -
+type CoalescingMetricsHandlerStub struct {
+	AddCoalescingRequestDataCalled func(operation string, isCoalesced bool)
+}
+
+// AddCoalescingRequestData -
+func (s *CoalescingMetricsHandlerStub) AddCoalescingRequestData(operation string, isCoalesced bool) {
+	if s.AddCoalescingRequestDataCalled != nil {
+		s.AddCoalescingRequestDataCalled(operation, isCoalesced)
+	}
+}
+
+// IsInterfaceNil -
+func (s *CoalescingMetricsHandlerStub) IsInterfaceNil() bool {
+	return s == nil
+}
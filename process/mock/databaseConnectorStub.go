@@ -0,0 +1,46 @@
+package mock
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// DatabaseConnectorStub -
+type DatabaseConnectorStub struct {
+	GetTransactionsByAddressCalled       func(address string, options common.TransactionsHistoryOptions) (*data.DatabaseTransactionsPage, error)
+	GetTransactionsByMiniblockHashCalled func(hash string) ([]data.DatabaseTransaction, error)
+	GetBlockByShardIDAndNonceCalled      func(shardID uint32, nonce uint64) (*data.DatabaseBlock, error)
+}
+
+// GetTransactionsByAddress -
+func (stub *DatabaseConnectorStub) GetTransactionsByAddress(_ context.Context, address string, options common.TransactionsHistoryOptions) (*data.DatabaseTransactionsPage, error) {
+	if stub.GetTransactionsByAddressCalled != nil {
+		return stub.GetTransactionsByAddressCalled(address, options)
+	}
+
+	return nil, errNotImplemented
+}
+
+// GetTransactionsByMiniblockHash -
+func (stub *DatabaseConnectorStub) GetTransactionsByMiniblockHash(_ context.Context, hash string) ([]data.DatabaseTransaction, error) {
+	if stub.GetTransactionsByMiniblockHashCalled != nil {
+		return stub.GetTransactionsByMiniblockHashCalled(hash)
+	}
+
+	return nil, errNotImplemented
+}
+
+// GetBlockByShardIDAndNonce -
+func (stub *DatabaseConnectorStub) GetBlockByShardIDAndNonce(_ context.Context, shardID uint32, nonce uint64) (*data.DatabaseBlock, error) {
+	if stub.GetBlockByShardIDAndNonceCalled != nil {
+		return stub.GetBlockByShardIDAndNonceCalled(shardID, nonce)
+	}
+
+	return nil, errNotImplemented
+}
+
+// IsInterfaceNil -
+func (stub *DatabaseConnectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
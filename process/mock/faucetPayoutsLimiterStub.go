@@ -0,0 +1,40 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// FaucetPayoutsLimiterStub -
+type FaucetPayoutsLimiterStub struct {
+	ReserveCalled func(payout *data.FaucetPayout) error
+	ConfirmCalled func(payout *data.FaucetPayout) error
+	CancelCalled  func(payout *data.FaucetPayout)
+}
+
+// Reserve -
+func (s *FaucetPayoutsLimiterStub) Reserve(payout *data.FaucetPayout) error {
+	if s.ReserveCalled != nil {
+		return s.ReserveCalled(payout)
+	}
+
+	return nil
+}
+
+// Confirm -
+func (s *FaucetPayoutsLimiterStub) Confirm(payout *data.FaucetPayout) error {
+	if s.ConfirmCalled != nil {
+		return s.ConfirmCalled(payout)
+	}
+
+	return nil
+}
+
+// Cancel -
+func (s *FaucetPayoutsLimiterStub) Cancel(payout *data.FaucetPayout) {
+	if s.CancelCalled != nil {
+		s.CancelCalled(payout)
+	}
+}
+
+// IsInterfaceNil -
+func (s *FaucetPayoutsLimiterStub) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,30 @@
+package mock
+
+// FinalityHandlerStub -
+type FinalityHandlerStub struct {
+	IsBlockFinalCalled      func(shardID uint32, nonce uint64) bool
+	IsHyperblockFinalCalled func(nonce uint64) bool
+}
+
+// IsBlockFinal -
+func (stub *FinalityHandlerStub) IsBlockFinal(shardID uint32, nonce uint64) bool {
+	if stub.IsBlockFinalCalled != nil {
+		return stub.IsBlockFinalCalled(shardID, nonce)
+	}
+
+	return false
+}
+
+// IsHyperblockFinal -
+func (stub *FinalityHandlerStub) IsHyperblockFinal(nonce uint64) bool {
+	if stub.IsHyperblockFinalCalled != nil {
+		return stub.IsHyperblockFinalCalled(nonce)
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (stub *FinalityHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"context"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// HyperblockBuilderStub -
+type HyperblockBuilderStub struct {
+	GetHyperBlockByNonceCalled func(nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error)
+}
+
+// GetHyperBlockByNonce -
+func (s *HyperblockBuilderStub) GetHyperBlockByNonce(_ context.Context, nonce uint64, options common.HyperblockQueryOptions) (*data.HyperblockApiResponse, error) {
+	if s.GetHyperBlockByNonceCalled != nil {
+		return s.GetHyperBlockByNonceCalled(nonce, options)
+	}
+
+	return &data.HyperblockApiResponse{}, nil
+}
+
+// IsInterfaceNil -
+func (s *HyperblockBuilderStub) IsInterfaceNil() bool {
+	return s == nil
+}
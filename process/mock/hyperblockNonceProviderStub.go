@@ -0,0 +1,22 @@
+package mock
+
+import "context"
+
+// HyperblockNonceProviderStub -
+type HyperblockNonceProviderStub struct {
+	GetLatestFullySynchronizedHyperblockNonceCalled func() (uint64, error)
+}
+
+// GetLatestFullySynchronizedHyperblockNonce -
+func (s *HyperblockNonceProviderStub) GetLatestFullySynchronizedHyperblockNonce(_ context.Context) (uint64, error) {
+	if s.GetLatestFullySynchronizedHyperblockNonceCalled != nil {
+		return s.GetLatestFullySynchronizedHyperblockNonceCalled()
+	}
+
+	return 0, nil
+}
+
+// IsInterfaceNil -
+func (s *HyperblockNonceProviderStub) IsInterfaceNil() bool {
+	return s == nil
+}
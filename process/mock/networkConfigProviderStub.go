@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// NetworkConfigProviderStub -
+type NetworkConfigProviderStub struct {
+	GetNetworkConfigMetricsCalled func() (*data.GenericAPIResponse, error)
+}
+
+// GetNetworkConfigMetrics -
+func (s *NetworkConfigProviderStub) GetNetworkConfigMetrics(_ context.Context) (*data.GenericAPIResponse, error) {
+	if s.GetNetworkConfigMetricsCalled != nil {
+		return s.GetNetworkConfigMetricsCalled()
+	}
+
+	return &data.GenericAPIResponse{}, nil
+}
+
+// IsInterfaceNil -
+func (s *NetworkConfigProviderStub) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,92 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// NodesAdminHandlerStub -
+type NodesAdminHandlerStub struct {
+	ReloadObserversCalled            func() data.NodesReloadResponse
+	ReloadFullHistoryObserversCalled func() data.NodesReloadResponse
+	AddNodeCalled                    func(nodesType data.NodeType, node *data.NodeData) error
+	RemoveNodeCalled                 func(nodesType data.NodeType, address string) error
+	DrainNodeCalled                  func(nodesType data.NodeType, address string) error
+	UndrainNodeCalled                func(nodesType data.NodeType, address string) error
+	GetNodesOverlayCalled            func(nodesType data.NodeType) data.NodesOverlay
+	SetNodesOverlayCalled            func(nodesType data.NodeType, overlay data.NodesOverlay) error
+}
+
+// ReloadObservers -
+func (stub *NodesAdminHandlerStub) ReloadObservers() data.NodesReloadResponse {
+	if stub.ReloadObserversCalled != nil {
+		return stub.ReloadObserversCalled()
+	}
+
+	return data.NodesReloadResponse{}
+}
+
+// ReloadFullHistoryObservers -
+func (stub *NodesAdminHandlerStub) ReloadFullHistoryObservers() data.NodesReloadResponse {
+	if stub.ReloadFullHistoryObserversCalled != nil {
+		return stub.ReloadFullHistoryObserversCalled()
+	}
+
+	return data.NodesReloadResponse{}
+}
+
+// AddNode -
+func (stub *NodesAdminHandlerStub) AddNode(nodesType data.NodeType, node *data.NodeData) error {
+	if stub.AddNodeCalled != nil {
+		return stub.AddNodeCalled(nodesType, node)
+	}
+
+	return nil
+}
+
+// RemoveNode -
+func (stub *NodesAdminHandlerStub) RemoveNode(nodesType data.NodeType, address string) error {
+	if stub.RemoveNodeCalled != nil {
+		return stub.RemoveNodeCalled(nodesType, address)
+	}
+
+	return nil
+}
+
+// DrainNode -
+func (stub *NodesAdminHandlerStub) DrainNode(nodesType data.NodeType, address string) error {
+	if stub.DrainNodeCalled != nil {
+		return stub.DrainNodeCalled(nodesType, address)
+	}
+
+	return nil
+}
+
+// UndrainNode -
+func (stub *NodesAdminHandlerStub) UndrainNode(nodesType data.NodeType, address string) error {
+	if stub.UndrainNodeCalled != nil {
+		return stub.UndrainNodeCalled(nodesType, address)
+	}
+
+	return nil
+}
+
+// GetNodesOverlay -
+func (stub *NodesAdminHandlerStub) GetNodesOverlay(nodesType data.NodeType) data.NodesOverlay {
+	if stub.GetNodesOverlayCalled != nil {
+		return stub.GetNodesOverlayCalled(nodesType)
+	}
+
+	return data.NodesOverlay{}
+}
+
+// SetNodesOverlay -
+func (stub *NodesAdminHandlerStub) SetNodesOverlay(nodesType data.NodeType, overlay data.NodesOverlay) error {
+	if stub.SetNodesOverlayCalled != nil {
+		return stub.SetNodesOverlayCalled(nodesType, overlay)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *NodesAdminHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/multiversx/mx-chain-proxy-go/data"
+
+// NodesOverlayStorerStub -
+type NodesOverlayStorerStub struct {
+	LoadCalled func() (*data.NodesOverlays, error)
+	SaveCalled func(overlays data.NodesOverlays) error
+}
+
+// Load -
+func (stub *NodesOverlayStorerStub) Load() (*data.NodesOverlays, error) {
+	if stub.LoadCalled != nil {
+		return stub.LoadCalled()
+	}
+
+	return &data.NodesOverlays{}, nil
+}
+
+// Save -
+func (stub *NodesOverlayStorerStub) Save(overlays data.NodesOverlays) error {
+	if stub.SaveCalled != nil {
+		return stub.SaveCalled(overlays)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *NodesOverlayStorerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"time"
+)
+
+// ObserversMetricsHandlerStub -
+type ObserversMetricsHandlerStub struct {
+	AddObserverRequestDataCalled func(address string, shardID uint32, duration time.Duration, isSuccessful bool, isTimeout bool)
+	SetObserverSyncStateCalled   func(address string, shardID uint32, isSynced bool)
+}
+
+// AddObserverRequestData -
+func (s *ObserversMetricsHandlerStub) AddObserverRequestData(address string, shardID uint32, duration time.Duration, isSuccessful bool, isTimeout bool) {
+	if s.AddObserverRequestDataCalled != nil {
+		s.AddObserverRequestDataCalled(address, shardID, duration, isSuccessful, isTimeout)
+	}
+}
+
+// SetObserverSyncState -
+func (s *ObserversMetricsHandlerStub) SetObserverSyncState(address string, shardID uint32, isSynced bool) {
+	if s.SetObserverSyncStateCalled != nil {
+		s.SetObserverSyncStateCalled(address, shardID, isSynced)
+	}
+}
+
+// IsInterfaceNil -
+func (s *ObserversMetricsHandlerStub) IsInterfaceNil() bool {
+	return s == nil
+}
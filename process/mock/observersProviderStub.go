@@ -1,7 +1,10 @@
 package mock
 
 import (
+	"time"
+
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/observer"
 )
 
 // ObserversProviderStub -
@@ -9,8 +12,19 @@ type ObserversProviderStub struct {
 	GetNodesByShardIdCalled           func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
 	GetAllNodesCalled                 func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
 	ReloadNodesCalled                 func(nodesType data.NodeType) data.NodesReloadResponse
+	SetNodesReloadHandlerCalled       func(handler observer.NodesReloadHandler) error
+	SetNodesCalled                    func(nodes []*data.NodeData) error
+	AddNodeCalled                     func(node *data.NodeData) error
+	RemoveNodeCalled                  func(address string) error
+	DrainNodeCalled                   func(address string) error
+	UndrainNodeCalled                 func(address string) error
+	GetOverlayCalled                  func() data.NodesOverlay
+	SetOverlayCalled                  func(overlay data.NodesOverlay) error
 	UpdateNodesBasedOnSyncStateCalled func(nodesWithSyncStatus []*data.NodeData)
 	GetAllNodesWithSyncStateCalled    func() []*data.NodeData
+	RecordNodeRequestCalled           func(address string)
+	RecordNodeResponseCalled          func(address string, responseTime time.Duration, isSuccessful bool)
+	GetCircuitBreakerStatusCalled     func() []*data.NodeCircuitBreakerStatus
 	PrintNodesInShardsCalled          func()
 }
 
@@ -58,6 +72,69 @@ func (ops *ObserversProviderStub) GetAllNodesWithSyncState() []*data.NodeData {
 	return make([]*data.NodeData, 0)
 }
 
+// SetNodes -
+func (ops *ObserversProviderStub) SetNodes(nodes []*data.NodeData) error {
+	if ops.SetNodesCalled != nil {
+		return ops.SetNodesCalled(nodes)
+	}
+
+	return nil
+}
+
+// AddNode -
+func (ops *ObserversProviderStub) AddNode(node *data.NodeData) error {
+	if ops.AddNodeCalled != nil {
+		return ops.AddNodeCalled(node)
+	}
+
+	return nil
+}
+
+// RemoveNode -
+func (ops *ObserversProviderStub) RemoveNode(address string) error {
+	if ops.RemoveNodeCalled != nil {
+		return ops.RemoveNodeCalled(address)
+	}
+
+	return nil
+}
+
+// DrainNode -
+func (ops *ObserversProviderStub) DrainNode(address string) error {
+	if ops.DrainNodeCalled != nil {
+		return ops.DrainNodeCalled(address)
+	}
+
+	return nil
+}
+
+// UndrainNode -
+func (ops *ObserversProviderStub) UndrainNode(address string) error {
+	if ops.UndrainNodeCalled != nil {
+		return ops.UndrainNodeCalled(address)
+	}
+
+	return nil
+}
+
+// GetOverlay -
+func (ops *ObserversProviderStub) GetOverlay() data.NodesOverlay {
+	if ops.GetOverlayCalled != nil {
+		return ops.GetOverlayCalled()
+	}
+
+	return data.NodesOverlay{}
+}
+
+// SetOverlay -
+func (ops *ObserversProviderStub) SetOverlay(overlay data.NodesOverlay) error {
+	if ops.SetOverlayCalled != nil {
+		return ops.SetOverlayCalled(overlay)
+	}
+
+	return nil
+}
+
 // ReloadNodes -
 func (ops *ObserversProviderStub) ReloadNodes(nodesType data.NodeType) data.NodesReloadResponse {
 	if ops.ReloadNodesCalled != nil {
@@ -67,6 +144,38 @@ func (ops *ObserversProviderStub) ReloadNodes(nodesType data.NodeType) data.Node
 	return data.NodesReloadResponse{}
 }
 
+// SetNodesReloadHandler -
+func (ops *ObserversProviderStub) SetNodesReloadHandler(handler observer.NodesReloadHandler) error {
+	if ops.SetNodesReloadHandlerCalled != nil {
+		return ops.SetNodesReloadHandlerCalled(handler)
+	}
+
+	return nil
+}
+
+// RecordNodeRequest -
+func (ops *ObserversProviderStub) RecordNodeRequest(address string) {
+	if ops.RecordNodeRequestCalled != nil {
+		ops.RecordNodeRequestCalled(address)
+	}
+}
+
+// RecordNodeResponse -
+func (ops *ObserversProviderStub) RecordNodeResponse(address string, responseTime time.Duration, isSuccessful bool) {
+	if ops.RecordNodeResponseCalled != nil {
+		ops.RecordNodeResponseCalled(address, responseTime, isSuccessful)
+	}
+}
+
+// GetCircuitBreakerStatus -
+func (ops *ObserversProviderStub) GetCircuitBreakerStatus() []*data.NodeCircuitBreakerStatus {
+	if ops.GetCircuitBreakerStatusCalled != nil {
+		return ops.GetCircuitBreakerStatusCalled()
+	}
+
+	return make([]*data.NodeCircuitBreakerStatus, 0)
+}
+
 // PrintNodesInShards -
 func (ops *ObserversProviderStub) PrintNodesInShards() {
 	if ops.PrintNodesInShardsCalled != nil {
@@ -0,0 +1,17 @@
+package mock
+
+import "context"
+
+// PoolNonceProviderStub -
+type PoolNonceProviderStub struct {
+	GetLastPoolNonceForSenderCalled func(sender string) (uint64, error)
+}
+
+// GetLastPoolNonceForSender -
+func (s *PoolNonceProviderStub) GetLastPoolNonceForSender(_ context.Context, sender string) (uint64, error) {
+	if s.GetLastPoolNonceForSenderCalled != nil {
+		return s.GetLastPoolNonceForSenderCalled(sender)
+	}
+
+	return 0, nil
+}
@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-proxy-go/common"
 	"github.com/multiversx/mx-chain-proxy-go/config"
@@ -12,21 +14,24 @@ import (
 var errNotImplemented = errors.New("not implemented")
 
 type ProcessorStub struct {
-	ApplyConfigCalled                    func(cfg *config.Config) error
-	GetObserversCalled                   func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
-	GetAllObserversCalled                func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
-	GetObserversOnePerShardCalled        func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
-	GetFullHistoryNodesOnePerShardCalled func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
-	GetFullHistoryNodesCalled            func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
-	GetAllFullHistoryNodesCalled         func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
-	GetShardIDsCalled                    func() []uint32
-	ComputeShardIdCalled                 func(addressBuff []byte) (uint32, error)
-	CallGetRestEndPointCalled            func(address string, path string, value interface{}) (int, error)
-	CallPostRestEndPointCalled           func(address string, path string, data interface{}, response interface{}) (int, error)
-	GetShardCoordinatorCalled            func() common.Coordinator
-	GetPubKeyConverterCalled             func() core.PubkeyConverter
-	GetObserverProviderCalled            func() observer.NodesProviderHandler
-	GetFullHistoryNodesProviderCalled    func() observer.NodesProviderHandler
+	ApplyConfigCalled                     func(cfg *config.Config) error
+	GetObserversCalled                    func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
+	GetAllObserversCalled                 func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
+	GetObserversOnePerShardCalled         func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
+	GetFullHistoryNodesOnePerShardCalled  func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
+	GetFullHistoryNodesCalled             func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
+	GetAllFullHistoryNodesCalled          func(dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error)
+	GetShardIDsCalled                     func() []uint32
+	ComputeShardIdCalled                  func(addressBuff []byte) (uint32, error)
+	CallGetRestEndPointCalled             func(address string, path string, value interface{}) (int, error)
+	CallPostRestEndPointCalled            func(address string, path string, data interface{}, response interface{}) (int, error)
+	CallGetRestEndPointWithContextCalled  func(ctx context.Context, address string, path string, value interface{}) (int, error)
+	CallPostRestEndPointWithContextCalled func(ctx context.Context, address string, path string, data interface{}, response interface{}) (int, error)
+	GetShardCoordinatorCalled             func() common.Coordinator
+	GetPubKeyConverterCalled              func() core.PubkeyConverter
+	GetObserverProviderCalled             func() observer.NodesProviderHandler
+	GetFullHistoryNodesProviderCalled     func() observer.NodesProviderHandler
+	GetConnectionPoolMetricsCalled        func() *data.ConnectionPoolMetrics
 }
 
 // GetShardCoordinator -
@@ -65,6 +70,15 @@ func (ps *ProcessorStub) GetFullHistoryNodesProvider() observer.NodesProviderHan
 	return &ObserversProviderStub{}
 }
 
+// GetConnectionPoolMetrics -
+func (ps *ProcessorStub) GetConnectionPoolMetrics() *data.ConnectionPoolMetrics {
+	if ps.GetConnectionPoolMetricsCalled != nil {
+		return ps.GetConnectionPoolMetricsCalled()
+	}
+
+	return &data.ConnectionPoolMetrics{}
+}
+
 // ApplyConfig will call the ApplyConfigCalled handler if not nil
 func (ps *ProcessorStub) ApplyConfig(cfg *config.Config) error {
 	if ps.ApplyConfigCalled != nil {
@@ -110,6 +124,26 @@ func (ps *ProcessorStub) CallPostRestEndPoint(address string, path string, data
 	return 0, errNotImplemented
 }
 
+// CallGetRestEndPointWithContext will call the CallGetRestEndPointWithContextCalled if not nil, falling back to
+// CallGetRestEndPoint otherwise
+func (ps *ProcessorStub) CallGetRestEndPointWithContext(ctx context.Context, address string, path string, value interface{}) (int, error) {
+	if ps.CallGetRestEndPointWithContextCalled != nil {
+		return ps.CallGetRestEndPointWithContextCalled(ctx, address, path, value)
+	}
+
+	return ps.CallGetRestEndPoint(address, path, value)
+}
+
+// CallPostRestEndPointWithContext will call the CallPostRestEndPointWithContextCalled if not nil, falling back to
+// CallPostRestEndPoint otherwise
+func (ps *ProcessorStub) CallPostRestEndPointWithContext(ctx context.Context, address string, path string, data interface{}, response interface{}) (int, error) {
+	if ps.CallPostRestEndPointWithContextCalled != nil {
+		return ps.CallPostRestEndPointWithContextCalled(ctx, address, path, data, response)
+	}
+
+	return ps.CallPostRestEndPoint(address, path, data, response)
+}
+
 // GetShardIDs will call the GetShardIDsCalled if not nil
 func (ps *ProcessorStub) GetShardIDs() []uint32 {
 	if ps.GetShardIDsCalled != nil {
@@ -0,0 +1,28 @@
+package mock
+
+// ResponseCacherStub -
+type ResponseCacherStub struct {
+	GetCalled func(key string, value interface{}) bool
+	PutCalled func(key string, value interface{})
+}
+
+// Get -
+func (stub *ResponseCacherStub) Get(key string, value interface{}) bool {
+	if stub.GetCalled != nil {
+		return stub.GetCalled(key, value)
+	}
+
+	return false
+}
+
+// Put -
+func (stub *ResponseCacherStub) Put(key string, value interface{}) {
+	if stub.PutCalled != nil {
+		stub.PutCalled(key, value)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *ResponseCacherStub) IsInterfaceNil() bool {
+	return stub == nil
+}
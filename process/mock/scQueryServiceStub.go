@@ -1,18 +1,19 @@
 package mock
 
 import (
+	"context"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 )
 
 // SCQueryServiceStub is a stub
 type SCQueryServiceStub struct {
-	ExecuteQueryCalled func(*data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
+	ExecuteQueryCalled func(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error)
 }
 
 // ExecuteQuery is a stub
-func (serviceStub *SCQueryServiceStub) ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
-	return serviceStub.ExecuteQueryCalled(query)
+func (serviceStub *SCQueryServiceStub) ExecuteQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+	return serviceStub.ExecuteQueryCalled(ctx, query)
 }
 
 // IsInterfaceNil returns true if the value under the interface is nil
@@ -0,0 +1,36 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// TransactionStatusProviderStub -
+type TransactionStatusProviderStub struct {
+	SendTransactionCalled              func(tx *data.Transaction) (int, string, error)
+	GetTransactionProgressStatusCalled func(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error)
+}
+
+// SendTransaction -
+func (s *TransactionStatusProviderStub) SendTransaction(_ context.Context, tx *data.Transaction) (int, string, error) {
+	if s.SendTransactionCalled != nil {
+		return s.SendTransactionCalled(tx)
+	}
+
+	return 0, "", nil
+}
+
+// GetTransactionProgressStatus -
+func (s *TransactionStatusProviderStub) GetTransactionProgressStatus(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error) {
+	if s.GetTransactionProgressStatusCalled != nil {
+		return s.GetTransactionProgressStatusCalled(ctx, txHash)
+	}
+
+	return &data.ProcessStatusResponse{Status: string(data.TxStatusUnknown)}, nil
+}
+
+// IsInterfaceNil -
+func (s *TransactionStatusProviderStub) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,26 @@
+package process
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// getNetworkConfig fetches the network config through the provided component and converts it to a data.NetworkConfig
+func getNetworkConfig(ctx context.Context, networkConfigProvider NetworkConfigProvider) (*data.NetworkConfig, error) {
+	genericResponse, err := networkConfigProvider.GetNetworkConfigMetrics(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	networkConfigBytes, err := json.Marshal(&genericResponse.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	networkConfig := &data.NetworkConfig{}
+	err = json.Unmarshal(networkConfigBytes, networkConfig)
+
+	return networkConfig, err
+}
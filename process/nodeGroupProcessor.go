@@ -56,7 +56,7 @@ func NewNodeGroupProcessor(
 }
 
 // IsOldStorageForToken returns true if the token is stored in the old fashion
-func (ngp *NodeGroupProcessor) IsOldStorageForToken(tokenID string, nonce uint64) (bool, error) {
+func (ngp *NodeGroupProcessor) IsOldStorageForToken(ctx context.Context, tokenID string, nonce uint64) (bool, error) {
 	observers, err := ngp.proc.GetAllObservers(data.AvailabilityRecent)
 	if err != nil {
 		return false, err
@@ -70,7 +70,7 @@ func (ngp *NodeGroupProcessor) IsOldStorageForToken(tokenID string, nonce uint64
 
 		apiResponse := data.AccountKeyValueResponse{}
 		apiPath := addressPath + systemAccountAddress + "/key/" + tokenStorageKey
-		respCode, err := ngp.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
+		respCode, err := ngp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, apiPath, &apiResponse)
 		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
 			log.Info("account value for key request",
 				"address", systemAccountAddress,
@@ -107,7 +107,7 @@ func computeTokenStorageKey(tokenID string, nonce uint64) string {
 }
 
 // GetHeartbeatData will simply forward the heartbeat status from an observer
-func (ngp *NodeGroupProcessor) GetHeartbeatData() (*data.HeartbeatResponse, error) {
+func (ngp *NodeGroupProcessor) GetHeartbeatData(ctx context.Context) (*data.HeartbeatResponse, error) {
 	heartbeatsToReturn, err := ngp.cacher.LoadHeartbeats()
 	if err == nil {
 		return heartbeatsToReturn, nil
@@ -115,10 +115,10 @@ func (ngp *NodeGroupProcessor) GetHeartbeatData() (*data.HeartbeatResponse, erro
 
 	log.Info("heartbeat: cannot get from cache. Will fetch from API", "error", err.Error())
 
-	return ngp.getHeartbeatsFromApi()
+	return ngp.getHeartbeatsFromApi(ctx)
 }
 
-func (ngp *NodeGroupProcessor) getHeartbeatsFromApi() (*data.HeartbeatResponse, error) {
+func (ngp *NodeGroupProcessor) getHeartbeatsFromApi(ctx context.Context) (*data.HeartbeatResponse, error) {
 	shardIDs := ngp.proc.GetShardIDs()
 
 	responseMap := make(map[string]data.PubKeyHeartbeat)
@@ -132,13 +132,17 @@ func (ngp *NodeGroupProcessor) getHeartbeatsFromApi() (*data.HeartbeatResponse,
 		errorsCount := 0
 		var response data.HeartbeatApiResponse
 		for _, observer := range observers {
-			_, err = ngp.proc.CallGetRestEndPoint(observer.Address, heartbeatPath, &response)
+			_, err = ngp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, heartbeatPath, &response)
 			heartbeats := response.Data.Heartbeats
 			if err == nil && len(heartbeats) > 0 {
 				ngp.addMessagesToMap(responseMap, heartbeats, shard)
 				break
 			}
 
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			errorsCount++
 			errorMsg := "no heartbeat messages"
 			if err != nil {
@@ -211,14 +215,14 @@ func (ngp *NodeGroupProcessor) StartCacheUpdate() {
 		timer := time.NewTimer(ngp.cacheValidityDuration)
 		defer timer.Stop()
 
-		ngp.handleHeartbeatCacheUpdate()
+		ngp.handleHeartbeatCacheUpdate(ctx)
 
 		for {
 			timer.Reset(ngp.cacheValidityDuration)
 
 			select {
 			case <-timer.C:
-				ngp.handleHeartbeatCacheUpdate()
+				ngp.handleHeartbeatCacheUpdate(ctx)
 			case <-ctx.Done():
 				log.Debug("finishing NodeGroupProcessor cache update...")
 				return
@@ -227,8 +231,8 @@ func (ngp *NodeGroupProcessor) StartCacheUpdate() {
 	}(ctx)
 }
 
-func (ngp *NodeGroupProcessor) handleHeartbeatCacheUpdate() {
-	hbts, err := ngp.getHeartbeatsFromApi()
+func (ngp *NodeGroupProcessor) handleHeartbeatCacheUpdate(ctx context.Context) {
+	hbts, err := ngp.getHeartbeatsFromApi(ctx)
 	if err != nil {
 		log.Warn("heartbeat: get from API", "error", err.Error())
 	}
@@ -242,7 +246,7 @@ func (ngp *NodeGroupProcessor) handleHeartbeatCacheUpdate() {
 }
 
 // GetWaitingEpochsLeftForPublicKey returns the number of epochs left for the public key until it becomes eligible
-func (ngp *NodeGroupProcessor) GetWaitingEpochsLeftForPublicKey(publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
+func (ngp *NodeGroupProcessor) GetWaitingEpochsLeftForPublicKey(ctx context.Context, publicKey string) (*data.WaitingEpochsLeftApiResponse, error) {
 	if len(publicKey) == 0 {
 		return nil, ErrEmptyPubKey
 	}
@@ -256,8 +260,12 @@ func (ngp *NodeGroupProcessor) GetWaitingEpochsLeftForPublicKey(publicKey string
 	responseWaitingEpochsLeft := data.WaitingEpochsLeftApiResponse{}
 	path := fmt.Sprintf(waitingEpochsLeftPath, publicKey)
 	for _, observer := range observers {
-		_, lastErr = ngp.proc.CallGetRestEndPoint(observer.Address, path, &responseWaitingEpochsLeft)
+		_, lastErr = ngp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, path, &responseWaitingEpochsLeft)
 		if lastErr != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("waiting epochs left request", "observer", observer.Address, "public key", publicKey, "error", lastErr.Error())
 			continue
 		}
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -55,6 +56,9 @@ const (
 
 	// MetricNonce is the metric for monitoring the nonce of a node
 	MetricNonce = "erd_nonce"
+
+	// MetricHighestFinalNonce is the metric for monitoring the highest final nonce of a node
+	MetricHighestFinalNonce = "erd_highest_final_nonce"
 )
 
 // NodeStatusProcessor handles the action needed for fetching data related to status metrics from nodes
@@ -89,7 +93,7 @@ func NewNodeStatusProcessor(
 }
 
 // GetNetworkStatusMetrics will simply forward the network status metrics from an observer in the given shard
-func (nsp *NodeStatusProcessor) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetObservers(shardID, data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -98,8 +102,12 @@ func (nsp *NodeStatusProcessor) GetNetworkStatusMetrics(shardID uint32) (*data.G
 	responseNetworkMetrics := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, NetworkStatusPath, &responseNetworkMetrics)
+		_, err := nsp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, NetworkStatusPath, &responseNetworkMetrics)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("network metrics request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
@@ -113,7 +121,7 @@ func (nsp *NodeStatusProcessor) GetNetworkStatusMetrics(shardID uint32) (*data.G
 }
 
 // GetNetworkConfigMetrics will simply forward the network config metrics from an observer in the given shard
-func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers(data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -122,8 +130,12 @@ func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics() (*data.GenericAPIRespo
 	responseNetworkMetrics := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err = nsp.proc.CallGetRestEndPoint(observer.Address, NetworkConfigPath, &responseNetworkMetrics)
+		_, err = nsp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, NetworkConfigPath, &responseNetworkMetrics)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("network metrics request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
@@ -137,7 +149,7 @@ func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics() (*data.GenericAPIRespo
 }
 
 // GetEnableEpochsMetrics will simply forward the activation epochs config metrics from an observer
-func (nsp *NodeStatusProcessor) GetEnableEpochsMetrics() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetEnableEpochsMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers(data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -146,8 +158,12 @@ func (nsp *NodeStatusProcessor) GetEnableEpochsMetrics() (*data.GenericAPIRespon
 	responseEnableEpochsMetrics := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, EnableEpochsPath, &responseEnableEpochsMetrics)
+		_, err := nsp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, EnableEpochsPath, &responseEnableEpochsMetrics)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("enable epochs metrics request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
@@ -160,7 +176,7 @@ func (nsp *NodeStatusProcessor) GetEnableEpochsMetrics() (*data.GenericAPIRespon
 }
 
 // GetAllIssuedESDTs will forward the issued ESDTs based on the provided type
-func (nsp *NodeStatusProcessor) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetAllIssuedESDTs(ctx context.Context, tokenType string) (*data.GenericAPIResponse, error) {
 	if !data.IsValidEsdtPath(tokenType) && tokenType != "" {
 		return nil, ErrInvalidTokenType
 	}
@@ -177,8 +193,12 @@ func (nsp *NodeStatusProcessor) GetAllIssuedESDTs(tokenType string) (*data.Gener
 		if tokenType != "" {
 			path = fmt.Sprintf("%s/%s", NetworkEsdtTokensPrefix, tokenType)
 		}
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, path, &responseAllIssuedESDTs)
+		_, err := nsp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, path, &responseAllIssuedESDTs)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("all issued esdts request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
@@ -192,7 +212,7 @@ func (nsp *NodeStatusProcessor) GetAllIssuedESDTs(tokenType string) (*data.Gener
 }
 
 // GetDelegatedInfo returns the delegated info from nodes
-func (nsp *NodeStatusProcessor) GetDelegatedInfo() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetDelegatedInfo(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetObservers(core.MetachainShardId, data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -201,8 +221,12 @@ func (nsp *NodeStatusProcessor) GetDelegatedInfo() (*data.GenericAPIResponse, er
 	delegatedInfoResponse := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, DelegatedInfoPath, &delegatedInfoResponse)
+		_, err := nsp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, DelegatedInfoPath, &delegatedInfoResponse)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("network delegated info request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
@@ -216,7 +240,7 @@ func (nsp *NodeStatusProcessor) GetDelegatedInfo() (*data.GenericAPIResponse, er
 }
 
 // GetDirectStakedInfo returns the delegated info from nodes
-func (nsp *NodeStatusProcessor) GetDirectStakedInfo() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetDirectStakedInfo(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetObservers(core.MetachainShardId, data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -225,8 +249,12 @@ func (nsp *NodeStatusProcessor) GetDirectStakedInfo() (*data.GenericAPIResponse,
 	directStakedResponse := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, DirectStakedPath, &directStakedResponse)
+		_, err := nsp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, DirectStakedPath, &directStakedResponse)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("network direct staked request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
@@ -240,7 +268,7 @@ func (nsp *NodeStatusProcessor) GetDirectStakedInfo() (*data.GenericAPIResponse,
 }
 
 // GetRatingsConfig will simply forward the ratings configuration from an observer
-func (nsp *NodeStatusProcessor) GetRatingsConfig() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetRatingsConfig(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers(data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -249,8 +277,12 @@ func (nsp *NodeStatusProcessor) GetRatingsConfig() (*data.GenericAPIResponse, er
 	responseRatingsConfig := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err = nsp.proc.CallGetRestEndPoint(observer.Address, RatingsConfigPath, &responseRatingsConfig)
+		_, err = nsp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, RatingsConfigPath, &responseRatingsConfig)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("ratings metrics request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
@@ -263,8 +295,12 @@ func (nsp *NodeStatusProcessor) GetRatingsConfig() (*data.GenericAPIResponse, er
 	return nil, WrapObserversError(responseRatingsConfig.Error)
 }
 
-func (nsp *NodeStatusProcessor) getNodeStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
-	observers, err := nsp.proc.GetObservers(shardID, data.AvailabilityRecent)
+func (nsp *NodeStatusProcessor) getNodeStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
+	return getNodeStatusMetricsFromObservers(ctx, nsp.proc, shardID)
+}
+
+func getNodeStatusMetricsFromObservers(ctx context.Context, proc Processor, shardID uint32) (*data.GenericAPIResponse, error) {
+	observers, err := proc.GetObservers(shardID, data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
 	}
@@ -272,8 +308,12 @@ func (nsp *NodeStatusProcessor) getNodeStatusMetrics(shardID uint32) (*data.Gene
 	responseNetworkMetrics := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err = nsp.proc.CallGetRestEndPoint(observer.Address, NodeStatusPath, &responseNetworkMetrics)
+		_, err = proc.CallGetRestEndPointWithContext(ctx, observer.Address, NodeStatusPath, &responseNetworkMetrics)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("node status metrics request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
@@ -287,7 +327,7 @@ func (nsp *NodeStatusProcessor) getNodeStatusMetrics(shardID uint32) (*data.Gene
 }
 
 // GetLatestFullySynchronizedHyperblockNonce will compute nonce of the latest hyperblock that can be returned
-func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce() (uint64, error) {
+func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce(ctx context.Context) (uint64, error) {
 	shardsIDs, err := nsp.getShardsIDs()
 	if err != nil {
 		return 0, err
@@ -295,7 +335,7 @@ func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce() (uin
 
 	nonces := make([]uint64, 0)
 	for shardID := range shardsIDs {
-		nodeStatusResponse, err := nsp.getNodeStatusMetrics(shardID)
+		nodeStatusResponse, err := nsp.getNodeStatusMetrics(ctx, shardID)
 		if err != nil {
 			return 0, err
 		}
@@ -322,8 +362,8 @@ func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce() (uin
 }
 
 // GetTriesStatistics will return trie statistics
-func (nsp *NodeStatusProcessor) GetTriesStatistics(shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
-	nodeStatusResponse, err := nsp.getNodeStatusMetrics(shardID)
+func (nsp *NodeStatusProcessor) GetTriesStatistics(ctx context.Context, shardID uint32) (*data.TrieStatisticsAPIResponse, error) {
+	nodeStatusResponse, err := nsp.getNodeStatusMetrics(ctx, shardID)
 	if err != nil {
 		return nil, err
 	}
@@ -445,7 +485,7 @@ func getUint(value interface{}) uint64 {
 }
 
 // GetGenesisNodesPubKeys will return genesis nodes public keys
-func (nsp *NodeStatusProcessor) GetGenesisNodesPubKeys() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetGenesisNodesPubKeys(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers(data.AvailabilityAll)
 	if err != nil {
 		return nil, err
@@ -454,8 +494,12 @@ func (nsp *NodeStatusProcessor) GetGenesisNodesPubKeys() (*data.GenericAPIRespon
 	response := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err = nsp.proc.CallGetRestEndPoint(observer.Address, GenesisNodesConfigPath, &response)
+		_, err = nsp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, GenesisNodesConfigPath, &response)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("genesis nodes request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
@@ -469,7 +513,7 @@ func (nsp *NodeStatusProcessor) GetGenesisNodesPubKeys() (*data.GenericAPIRespon
 }
 
 // GetGasConfigs will return gas configs
-func (nsp *NodeStatusProcessor) GetGasConfigs() (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetGasConfigs(ctx context.Context) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetAllObservers(data.AvailabilityRecent)
 	if err != nil {
 		return nil, err
@@ -478,8 +522,12 @@ func (nsp *NodeStatusProcessor) GetGasConfigs() (*data.GenericAPIResponse, error
 	responseGenesisNodesConfig := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, GasConfigsPath, &responseGenesisNodesConfig)
+		_, err := nsp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, GasConfigsPath, &responseGenesisNodesConfig)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("gas configs request", "observer", observer.Address, "error", err.Error())
 			continue
 		}
@@ -493,7 +541,7 @@ func (nsp *NodeStatusProcessor) GetGasConfigs() (*data.GenericAPIResponse, error
 }
 
 // GetEpochStartData will return the epoch-start data for the given epoch and shard
-func (nsp *NodeStatusProcessor) GetEpochStartData(epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) GetEpochStartData(ctx context.Context, epoch uint32, shardID uint32) (*data.GenericAPIResponse, error) {
 	observers, err := nsp.proc.GetObservers(shardID, data.AvailabilityAll)
 	if err != nil {
 		return nil, err
@@ -503,8 +551,12 @@ func (nsp *NodeStatusProcessor) GetEpochStartData(epoch uint32, shardID uint32)
 	path := fmt.Sprintf("/node/epoch-start/%d", epoch)
 	for _, observer := range observers {
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, path, &responseEpochStartData)
+		_, err := nsp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, path, &responseEpochStartData)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("epoch start data request", "observer", observer.Address, "shard ID", observer.ShardId, "error", err)
 			continue
 		}
@@ -515,3 +567,8 @@ func (nsp *NodeStatusProcessor) GetEpochStartData(epoch uint32, shardID uint32)
 
 	return nil, WrapObserversError(responseEpochStartData.Error)
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nsp *NodeStatusProcessor) IsInterfaceNil() bool {
+	return nsp == nil
+}
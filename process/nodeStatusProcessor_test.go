@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"testing"
@@ -57,7 +58,7 @@ func TestNodeStatusProcessor_GetConfigMetricsGetRestEndPointError(t *testing.T)
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetNetworkConfigMetrics()
+	status, err := nodeStatusProc.GetNetworkConfigMetrics(context.Background())
 	require.True(t, errors.Is(err, ErrSendingRequest))
 	require.Nil(t, status)
 }
@@ -85,7 +86,7 @@ func TestNodeStatusProcessor_GetConfigMetrics(t *testing.T) {
 		time.Nanosecond,
 	)
 
-	genericResponse, err := nodeStatusProc.GetNetworkConfigMetrics()
+	genericResponse, err := nodeStatusProc.GetNetworkConfigMetrics(context.Background())
 	require.Nil(t, err)
 	require.NotNil(t, genericResponse)
 
@@ -111,7 +112,7 @@ func TestNodeStatusProcessor_GetNetworkMetricsGetObserversFailedShouldErr(t *tes
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetNetworkStatusMetrics(0)
+	status, err := nodeStatusProc.GetNetworkStatusMetrics(context.Background(), 0)
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -134,7 +135,7 @@ func TestNodeStatusProcessor_GetNetworkMetricsGetRestEndPointError(t *testing.T)
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetNetworkStatusMetrics(0)
+	status, err := nodeStatusProc.GetNetworkStatusMetrics(context.Background(), 0)
 	require.True(t, errors.Is(err, ErrSendingRequest))
 	require.Nil(t, status)
 }
@@ -162,7 +163,7 @@ func TestNodeStatusProcessor_GetNetworkMetrics(t *testing.T) {
 		time.Nanosecond,
 	)
 
-	genericResponse, err := nodeStatusProc.GetNetworkStatusMetrics(0)
+	genericResponse, err := nodeStatusProc.GetNetworkStatusMetrics(context.Background(), 0)
 	require.Nil(t, err)
 	require.NotNil(t, genericResponse)
 
@@ -223,7 +224,7 @@ func TestNodeStatusProcessor_GetLatestBlockNonce(t *testing.T) {
 		time.Nanosecond,
 	)
 
-	nonce, err := nodeStatusProc.GetLatestFullySynchronizedHyperblockNonce()
+	nonce, err := nodeStatusProc.GetLatestFullySynchronizedHyperblockNonce(context.Background())
 	require.NoError(t, err)
 	require.Equal(t, uint64(122), nonce)
 }
@@ -241,7 +242,7 @@ func TestNodeStatusProcessor_GetAllIssuedEDTsGetObserversFailedShouldErr(t *test
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetAllIssuedESDTs("")
+	status, err := nodeStatusProc.GetAllIssuedESDTs(context.Background(), "")
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -264,7 +265,7 @@ func TestNodeStatusProcessor_GetAllIssuedESDTsGetRestEndPointError(t *testing.T)
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetAllIssuedESDTs("")
+	status, err := nodeStatusProc.GetAllIssuedESDTs(context.Background(), "")
 	require.True(t, errors.Is(err, ErrSendingRequest))
 	require.Nil(t, status)
 }
@@ -290,7 +291,7 @@ func TestNodeStatusProcessor_GetAllIssuedESDTs(t *testing.T) {
 		time.Nanosecond,
 	)
 
-	genericResponse, err := nodeStatusProc.GetAllIssuedESDTs("")
+	genericResponse, err := nodeStatusProc.GetAllIssuedESDTs(context.Background(), "")
 	require.Nil(t, err)
 	require.NotNil(t, genericResponse)
 
@@ -327,7 +328,7 @@ func TestNodeStatusProcessor_ApiPathIsCorrect(t *testing.T) {
 		time.Nanosecond,
 	)
 
-	_, err := nodeStatusProc.GetAllIssuedESDTs(data.SemiFungibleTokens)
+	_, err := nodeStatusProc.GetAllIssuedESDTs(context.Background(), data.SemiFungibleTokens)
 	require.Nil(t, err)
 }
 
@@ -344,7 +345,7 @@ func TestNodeStatusProcessor_GetDelegatedInfoGetObserversFailedShouldErr(t *test
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetDelegatedInfo()
+	status, err := nodeStatusProc.GetDelegatedInfo(context.Background())
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -367,7 +368,7 @@ func TestNodeStatusProcessor_GetDelegatedInfoGetRestEndPointError(t *testing.T)
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetDelegatedInfo()
+	status, err := nodeStatusProc.GetDelegatedInfo(context.Background())
 	require.True(t, errors.Is(err, ErrSendingRequest))
 	require.Nil(t, status)
 }
@@ -392,7 +393,7 @@ func TestNodeStatusProcessor_GetDelegatedInfo(t *testing.T) {
 		time.Nanosecond,
 	)
 
-	actualResponse, err := nodeStatusProc.GetDelegatedInfo()
+	actualResponse, err := nodeStatusProc.GetDelegatedInfo(context.Background())
 	require.Nil(t, err)
 	require.Equal(t, expectedResp, actualResponse)
 }
@@ -410,7 +411,7 @@ func TestNodeStatusProcessor_GetDirectStakedInfoGetObserversFailedShouldErr(t *t
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetDirectStakedInfo()
+	status, err := nodeStatusProc.GetDirectStakedInfo(context.Background())
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -433,7 +434,7 @@ func TestNodeStatusProcessor_GetDirectStakedInfoGetRestEndPointError(t *testing.
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetDirectStakedInfo()
+	status, err := nodeStatusProc.GetDirectStakedInfo(context.Background())
 	require.True(t, errors.Is(err, ErrSendingRequest))
 	require.Nil(t, status)
 }
@@ -458,7 +459,7 @@ func TestNodeStatusProcessor_GetDirectStakedInfo(t *testing.T) {
 		time.Nanosecond,
 	)
 
-	actualResponse, err := nodeStatusProc.GetDirectStakedInfo()
+	actualResponse, err := nodeStatusProc.GetDirectStakedInfo(context.Background())
 	require.Nil(t, err)
 	require.Equal(t, expectedResp, actualResponse)
 }
@@ -481,7 +482,7 @@ func TestNodeStatusProcessor_GetEnableEpochsMetricsGetEndpointErr(t *testing.T)
 		time.Nanosecond,
 	)
 
-	status, err := nodesStatusProc.GetEnableEpochsMetrics()
+	status, err := nodesStatusProc.GetEnableEpochsMetrics(context.Background())
 	require.True(t, errors.Is(err, ErrSendingRequest))
 	require.Nil(t, status)
 }
@@ -511,7 +512,7 @@ func TestNodeStatusProcessor_GetEnableEpochsMetricsShouldWork(t *testing.T) {
 		time.Nanosecond,
 	)
 
-	genericResponse, err := nodesStatusProc.GetEnableEpochsMetrics()
+	genericResponse, err := nodesStatusProc.GetEnableEpochsMetrics(context.Background())
 	require.Nil(t, err)
 	require.NotNil(t, genericResponse)
 
@@ -536,7 +537,7 @@ func TestNodeStatusProcessor_GetEnableEpochsMetricsGetObserversShouldErr(t *test
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetEnableEpochsMetrics()
+	status, err := nodeStatusProc.GetEnableEpochsMetrics(context.Background())
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -554,7 +555,7 @@ func TestNodeStatusProcessor_GetRatingsConfigGetAllObserversShouldFail(t *testin
 		time.Nanosecond,
 	)
 
-	status, err := nodeStatusProc.GetRatingsConfig()
+	status, err := nodeStatusProc.GetRatingsConfig(context.Background())
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -579,7 +580,7 @@ func TestNodeStatusProcessor_GetRatingsConfig(t *testing.T) {
 		time.Nanosecond,
 	)
 
-	actualResponse, err := nodeStatusProc.GetRatingsConfig()
+	actualResponse, err := nodeStatusProc.GetRatingsConfig(context.Background())
 	require.Nil(t, err)
 	require.Equal(t, expectedResp, actualResponse)
 }
@@ -604,7 +605,7 @@ func TestNodeStatusProcessor_GetGenesisNodesPubKeys(t *testing.T) {
 		time.Nanosecond,
 	)
 
-	actualResponse, err := nodeStatusProc.GetGenesisNodesPubKeys()
+	actualResponse, err := nodeStatusProc.GetGenesisNodesPubKeys(context.Background())
 	require.Nil(t, err)
 	require.Equal(t, expectedResp, actualResponse)
 }
@@ -629,7 +630,7 @@ func TestNodeStatusProcessor_GetGasConfigs(t *testing.T) {
 			time.Nanosecond,
 		)
 
-		actualResponse, err := nodeStatusProc.GetGasConfigs()
+		actualResponse, err := nodeStatusProc.GetGasConfigs(context.Background())
 		require.Nil(t, actualResponse)
 		require.True(t, errors.Is(err, ErrSendingRequest))
 	})
@@ -654,7 +655,7 @@ func TestNodeStatusProcessor_GetGasConfigs(t *testing.T) {
 			time.Nanosecond,
 		)
 
-		actualResponse, err := nodeStatusProc.GetGasConfigs()
+		actualResponse, err := nodeStatusProc.GetGasConfigs(context.Background())
 		require.Nil(t, err)
 		require.Equal(t, expectedResp, actualResponse)
 	})
@@ -680,7 +681,7 @@ func TestNodeStatusProcessor_GetTriesStatistics(t *testing.T) {
 			time.Second,
 		)
 
-		response, err := nodeStatusProc.GetTriesStatistics(0)
+		response, err := nodeStatusProc.GetTriesStatistics(context.Background(), 0)
 		require.Nil(t, response)
 		require.True(t, errors.Is(err, ErrSendingRequest))
 	})
@@ -708,7 +709,7 @@ func TestNodeStatusProcessor_GetTriesStatistics(t *testing.T) {
 			time.Second,
 		)
 
-		response, err := nodeStatusProc.GetTriesStatistics(0)
+		response, err := nodeStatusProc.GetTriesStatistics(context.Background(), 0)
 		require.Nil(t, response)
 		require.Equal(t, ErrCannotParseNodeStatusMetrics, err)
 	})
@@ -739,7 +740,7 @@ func TestNodeStatusProcessor_GetTriesStatistics(t *testing.T) {
 			time.Nanosecond,
 		)
 
-		response, err := nodeStatusProc.GetTriesStatistics(0)
+		response, err := nodeStatusProc.GetTriesStatistics(context.Background(), 0)
 		require.Nil(t, err)
 		require.Equal(t, providedNumNodes, response.Data.AccountsSnapshotNumNodes)
 	})
@@ -765,7 +766,7 @@ func TestNodeStatusProcessor_GetEpochStartData(t *testing.T) {
 			time.Nanosecond,
 		)
 
-		actualResponse, err := nodeStatusProc.GetEpochStartData(0, 0)
+		actualResponse, err := nodeStatusProc.GetEpochStartData(context.Background(), 0, 0)
 		require.Nil(t, actualResponse)
 		require.True(t, errors.Is(err, ErrSendingRequest))
 	})
@@ -790,7 +791,7 @@ func TestNodeStatusProcessor_GetEpochStartData(t *testing.T) {
 			time.Nanosecond,
 		)
 
-		actualResponse, err := nodeStatusProc.GetEpochStartData(0, 0)
+		actualResponse, err := nodeStatusProc.GetEpochStartData(context.Background(), 0, 0)
 		require.Nil(t, err)
 		require.Equal(t, expectedResp, actualResponse)
 	})
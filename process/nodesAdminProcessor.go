@@ -0,0 +1,206 @@
+package process
+
+import (
+	"fmt"
+	"net/url"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// NodesAdminProcessor handles the observers and the full history nodes added, removed, drained or undrained at
+// runtime. Each change is persisted through the overlay storer, so it survives the restarts of the proxy
+type NodesAdminProcessor struct {
+	mut           sync.Mutex
+	nodesHandler  NodesAdminHandler
+	overlayStorer NodesOverlayStorer
+}
+
+// NewNodesAdminProcessor creates a new instance of NodesAdminProcessor. The stored changes are applied over the
+// configured nodes
+func NewNodesAdminProcessor(nodesHandler NodesAdminHandler, overlayStorer NodesOverlayStorer) (*NodesAdminProcessor, error) {
+	if check.IfNil(nodesHandler) {
+		return nil, ErrNilNodesAdminHandler
+	}
+	if check.IfNil(overlayStorer) {
+		return nil, ErrNilNodesOverlayStorer
+	}
+
+	overlays, err := overlayStorer.Load()
+	if err != nil {
+		return nil, fmt.Errorf("cannot load the nodes overlay: %w", err)
+	}
+
+	err = nodesHandler.SetNodesOverlay(data.Observer, overlays.Observers)
+	if err != nil {
+		return nil, fmt.Errorf("cannot apply the observers overlay: %w", err)
+	}
+
+	err = nodesHandler.SetNodesOverlay(data.FullHistoryNode, overlays.FullHistoryNodes)
+	if err != nil {
+		return nil, fmt.Errorf("cannot apply the full history nodes overlay: %w", err)
+	}
+
+	return &NodesAdminProcessor{
+		nodesHandler:  nodesHandler,
+		overlayStorer: overlayStorer,
+	}, nil
+}
+
+// ReloadObservers reloads the observers from the config file, keeping the changes made at runtime
+func (nap *NodesAdminProcessor) ReloadObservers() data.NodesReloadResponse {
+	nap.mut.Lock()
+	defer nap.mut.Unlock()
+
+	response := nap.nodesHandler.ReloadObservers()
+	nap.saveOverlaysAfterReload(response)
+
+	return response
+}
+
+// ReloadFullHistoryObservers reloads the full history nodes from the config file, keeping the changes made at runtime
+func (nap *NodesAdminProcessor) ReloadFullHistoryObservers() data.NodesReloadResponse {
+	nap.mut.Lock()
+	defer nap.mut.Unlock()
+
+	response := nap.nodesHandler.ReloadFullHistoryObservers()
+	nap.saveOverlaysAfterReload(response)
+
+	return response
+}
+
+// the changes referring the nodes removed from the config file are dropped on reload
+func (nap *NodesAdminProcessor) saveOverlaysAfterReload(response data.NodesReloadResponse) {
+	if len(response.Error) > 0 {
+		return
+	}
+
+	err := nap.overlayStorer.Save(nap.getOverlays())
+	if err != nil {
+		log.Warn("NodesAdminProcessor: cannot persist the nodes overlay after reload", "error", err.Error())
+	}
+}
+
+// AddNode adds the provided observer or full history node
+func (nap *NodesAdminProcessor) AddNode(nodesType data.NodeType, request *data.NodeAdminRequest) data.NodesReloadResponse {
+	err := checkNodeAddress(request.Address)
+	if err != nil {
+		return createNodesChangeErrorResponse(nodesType, "added", err)
+	}
+
+	node := &data.NodeData{
+		ShardId:        request.ShardId,
+		Address:        request.Address,
+		IsFallback:     request.IsFallback,
+		IsSnapshotless: request.IsSnapshotless,
+	}
+
+	return nap.changeNodes(nodesType, request.Address, "added", func() error {
+		return nap.nodesHandler.AddNode(nodesType, node)
+	})
+}
+
+// RemoveNode removes the observers or the full history nodes with the provided address
+func (nap *NodesAdminProcessor) RemoveNode(nodesType data.NodeType, address string) data.NodesReloadResponse {
+	return nap.changeNodes(nodesType, address, "removed", func() error {
+		return nap.nodesHandler.RemoveNode(nodesType, address)
+	})
+}
+
+// DrainNode stops sending new requests to the observers or to the full history nodes with the provided address. The
+// requests already sent are not affected
+func (nap *NodesAdminProcessor) DrainNode(nodesType data.NodeType, address string) data.NodesReloadResponse {
+	return nap.changeNodes(nodesType, address, "drained", func() error {
+		return nap.nodesHandler.DrainNode(nodesType, address)
+	})
+}
+
+// UndrainNode resumes sending new requests to the observers or to the full history nodes with the provided address
+func (nap *NodesAdminProcessor) UndrainNode(nodesType data.NodeType, address string) data.NodesReloadResponse {
+	return nap.changeNodes(nodesType, address, "undrained", func() error {
+		return nap.nodesHandler.UndrainNode(nodesType, address)
+	})
+}
+
+// changeNodes applies the change and persists it. If the change cannot be persisted, it is reverted
+func (nap *NodesAdminProcessor) changeNodes(
+	nodesType data.NodeType,
+	address string,
+	action string,
+	changeHandler func() error,
+) data.NodesReloadResponse {
+	nap.mut.Lock()
+	defer nap.mut.Unlock()
+
+	previousOverlay := nap.nodesHandler.GetNodesOverlay(nodesType)
+	err := changeHandler()
+	if err != nil {
+		return createNodesChangeErrorResponse(nodesType, action, err)
+	}
+
+	err = nap.overlayStorer.Save(nap.getOverlays())
+	if err != nil {
+		errRevert := nap.nodesHandler.SetNodesOverlay(nodesType, previousOverlay)
+		if errRevert != nil {
+			log.Error("NodesAdminProcessor: cannot revert the nodes change", "error", errRevert.Error())
+		}
+
+		return data.NodesReloadResponse{
+			OkRequest:   true,
+			Description: fmt.Sprintf("%s not %s", getNodeTypeName(nodesType), action),
+			Error:       "cannot persist the nodes change: " + err.Error(),
+		}
+	}
+
+	description := fmt.Sprintf("%s %s %s", getNodeTypeName(nodesType), address, action)
+	log.Info("NodesAdminProcessor: " + description)
+
+	return data.NodesReloadResponse{
+		OkRequest:   true,
+		Description: description,
+	}
+}
+
+func (nap *NodesAdminProcessor) getOverlays() data.NodesOverlays {
+	return data.NodesOverlays{
+		Observers:        nap.nodesHandler.GetNodesOverlay(data.Observer),
+		FullHistoryNodes: nap.nodesHandler.GetNodesOverlay(data.FullHistoryNode),
+	}
+}
+
+func createNodesChangeErrorResponse(nodesType data.NodeType, action string, err error) data.NodesReloadResponse {
+	return data.NodesReloadResponse{
+		OkRequest:   false,
+		Description: fmt.Sprintf("%s not %s", getNodeTypeName(nodesType), action),
+		Error:       err.Error(),
+	}
+}
+
+func checkNodeAddress(address string) error {
+	nodeURL, err := url.Parse(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidNodeAddress, err.Error())
+	}
+	if nodeURL.Scheme != "http" && nodeURL.Scheme != "https" {
+		return fmt.Errorf("%w: %s", ErrInvalidNodeAddress, address)
+	}
+	if len(nodeURL.Host) == 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidNodeAddress, address)
+	}
+
+	return nil
+}
+
+func getNodeTypeName(nodesType data.NodeType) string {
+	if nodesType == data.FullHistoryNode {
+		return "full history node"
+	}
+
+	return "observer"
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nap *NodesAdminProcessor) IsInterfaceNil() bool {
+	return nap == nil
+}
@@ -0,0 +1,256 @@
+package process_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNodesAdminProcessor(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	t.Run("nil nodes handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		nap, err := process.NewNodesAdminProcessor(nil, &mock.NodesOverlayStorerStub{})
+		require.Equal(t, process.ErrNilNodesAdminHandler, err)
+		require.True(t, check.IfNil(nap))
+	})
+	t.Run("nil overlay storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		nap, err := process.NewNodesAdminProcessor(&mock.NodesAdminHandlerStub{}, nil)
+		require.Equal(t, process.ErrNilNodesOverlayStorer, err)
+		require.True(t, check.IfNil(nap))
+	})
+	t.Run("load error should error", func(t *testing.T) {
+		t.Parallel()
+
+		storer := &mock.NodesOverlayStorerStub{
+			LoadCalled: func() (*data.NodesOverlays, error) {
+				return nil, expectedErr
+			},
+		}
+		nap, err := process.NewNodesAdminProcessor(&mock.NodesAdminHandlerStub{}, storer)
+		require.ErrorIs(t, err, expectedErr)
+		require.True(t, check.IfNil(nap))
+	})
+	t.Run("overlay not applied should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodesHandler := &mock.NodesAdminHandlerStub{
+			SetNodesOverlayCalled: func(nodesType data.NodeType, _ data.NodesOverlay) error {
+				if nodesType == data.FullHistoryNode {
+					return expectedErr
+				}
+
+				return nil
+			},
+		}
+		nap, err := process.NewNodesAdminProcessor(nodesHandler, &mock.NodesOverlayStorerStub{})
+		require.ErrorIs(t, err, expectedErr)
+		require.True(t, check.IfNil(nap))
+	})
+	t.Run("should apply the stored overlays", func(t *testing.T) {
+		t.Parallel()
+
+		storedOverlays := &data.NodesOverlays{
+			Observers:        data.NodesOverlay{Removed: []string{"observer"}},
+			FullHistoryNodes: data.NodesOverlay{Drained: []string{"full history node"}},
+		}
+		storer := &mock.NodesOverlayStorerStub{
+			LoadCalled: func() (*data.NodesOverlays, error) {
+				return storedOverlays, nil
+			},
+		}
+		appliedOverlays := make(map[data.NodeType]data.NodesOverlay)
+		nodesHandler := &mock.NodesAdminHandlerStub{
+			SetNodesOverlayCalled: func(nodesType data.NodeType, overlay data.NodesOverlay) error {
+				appliedOverlays[nodesType] = overlay
+				return nil
+			},
+		}
+		nap, err := process.NewNodesAdminProcessor(nodesHandler, storer)
+		require.NoError(t, err)
+		require.False(t, check.IfNil(nap))
+		require.Equal(t, storedOverlays.Observers, appliedOverlays[data.Observer])
+		require.Equal(t, storedOverlays.FullHistoryNodes, appliedOverlays[data.FullHistoryNode])
+	})
+}
+
+func TestNodesAdminProcessor_AddNode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should not add", func(t *testing.T) {
+		t.Parallel()
+
+		nodesHandler := &mock.NodesAdminHandlerStub{
+			AddNodeCalled: func(_ data.NodeType, _ *data.NodeData) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		nap, _ := process.NewNodesAdminProcessor(nodesHandler, &mock.NodesOverlayStorerStub{})
+
+		for _, address := range []string{"", "observer:8080", "ftp://observer:8080", "http://"} {
+			response := nap.AddNode(data.Observer, &data.NodeAdminRequest{Address: address})
+			require.False(t, response.OkRequest)
+			require.Contains(t, response.Error, process.ErrInvalidNodeAddress.Error())
+		}
+	})
+	t.Run("node not added should return a request error", func(t *testing.T) {
+		t.Parallel()
+
+		nodesHandler := &mock.NodesAdminHandlerStub{
+			AddNodeCalled: func(_ data.NodeType, _ *data.NodeData) error {
+				return errors.New("node already exists")
+			},
+		}
+		nap, _ := process.NewNodesAdminProcessor(nodesHandler, &mock.NodesOverlayStorerStub{})
+
+		response := nap.AddNode(data.FullHistoryNode, &data.NodeAdminRequest{Address: "http://node:8080"})
+		require.Equal(t, data.NodesReloadResponse{
+			OkRequest:   false,
+			Description: "full history node not added",
+			Error:       "node already exists",
+		}, response)
+	})
+	t.Run("should add and persist", func(t *testing.T) {
+		t.Parallel()
+
+		var addedNode *data.NodeData
+		nodesHandler := &mock.NodesAdminHandlerStub{
+			AddNodeCalled: func(nodesType data.NodeType, node *data.NodeData) error {
+				require.Equal(t, data.Observer, nodesType)
+				addedNode = node
+				return nil
+			},
+			GetNodesOverlayCalled: func(nodesType data.NodeType) data.NodesOverlay {
+				if nodesType == data.Observer && addedNode != nil {
+					return data.NodesOverlay{Added: []*data.NodeData{addedNode}}
+				}
+
+				return data.NodesOverlay{}
+			},
+		}
+		var savedOverlays data.NodesOverlays
+		storer := &mock.NodesOverlayStorerStub{
+			SaveCalled: func(overlays data.NodesOverlays) error {
+				savedOverlays = overlays
+				return nil
+			},
+		}
+		nap, _ := process.NewNodesAdminProcessor(nodesHandler, storer)
+
+		request := &data.NodeAdminRequest{
+			ShardId:    1,
+			Address:    "https://observer:8080",
+			IsFallback: true,
+		}
+		response := nap.AddNode(data.Observer, request)
+		require.Equal(t, data.NodesReloadResponse{
+			OkRequest:   true,
+			Description: "observer https://observer:8080 added",
+		}, response)
+		expectedNode := &data.NodeData{ShardId: 1, Address: "https://observer:8080", IsFallback: true}
+		require.Equal(t, expectedNode, addedNode)
+		require.Equal(t, []*data.NodeData{expectedNode}, savedOverlays.Observers.Added)
+	})
+}
+
+func TestNodesAdminProcessor_ChangesNotPersistedShouldRevert(t *testing.T) {
+	t.Parallel()
+
+	previousOverlay := data.NodesOverlay{Drained: []string{"http://observer:8080"}}
+	var revertedOverlay *data.NodesOverlay
+	nodesHandler := &mock.NodesAdminHandlerStub{
+		GetNodesOverlayCalled: func(_ data.NodeType) data.NodesOverlay {
+			return previousOverlay
+		},
+		SetNodesOverlayCalled: func(_ data.NodeType, overlay data.NodesOverlay) error {
+			revertedOverlay = &overlay
+			return nil
+		},
+	}
+	storer := &mock.NodesOverlayStorerStub{
+		SaveCalled: func(_ data.NodesOverlays) error {
+			return errors.New("disk full")
+		},
+	}
+	nap, _ := process.NewNodesAdminProcessor(nodesHandler, storer)
+	revertedOverlay = nil
+
+	response := nap.UndrainNode(data.Observer, "http://observer:8080")
+	require.Equal(t, data.NodesReloadResponse{
+		OkRequest:   true,
+		Description: "observer not undrained",
+		Error:       "cannot persist the nodes change: disk full",
+	}, response)
+	require.Equal(t, &previousOverlay, revertedOverlay)
+}
+
+func TestNodesAdminProcessor_RemoveAndDrainNode(t *testing.T) {
+	t.Parallel()
+
+	calledAddresses := make(map[string]string)
+	nodesHandler := &mock.NodesAdminHandlerStub{
+		RemoveNodeCalled: func(nodesType data.NodeType, address string) error {
+			require.Equal(t, data.FullHistoryNode, nodesType)
+			calledAddresses["remove"] = address
+			return nil
+		},
+		DrainNodeCalled: func(nodesType data.NodeType, address string) error {
+			require.Equal(t, data.Observer, nodesType)
+			calledAddresses["drain"] = address
+			return nil
+		},
+	}
+	numSaves := 0
+	storer := &mock.NodesOverlayStorerStub{
+		SaveCalled: func(_ data.NodesOverlays) error {
+			numSaves++
+			return nil
+		},
+	}
+	nap, _ := process.NewNodesAdminProcessor(nodesHandler, storer)
+
+	response := nap.RemoveNode(data.FullHistoryNode, "http://node:8080")
+	require.Equal(t, "full history node http://node:8080 removed", response.Description)
+	response = nap.DrainNode(data.Observer, "http://observer:8080")
+	require.Equal(t, "observer http://observer:8080 drained", response.Description)
+	require.Equal(t, map[string]string{"remove": "http://node:8080", "drain": "http://observer:8080"}, calledAddresses)
+	require.Equal(t, 2, numSaves)
+}
+
+func TestNodesAdminProcessor_ReloadShouldPersistOnlyOnSuccess(t *testing.T) {
+	t.Parallel()
+
+	reloadResponse := data.NodesReloadResponse{OkRequest: true, Error: "cannot load configuration file"}
+	nodesHandler := &mock.NodesAdminHandlerStub{
+		ReloadObserversCalled: func() data.NodesReloadResponse {
+			return reloadResponse
+		},
+		ReloadFullHistoryObserversCalled: func() data.NodesReloadResponse {
+			return data.NodesReloadResponse{OkRequest: true, Description: "reloaded"}
+		},
+	}
+	numSaves := 0
+	storer := &mock.NodesOverlayStorerStub{
+		SaveCalled: func(_ data.NodesOverlays) error {
+			numSaves++
+			return nil
+		},
+	}
+	nap, _ := process.NewNodesAdminProcessor(nodesHandler, storer)
+
+	require.Equal(t, reloadResponse, nap.ReloadObservers())
+	require.Equal(t, 0, numSaves)
+	require.Equal(t, "reloaded", nap.ReloadFullHistoryObservers().Description)
+	require.Equal(t, 1, numSaves)
+}
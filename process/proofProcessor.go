@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -30,7 +31,7 @@ func NewProofProcessor(proc Processor, pubKeyConverter core.PubkeyConverter) (*P
 }
 
 // GetProof sends the request to the right observer and then replies with the returned answer
-func (pp *ProofProcessor) GetProof(rootHash string, address string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessor) GetProof(ctx context.Context, rootHash string, address string) (*data.GenericAPIResponse, error) {
 	observers, err := pp.getObserversForAddress(address)
 	if err != nil {
 		return nil, err
@@ -40,13 +41,17 @@ func (pp *ProofProcessor) GetProof(rootHash string, address string) (*data.Gener
 	getProofEndpoint := "/proof/root-hash/" + rootHash + "/address/" + address
 	for _, observer := range observers {
 
-		respCode, err := pp.proc.CallGetRestEndPoint(observer.Address, getProofEndpoint, &responseGetProof)
+		respCode, err := pp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, getProofEndpoint, &responseGetProof)
 
 		if responseGetProof.Error != "" {
 			return nil, errors.New(responseGetProof.Error)
 		}
 
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("GetProof request",
 				"observer", observer.Address,
 				"address", address,
@@ -73,7 +78,7 @@ func (pp *ProofProcessor) GetProof(rootHash string, address string) (*data.Gener
 }
 
 // GetProofDataTrie sends the request to the right observer and then replies with the returned answer
-func (pp *ProofProcessor) GetProofDataTrie(rootHash string, address string, key string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessor) GetProofDataTrie(ctx context.Context, rootHash string, address string, key string) (*data.GenericAPIResponse, error) {
 	observers, err := pp.getObserversForAddress(address)
 	if err != nil {
 		return nil, err
@@ -83,13 +88,17 @@ func (pp *ProofProcessor) GetProofDataTrie(rootHash string, address string, key
 	getProofDataTrieEndpoint := fmt.Sprintf("/proof/root-hash/%s/address/%s/key/%s", rootHash, address, key)
 	for _, observer := range observers {
 
-		respCode, err := pp.proc.CallGetRestEndPoint(observer.Address, getProofDataTrieEndpoint, &responseGetProof)
+		respCode, err := pp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, getProofDataTrieEndpoint, &responseGetProof)
 
 		if responseGetProof.Error != "" {
 			return nil, errors.New(responseGetProof.Error)
 		}
 
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("GetProofDataTrie request",
 				"observer", observer.Address,
 				"address", address,
@@ -116,7 +125,7 @@ func (pp *ProofProcessor) GetProofDataTrie(rootHash string, address string, key
 }
 
 // GetProofCurrentRootHash sends the request to the right observer and then replies with the returned answer
-func (pp *ProofProcessor) GetProofCurrentRootHash(address string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessor) GetProofCurrentRootHash(ctx context.Context, address string) (*data.GenericAPIResponse, error) {
 	observers, err := pp.getObserversForAddress(address)
 	if err != nil {
 		return nil, err
@@ -126,13 +135,17 @@ func (pp *ProofProcessor) GetProofCurrentRootHash(address string) (*data.Generic
 	getProofEndpoint := "/proof/address/" + address
 	for _, observer := range observers {
 
-		respCode, err := pp.proc.CallGetRestEndPoint(observer.Address, getProofEndpoint, &responseGetProof)
+		respCode, err := pp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, getProofEndpoint, &responseGetProof)
 
 		if responseGetProof.Error != "" {
 			return nil, errors.New(responseGetProof.Error)
 		}
 
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("GetProofCurrentRootHash request",
 				"observer", observer.Address,
 				"address", address,
@@ -158,7 +171,7 @@ func (pp *ProofProcessor) GetProofCurrentRootHash(address string) (*data.Generic
 }
 
 // VerifyProof sends the request to the right observer and then replies with the returned answer
-func (pp *ProofProcessor) VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
+func (pp *ProofProcessor) VerifyProof(ctx context.Context, rootHash string, address string, proof []string) (*data.GenericAPIResponse, error) {
 	observers, err := pp.getObserversForAddress(address)
 	if err != nil {
 		return nil, err
@@ -173,13 +186,17 @@ func (pp *ProofProcessor) VerifyProof(rootHash string, address string, proof []s
 	responseVerifyProof := data.GenericAPIResponse{}
 	for _, observer := range observers {
 
-		respCode, err := pp.proc.CallPostRestEndPoint(observer.Address, verifyProofEndpoint, requestParams, &responseVerifyProof)
+		respCode, err := pp.proc.CallPostRestEndPointWithContext(ctx, observer.Address, verifyProofEndpoint, requestParams, &responseVerifyProof)
 
 		if responseVerifyProof.Error != "" {
 			return nil, errors.New(responseVerifyProof.Error)
 		}
 
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
 			log.Error("VerifyProof request",
 				"observer", observer.Address,
 				"address", address,
@@ -0,0 +1,330 @@
+package process
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+const relayerBudgetDayLayout = "2006-01-02"
+
+// ArgsRelayerProcessor holds the arguments needed to create a new RelayerProcessor
+type ArgsRelayerProcessor struct {
+	BaseProc                Processor
+	PrivKeysLoader          PrivateKeysLoaderHandler
+	PubKeyConverter         core.PubkeyConverter
+	TxSender                TransactionSender
+	AllowedReceivers        []string
+	AllowedFunctions        []string
+	DailyGasBudgetPerSender uint64
+	MaxGasPrice             uint64
+}
+
+type relayerAccount struct {
+	privateKey crypto.PrivateKey
+	address    string
+}
+
+// RelayerProcessor co-signs, as relayer, the relayed v3 transactions of the users and sends them to the observers.
+// Each shard has its own relayer account, as the relayer of a transaction must be in the shard of its sender
+type RelayerProcessor struct {
+	baseProc         Processor
+	pubKeyConverter  core.PubkeyConverter
+	txSender         TransactionSender
+	relayersByShard  map[uint32]*relayerAccount
+	allowedReceivers map[string]struct{}
+	allowedFunctions map[string]struct{}
+	maxGasPrice      uint64
+	keyGen           crypto.KeyGenerator
+	singleSigner     crypto.SingleSigner
+	txMarshaller     marshal.Marshalizer
+	txSignHasher     hashing.Hasher
+
+	dailyGasBudget  uint64
+	mutGasBudget    sync.Mutex
+	gasBudgetDay    string
+	usedGasBySender map[string]uint64
+	getTimeHandler  func() time.Time
+}
+
+// NewRelayerProcessor creates a new instance of RelayerProcessor
+func NewRelayerProcessor(args ArgsRelayerProcessor) (*RelayerProcessor, error) {
+	if check.IfNil(args.BaseProc) {
+		return nil, ErrNilCoreProcessor
+	}
+	if args.PrivKeysLoader == nil {
+		return nil, ErrNilPrivateKeysLoader
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if check.IfNil(args.TxSender) {
+		return nil, ErrNilTransactionSender
+	}
+
+	relayersByShard, err := loadRelayerAccounts(args.PrivKeysLoader, args.PubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RelayerProcessor{
+		baseProc:         args.BaseProc,
+		pubKeyConverter:  args.PubKeyConverter,
+		txSender:         args.TxSender,
+		relayersByShard:  relayersByShard,
+		allowedReceivers: createLookupSet(args.AllowedReceivers),
+		allowedFunctions: createLookupSet(args.AllowedFunctions),
+		maxGasPrice:      args.MaxGasPrice,
+		keyGen:           signing.NewKeyGenerator(ed25519.NewEd25519()),
+		singleSigner:     getSingleSigner(),
+		txMarshaller:     &marshal.JsonMarshalizer{},
+		txSignHasher:     keccak.NewKeccak(),
+		dailyGasBudget:   args.DailyGasBudgetPerSender,
+		usedGasBySender:  make(map[string]uint64),
+		getTimeHandler:   time.Now,
+	}, nil
+}
+
+// loadRelayerAccounts returns the relayer account of each shard: the first account of the shard in the pem file
+func loadRelayerAccounts(privKeysLoader PrivateKeysLoaderHandler, pubKeyConverter core.PubkeyConverter) (map[uint32]*relayerAccount, error) {
+	privKeysByShard, err := privKeysLoader.PrivateKeysByShard()
+	if err != nil {
+		return nil, err
+	}
+
+	relayersByShard := make(map[uint32]*relayerAccount)
+	for shardID, privKeys := range privKeysByShard {
+		if len(privKeys) == 0 {
+			continue
+		}
+
+		pubKeyBytes, errConvert := privKeys[0].GeneratePublic().ToByteArray()
+		if errConvert != nil {
+			return nil, errConvert
+		}
+
+		address, errEncode := pubKeyConverter.Encode(pubKeyBytes)
+		if errEncode != nil {
+			return nil, errEncode
+		}
+
+		relayersByShard[shardID] = &relayerAccount{
+			privateKey: privKeys[0],
+			address:    address,
+		}
+		log.Info("relayer account loaded", "shard ID", shardID, "address", address)
+	}
+
+	if len(relayersByShard) == 0 {
+		return nil, ErrEmptyMapOfAccountsFromPem
+	}
+
+	return relayersByShard, nil
+}
+
+func createLookupSet(values []string) map[string]struct{} {
+	lookupSet := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		lookupSet[value] = struct{}{}
+	}
+
+	return lookupSet
+}
+
+// IsEnabled returns true
+func (rp *RelayerProcessor) IsEnabled() bool {
+	return true
+}
+
+// GetRelayerAddress returns the address of the relayer to be set by the provided sender in its relayed transactions
+func (rp *RelayerProcessor) GetRelayerAddress(sender string) (string, error) {
+	relayer, err := rp.getRelayerForSender(sender)
+	if err != nil {
+		return "", err
+	}
+
+	return relayer.address, nil
+}
+
+// RelayTransaction checks the user signed transaction against the relayer policies, adds the relayer signature and
+// sends it to the observers. The returned int is the status code of the request
+func (rp *RelayerProcessor) RelayTransaction(ctx context.Context, tx *data.Transaction) (int, string, error) {
+	if len(tx.RelayerAddr) == 0 {
+		return http.StatusBadRequest, "", ErrMissingRelayerAddress
+	}
+
+	relayer, err := rp.getRelayerForSender(tx.Sender)
+	if err != nil {
+		return http.StatusBadRequest, "", err
+	}
+	if relayer.address != tx.RelayerAddr {
+		return http.StatusBadRequest, "", ErrInvalidRelayerAddress
+	}
+
+	err = rp.checkPolicies(tx)
+	if err != nil {
+		return http.StatusForbidden, "", err
+	}
+
+	dataForSigning, err := computeDataForSigning(tx, rp.pubKeyConverter, rp.txMarshaller, rp.txSignHasher)
+	if err != nil {
+		return http.StatusBadRequest, "", err
+	}
+
+	err = rp.verifyUserSignature(tx, dataForSigning)
+	if err != nil {
+		return http.StatusBadRequest, "", err
+	}
+
+	relayerSignature, err := rp.singleSigner.Sign(relayer.privateKey, dataForSigning)
+	if err != nil {
+		return http.StatusInternalServerError, "", err
+	}
+
+	if !rp.reserveGas(tx.Sender, tx.GasLimit) {
+		return http.StatusTooManyRequests, "", ErrRelayerGasBudgetExceeded
+	}
+
+	tx.RelayerSignature = hex.EncodeToString(relayerSignature)
+	statusCode, txHash, err := rp.txSender.SendTransaction(ctx, tx)
+	if err != nil {
+		rp.releaseGas(tx.Sender, tx.GasLimit)
+		return statusCode, "", err
+	}
+
+	log.Debug("relayed transaction sent", "sender", tx.Sender, "relayer", tx.RelayerAddr, "txHash", txHash)
+
+	return statusCode, txHash, nil
+}
+
+func (rp *RelayerProcessor) getRelayerForSender(sender string) (*relayerAccount, error) {
+	senderBytes, err := rp.pubKeyConverter.Decode(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	senderShardID, err := rp.baseProc.ComputeShardId(senderBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	relayer, ok := rp.relayersByShard[senderShardID]
+	if !ok {
+		return nil, ErrNoRelayerAccountForGivenShard
+	}
+
+	return relayer, nil
+}
+
+// checkPolicies verifies the gas price of the transaction against the maximum one, as the gas budget only limits the
+// gas limit of the relayed transactions, and the receiver and the called function against the allowed ones, if any.
+// The called function is the first token of the data field, so a transfer without data is not allowed if only some
+// functions are
+func (rp *RelayerProcessor) checkPolicies(tx *data.Transaction) error {
+	if rp.maxGasPrice > 0 && tx.GasPrice > rp.maxGasPrice {
+		return fmt.Errorf("%w: gas price %d is higher than the maximum %d", ErrRelayerPolicyViolation, tx.GasPrice, rp.maxGasPrice)
+	}
+
+	if len(rp.allowedReceivers) > 0 {
+		_, isAllowed := rp.allowedReceivers[tx.Receiver]
+		if !isAllowed {
+			return fmt.Errorf("%w: receiver %s is not allowed", ErrRelayerPolicyViolation, tx.Receiver)
+		}
+	}
+
+	if len(rp.allowedFunctions) > 0 {
+		function := strings.Split(string(tx.Data), "@")[0]
+		_, isAllowed := rp.allowedFunctions[function]
+		if !isAllowed {
+			return fmt.Errorf("%w: function %q is not allowed", ErrRelayerPolicyViolation, function)
+		}
+	}
+
+	return nil
+}
+
+func (rp *RelayerProcessor) verifyUserSignature(tx *data.Transaction, dataForSigning []byte) error {
+	signature, err := hex.DecodeString(tx.Signature)
+	if err != nil {
+		return ErrInvalidSignatureBytes
+	}
+
+	senderBytes, err := rp.pubKeyConverter.Decode(tx.Sender)
+	if err != nil {
+		return err
+	}
+
+	senderPubKey, err := rp.keyGen.PublicKeyFromByteArray(senderBytes)
+	if err != nil {
+		return err
+	}
+
+	err = rp.singleSigner.Verify(senderPubKey, dataForSigning, signature)
+	if err != nil {
+		return ErrInvalidTransactionSignature
+	}
+
+	return nil
+}
+
+// reserveGas accounts the gas limit of a relayed transaction in the daily budget of its sender. The budgets are reset
+// at the start of each UTC day
+func (rp *RelayerProcessor) reserveGas(sender string, gasLimit uint64) bool {
+	if rp.dailyGasBudget == 0 {
+		return true
+	}
+
+	rp.mutGasBudget.Lock()
+	defer rp.mutGasBudget.Unlock()
+
+	currentDay := rp.getTimeHandler().UTC().Format(relayerBudgetDayLayout)
+	if currentDay != rp.gasBudgetDay {
+		rp.gasBudgetDay = currentDay
+		rp.usedGasBySender = make(map[string]uint64)
+	}
+
+	usedGas := rp.usedGasBySender[sender]
+	if gasLimit > rp.dailyGasBudget-usedGas {
+		return false
+	}
+
+	rp.usedGasBySender[sender] = usedGas + gasLimit
+
+	return true
+}
+
+func (rp *RelayerProcessor) releaseGas(sender string, gasLimit uint64) {
+	if rp.dailyGasBudget == 0 {
+		return
+	}
+
+	rp.mutGasBudget.Lock()
+	defer rp.mutGasBudget.Unlock()
+
+	usedGas := rp.usedGasBySender[sender]
+	if usedGas <= gasLimit {
+		delete(rp.usedGasBySender, sender)
+		return
+	}
+
+	rp.usedGasBySender[sender] = usedGas - gasLimit
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rp *RelayerProcessor) IsInterfaceNil() bool {
+	return rp == nil
+}
@@ -0,0 +1,331 @@
+package process_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testRelayedTxHash = "relayedTxHash"
+
+func createRelayerProcessorArgs(relayerSk crypto.PrivateKey) process.ArgsRelayerProcessor {
+	return process.ArgsRelayerProcessor{
+		BaseProc: &mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return 0, nil
+			},
+		},
+		PrivKeysLoader: &mock.PrivateKeysLoaderStub{
+			PrivateKeysByShardCalled: func() (map[uint32][]crypto.PrivateKey, error) {
+				return map[uint32][]crypto.PrivateKey{0: {relayerSk}}, nil
+			},
+		},
+		PubKeyConverter: &mock.PubKeyConverterMock{},
+		TxSender: &mock.TransactionStatusProviderStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				return http.StatusOK, testRelayedTxHash, nil
+			},
+		},
+	}
+}
+
+func getAddress(t *testing.T, sk crypto.PrivateKey) string {
+	pkBytes, err := sk.GeneratePublic().ToByteArray()
+	require.NoError(t, err)
+
+	return hex.EncodeToString(pkBytes)
+}
+
+func TestNewRelayerProcessor(t *testing.T) {
+	t.Parallel()
+
+	relayerSk, _ := signing.NewKeyGenerator(ed25519.NewEd25519()).GeneratePair()
+
+	t.Run("nil base processor should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRelayerProcessorArgs(relayerSk)
+		args.BaseProc = nil
+		rp, err := process.NewRelayerProcessor(args)
+		require.True(t, check.IfNil(rp))
+		require.Equal(t, process.ErrNilCoreProcessor, err)
+	})
+	t.Run("nil private keys loader should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRelayerProcessorArgs(relayerSk)
+		args.PrivKeysLoader = nil
+		rp, err := process.NewRelayerProcessor(args)
+		require.True(t, check.IfNil(rp))
+		require.Equal(t, process.ErrNilPrivateKeysLoader, err)
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRelayerProcessorArgs(relayerSk)
+		args.PubKeyConverter = nil
+		rp, err := process.NewRelayerProcessor(args)
+		require.True(t, check.IfNil(rp))
+		require.Equal(t, process.ErrNilPubKeyConverter, err)
+	})
+	t.Run("nil transaction sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRelayerProcessorArgs(relayerSk)
+		args.TxSender = nil
+		rp, err := process.NewRelayerProcessor(args)
+		require.True(t, check.IfNil(rp))
+		require.Equal(t, process.ErrNilTransactionSender, err)
+	})
+	t.Run("private keys loading error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createRelayerProcessorArgs(relayerSk)
+		args.PrivKeysLoader = &mock.PrivateKeysLoaderStub{
+			PrivateKeysByShardCalled: func() (map[uint32][]crypto.PrivateKey, error) {
+				return nil, expectedErr
+			},
+		}
+		rp, err := process.NewRelayerProcessor(args)
+		require.True(t, check.IfNil(rp))
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("no relayer account should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRelayerProcessorArgs(relayerSk)
+		args.PrivKeysLoader = &mock.PrivateKeysLoaderStub{
+			PrivateKeysByShardCalled: func() (map[uint32][]crypto.PrivateKey, error) {
+				return map[uint32][]crypto.PrivateKey{0: {}}, nil
+			},
+		}
+		rp, err := process.NewRelayerProcessor(args)
+		require.True(t, check.IfNil(rp))
+		require.Equal(t, process.ErrEmptyMapOfAccountsFromPem, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rp, err := process.NewRelayerProcessor(createRelayerProcessorArgs(relayerSk))
+		require.False(t, check.IfNil(rp))
+		require.NoError(t, err)
+		require.True(t, rp.IsEnabled())
+	})
+}
+
+func TestRelayerProcessor_GetRelayerAddress(t *testing.T) {
+	t.Parallel()
+
+	relayerSk, _ := signing.NewKeyGenerator(ed25519.NewEd25519()).GeneratePair()
+	args := createRelayerProcessorArgs(relayerSk)
+	args.BaseProc = &mock.ProcessorStub{
+		ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+			return uint32(addressBuff[0]), nil
+		},
+	}
+	rp, _ := process.NewRelayerProcessor(args)
+
+	address, err := rp.GetRelayerAddress("00aa")
+	require.NoError(t, err)
+	require.Equal(t, getAddress(t, relayerSk), address)
+
+	address, err = rp.GetRelayerAddress("01aa")
+	require.Empty(t, address)
+	require.Equal(t, process.ErrNoRelayerAccountForGivenShard, err)
+}
+
+func TestRelayerProcessor_RelayTransaction(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
+	relayerSk, relayerPk := keyGen.GeneratePair()
+	userSk, _ := keyGen.GeneratePair()
+	relayerAddress := getAddress(t, relayerSk)
+	receiver := "0102030405060708091011121314151617181920212223242526272829303132"
+
+	createRelayedTransaction := func(gasLimit uint64, dataField string) *data.Transaction {
+		return createSignedTransaction(t, userSk, &data.Transaction{
+			Receiver:    receiver,
+			Value:       "0",
+			GasLimit:    gasLimit,
+			GasPrice:    1000000000,
+			Data:        []byte(dataField),
+			RelayerAddr: relayerAddress,
+		})
+	}
+
+	t.Run("missing relayer address should error", func(t *testing.T) {
+		t.Parallel()
+
+		rp, _ := process.NewRelayerProcessor(createRelayerProcessorArgs(relayerSk))
+		tx := createRelayedTransaction(100000, "")
+		tx.RelayerAddr = ""
+
+		statusCode, txHash, err := rp.RelayTransaction(context.Background(), tx)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Empty(t, txHash)
+		require.Equal(t, process.ErrMissingRelayerAddress, err)
+	})
+	t.Run("other relayer address should error", func(t *testing.T) {
+		t.Parallel()
+
+		rp, _ := process.NewRelayerProcessor(createRelayerProcessorArgs(relayerSk))
+		tx := createRelayedTransaction(100000, "")
+		tx.RelayerAddr = receiver
+
+		statusCode, _, err := rp.RelayTransaction(context.Background(), tx)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Equal(t, process.ErrInvalidRelayerAddress, err)
+	})
+	t.Run("receiver not allowed should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRelayerProcessorArgs(relayerSk)
+		args.AllowedReceivers = []string{relayerAddress}
+		rp, _ := process.NewRelayerProcessor(args)
+
+		statusCode, _, err := rp.RelayTransaction(context.Background(), createRelayedTransaction(100000, ""))
+		require.Equal(t, http.StatusForbidden, statusCode)
+		require.True(t, errors.Is(err, process.ErrRelayerPolicyViolation))
+	})
+	t.Run("function not allowed should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRelayerProcessorArgs(relayerSk)
+		args.AllowedReceivers = []string{receiver}
+		args.AllowedFunctions = []string{"claim"}
+		rp, _ := process.NewRelayerProcessor(args)
+
+		statusCode, _, err := rp.RelayTransaction(context.Background(), createRelayedTransaction(100000, "stake@01"))
+		require.Equal(t, http.StatusForbidden, statusCode)
+		require.True(t, errors.Is(err, process.ErrRelayerPolicyViolation))
+
+		statusCode, _, err = rp.RelayTransaction(context.Background(), createRelayedTransaction(100000, ""))
+		require.Equal(t, http.StatusForbidden, statusCode)
+		require.True(t, errors.Is(err, process.ErrRelayerPolicyViolation))
+	})
+	t.Run("gas price above the maximum should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRelayerProcessorArgs(relayerSk)
+		args.MaxGasPrice = 1000000000
+		args.TxSender = &mock.TransactionStatusProviderStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				require.Fail(t, "should have not sent the transaction")
+				return http.StatusOK, testRelayedTxHash, nil
+			},
+		}
+		rp, _ := process.NewRelayerProcessor(args)
+		tx := createSignedTransaction(t, userSk, &data.Transaction{
+			Receiver:    receiver,
+			Value:       "0",
+			GasLimit:    100000,
+			GasPrice:    1000000001,
+			RelayerAddr: relayerAddress,
+		})
+
+		statusCode, _, err := rp.RelayTransaction(context.Background(), tx)
+		require.Equal(t, http.StatusForbidden, statusCode)
+		require.True(t, errors.Is(err, process.ErrRelayerPolicyViolation))
+	})
+	t.Run("invalid user signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		rp, _ := process.NewRelayerProcessor(createRelayerProcessorArgs(relayerSk))
+		tx := createRelayedTransaction(100000, "claim")
+		tx.GasLimit++
+
+		statusCode, _, err := rp.RelayTransaction(context.Background(), tx)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Equal(t, process.ErrInvalidTransactionSignature, err)
+	})
+	t.Run("should add the relayer signature and send", func(t *testing.T) {
+		t.Parallel()
+
+		var sentTx *data.Transaction
+		args := createRelayerProcessorArgs(relayerSk)
+		args.AllowedReceivers = []string{receiver}
+		args.AllowedFunctions = []string{"claim"}
+		args.TxSender = &mock.TransactionStatusProviderStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				sentTx = tx
+				return http.StatusOK, testRelayedTxHash, nil
+			},
+		}
+		rp, _ := process.NewRelayerProcessor(args)
+
+		statusCode, txHash, err := rp.RelayTransaction(context.Background(), createRelayedTransaction(100000, "claim@01"))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, testRelayedTxHash, txHash)
+
+		relayerSignature, err := hex.DecodeString(sentTx.RelayerSignature)
+		require.NoError(t, err)
+		err = (&singlesig.Ed25519Signer{}).Verify(relayerPk, getTestDataForSigning(t, sentTx), relayerSignature)
+		require.NoError(t, err)
+	})
+	t.Run("daily gas budget should be enforced per sender and reset each day", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC)
+		args := createRelayerProcessorArgs(relayerSk)
+		args.DailyGasBudgetPerSender = 250000
+		rp, _ := process.NewRelayerProcessor(args)
+		rp.SetTimeHandler(func() time.Time {
+			return currentTime
+		})
+
+		_, _, err := rp.RelayTransaction(context.Background(), createRelayedTransaction(100000, ""))
+		require.NoError(t, err)
+		_, _, err = rp.RelayTransaction(context.Background(), createRelayedTransaction(100000, ""))
+		require.NoError(t, err)
+
+		statusCode, _, err := rp.RelayTransaction(context.Background(), createRelayedTransaction(100000, ""))
+		require.Equal(t, http.StatusTooManyRequests, statusCode)
+		require.Equal(t, process.ErrRelayerGasBudgetExceeded, err)
+
+		currentTime = currentTime.Add(2 * time.Hour)
+		_, _, err = rp.RelayTransaction(context.Background(), createRelayedTransaction(100000, ""))
+		require.NoError(t, err)
+	})
+	t.Run("failed send should release the reserved gas", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		shouldFail := true
+		args := createRelayerProcessorArgs(relayerSk)
+		args.DailyGasBudgetPerSender = 100000
+		args.TxSender = &mock.TransactionStatusProviderStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				if shouldFail {
+					return http.StatusInternalServerError, "", expectedErr
+				}
+				return http.StatusOK, testRelayedTxHash, nil
+			},
+		}
+		rp, _ := process.NewRelayerProcessor(args)
+
+		statusCode, _, err := rp.RelayTransaction(context.Background(), createRelayedTransaction(100000, ""))
+		require.Equal(t, http.StatusInternalServerError, statusCode)
+		require.Equal(t, expectedErr, err)
+
+		shouldFail = false
+		_, txHash, err := rp.RelayTransaction(context.Background(), createRelayedTransaction(100000, ""))
+		require.NoError(t, err)
+		require.Equal(t, testRelayedTxHash, txHash)
+	})
+}
@@ -0,0 +1,143 @@
+package process
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+type coalescedCall struct {
+	done       chan struct{}
+	response   interface{}
+	err        error
+	numWaiters int
+	cancel     context.CancelFunc
+}
+
+// detachedContext keeps the values of its parent context, but neither its cancellation nor its deadline
+type detachedContext struct {
+	parent context.Context
+}
+
+// Deadline returns no deadline, as the context is detached from the deadline of its parent
+func (dc detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+// Done returns a nil channel, as the context is never cancelled
+func (dc detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+// Err returns nil, as the context is never cancelled
+func (dc detachedContext) Err() error {
+	return nil
+}
+
+// Value returns the value of the parent context associated with the provided key
+func (dc detachedContext) Value(key interface{}) interface{} {
+	return dc.parent.Value(key)
+}
+
+// requestsCoalescer merges the identical requests received while a previous one is still in flight: only the first
+// request is sent to the observers, while all the callers get its response. The request is sent with a context that
+// keeps the values of the first caller's context, such as the request ID and the tracing span, but not its
+// cancellation: it is only cancelled when all the callers gave up waiting, so a caller that leaves does not fail the
+// others
+type requestsCoalescer struct {
+	mutCalls       sync.Mutex
+	calls          map[string]*coalescedCall
+	metricsHandler CoalescingMetricsHandler
+}
+
+// NewRequestsCoalescer returns a new instance of requestsCoalescer
+func NewRequestsCoalescer(metricsHandler CoalescingMetricsHandler) (*requestsCoalescer, error) {
+	if check.IfNil(metricsHandler) {
+		return nil, ErrNilCoalescingMetricsHandler
+	}
+
+	return &requestsCoalescer{
+		calls:          make(map[string]*coalescedCall),
+		metricsHandler: metricsHandler,
+	}, nil
+}
+
+// Do sends the request, unless an identical request of the same operation is in flight, in which case its response
+// is awaited. The key has to contain everything that identifies the request, such as the path and the body
+func (rc *requestsCoalescer) Do(ctx context.Context, operation string, key string, requestHandler CoalescedRequestHandler) (interface{}, error) {
+	callKey := operation + "_" + key
+
+	rc.mutCalls.Lock()
+	call, found := rc.calls[callKey]
+	if found {
+		call.numWaiters++
+		rc.mutCalls.Unlock()
+
+		rc.metricsHandler.AddCoalescingRequestData(operation, true)
+		return rc.wait(ctx, callKey, call)
+	}
+
+	callCtx, cancel := context.WithCancel(detachedContext{parent: ctx})
+	call = &coalescedCall{
+		done:       make(chan struct{}),
+		numWaiters: 1,
+		cancel:     cancel,
+	}
+	rc.calls[callKey] = call
+	rc.mutCalls.Unlock()
+
+	rc.metricsHandler.AddCoalescingRequestData(operation, false)
+	go rc.execute(callCtx, callKey, call, requestHandler)
+
+	return rc.wait(ctx, callKey, call)
+}
+
+func (rc *requestsCoalescer) execute(ctx context.Context, callKey string, call *coalescedCall, requestHandler CoalescedRequestHandler) {
+	defer call.cancel()
+
+	call.response, call.err = requestHandler(ctx)
+
+	rc.mutCalls.Lock()
+	rc.removeCall(callKey, call)
+	rc.mutCalls.Unlock()
+
+	close(call.done)
+}
+
+func (rc *requestsCoalescer) wait(ctx context.Context, callKey string, call *coalescedCall) (interface{}, error) {
+	select {
+	case <-call.done:
+		return call.response, call.err
+	case <-ctx.Done():
+		rc.leave(callKey, call)
+		return nil, ctx.Err()
+	}
+}
+
+// leave cancels the request once no caller waits for its response anymore
+func (rc *requestsCoalescer) leave(callKey string, call *coalescedCall) {
+	rc.mutCalls.Lock()
+	defer rc.mutCalls.Unlock()
+
+	call.numWaiters--
+	if call.numWaiters > 0 {
+		return
+	}
+
+	call.cancel()
+	// the cancelled call should not be joined by the next identical requests
+	rc.removeCall(callKey, call)
+}
+
+func (rc *requestsCoalescer) removeCall(callKey string, call *coalescedCall) {
+	if rc.calls[callKey] == call {
+		delete(rc.calls, callKey)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *requestsCoalescer) IsInterfaceNil() bool {
+	return rc == nil
+}
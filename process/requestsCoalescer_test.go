@@ -0,0 +1,254 @@
+package process
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testCoalescingOperation = "operation"
+
+func createCoalescingMetricsHandler(numCoalesced *uint32) *mock.CoalescingMetricsHandlerStub {
+	return &mock.CoalescingMetricsHandlerStub{
+		AddCoalescingRequestDataCalled: func(operation string, isCoalesced bool) {
+			if isCoalesced {
+				atomic.AddUint32(numCoalesced, 1)
+			}
+		},
+	}
+}
+
+func waitForCoalescedRequests(t *testing.T, numCoalesced *uint32, expected uint32) {
+	require.Eventually(t, func() bool {
+		return atomic.LoadUint32(numCoalesced) == expected
+	}, time.Second, time.Millisecond)
+}
+
+func TestNewRequestsCoalescer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil metrics handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		rc, err := NewRequestsCoalescer(nil)
+		require.True(t, check.IfNil(rc))
+		require.Equal(t, ErrNilCoalescingMetricsHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rc, err := NewRequestsCoalescer(&mock.CoalescingMetricsHandlerStub{})
+		require.False(t, check.IfNil(rc))
+		require.NoError(t, err)
+	})
+}
+
+func TestRequestsCoalescer_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("identical requests should be sent once", func(t *testing.T) {
+		t.Parallel()
+
+		numCoalesced := uint32(0)
+		numSent := uint32(0)
+		release := make(chan struct{})
+		rc, _ := NewRequestsCoalescer(createCoalescingMetricsHandler(&numCoalesced))
+		handler := func(ctx context.Context) (interface{}, error) {
+			atomic.AddUint32(&numSent, 1)
+			<-release
+			return "response", nil
+		}
+
+		numCallers := 5
+		var wg sync.WaitGroup
+		wg.Add(numCallers)
+		for i := 0; i < numCallers; i++ {
+			go func() {
+				defer wg.Done()
+
+				response, err := rc.Do(context.Background(), testCoalescingOperation, "key", handler)
+				require.NoError(t, err)
+				require.Equal(t, "response", response)
+			}()
+		}
+
+		waitForCoalescedRequests(t, &numCoalesced, uint32(numCallers-1))
+		close(release)
+		wg.Wait()
+
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numSent))
+	})
+	t.Run("the error should be shared as well", func(t *testing.T) {
+		t.Parallel()
+
+		numCoalesced := uint32(0)
+		expectedErr := errors.New("expected error")
+		release := make(chan struct{})
+		rc, _ := NewRequestsCoalescer(createCoalescingMetricsHandler(&numCoalesced))
+		handler := func(ctx context.Context) (interface{}, error) {
+			<-release
+			return nil, expectedErr
+		}
+
+		errs := make(chan error, 2)
+		for i := 0; i < 2; i++ {
+			go func() {
+				_, err := rc.Do(context.Background(), testCoalescingOperation, "key", handler)
+				errs <- err
+			}()
+		}
+
+		waitForCoalescedRequests(t, &numCoalesced, 1)
+		close(release)
+		require.Equal(t, expectedErr, <-errs)
+		require.Equal(t, expectedErr, <-errs)
+	})
+	t.Run("different keys or operations should be sent separately", func(t *testing.T) {
+		t.Parallel()
+
+		numSent := uint32(0)
+		release := make(chan struct{})
+		rc, _ := NewRequestsCoalescer(&mock.CoalescingMetricsHandlerStub{})
+		handler := func(ctx context.Context) (interface{}, error) {
+			atomic.AddUint32(&numSent, 1)
+			<-release
+			return nil, nil
+		}
+
+		var wg sync.WaitGroup
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			_, _ = rc.Do(context.Background(), testCoalescingOperation, "key1", handler)
+		}()
+		go func() {
+			defer wg.Done()
+			_, _ = rc.Do(context.Background(), testCoalescingOperation, "key2", handler)
+		}()
+		go func() {
+			defer wg.Done()
+			_, _ = rc.Do(context.Background(), "other operation", "key1", handler)
+		}()
+
+		require.Eventually(t, func() bool {
+			return atomic.LoadUint32(&numSent) == 3
+		}, time.Second, time.Millisecond)
+		close(release)
+		wg.Wait()
+	})
+	t.Run("a cancelled caller should not affect the others", func(t *testing.T) {
+		t.Parallel()
+
+		numCoalesced := uint32(0)
+		release := make(chan struct{})
+		rc, _ := NewRequestsCoalescer(createCoalescingMetricsHandler(&numCoalesced))
+		handler := func(ctx context.Context) (interface{}, error) {
+			select {
+			case <-release:
+				return "response", nil
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		firstCtx, cancelFirst := context.WithCancel(context.Background())
+		firstErr := make(chan error, 1)
+		go func() {
+			_, err := rc.Do(firstCtx, testCoalescingOperation, "key", handler)
+			firstErr <- err
+		}()
+		require.Eventually(t, func() bool {
+			rc.mutCalls.Lock()
+			defer rc.mutCalls.Unlock()
+
+			return len(rc.calls) == 1
+		}, time.Second, time.Millisecond)
+
+		secondResponse := make(chan interface{}, 1)
+		go func() {
+			response, _ := rc.Do(context.Background(), testCoalescingOperation, "key", handler)
+			secondResponse <- response
+		}()
+		waitForCoalescedRequests(t, &numCoalesced, 1)
+
+		cancelFirst()
+		require.Equal(t, context.Canceled, <-firstErr)
+
+		close(release)
+		require.Equal(t, "response", <-secondResponse)
+	})
+	t.Run("all callers cancelled should cancel the request", func(t *testing.T) {
+		t.Parallel()
+
+		numSent := uint32(0)
+		requestCancelled := make(chan struct{})
+		rc, _ := NewRequestsCoalescer(&mock.CoalescingMetricsHandlerStub{})
+		blockingHandler := func(ctx context.Context) (interface{}, error) {
+			atomic.AddUint32(&numSent, 1)
+			<-ctx.Done()
+			close(requestCancelled)
+			return nil, ctx.Err()
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errs := make(chan error, 1)
+		go func() {
+			_, err := rc.Do(ctx, testCoalescingOperation, "key", blockingHandler)
+			errs <- err
+		}()
+		require.Eventually(t, func() bool {
+			return atomic.LoadUint32(&numSent) == 1
+		}, time.Second, time.Millisecond)
+
+		cancel()
+		require.Equal(t, context.Canceled, <-errs)
+		<-requestCancelled
+
+		// the next identical request should be sent again
+		response, err := rc.Do(context.Background(), testCoalescingOperation, "key", func(ctx context.Context) (interface{}, error) {
+			atomic.AddUint32(&numSent, 1)
+			return "response", nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "response", response)
+		require.Equal(t, uint32(2), atomic.LoadUint32(&numSent))
+	})
+	t.Run("the request should keep the values of the caller context", func(t *testing.T) {
+		t.Parallel()
+
+		type contextKey struct{}
+		rc, _ := NewRequestsCoalescer(&mock.CoalescingMetricsHandlerStub{})
+		ctx := context.WithValue(context.Background(), contextKey{}, "request ID")
+		response, err := rc.Do(ctx, testCoalescingOperation, "key", func(ctx context.Context) (interface{}, error) {
+			return ctx.Value(contextKey{}), nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "request ID", response)
+	})
+}
+
+func TestDisabledRequestsCoalescer_Do(t *testing.T) {
+	t.Parallel()
+
+	numSent := uint32(0)
+	drc := NewDisabledRequestsCoalescer()
+	require.False(t, check.IfNil(drc))
+
+	for i := 0; i < 3; i++ {
+		response, err := drc.Do(context.Background(), testCoalescingOperation, "key", func(ctx context.Context) (interface{}, error) {
+			atomic.AddUint32(&numSent, 1)
+			return "response", nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "response", response)
+	}
+
+	require.Equal(t, uint32(3), atomic.LoadUint32(&numSent))
+}
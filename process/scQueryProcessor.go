@@ -1,7 +1,9 @@
 package process
 
 import (
+	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -18,31 +20,49 @@ const scQueryServicePath = "/vm-values/query"
 const blockNonce = "blockNonce"
 const blockHash = "blockHash"
 
+// scQueryCoalescingOperation defines the name of the coalesced smart contract queries
+const scQueryCoalescingOperation = "vm-query"
+
 // SCQueryProcessor is able to process smart contract queries
 type SCQueryProcessor struct {
 	proc                 Processor
 	pubKeyConverter      core.PubkeyConverter
+	hedgedRequests       HedgedRequestsHandler
+	coalescer            RequestsCoalescer
 	availabilityProvider availabilityCommon.AvailabilityProvider
 }
 
 // NewSCQueryProcessor creates a new instance of SCQueryProcessor
-func NewSCQueryProcessor(proc Processor, pubKeyConverter core.PubkeyConverter) (*SCQueryProcessor, error) {
+func NewSCQueryProcessor(
+	proc Processor,
+	pubKeyConverter core.PubkeyConverter,
+	hedgedRequests HedgedRequestsHandler,
+	coalescer RequestsCoalescer,
+) (*SCQueryProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
 	if check.IfNil(pubKeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
+	if check.IfNil(hedgedRequests) {
+		return nil, ErrNilHedgedRequestsHandler
+	}
+	if check.IfNil(coalescer) {
+		return nil, ErrNilRequestsCoalescer
+	}
 
 	return &SCQueryProcessor{
 		proc:                 proc,
 		pubKeyConverter:      pubKeyConverter,
+		hedgedRequests:       hedgedRequests,
+		coalescer:            coalescer,
 		availabilityProvider: availabilityCommon.AvailabilityProvider{},
 	}, nil
 }
 
 // ExecuteQuery resolves the request by sending the request to the right observer and replies back the answer
-func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
+func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, data.BlockInfo, error) {
 	addressBytes, err := scQueryProcessor.pubKeyConverter.Decode(query.ScAddress)
 	if err != nil {
 		return nil, data.BlockInfo{}, err
@@ -59,47 +79,85 @@ func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm
 		return nil, data.BlockInfo{}, err
 	}
 
-	response := data.ResponseVmValue{}
-	for _, observer := range observers {
-		request := scQueryProcessor.createRequestFromQuery(query)
+	request := scQueryProcessor.createRequestFromQuery(query)
+	path := createSCQueryPath(query)
+	coalescingKey, err := createSCQueryCoalescingKey(shardID, path, request)
+	if err != nil {
+		return nil, data.BlockInfo{}, err
+	}
 
-		params := url.Values{}
-		if query.BlockNonce.HasValue {
-			params.Add(blockNonce, fmt.Sprintf("%d", query.BlockNonce.Value))
-		}
-		if len(query.BlockHash) > 0 {
-			params.Add(blockHash, hex.EncodeToString(query.BlockHash))
-		}
+	response, err := scQueryProcessor.coalescer.Do(ctx, scQueryCoalescingOperation, coalescingKey, func(coalescedCtx context.Context) (interface{}, error) {
+		return scQueryProcessor.executeQueryOnObservers(coalescedCtx, observers, shardID, path, request)
+	})
+	if err != nil {
+		return nil, data.BlockInfo{}, err
+	}
 
-		queryParams := params.Encode()
-		path := scQueryServicePath
-		if len(queryParams) > 0 {
-			path = path + "?" + queryParams
-		}
+	responseData := response.(*data.VmValuesResponseData)
 
-		httpStatus, err := scQueryProcessor.proc.CallPostRestEndPoint(observer.Address, path, request, &response)
+	return responseData.Data, responseData.BlockInfo, nil
+}
+
+// createSCQueryCoalescingKey returns the key of the identical smart contract queries: the same request sent on the
+// same path to the observers of the same shard
+func createSCQueryCoalescingKey(shardID uint32, path string, request data.VmValueRequest) (string, error) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d_%s_%s", shardID, path, requestBytes), nil
+}
+
+func (scQueryProcessor *SCQueryProcessor) executeQueryOnObservers(
+	ctx context.Context,
+	observers []*data.NodeData,
+	shardID uint32,
+	path string,
+	request data.VmValueRequest,
+) (interface{}, error) {
+	return scQueryProcessor.hedgedRequests.Execute(ctx, observers, func(requestCtx context.Context, observer *data.NodeData) (interface{}, bool, error) {
+		vmResponse := data.ResponseVmValue{}
+		httpStatus, errPost := scQueryProcessor.proc.CallPostRestEndPointWithContext(requestCtx, observer.Address, path, request, &vmResponse)
 		isObserverDown := httpStatus == http.StatusNotFound || httpStatus == http.StatusRequestTimeout
 		isOk := httpStatus == http.StatusOK
-		responseHasExplicitError := len(response.Error) > 0
+		responseHasExplicitError := len(vmResponse.Error) > 0
 
 		if isObserverDown {
-			log.LogIfError(err)
-			continue
+			if requestCtx.Err() == nil {
+				log.LogIfError(errPost)
+			}
+			return nil, true, WrapObserversError(vmResponse.Error)
 		}
 
 		if isOk {
 			log.Debug("SC query sent successfully, received response", "observer", observer.Address, "shard", shardID)
-			return response.Data.Data, response.Data.BlockInfo, nil
+			return &vmResponse.Data, false, nil
 		}
 
 		if responseHasExplicitError {
-			return nil, data.BlockInfo{}, fmt.Errorf(response.Error)
+			return nil, false, fmt.Errorf(vmResponse.Error)
 		}
 
-		return nil, data.BlockInfo{}, err
+		return nil, false, errPost
+	})
+}
+
+func createSCQueryPath(query *data.SCQuery) string {
+	params := url.Values{}
+	if query.BlockNonce.HasValue {
+		params.Add(blockNonce, fmt.Sprintf("%d", query.BlockNonce.Value))
+	}
+	if len(query.BlockHash) > 0 {
+		params.Add(blockHash, hex.EncodeToString(query.BlockHash))
+	}
+
+	queryParams := params.Encode()
+	if len(queryParams) == 0 {
+		return scQueryServicePath
 	}
 
-	return nil, data.BlockInfo{}, WrapObserversError(response.Error)
+	return scQueryServicePath + "?" + queryParams
 }
 
 func (scQueryProcessor *SCQueryProcessor) createRequestFromQuery(query *data.SCQuery) data.VmValueRequest {
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -21,15 +22,23 @@ var dummyScAddress = "erd1l453hd0gt5gzdp7czpuall8ggt2dcv5zwmfdf3sd3lguxseux2fsms
 func TestNewSCQueryProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	processor, err := NewSCQueryProcessor(nil, testPubKeyConverter)
+	processor, err := NewSCQueryProcessor(nil, testPubKeyConverter, NewDisabledHedgedRequestsHandler(), NewDisabledRequestsCoalescer())
 	require.Nil(t, processor)
 	require.Equal(t, ErrNilCoreProcessor, err)
 }
 
+func TestNewSCQueryProcessor_NilRequestsCoalescerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, testPubKeyConverter, NewDisabledHedgedRequestsHandler(), nil)
+	require.Nil(t, processor)
+	require.Equal(t, ErrNilRequestsCoalescer, err)
+}
+
 func TestNewSCQueryProcessor_NilPubConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, nil)
+	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, nil, NewDisabledHedgedRequestsHandler(), NewDisabledRequestsCoalescer())
 	require.Nil(t, processor)
 	require.Equal(t, ErrNilPubKeyConverter, err)
 }
@@ -37,7 +46,7 @@ func TestNewSCQueryProcessor_NilPubConverterShouldErr(t *testing.T) {
 func TestNewSCQueryProcessor_WithCoreProcessor(t *testing.T) {
 	t.Parallel()
 
-	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, testPubKeyConverter)
+	processor, err := NewSCQueryProcessor(&mock.ProcessorStub{}, testPubKeyConverter, NewDisabledHedgedRequestsHandler(), NewDisabledRequestsCoalescer())
 	require.NotNil(t, processor)
 	require.Nil(t, err)
 }
@@ -50,9 +59,9 @@ func TestSCQueryProcessor_ExecuteQueryComputeShardIdFailsShouldErr(t *testing.T)
 		ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
 			return 0, errExpected
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, NewDisabledHedgedRequestsHandler(), NewDisabledRequestsCoalescer())
 
-	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -68,9 +77,9 @@ func TestSCQueryProcessor_ExecuteQueryGetObserversFailsShouldErr(t *testing.T) {
 		GetObserversCalled: func(shardId uint32, _ data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
 			return nil, errExpected
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, NewDisabledHedgedRequestsHandler(), NewDisabledRequestsCoalescer())
 
-	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -92,9 +101,9 @@ func TestSCQueryProcessor_ExecuteQuerySendingFailsOnAllObserversShouldErr(t *tes
 		CallPostRestEndPointCalled: func(address string, path string, data interface{}, response interface{}) (int, error) {
 			return http.StatusNotFound, errExpected
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, NewDisabledHedgedRequestsHandler(), NewDisabledRequestsCoalescer())
 
-	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.True(t, errors.Is(err, ErrSendingRequest))
 }
@@ -124,9 +133,9 @@ func TestSCQueryProcessor_ExecuteQuery(t *testing.T) {
 
 			return http.StatusOK, nil
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, NewDisabledHedgedRequestsHandler(), NewDisabledRequestsCoalescer())
 
-	value, blockInfo, err := processor.ExecuteQuery(&data.SCQuery{
+	value, blockInfo, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{
 		ScAddress: dummyScAddress,
 		FuncName:  "function",
 		Arguments: [][]byte{[]byte("aa")},
@@ -168,9 +177,9 @@ func TestSCQueryProcessor_ExecuteQueryWithCoordinates(t *testing.T) {
 
 			return http.StatusOK, nil
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, NewDisabledHedgedRequestsHandler(), NewDisabledRequestsCoalescer())
 
-	value, blockInfo, err := processor.ExecuteQuery(&data.SCQuery{
+	value, blockInfo, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{
 		ScAddress: dummyScAddress,
 		FuncName:  "function",
 		Arguments: [][]byte{[]byte("aa")},
@@ -203,9 +212,9 @@ func TestSCQueryProcessor_ExecuteQueryFailsOnRandomErrorShouldErr(t *testing.T)
 		CallPostRestEndPointCalled: func(address string, path string, data interface{}, response interface{}) (int, error) {
 			return http.StatusInternalServerError, errExpected
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, NewDisabledHedgedRequestsHandler(), NewDisabledRequestsCoalescer())
 
-	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -228,9 +237,9 @@ func TestSCQueryProcessor_ExecuteQueryFailsOnBadRequestWithExplicitErrorShouldEr
 			response.(*data.ResponseVmValue).Error = errExpected.Error()
 			return http.StatusBadRequest, nil
 		},
-	}, testPubKeyConverter)
+	}, testPubKeyConverter, NewDisabledHedgedRequestsHandler(), NewDisabledRequestsCoalescer())
 
-	value, _, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, _, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -35,3 +35,16 @@ func (sp *StatusProcessor) GetMetrics() map[string]*data.EndpointMetrics {
 func (sp *StatusProcessor) GetMetricsForPrometheus() string {
 	return sp.statusMetricsProvider.GetMetricsForPrometheus()
 }
+
+// GetNodesCircuitBreakerStatus returns the circuit breaker details for all the observers and full history nodes
+func (sp *StatusProcessor) GetNodesCircuitBreakerStatus() *data.NodesCircuitBreakerStatus {
+	return &data.NodesCircuitBreakerStatus{
+		Observers:        sp.proc.GetObserverProvider().GetCircuitBreakerStatus(),
+		FullHistoryNodes: sp.proc.GetFullHistoryNodesProvider().GetCircuitBreakerStatus(),
+	}
+}
+
+// GetConnectionPoolMetrics returns the metrics of the connections opened towards the nodes
+func (sp *StatusProcessor) GetConnectionPoolMetrics() *data.ConnectionPoolMetrics {
+	return sp.proc.GetConnectionPoolMetrics()
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/observer"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -74,3 +75,53 @@ func TestStatusProcessor_GetMetricsForPrometheus(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedOutput, metrics)
 }
+
+func TestStatusProcessor_GetNodesCircuitBreakerStatus(t *testing.T) {
+	t.Parallel()
+
+	observersStatus := []*data.NodeCircuitBreakerStatus{
+		{Address: "observer0", State: data.CircuitBreakerOpen, ConsecutiveFailures: 3},
+	}
+	fullHistoryNodesStatus := []*data.NodeCircuitBreakerStatus{
+		{Address: "fullHistory0", State: data.CircuitBreakerClosed},
+	}
+	proc := &mock.ProcessorStub{
+		GetObserverProviderCalled: func() observer.NodesProviderHandler {
+			return &mock.ObserversProviderStub{
+				GetCircuitBreakerStatusCalled: func() []*data.NodeCircuitBreakerStatus {
+					return observersStatus
+				},
+			}
+		},
+		GetFullHistoryNodesProviderCalled: func() observer.NodesProviderHandler {
+			return &mock.ObserversProviderStub{
+				GetCircuitBreakerStatusCalled: func() []*data.NodeCircuitBreakerStatus {
+					return fullHistoryNodesStatus
+				},
+			}
+		},
+	}
+	sp, err := NewStatusProcessor(proc, &mock.StatusMetricsProviderStub{})
+	require.NoError(t, err)
+
+	status := sp.GetNodesCircuitBreakerStatus()
+	require.Equal(t, observersStatus, status.Observers)
+	require.Equal(t, fullHistoryNodesStatus, status.FullHistoryNodes)
+}
+
+func TestStatusProcessor_GetConnectionPoolMetrics(t *testing.T) {
+	t.Parallel()
+
+	expectedMetrics := &data.ConnectionPoolMetrics{
+		Total: data.HostConnectionsMetrics{OpenConnections: 1, DialedConnections: 1},
+	}
+	proc := &mock.ProcessorStub{
+		GetConnectionPoolMetricsCalled: func() *data.ConnectionPoolMetrics {
+			return expectedMetrics
+		},
+	}
+	sp, err := NewStatusProcessor(proc, &mock.StatusMetricsProviderStub{})
+	require.NoError(t, err)
+
+	require.Equal(t, expectedMetrics, sp.GetConnectionPoolMetrics())
+}
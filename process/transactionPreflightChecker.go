@@ -0,0 +1,353 @@
+package process
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// feeSettingsValidity is the duration for which the fee settings fetched from the network config are reused
+const feeSettingsValidity = time.Minute
+
+// ArgsTransactionPreflightChecker holds the arguments needed to create a new TransactionPreflightChecker
+type ArgsTransactionPreflightChecker struct {
+	AccountProvider       AccountProvider
+	NetworkConfigProvider NetworkConfigProvider
+	PubKeyConverter       core.PubkeyConverter
+	MaxNonceGap           uint64
+}
+
+// TransactionPreflightChecker verifies the transactions locally, before they are sent to the observers: the signature
+// of the sender, the nonce against the sender's account and transactions pool nonces and the balance needed for the
+// value and the fee. The checks relying on an account are skipped if the account cannot be fetched
+type TransactionPreflightChecker struct {
+	accountProvider       AccountProvider
+	networkConfigProvider NetworkConfigProvider
+	pubKeyConverter       core.PubkeyConverter
+	maxNonceGap           uint64
+	keyGen                crypto.KeyGenerator
+	singleSigner          crypto.SingleSigner
+	txMarshaller          marshal.Marshalizer
+	txSignHasher          hashing.Hasher
+
+	mutFeeSettings       sync.Mutex
+	feeSettings          *txFeeSettings
+	feeSettingsTimestamp time.Time
+}
+
+// txFeeSettings holds the network settings needed to compute the fee of a transaction as the network does
+type txFeeSettings struct {
+	minGasLimit      uint64
+	gasPerDataByte   uint64
+	gasPriceModifier float64
+}
+
+// preflightBatch holds the accounts fetched while checking the transactions of a batch
+type preflightBatch struct {
+	poolNonceProvider PoolNonceProvider
+	accounts          map[string]*preflightAccount
+	accountErrors     map[string]error
+}
+
+type preflightAccount struct {
+	account            data.Account
+	expectedNonce      uint64
+	isPoolNonceChecked bool
+}
+
+func newPreflightBatch(poolNonceProvider PoolNonceProvider) *preflightBatch {
+	return &preflightBatch{
+		poolNonceProvider: poolNonceProvider,
+		accounts:          make(map[string]*preflightAccount),
+		accountErrors:     make(map[string]error),
+	}
+}
+
+// NewTransactionPreflightChecker creates a new instance of TransactionPreflightChecker
+func NewTransactionPreflightChecker(args ArgsTransactionPreflightChecker) (*TransactionPreflightChecker, error) {
+	if check.IfNil(args.AccountProvider) {
+		return nil, ErrNilAccountProvider
+	}
+	if check.IfNil(args.NetworkConfigProvider) {
+		return nil, ErrNilNetworkConfigProvider
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	return &TransactionPreflightChecker{
+		accountProvider:       args.AccountProvider,
+		networkConfigProvider: args.NetworkConfigProvider,
+		pubKeyConverter:       args.PubKeyConverter,
+		maxNonceGap:           args.MaxNonceGap,
+		keyGen:                signing.NewKeyGenerator(ed25519.NewEd25519()),
+		singleSigner:          getSingleSigner(),
+		txMarshaller:          &marshal.JsonMarshalizer{},
+		txSignHasher:          keccak.NewKeccak(),
+	}, nil
+}
+
+// CheckTransaction returns an ErrTxPreflightCheckFailed error if the transaction would be rejected by the observers
+func (tpc *TransactionPreflightChecker) CheckTransaction(ctx context.Context, tx *data.Transaction, poolNonceProvider PoolNonceProvider) error {
+	return tpc.checkTransaction(ctx, tx, newPreflightBatch(poolNonceProvider))
+}
+
+// CheckTransactions checks the transactions of a batch and returns the error of each one, nil for the accepted ones.
+// The account of each sender is fetched once per batch and the nonce expected for a sender advances with each of its
+// accepted transactions, so the consecutive nonces of a batch are not rejected as being too far ahead
+func (tpc *TransactionPreflightChecker) CheckTransactions(ctx context.Context, txs []*data.Transaction, poolNonceProvider PoolNonceProvider) []error {
+	batch := newPreflightBatch(poolNonceProvider)
+	errs := make([]error, len(txs))
+	for i, tx := range txs {
+		errs[i] = tpc.checkTransaction(ctx, tx, batch)
+	}
+
+	return errs
+}
+
+func (tpc *TransactionPreflightChecker) checkTransaction(ctx context.Context, tx *data.Transaction, batch *preflightBatch) error {
+	senderBytes, err := tpc.pubKeyConverter.Decode(tx.Sender)
+	if err != nil {
+		return &errors.ErrInvalidTxFields{
+			Message: errors.ErrInvalidSenderAddress.Error(),
+			Reason:  err.Error(),
+		}
+	}
+
+	err = tpc.checkSignature(tx, senderBytes)
+	if err != nil {
+		return err
+	}
+
+	sender, err := tpc.getAccount(ctx, tx.Sender, batch)
+	if err != nil {
+		log.Debug("transaction pre-flight: cannot get the sender account, the nonce and balance checks are skipped",
+			"sender", tx.Sender, "error", err.Error())
+		return nil
+	}
+
+	err = tpc.checkNonce(ctx, tx, sender, batch.poolNonceProvider)
+	if err != nil {
+		return err
+	}
+
+	err = tpc.checkBalance(ctx, tx, sender.account.Balance, batch)
+	if err != nil {
+		return err
+	}
+
+	if tx.Nonce >= sender.expectedNonce {
+		sender.expectedNonce = tx.Nonce + 1
+	}
+
+	return nil
+}
+
+func (tpc *TransactionPreflightChecker) getAccount(ctx context.Context, address string, batch *preflightBatch) (*preflightAccount, error) {
+	cached, found := batch.accounts[address]
+	if found {
+		return cached, batch.accountErrors[address]
+	}
+
+	accountModel, err := tpc.accountProvider.GetAccount(ctx, address, common.AccountQueryOptions{})
+	if err != nil {
+		batch.accounts[address] = nil
+		batch.accountErrors[address] = err
+		return nil, err
+	}
+
+	account := &preflightAccount{
+		account:       accountModel.Account,
+		expectedNonce: accountModel.Account.Nonce,
+	}
+	batch.accounts[address] = account
+
+	return account, nil
+}
+
+func (tpc *TransactionPreflightChecker) checkSignature(tx *data.Transaction, senderBytes []byte) error {
+	signature, err := hex.DecodeString(tx.Signature)
+	if err != nil {
+		return &errors.ErrInvalidTxFields{
+			Message: errors.ErrInvalidSignatureHex.Error(),
+			Reason:  err.Error(),
+		}
+	}
+
+	dataForSigning, err := computeDataForSigning(tx, tpc.pubKeyConverter, tpc.txMarshaller, tpc.txSignHasher)
+	if err != nil {
+		return &errors.ErrInvalidTxFields{
+			Message: "cannot compute the signed data",
+			Reason:  err.Error(),
+		}
+	}
+
+	senderPubKey, err := tpc.keyGen.PublicKeyFromByteArray(senderBytes)
+	if err != nil {
+		return newPreflightCheckError(data.ReturnCodeInvalidSignature, fmt.Sprintf("invalid sender public key: %s", err.Error()))
+	}
+
+	err = tpc.singleSigner.Verify(senderPubKey, dataForSigning, signature)
+	if err != nil {
+		return newPreflightCheckError(data.ReturnCodeInvalidSignature, "the signature does not match the sender")
+	}
+
+	return nil
+}
+
+// checkNonce rejects the already used nonces and, if a maximum nonce gap is set, the nonces too far ahead of the next
+// nonce expected for the sender, considering its transactions already in pool and the ones accepted earlier in the batch
+func (tpc *TransactionPreflightChecker) checkNonce(ctx context.Context, tx *data.Transaction, sender *preflightAccount, poolNonceProvider PoolNonceProvider) error {
+	accountNonce := sender.account.Nonce
+	if tx.Nonce < accountNonce {
+		return newPreflightCheckError(data.ReturnCodeNonceTooLow,
+			fmt.Sprintf("nonce %d is lower than the sender's account nonce %d", tx.Nonce, accountNonce))
+	}
+	if tpc.maxNonceGap == 0 {
+		return nil
+	}
+
+	if !sender.isPoolNonceChecked {
+		sender.isPoolNonceChecked = true
+		lastPoolNonce, err := poolNonceProvider.GetLastPoolNonceForSender(ctx, tx.Sender)
+		if err == nil && lastPoolNonce >= sender.expectedNonce {
+			sender.expectedNonce = lastPoolNonce + 1
+		}
+	}
+
+	if tx.Nonce > sender.expectedNonce+tpc.maxNonceGap {
+		return newPreflightCheckError(data.ReturnCodeNonceTooHigh,
+			fmt.Sprintf("nonce %d is more than %d ahead of the expected nonce %d", tx.Nonce, tpc.maxNonceGap, sender.expectedNonce))
+	}
+
+	return nil
+}
+
+// checkBalance verifies that the sender can pay for the value and the fee of the transaction, computed as the network
+// does. The fee of a relayed transaction is paid by its relayer. Only the value is checked if the network fee settings
+// cannot be fetched
+func (tpc *TransactionPreflightChecker) checkBalance(ctx context.Context, tx *data.Transaction, senderBalance string, batch *preflightBatch) error {
+	value, ok := big.NewInt(0).SetString(tx.Value, 10)
+	if !ok {
+		return ErrInvalidTransactionValueField
+	}
+
+	feeSettings, err := tpc.getFeeSettings(ctx)
+	if err != nil {
+		log.Debug("transaction pre-flight: cannot get the fee settings, the fee is not checked", "error", err.Error())
+		return checkAccountCanAfford(tx.Sender, senderBalance, value)
+	}
+
+	fee := computeTxFee(tx, feeSettings)
+	if len(tx.RelayerAddr) == 0 {
+		return checkAccountCanAfford(tx.Sender, senderBalance, big.NewInt(0).Add(value, fee))
+	}
+
+	err = checkAccountCanAfford(tx.Sender, senderBalance, value)
+	if err != nil {
+		return err
+	}
+
+	relayer, err := tpc.getAccount(ctx, tx.RelayerAddr, batch)
+	if err != nil {
+		log.Debug("transaction pre-flight: cannot get the relayer account, the relayer balance check is skipped",
+			"relayer", tx.RelayerAddr, "error", err.Error())
+		return nil
+	}
+
+	return checkAccountCanAfford(tx.RelayerAddr, relayer.account.Balance, fee)
+}
+
+// computeTxFee computes the fee of a transaction as the network does: the gas needed to move the balance is paid at
+// the full gas price, while the rest of the gas limit is paid at the gas price adjusted by the gas price modifier
+func computeTxFee(tx *data.Transaction, feeSettings *txFeeSettings) *big.Int {
+	gasPrice := big.NewInt(0).SetUint64(tx.GasPrice)
+	moveBalanceGas := feeSettings.minGasLimit + uint64(len(tx.Data))*feeSettings.gasPerDataByte
+	if len(tx.RelayerAddr) > 0 {
+		// the relayer of a relayed transaction pays an extra minimum gas limit for moving the balance
+		moveBalanceGas += feeSettings.minGasLimit
+	}
+
+	fee := big.NewInt(0).Mul(big.NewInt(0).SetUint64(moveBalanceGas), gasPrice)
+	if tx.GasLimit <= moveBalanceGas {
+		return fee
+	}
+
+	processingGasPrice := uint64(float64(tx.GasPrice) * feeSettings.gasPriceModifier)
+	processingFee := big.NewInt(0).Mul(big.NewInt(0).SetUint64(tx.GasLimit-moveBalanceGas), big.NewInt(0).SetUint64(processingGasPrice))
+
+	return fee.Add(fee, processingFee)
+}
+
+// getFeeSettings returns the fee settings of the network, fetched again once they are older than feeSettingsValidity
+func (tpc *TransactionPreflightChecker) getFeeSettings(ctx context.Context) (*txFeeSettings, error) {
+	tpc.mutFeeSettings.Lock()
+	defer tpc.mutFeeSettings.Unlock()
+
+	if tpc.feeSettings != nil && time.Since(tpc.feeSettingsTimestamp) < feeSettingsValidity {
+		return tpc.feeSettings, nil
+	}
+
+	networkConfig, err := getNetworkConfig(ctx, tpc.networkConfigProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPriceModifier, err := strconv.ParseFloat(networkConfig.Config.GasPriceModifier, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gas price modifier: %w", err)
+	}
+
+	tpc.feeSettings = &txFeeSettings{
+		minGasLimit:      networkConfig.Config.MinGasLimit,
+		gasPerDataByte:   networkConfig.Config.GasPerDataByte,
+		gasPriceModifier: gasPriceModifier,
+	}
+	tpc.feeSettingsTimestamp = time.Now()
+
+	return tpc.feeSettings, nil
+}
+
+func checkAccountCanAfford(address string, balance string, cost *big.Int) error {
+	balanceValue, ok := big.NewInt(0).SetString(balance, 10)
+	if !ok {
+		log.Debug("transaction pre-flight: invalid account balance, the balance check is skipped",
+			"address", address, "balance", balance)
+		return nil
+	}
+
+	if balanceValue.Cmp(cost) < 0 {
+		return newPreflightCheckError(data.ReturnCodeInsufficientBalance,
+			fmt.Sprintf("the balance %s of %s does not cover the required %s", balance, address, cost.String()))
+	}
+
+	return nil
+}
+
+func newPreflightCheckError(code data.ReturnCode, reason string) error {
+	return &errors.ErrTxPreflightCheckFailed{
+		Code:   code,
+		Reason: reason,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tpc *TransactionPreflightChecker) IsInterfaceNil() bool {
+	return tpc == nil
+}
@@ -0,0 +1,329 @@
+package process_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/common"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testRelayerAddress = "0102030405060708091011121314151617181920212223242526272829303132"
+
+func createSignedTransaction(t *testing.T, sk crypto.PrivateKey, txArgs *data.Transaction) *data.Transaction {
+	pkBytes, err := sk.GeneratePublic().ToByteArray()
+	require.NoError(t, err)
+
+	txArgs.Sender = hex.EncodeToString(pkBytes)
+	txArgs.ChainID = "chain"
+	txArgs.Version = 1
+	if len(txArgs.Receiver) == 0 {
+		txArgs.Receiver = txArgs.Sender
+	}
+
+	signature, err := (&singlesig.Ed25519Signer{}).Sign(sk, getTestDataForSigning(t, txArgs))
+	require.NoError(t, err)
+	txArgs.Signature = hex.EncodeToString(signature)
+
+	return txArgs
+}
+
+// getTestDataForSigning returns the data to be signed for a transaction with hex encoded addresses
+func getTestDataForSigning(t *testing.T, tx *data.Transaction) []byte {
+	value, _ := big.NewInt(0).SetString(tx.Value, 10)
+	senderBytes, _ := hex.DecodeString(tx.Sender)
+	receiverBytes, _ := hex.DecodeString(tx.Receiver)
+	regularTx := &transaction.Transaction{
+		Nonce:    tx.Nonce,
+		Value:    value,
+		RcvAddr:  receiverBytes,
+		SndAddr:  senderBytes,
+		GasPrice: tx.GasPrice,
+		GasLimit: tx.GasLimit,
+		Data:     tx.Data,
+		ChainID:  []byte(tx.ChainID),
+		Version:  tx.Version,
+	}
+	if len(tx.RelayerAddr) > 0 {
+		regularTx.RelayerAddr, _ = hex.DecodeString(tx.RelayerAddr)
+	}
+
+	dataForSigning, err := regularTx.GetDataForSigning(&mock.PubKeyConverterMock{}, &marshal.JsonMarshalizer{}, keccak.NewKeccak())
+	require.NoError(t, err)
+
+	return dataForSigning
+}
+
+func createAccountProviderWithBalances(nonce uint64, balances map[string]string) *mock.AccountProviderStub {
+	return &mock.AccountProviderStub{
+		GetAccountCalled: func(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+			balance, found := balances[address]
+			if !found {
+				balance = "0"
+			}
+
+			return &data.AccountModel{Account: data.Account{Address: address, Nonce: nonce, Balance: balance}}, nil
+		},
+	}
+}
+
+func createNetworkConfigProvider() *mock.NetworkConfigProviderStub {
+	return &mock.NetworkConfigProviderStub{
+		GetNetworkConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"config": map[string]interface{}{
+						"erd_min_gas_limit":      50000,
+						"erd_gas_per_data_byte":  1500,
+						"erd_gas_price_modifier": "0.01",
+					},
+				},
+			}, nil
+		},
+	}
+}
+
+func requirePreflightCheckFailure(t *testing.T, err error, expectedCode data.ReturnCode) {
+	var preflightErr *apiErrors.ErrTxPreflightCheckFailed
+	require.True(t, errors.As(err, &preflightErr))
+	require.Equal(t, expectedCode, preflightErr.Code)
+}
+
+func TestNewTransactionPreflightChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil account provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		tpc, err := process.NewTransactionPreflightChecker(process.ArgsTransactionPreflightChecker{
+			NetworkConfigProvider: &mock.NetworkConfigProviderStub{},
+			PubKeyConverter:       &mock.PubKeyConverterMock{},
+		})
+		require.True(t, check.IfNil(tpc))
+		require.Equal(t, process.ErrNilAccountProvider, err)
+	})
+	t.Run("nil network config provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		tpc, err := process.NewTransactionPreflightChecker(process.ArgsTransactionPreflightChecker{
+			AccountProvider: &mock.AccountProviderStub{},
+			PubKeyConverter: &mock.PubKeyConverterMock{},
+		})
+		require.True(t, check.IfNil(tpc))
+		require.Equal(t, process.ErrNilNetworkConfigProvider, err)
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		tpc, err := process.NewTransactionPreflightChecker(process.ArgsTransactionPreflightChecker{
+			AccountProvider:       &mock.AccountProviderStub{},
+			NetworkConfigProvider: &mock.NetworkConfigProviderStub{},
+		})
+		require.True(t, check.IfNil(tpc))
+		require.Equal(t, process.ErrNilPubKeyConverter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tpc, err := process.NewTransactionPreflightChecker(process.ArgsTransactionPreflightChecker{
+			AccountProvider:       &mock.AccountProviderStub{},
+			NetworkConfigProvider: &mock.NetworkConfigProviderStub{},
+			PubKeyConverter:       &mock.PubKeyConverterMock{},
+		})
+		require.False(t, check.IfNil(tpc))
+		require.NoError(t, err)
+	})
+}
+
+func TestTransactionPreflightChecker_CheckTransaction(t *testing.T) {
+	t.Parallel()
+
+	sk, _ := signing.NewKeyGenerator(ed25519.NewEd25519()).GeneratePair()
+	createChecker := func(accountProvider process.AccountProvider) *process.TransactionPreflightChecker {
+		tpc, _ := process.NewTransactionPreflightChecker(process.ArgsTransactionPreflightChecker{
+			AccountProvider:       accountProvider,
+			NetworkConfigProvider: createNetworkConfigProvider(),
+			PubKeyConverter:       &mock.PubKeyConverterMock{},
+			MaxNonceGap:           10,
+		})
+
+		return tpc
+	}
+
+	t.Run("valid transaction should pass", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createSignedTransaction(t, sk, &data.Transaction{Nonce: 5, Value: "100", GasLimit: 50000, GasPrice: 1000000000})
+		tpc := createChecker(createAccountProviderWithBalances(5, map[string]string{tx.Sender: "50000000000100"}))
+
+		err := tpc.CheckTransaction(context.Background(), tx, &mock.PoolNonceProviderStub{})
+		require.NoError(t, err)
+	})
+	t.Run("altered transaction should fail with invalid signature", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createSignedTransaction(t, sk, &data.Transaction{Nonce: 5, Value: "100", GasLimit: 50000, GasPrice: 1000000000})
+		tx.Value = "1000"
+		tpc := createChecker(createAccountProviderWithBalances(5, nil))
+
+		err := tpc.CheckTransaction(context.Background(), tx, &mock.PoolNonceProviderStub{})
+		requirePreflightCheckFailure(t, err, data.ReturnCodeInvalidSignature)
+	})
+	t.Run("used nonce should fail with nonce too low", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createSignedTransaction(t, sk, &data.Transaction{Nonce: 4, Value: "0", GasLimit: 50000, GasPrice: 1000000000})
+		tpc := createChecker(createAccountProviderWithBalances(5, nil))
+
+		err := tpc.CheckTransaction(context.Background(), tx, &mock.PoolNonceProviderStub{})
+		requirePreflightCheckFailure(t, err, data.ReturnCodeNonceTooLow)
+	})
+	t.Run("nonce too far ahead should fail with nonce too high", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createSignedTransaction(t, sk, &data.Transaction{Nonce: 16, Value: "0", GasLimit: 50000, GasPrice: 1000000000})
+		tpc := createChecker(createAccountProviderWithBalances(5, nil))
+
+		err := tpc.CheckTransaction(context.Background(), tx, &mock.PoolNonceProviderStub{})
+		requirePreflightCheckFailure(t, err, data.ReturnCodeNonceTooHigh)
+	})
+	t.Run("nonces in pool should extend the accepted nonce gap", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createSignedTransaction(t, sk, &data.Transaction{Nonce: 16, Value: "0", GasLimit: 50000, GasPrice: 1000000000})
+		tpc := createChecker(createAccountProviderWithBalances(5, map[string]string{tx.Sender: "50000000000000"}))
+		poolNonceProvider := &mock.PoolNonceProviderStub{
+			GetLastPoolNonceForSenderCalled: func(sender string) (uint64, error) {
+				return 8, nil
+			},
+		}
+
+		err := tpc.CheckTransaction(context.Background(), tx, poolNonceProvider)
+		require.NoError(t, err)
+	})
+	t.Run("balance not covering the fee should fail with insufficient balance", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createSignedTransaction(t, sk, &data.Transaction{Nonce: 5, Value: "100", GasLimit: 50000, GasPrice: 1000000000})
+		tpc := createChecker(createAccountProviderWithBalances(5, map[string]string{tx.Sender: "50000000000099"}))
+
+		err := tpc.CheckTransaction(context.Background(), tx, &mock.PoolNonceProviderStub{})
+		requirePreflightCheckFailure(t, err, data.ReturnCodeInsufficientBalance)
+	})
+	t.Run("balance covering the network fee but not the gas limit at full gas price should pass", func(t *testing.T) {
+		t.Parallel()
+
+		// fee = (50000 + 4*1500) * 1000000000 + (5000000 - 56000) * 1000000000 * 0.01 = 105440000000000, while
+		// gasLimit * gasPrice = 5000000000000000
+		tx := createSignedTransaction(t, sk, &data.Transaction{Nonce: 5, Value: "100", Data: []byte("test"), GasLimit: 5000000, GasPrice: 1000000000})
+		tpc := createChecker(createAccountProviderWithBalances(5, map[string]string{tx.Sender: "105440000000100"}))
+		err := tpc.CheckTransaction(context.Background(), tx, &mock.PoolNonceProviderStub{})
+		require.NoError(t, err)
+
+		tpc = createChecker(createAccountProviderWithBalances(5, map[string]string{tx.Sender: "105440000000099"}))
+		err = tpc.CheckTransaction(context.Background(), tx, &mock.PoolNonceProviderStub{})
+		requirePreflightCheckFailure(t, err, data.ReturnCodeInsufficientBalance)
+	})
+	t.Run("fee settings fetch error should check only the value", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createSignedTransaction(t, sk, &data.Transaction{Nonce: 5, Value: "100", GasLimit: 50000, GasPrice: 1000000000})
+		tpc, _ := process.NewTransactionPreflightChecker(process.ArgsTransactionPreflightChecker{
+			AccountProvider: createAccountProviderWithBalances(5, map[string]string{tx.Sender: "100"}),
+			NetworkConfigProvider: &mock.NetworkConfigProviderStub{
+				GetNetworkConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+					return nil, errors.New("observers unavailable")
+				},
+			},
+			PubKeyConverter: &mock.PubKeyConverterMock{},
+		})
+		err := tpc.CheckTransaction(context.Background(), tx, &mock.PoolNonceProviderStub{})
+		require.NoError(t, err)
+
+		tx = createSignedTransaction(t, sk, &data.Transaction{Nonce: 5, Value: "101", GasLimit: 50000, GasPrice: 1000000000})
+		err = tpc.CheckTransaction(context.Background(), tx, &mock.PoolNonceProviderStub{})
+		requirePreflightCheckFailure(t, err, data.ReturnCodeInsufficientBalance)
+	})
+	t.Run("fee of a relayed transaction should be paid by the relayer", func(t *testing.T) {
+		t.Parallel()
+
+		// the relayer pays an extra minimum gas limit for moving the balance
+		tx := createSignedTransaction(t, sk, &data.Transaction{Nonce: 5, Value: "100", GasLimit: 100000, GasPrice: 1000000000, RelayerAddr: testRelayerAddress})
+		tpc := createChecker(createAccountProviderWithBalances(5, map[string]string{tx.Sender: "100", testRelayerAddress: "100000000000000"}))
+		err := tpc.CheckTransaction(context.Background(), tx, &mock.PoolNonceProviderStub{})
+		require.NoError(t, err)
+
+		tpc = createChecker(createAccountProviderWithBalances(5, map[string]string{tx.Sender: "100000000000100", testRelayerAddress: "99999999999999"}))
+		err = tpc.CheckTransaction(context.Background(), tx, &mock.PoolNonceProviderStub{})
+		requirePreflightCheckFailure(t, err, data.ReturnCodeInsufficientBalance)
+	})
+	t.Run("account fetch error should skip the nonce and balance checks", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createSignedTransaction(t, sk, &data.Transaction{Nonce: 0, Value: "100", GasLimit: 50000, GasPrice: 1000000000})
+		tpc := createChecker(&mock.AccountProviderStub{
+			GetAccountCalled: func(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+				return nil, errors.New("observers unavailable")
+			},
+		})
+
+		err := tpc.CheckTransaction(context.Background(), tx, &mock.PoolNonceProviderStub{})
+		require.NoError(t, err)
+	})
+}
+
+func TestTransactionPreflightChecker_CheckTransactions(t *testing.T) {
+	t.Parallel()
+
+	sk, _ := signing.NewKeyGenerator(ed25519.NewEd25519()).GeneratePair()
+	accountProvider := createAccountProviderWithBalances(5, nil)
+	numAccountFetches := 0
+	getAccount := accountProvider.GetAccountCalled
+	accountProvider.GetAccountCalled = func(ctx context.Context, address string, options common.AccountQueryOptions) (*data.AccountModel, error) {
+		numAccountFetches++
+		return getAccount(ctx, address, options)
+	}
+	numPoolNonceFetches := 0
+	poolNonceProvider := &mock.PoolNonceProviderStub{
+		GetLastPoolNonceForSenderCalled: func(sender string) (uint64, error) {
+			numPoolNonceFetches++
+			return 0, errors.New("not in pool")
+		},
+	}
+	tpc, _ := process.NewTransactionPreflightChecker(process.ArgsTransactionPreflightChecker{
+		AccountProvider:       accountProvider,
+		NetworkConfigProvider: createNetworkConfigProvider(),
+		PubKeyConverter:       &mock.PubKeyConverterMock{},
+		MaxNonceGap:           10,
+	})
+
+	txs := make([]*data.Transaction, 0)
+	for nonce := uint64(5); nonce < 20; nonce++ {
+		txs = append(txs, createSignedTransaction(t, sk, &data.Transaction{Nonce: nonce, Value: "0"}))
+	}
+	txs = append(txs, createSignedTransaction(t, sk, &data.Transaction{Nonce: 31, Value: "0"}))
+
+	errs := tpc.CheckTransactions(context.Background(), txs, poolNonceProvider)
+	require.Len(t, errs, len(txs))
+	for i := 0; i < len(txs)-1; i++ {
+		require.NoError(t, errs[i])
+	}
+	requirePreflightCheckFailure(t, errs[len(txs)-1], data.ReturnCodeNonceTooHigh)
+	require.Equal(t, 1, numAccountFetches)
+	require.Equal(t, 1, numPoolNonceFetches)
+}
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -14,6 +15,9 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/data"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // TransactionPath defines the transaction group path of the node
@@ -84,7 +88,11 @@ type TransactionProcessor struct {
 	relayedTxsMarshaller         marshal.Marshalizer
 	newTxCostProcessor           func() (TransactionCostHandler, error)
 	mergeLogsHandler             LogsMergerHandler
+	responseCacher               ResponseCacheHandler
+	finalityHandler              FinalityHandler
+	preflightChecker             TransactionPreflightHandler
 	shouldAllowEntireTxPoolFetch bool
+	tracer                       trace.Tracer
 }
 
 // NewTransactionProcessor creates a new instance of TransactionProcessor
@@ -95,6 +103,9 @@ func NewTransactionProcessor(
 	marshalizer marshal.Marshalizer,
 	newTxCostProcessor func() (TransactionCostHandler, error),
 	logsMerger LogsMergerHandler,
+	responseCacher ResponseCacheHandler,
+	finalityHandler FinalityHandler,
+	preflightChecker TransactionPreflightHandler,
 	allowEntireTxPoolFetch bool,
 ) (*TransactionProcessor, error) {
 	if check.IfNil(proc) {
@@ -115,6 +126,15 @@ func NewTransactionProcessor(
 	if check.IfNil(logsMerger) {
 		return nil, ErrNilLogsMerger
 	}
+	if check.IfNil(responseCacher) {
+		return nil, ErrNilResponseCacher
+	}
+	if check.IfNil(finalityHandler) {
+		return nil, ErrNilFinalityHandler
+	}
+	if check.IfNil(preflightChecker) {
+		return nil, ErrNilTransactionPreflightChecker
+	}
 
 	// no reason to get this from configs. If we are going to change the marshaller for the relayed transaction v1,
 	// we will need also an enable epoch handler
@@ -126,18 +146,27 @@ func NewTransactionProcessor(
 		marshalizer:                  marshalizer,
 		newTxCostProcessor:           newTxCostProcessor,
 		mergeLogsHandler:             logsMerger,
+		responseCacher:               responseCacher,
+		finalityHandler:              finalityHandler,
+		preflightChecker:             preflightChecker,
 		shouldAllowEntireTxPoolFetch: allowEntireTxPoolFetch,
 		relayedTxsMarshaller:         relayedTxsMarshaller,
+		tracer:                       otel.Tracer(tracerName),
 	}, nil
 }
 
 // SendTransaction relays the post request by sending the request to the right observer and replies back the answer
-func (tp *TransactionProcessor) SendTransaction(tx *data.Transaction) (int, string, error) {
+func (tp *TransactionProcessor) SendTransaction(ctx context.Context, tx *data.Transaction) (int, string, error) {
 	err := tp.checkTransactionFields(tx)
 	if err != nil {
 		return http.StatusBadRequest, "", err
 	}
 
+	err = tp.preflightChecker.CheckTransaction(ctx, tx, tp)
+	if err != nil {
+		return http.StatusBadRequest, "", err
+	}
+
 	senderBuff, err := tp.pubKeyConverter.Decode(tx.Sender)
 	if err != nil {
 		return http.StatusBadRequest, "", err
@@ -156,7 +185,7 @@ func (tp *TransactionProcessor) SendTransaction(tx *data.Transaction) (int, stri
 	txResponse := data.ResponseTransaction{}
 	for _, observer := range observers {
 
-		respCode, err := tp.proc.CallPostRestEndPoint(observer.Address, TransactionSendPath, tx, &txResponse)
+		respCode, err := tp.proc.CallPostRestEndPointWithContext(ctx, observer.Address, TransactionSendPath, tx, &txResponse)
 		if respCode == http.StatusOK && err == nil {
 			log.Info(fmt.Sprintf("Transaction sent successfully to observer %v from shard %v, received tx hash %s",
 				observer.Address,
@@ -165,6 +194,9 @@ func (tp *TransactionProcessor) SendTransaction(tx *data.Transaction) (int, stri
 			))
 			return respCode, txResponse.Data.TxHash, nil
 		}
+		if ctx.Err() != nil {
+			return http.StatusRequestTimeout, "", ctx.Err()
+		}
 
 		// if observer was down (or didn't respond in time), skip to the next one
 		if respCode == http.StatusNotFound || respCode == http.StatusRequestTimeout {
@@ -180,7 +212,7 @@ func (tp *TransactionProcessor) SendTransaction(tx *data.Transaction) (int, stri
 }
 
 // SimulateTransaction relays the post request by sending the request to the right observer and replies back the answer
-func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+func (tp *TransactionProcessor) SimulateTransaction(ctx context.Context, tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
 	err := tp.checkTransactionFields(tx)
 	if err != nil {
 		return nil, err
@@ -201,7 +233,7 @@ func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction, checkS
 		return nil, err
 	}
 
-	response, err := tp.simulateTransaction(observers, tx, checkSignature)
+	response, err := tp.simulateTransaction(ctx, observers, tx, checkSignature)
 	if err != nil {
 		return nil, fmt.Errorf("%w while trying to simulate on sender shard (shard %d)", err, senderShardID)
 	}
@@ -229,7 +261,7 @@ func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction, checkS
 		return nil, err
 	}
 
-	responseFromReceiverShard, err := tp.simulateTransaction(observersForReceiverShard, tx, checkSignature)
+	responseFromReceiverShard, err := tp.simulateTransaction(ctx, observersForReceiverShard, tx, checkSignature)
 	if err != nil {
 		return nil, fmt.Errorf("%w while trying to simulate on receiver shard (shard %d)", err, receiverShardID)
 	}
@@ -248,6 +280,7 @@ func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction, checkS
 }
 
 func (tp *TransactionProcessor) simulateTransaction(
+	ctx context.Context,
 	observers []*data.NodeData,
 	tx *data.Transaction,
 	checkSignature bool,
@@ -260,7 +293,7 @@ func (tp *TransactionProcessor) simulateTransaction(
 	txResponse := data.ResponseTransactionSimulation{}
 	for _, observer := range observers {
 
-		respCode, err := tp.proc.CallPostRestEndPoint(observer.Address, txSimulatePath, tx, &txResponse)
+		respCode, err := tp.proc.CallPostRestEndPointWithContext(ctx, observer.Address, txSimulatePath, tx, &txResponse)
 		if respCode == http.StatusOK && err == nil {
 			log.Info(fmt.Sprintf("Transaction simulation sent successfully to observer %v from shard %v, received tx hash %s",
 				observer.Address,
@@ -269,6 +302,9 @@ func (tp *TransactionProcessor) simulateTransaction(
 			))
 			return &txResponse, nil
 		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
 
 		// if observer was down (or didn't respond in time), skip to the next one
 		if respCode == http.StatusNotFound || respCode == http.StatusRequestTimeout {
@@ -283,69 +319,150 @@ func (tp *TransactionProcessor) simulateTransaction(
 	return nil, WrapObserversError(txResponse.Error)
 }
 
-// SendMultipleTransactions relays the post request by sending the request to the first available observer and replies back the answer
-func (tp *TransactionProcessor) SendMultipleTransactions(txs []*data.Transaction) (
+// SendMultipleTransactions relays the post request by sending the transactions to the observers of their sender shards
+// and replies back the result of each transaction: its hash, or the reason it was not sent. The transactions of a shard
+// are sent to the next observer of the shard if the current one fails
+func (tp *TransactionProcessor) SendMultipleTransactions(ctx context.Context, txs []*data.Transaction) (
 	data.MultipleTransactionsResponseData, error,
 ) {
-	// TODO: Analyze and improve the robustness of this function. Currently, an error within `GetObservers`
-	// breaks the function and returns nothing (but an error) even if some transactions were actually sent, successfully.
+	if len(txs) == 0 {
+		return data.MultipleTransactionsResponseData{}, ErrNoValidTransactionToSend
+	}
 
-	totalTxsSent := uint64(0)
-	txsToSend := make([]*data.Transaction, 0)
+	txsResults := make([]*data.TransactionSendResult, len(txs))
+	txsToCheck := make([]*data.Transaction, 0, len(txs))
 	for i := 0; i < len(txs); i++ {
 		currentTx := txs[i]
+		currentTx.Index = i
+
 		err := tp.checkTransactionFields(currentTx)
 		if err != nil {
 			log.Warn("invalid tx received",
 				"sender", currentTx.Sender,
 				"receiver", currentTx.Receiver,
 				"error", err)
+			txsResults[i] = newFailedTransactionSendResult(i, data.TxSendErrorValidation, err.Error())
 			continue
 		}
-		txsToSend = append(txsToSend, currentTx)
-	}
-	if len(txsToSend) == 0 {
-		return data.MultipleTransactionsResponseData{}, ErrNoValidTransactionToSend
+		txsToCheck = append(txsToCheck, currentTx)
 	}
 
-	txsHashes := make(map[int]string)
-	txsByShardID := tp.groupTxsByShard(txsToSend)
-	for shardID, groupOfTxs := range txsByShardID {
-		observersInShard, err := tp.proc.GetObservers(shardID, data.AvailabilityRecent)
+	txsToSend := make([]*data.Transaction, 0, len(txsToCheck))
+	preflightErrs := tp.preflightChecker.CheckTransactions(ctx, txsToCheck, tp)
+	for i, currentTx := range txsToCheck {
+		err := preflightErrs[i]
 		if err != nil {
-			return data.MultipleTransactionsResponseData{}, ErrMissingObserver
+			log.Debug("transaction rejected by the pre-flight checks",
+				"sender", currentTx.Sender,
+				"nonce", currentTx.Nonce,
+				"error", err)
+			txsResults[currentTx.Index] = newFailedTransactionSendResult(currentTx.Index, data.TxSendErrorValidation, err.Error())
+			continue
 		}
+		txsToSend = append(txsToSend, currentTx)
+	}
 
-		for _, observer := range observersInShard {
-			txResponse := &data.ResponseMultipleTransactions{}
-			respCode, err := tp.proc.CallPostRestEndPoint(observer.Address, MultipleTransactionsPath, groupOfTxs, txResponse)
-			if respCode == http.StatusOK && err == nil {
-				log.Info("transactions sent",
-					"observer", observer.Address,
-					"shard ID", shardID,
-					"total processed", txResponse.Data.NumOfTxs,
-				)
-				totalTxsSent += txResponse.Data.NumOfTxs
-
-				for key, hash := range txResponse.Data.TxsHashes {
-					txsHashes[groupOfTxs[key].Index] = hash
-				}
+	totalTxsSent := uint64(0)
+	txsHashes := make(map[int]string)
+	txsByShardID := tp.groupTxsByShard(txsToSend, txsResults)
+	for shardID, groupOfTxs := range txsByShardID {
+		groupHashes, sendErr := tp.sendTransactionsToShard(ctx, shardID, groupOfTxs)
+		for key, tx := range groupOfTxs {
+			if sendErr != nil {
+				txsResults[tx.Index] = &data.TransactionSendResult{Index: tx.Index, Error: sendErr}
+				continue
+			}
 
-				break
+			hash, isSent := groupHashes[key]
+			if !isSent {
+				txsResults[tx.Index] = newFailedTransactionSendResult(tx.Index, data.TxSendErrorObserverRejected, ErrTransactionNotAccepted.Error())
+				continue
 			}
 
-			log.LogIfError(err)
+			totalTxsSent++
+			txsHashes[tx.Index] = hash
+			txsResults[tx.Index] = &data.TransactionSendResult{Index: tx.Index, TxHash: hash}
 		}
 	}
 
 	return data.MultipleTransactionsResponseData{
-		NumOfTxs:  totalTxsSent,
-		TxsHashes: txsHashes,
+		NumOfTxs:   totalTxsSent,
+		TxsHashes:  txsHashes,
+		TxsResults: txsResults,
 	}, nil
 }
 
+// sendTransactionsToShard sends the transactions to the first observer of the shard able to handle them. The returned
+// hashes are indexed by the position of the transactions in the provided slice
+func (tp *TransactionProcessor) sendTransactionsToShard(ctx context.Context, shardID uint32, txs []*data.Transaction) (map[int]string, *data.TransactionSendError) {
+	observersInShard, err := tp.proc.GetObservers(shardID, data.AvailabilityRecent)
+	if err != nil {
+		return nil, &data.TransactionSendError{
+			Type:    data.TxSendErrorShardUnavailable,
+			Message: fmt.Sprintf("%s: %s", ErrMissingObserver.Error(), err.Error()),
+		}
+	}
+
+	sendErr := &data.TransactionSendError{
+		Type:    data.TxSendErrorShardUnavailable,
+		Message: fmt.Sprintf("%s for shard %d", ErrSendingRequest.Error(), shardID),
+	}
+	for _, observer := range observersInShard {
+		txResponse := &data.ResponseMultipleTransactions{}
+		respCode, errPost := tp.proc.CallPostRestEndPointWithContext(ctx, observer.Address, MultipleTransactionsPath, txs, txResponse)
+		if respCode == http.StatusOK && errPost == nil {
+			log.Info("transactions sent",
+				"observer", observer.Address,
+				"shard ID", shardID,
+				"total processed", txResponse.Data.NumOfTxs,
+			)
+			return txResponse.Data.TxsHashes, nil
+		}
+		if ctx.Err() != nil {
+			return nil, &data.TransactionSendError{Type: data.TxSendErrorShardUnavailable, Message: ctx.Err().Error()}
+		}
+
+		log.Debug("cannot send transactions, trying the next observer",
+			"observer", observer.Address,
+			"shard ID", shardID,
+			"response code", respCode,
+			"error", errPost,
+		)
+		sendErr = createTransactionSendError(respCode, errPost)
+	}
+
+	return nil, sendErr
+}
+
+// createTransactionSendError returns an observer rejection if the observer answered the request, or a shard
+// unavailability error otherwise
+func createTransactionSendError(respCode int, err error) *data.TransactionSendError {
+	message := http.StatusText(respCode)
+	if err != nil {
+		message = err.Error()
+	}
+
+	isRejected := respCode >= http.StatusBadRequest && respCode < http.StatusInternalServerError &&
+		respCode != http.StatusNotFound && respCode != http.StatusRequestTimeout && respCode != http.StatusTooManyRequests
+	if isRejected {
+		return &data.TransactionSendError{Type: data.TxSendErrorObserverRejected, Message: message}
+	}
+
+	return &data.TransactionSendError{Type: data.TxSendErrorShardUnavailable, Message: message}
+}
+
+func newFailedTransactionSendResult(index int, errType string, message string) *data.TransactionSendResult {
+	return &data.TransactionSendResult{
+		Index: index,
+		Error: &data.TransactionSendError{
+			Type:    errType,
+			Message: message,
+		},
+	}
+}
+
 // TransactionCostRequest should return how many gas units a transaction will cost
-func (tp *TransactionProcessor) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
+func (tp *TransactionProcessor) TransactionCostRequest(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
 	err := tp.checkTransactionFields(tx)
 	if err != nil {
 		return nil, err
@@ -356,12 +473,21 @@ func (tp *TransactionProcessor) TransactionCostRequest(tx *data.Transaction) (*d
 		return nil, err
 	}
 
-	return newTxCostProcessor.ResolveCostRequest(tx)
+	return newTxCostProcessor.ResolveCostRequest(ctx, tx)
 }
 
-// GetTransaction should return a transaction from observer
-func (tp *TransactionProcessor) GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
-	tx, err := tp.getTxFromObservers(txHash, requestTypeFullHistoryNodes, withResults)
+// GetTransaction should return a transaction from observer. Only the final transactions requested without results are
+// cached, as the cross-shard smart contract results can still be incomplete when the transaction becomes final
+func (tp *TransactionProcessor) GetTransaction(ctx context.Context, txHash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+	cacheKey := fmt.Sprintf("tx_%s", txHash)
+	if !withResults {
+		cachedTx := &transaction.ApiTransactionResult{}
+		if tp.responseCacher.Get(cacheKey, cachedTx) {
+			return cachedTx, nil
+		}
+	}
+
+	tx, err := tp.getTxFromObservers(ctx, txHash, requestTypeFullHistoryNodes, withResults)
 	if err != nil {
 		return nil, err
 	}
@@ -369,16 +495,35 @@ func (tp *TransactionProcessor) GetTransaction(txHash string, withResults bool)
 	tx.HyperblockNonce = tx.NotarizedAtDestinationInMetaNonce
 	tx.HyperblockHash = tx.NotarizedAtDestinationInMetaHash
 
+	if !withResults && tp.isTransactionFinal(tx) {
+		tp.responseCacher.Put(cacheKey, tx)
+	}
+
 	return tx, nil
 }
 
+func (tp *TransactionProcessor) isTransactionFinal(tx *transaction.ApiTransactionResult) bool {
+	switch tx.Status {
+	case transaction.TxStatusSuccess, transaction.TxStatusFail, transaction.TxStatusInvalid:
+	default:
+		return false
+	}
+
+	if tx.HyperblockNonce == 0 {
+		return false
+	}
+
+	return tp.finalityHandler.IsHyperblockFinal(tx.HyperblockNonce)
+}
+
 // GetTransactionByHashAndSenderAddress returns a transaction
 func (tp *TransactionProcessor) GetTransactionByHashAndSenderAddress(
+	ctx context.Context,
 	txHash string,
 	sndAddr string,
 	withResults bool,
 ) (*transaction.ApiTransactionResult, int, error) {
-	tx, err := tp.getTxWithSenderAddr(txHash, sndAddr, withResults)
+	tx, err := tp.getTxWithSenderAddr(ctx, txHash, sndAddr, withResults)
 	if err != nil {
 		return nil, http.StatusNotFound, err
 	}
@@ -406,8 +551,8 @@ func (tp *TransactionProcessor) getShardByAddress(address string) (uint32, error
 }
 
 // GetTransactionStatus returns the status of a transaction
-func (tp *TransactionProcessor) GetTransactionStatus(txHash string, sender string) (string, error) {
-	tx, err := tp.getTransaction(txHash, sender, false)
+func (tp *TransactionProcessor) GetTransactionStatus(ctx context.Context, txHash string, sender string) (string, error) {
+	tx, err := tp.getTransaction(ctx, txHash, sender, false)
 	if err != nil {
 		return string(data.TxStatusUnknown), err
 	}
@@ -415,29 +560,58 @@ func (tp *TransactionProcessor) GetTransactionStatus(txHash string, sender strin
 	return string(tx.Status), nil
 }
 
-func (tp *TransactionProcessor) getTransaction(txHash string, sender string, withResults bool) (*transaction.ApiTransactionResult, error) {
+func (tp *TransactionProcessor) getTransaction(ctx context.Context, txHash string, sender string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	if sender != "" {
-		return tp.getTxWithSenderAddr(txHash, sender, withResults)
+		return tp.getTxWithSenderAddr(ctx, txHash, sender, withResults)
 	}
 
 	// get status of transaction from random observers
-	return tp.getTxFromObservers(txHash, requestTypeObservers, withResults)
+	return tp.getTxFromObservers(ctx, txHash, requestTypeObservers, withResults)
 }
 
 // GetProcessedTransactionStatus returns the status of a transaction after local processing
-func (tp *TransactionProcessor) GetProcessedTransactionStatus(txHash string) (*data.ProcessStatusResponse, error) {
+func (tp *TransactionProcessor) GetProcessedTransactionStatus(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error) {
 	const withResults = true
-	tx, err := tp.getTxFromObservers(txHash, requestTypeObservers, withResults)
+	tx, err := tp.getTxFromObservers(ctx, txHash, requestTypeObservers, withResults)
 	if err != nil {
 		return &data.ProcessStatusResponse{
 			Status: string(data.TxStatusUnknown),
 		}, err
 	}
 
-	return tp.computeTransactionStatus(tx, withResults), nil
+	return tp.computeTransactionStatus(ctx, tx, withResults), nil
 }
 
-func (tp *TransactionProcessor) computeTransactionStatus(tx *transaction.ApiTransactionResult, withResults bool) *data.ProcessStatusResponse {
+// GetTransactionProgressStatus returns the process status of a transaction, just like GetProcessedTransactionStatus,
+// but the pending transactions which were already executed in their source shard are reported as partially executed
+func (tp *TransactionProcessor) GetTransactionProgressStatus(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error) {
+	const withResults = true
+	tx, err := tp.getTxFromObservers(ctx, txHash, requestTypeObservers, withResults)
+	if err != nil {
+		return &data.ProcessStatusResponse{
+			Status: string(data.TxStatusUnknown),
+		}, err
+	}
+
+	status := tp.computeTransactionStatus(ctx, tx, withResults)
+	if status.Status == string(transaction.TxStatusPending) && isPartiallyExecuted(tx) {
+		status.Status = string(data.TxStatusPartiallyExecuted)
+	}
+
+	return status, nil
+}
+
+func isPartiallyExecuted(tx *transaction.ApiTransactionResult) bool {
+	// the transaction was executed, but some of its smart contract results are still pending
+	if tx.Status == transaction.TxStatusSuccess {
+		return true
+	}
+
+	isCrossShard := tx.SourceShard != tx.DestinationShard
+	return isCrossShard && tx.NotarizedAtSourceInMetaNonce > 0
+}
+
+func (tp *TransactionProcessor) computeTransactionStatus(ctx context.Context, tx *transaction.ApiTransactionResult, withResults bool) *data.ProcessStatusResponse {
 	if !withResults {
 		return &data.ProcessStatusResponse{
 			Status: string(data.TxStatusUnknown),
@@ -461,7 +635,7 @@ func (tp *TransactionProcessor) computeTransactionStatus(tx *transaction.ApiTran
 		}
 	}
 
-	allLogs, allScrs, err := tp.gatherAllLogsAndScrs(tx)
+	allLogs, allScrs, err := tp.gatherAllLogsAndScrs(ctx, tx)
 	if err != nil {
 		log.Warn("error in TransactionProcessor.computeTransactionStatus", "error", err)
 		return &data.ProcessStatusResponse{
@@ -704,7 +878,7 @@ func findIdentifierInSingleLog(log *transaction.ApiLogs, identifier string) (boo
 	return false, []byte(emptyDataStr)
 }
 
-func (tp *TransactionProcessor) gatherAllLogsAndScrs(tx *transaction.ApiTransactionResult) ([]*transaction.ApiLogs, []*transaction.ApiTransactionResult, error) {
+func (tp *TransactionProcessor) gatherAllLogsAndScrs(ctx context.Context, tx *transaction.ApiTransactionResult) ([]*transaction.ApiLogs, []*transaction.ApiTransactionResult, error) {
 	const withResults = true
 	allLogs := make([]*transaction.ApiLogs, 0)
 	allScrs := make([]*transaction.ApiTransactionResult, 0)
@@ -714,7 +888,7 @@ func (tp *TransactionProcessor) gatherAllLogsAndScrs(tx *transaction.ApiTransact
 	}
 
 	for _, scrFromTx := range tx.SmartContractResults {
-		scr, err := tp.GetTransaction(scrFromTx.Hash, withResults)
+		scr, err := tp.GetTransaction(ctx, scrFromTx.Hash, withResults)
 		if err != nil {
 			return nil, nil, fmt.Errorf("%w for scr hash %s", err, scrFromTx.Hash)
 		}
@@ -733,10 +907,21 @@ func (tp *TransactionProcessor) gatherAllLogsAndScrs(tx *transaction.ApiTransact
 	return allLogs, allScrs, nil
 }
 
-func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType requestType, withResults bool) (*transaction.ApiTransactionResult, error) {
+func (tp *TransactionProcessor) getTxFromObservers(ctx context.Context, txHash string, reqType requestType, withResults bool) (*transaction.ApiTransactionResult, error) {
+	ctx, span := tp.tracer.Start(ctx, "TransactionProcessor.getTxFromObservers", trace.WithAttributes(
+		attribute.String("tx.hash", txHash),
+		attribute.Bool("tx.withResults", withResults),
+	))
+	defer span.End()
+
 	observersShardIDs := tp.proc.GetShardIDs()
 	shardIDWasFetch := make(map[uint32]*tupleHashWasFetched)
 	for _, observerShardID := range observersShardIDs {
+		if ctx.Err() != nil {
+			// the request was aborted, there is no need to continue the search in the other shards
+			return nil, ctx.Err()
+		}
+
 		nodesInShard, err := tp.getNodesInShard(observerShardID, reqType)
 		if err != nil {
 			return nil, err
@@ -746,7 +931,7 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 		var withHttpError bool
 		var ok bool
 		for _, observerInShard := range nodesInShard {
-			getTxResponse, ok, withHttpError = tp.getTxFromObserver(observerInShard, txHash, withResults)
+			getTxResponse, ok, withHttpError = tp.getTxFromObserver(ctx, observerInShard, txHash, withResults)
 			if !withHttpError {
 				break
 			}
@@ -793,11 +978,11 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 		if observerIsInDestShard {
 			// need to get transaction from source shard and merge scResults
 			// if withEvents is true
-			txFromSource := tp.alterTxWithScResultsFromSourceIfNeeded(txHash, &getTxResponse.Data.Transaction, withResults, shardIDWasFetch)
+			txFromSource := tp.alterTxWithScResultsFromSourceIfNeeded(ctx, txHash, &getTxResponse.Data.Transaction, withResults, shardIDWasFetch)
 
 			tp.extraShardFromSCRs(txFromSource.SmartContractResults, shardIDWasFetch)
 
-			err = tp.fetchSCRSBasedOnShardMap(txFromSource, shardIDWasFetch)
+			err = tp.fetchSCRSBasedOnShardMap(ctx, txFromSource, shardIDWasFetch)
 			if err != nil {
 				return nil, err
 			}
@@ -806,13 +991,13 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 		}
 
 		// get transaction from observer that is in destination shard
-		txFromDstShard, ok := tp.getTxFromDestShard(txHash, rcvShardID, withResults)
+		txFromDstShard, ok := tp.getTxFromDestShard(ctx, txHash, rcvShardID, withResults)
 		if ok {
 			tp.extraShardFromSCRs(txFromDstShard.SmartContractResults, shardIDWasFetch)
 
 			alteredTxFromDest := tp.mergeScResultsFromSourceAndDestIfNeeded(&getTxResponse.Data.Transaction, txFromDstShard, withResults)
 
-			err = tp.fetchSCRSBasedOnShardMap(alteredTxFromDest, shardIDWasFetch)
+			err = tp.fetchSCRSBasedOnShardMap(ctx, alteredTxFromDest, shardIDWasFetch)
 			if err != nil {
 				return nil, err
 			}
@@ -823,7 +1008,7 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 		// return transaction from observer from source shard
 		// if did not get ok responses from observers from destination shard
 
-		err = tp.fetchSCRSBasedOnShardMap(&getTxResponse.Data.Transaction, shardIDWasFetch)
+		err = tp.fetchSCRSBasedOnShardMap(ctx, &getTxResponse.Data.Transaction, shardIDWasFetch)
 		if err != nil {
 			return nil, err
 		}
@@ -834,9 +1019,15 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 	return nil, errors.ErrTransactionNotFound
 }
 
-func (tp *TransactionProcessor) fetchSCRSBasedOnShardMap(tx *transaction.ApiTransactionResult, shardIDWasFetch map[uint32]*tupleHashWasFetched) error {
+func (tp *TransactionProcessor) fetchSCRSBasedOnShardMap(ctx context.Context, tx *transaction.ApiTransactionResult, shardIDWasFetch map[uint32]*tupleHashWasFetched) error {
+	ctx, span := tp.tracer.Start(ctx, "TransactionProcessor.fetchSCRSBasedOnShardMap", trace.WithAttributes(
+		attribute.String("tx.hash", tx.Hash),
+		attribute.Int("shards", len(shardIDWasFetch)),
+	))
+	defer span.End()
+
 	for shardID, info := range shardIDWasFetch {
-		scrs, err := tp.fetchSCRs(tx.Hash, info.hash, shardID)
+		scrs, err := tp.fetchSCRs(ctx, tx.Hash, info.hash, shardID)
 		if err != nil {
 			return err
 		}
@@ -851,7 +1042,7 @@ func (tp *TransactionProcessor) fetchSCRSBasedOnShardMap(tx *transaction.ApiTran
 	return nil
 }
 
-func (tp *TransactionProcessor) fetchSCRs(txHash, scrHash string, shardID uint32) ([]*transaction.ApiSmartContractResult, error) {
+func (tp *TransactionProcessor) fetchSCRs(ctx context.Context, txHash, scrHash string, shardID uint32) ([]*transaction.ApiSmartContractResult, error) {
 	observers, err := tp.getNodesInShard(shardID, requestTypeFullHistoryNodes)
 	if err != nil {
 		return nil, err
@@ -860,7 +1051,7 @@ func (tp *TransactionProcessor) fetchSCRs(txHash, scrHash string, shardID uint32
 	apiPath := SCRsByTxHash + txHash + fmt.Sprintf(scrHashParam, scrHash)
 	for _, observer := range observers {
 		getTxResponseDst := &data.GetSCRsResponse{}
-		respCode, errG := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, getTxResponseDst)
+		respCode, errG := tp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, apiPath, getTxResponseDst)
 		if errG != nil {
 			log.Trace("cannot get smart contract results", "address", observer.Address, "error", errG)
 			continue
@@ -913,7 +1104,7 @@ func (tp *TransactionProcessor) extraShardFromSCRs(scrs []*transaction.ApiSmartC
 	}
 }
 
-func (tp *TransactionProcessor) alterTxWithScResultsFromSourceIfNeeded(txHash string, tx *transaction.ApiTransactionResult, withResults bool, shardIDWasFetch map[uint32]*tupleHashWasFetched) *transaction.ApiTransactionResult {
+func (tp *TransactionProcessor) alterTxWithScResultsFromSourceIfNeeded(ctx context.Context, txHash string, tx *transaction.ApiTransactionResult, withResults bool, shardIDWasFetch map[uint32]*tupleHashWasFetched) *transaction.ApiTransactionResult {
 	if !withResults || len(tx.SmartContractResults) == 0 {
 		return tx
 	}
@@ -924,7 +1115,7 @@ func (tp *TransactionProcessor) alterTxWithScResultsFromSourceIfNeeded(txHash st
 	}
 
 	for _, observer := range observers {
-		getTxResponse, ok, _ := tp.getTxFromObserver(observer, txHash, withResults)
+		getTxResponse, ok, _ := tp.getTxFromObserver(ctx, observer, txHash, withResults)
 		if !ok {
 			continue
 		}
@@ -942,14 +1133,14 @@ func (tp *TransactionProcessor) alterTxWithScResultsFromSourceIfNeeded(txHash st
 	return tx
 }
 
-func (tp *TransactionProcessor) getTxWithSenderAddr(txHash, sender string, withResults bool) (*transaction.ApiTransactionResult, error) {
+func (tp *TransactionProcessor) getTxWithSenderAddr(ctx context.Context, txHash, sender string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	observers, sndShardID, err := tp.getShardObserversForSender(sender, requestTypeFullHistoryNodes)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, observer := range observers {
-		getTxResponse, ok, _ := tp.getTxFromObserver(observer, txHash, withResults)
+		getTxResponse, ok, _ := tp.getTxFromObserver(ctx, observer, txHash, withResults)
 		if !ok {
 			continue
 		}
@@ -966,7 +1157,7 @@ func (tp *TransactionProcessor) getTxWithSenderAddr(txHash, sender string, withR
 			return &getTxResponse.Data.Transaction, nil
 		}
 
-		txFromDstShard, ok := tp.getTxFromDestShard(txHash, rcvShardID, withResults)
+		txFromDstShard, ok := tp.getTxFromDestShard(ctx, txHash, rcvShardID, withResults)
 		if ok {
 			alteredTxFromDest := tp.mergeScResultsFromSourceAndDestIfNeeded(&getTxResponse.Data.Transaction, txFromDstShard, withResults)
 			return alteredTxFromDest, nil
@@ -1018,6 +1209,7 @@ func (tp *TransactionProcessor) getScResultsUnion(scResults []*transaction.ApiSm
 }
 
 func (tp *TransactionProcessor) getTxFromObserver(
+	ctx context.Context,
 	observer *data.NodeData,
 	txHash string,
 	withResults bool,
@@ -1028,7 +1220,7 @@ func (tp *TransactionProcessor) getTxFromObserver(
 		apiPath += withResultsParam
 	}
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, getTxResponse)
+	respCode, err := tp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, apiPath, getTxResponse)
 	if err != nil {
 		log.Trace("cannot get transaction", "address", observer.Address, "error", err)
 
@@ -1046,7 +1238,7 @@ func (tp *TransactionProcessor) getTxFromObserver(
 	return getTxResponse, true, false
 }
 
-func (tp *TransactionProcessor) getTxFromDestShard(txHash string, dstShardID uint32, withEvents bool) (*transaction.ApiTransactionResult, bool) {
+func (tp *TransactionProcessor) getTxFromDestShard(ctx context.Context, txHash string, dstShardID uint32, withEvents bool) (*transaction.ApiTransactionResult, bool) {
 	// cross shard transaction
 	destinationShardObservers, err := tp.proc.GetObservers(dstShardID, data.AvailabilityAll)
 	if err != nil {
@@ -1060,7 +1252,7 @@ func (tp *TransactionProcessor) getTxFromDestShard(txHash string, dstShardID uin
 
 	for _, dstObserver := range destinationShardObservers {
 		getTxResponseDst := &data.GetTransactionResponse{}
-		respCode, err := tp.proc.CallGetRestEndPoint(dstObserver.Address, apiPath, getTxResponseDst)
+		respCode, err := tp.proc.CallGetRestEndPointWithContext(ctx, dstObserver.Address, apiPath, getTxResponseDst)
 		if err != nil {
 			log.Trace("cannot get transaction", "address", dstObserver.Address, "error", err)
 			continue
@@ -1076,20 +1268,23 @@ func (tp *TransactionProcessor) getTxFromDestShard(txHash string, dstShardID uin
 	return nil, false
 }
 
-func (tp *TransactionProcessor) groupTxsByShard(txs []*data.Transaction) map[uint32][]*data.Transaction {
+// groupTxsByShard groups the transactions by their sender shard. The transactions whose shard cannot be computed are
+// reported as invalid in the provided results, at their index
+func (tp *TransactionProcessor) groupTxsByShard(txs []*data.Transaction, txsResults []*data.TransactionSendResult) map[uint32][]*data.Transaction {
 	txsMap := make(map[uint32][]*data.Transaction)
-	for idx, tx := range txs {
+	for _, tx := range txs {
 		senderBytes, err := tp.pubKeyConverter.Decode(tx.Sender)
 		if err != nil {
+			txsResults[tx.Index] = newFailedTransactionSendResult(tx.Index, data.TxSendErrorValidation, err.Error())
 			continue
 		}
 
 		senderShardID, err := tp.proc.ComputeShardId(senderBytes)
 		if err != nil {
+			txsResults[tx.Index] = newFailedTransactionSendResult(tx.Index, data.TxSendErrorValidation, err.Error())
 			continue
 		}
 
-		tx.Index = idx
 		txsMap[senderShardID] = append(txsMap[senderShardID], tx)
 	}
 
@@ -1232,12 +1427,12 @@ func (tp *TransactionProcessor) getNodesInShard(shardID uint32, reqType requestT
 }
 
 // GetTransactionsPool should return all transactions from all shards pool
-func (tp *TransactionProcessor) GetTransactionsPool(fields string) (*data.TransactionsPool, error) {
+func (tp *TransactionProcessor) GetTransactionsPool(ctx context.Context, fields string) (*data.TransactionsPool, error) {
 	if !tp.shouldAllowEntireTxPoolFetch {
 		return nil, errors.ErrOperationNotAllowed
 	}
 
-	txPool, err := tp.getTxPool(fields)
+	txPool, err := tp.getTxPool(ctx, fields)
 	if err != nil {
 		return nil, err
 	}
@@ -1246,12 +1441,12 @@ func (tp *TransactionProcessor) GetTransactionsPool(fields string) (*data.Transa
 }
 
 // GetTransactionsPoolForShard should return transactions pool from one observer from shard
-func (tp *TransactionProcessor) GetTransactionsPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
+func (tp *TransactionProcessor) GetTransactionsPoolForShard(ctx context.Context, shardID uint32, fields string) (*data.TransactionsPool, error) {
 	if !tp.shouldAllowEntireTxPoolFetch {
 		return nil, errors.ErrOperationNotAllowed
 	}
 
-	txPool, err := tp.getTxPoolForShard(shardID, fields)
+	txPool, err := tp.getTxPoolForShard(ctx, shardID, fields)
 	if err != nil {
 		return nil, err
 	}
@@ -1260,8 +1455,8 @@ func (tp *TransactionProcessor) GetTransactionsPoolForShard(shardID uint32, fiel
 }
 
 // GetTransactionsPoolForSender should return transactions for sender from observer's pool
-func (tp *TransactionProcessor) GetTransactionsPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
-	txPool, err := tp.getTxPoolForSender(sender, fields)
+func (tp *TransactionProcessor) GetTransactionsPoolForSender(ctx context.Context, sender, fields string) (*data.TransactionsPoolForSender, error) {
+	txPool, err := tp.getTxPoolForSender(ctx, sender, fields)
 	if err != nil {
 		return nil, err
 	}
@@ -1270,13 +1465,13 @@ func (tp *TransactionProcessor) GetTransactionsPoolForSender(sender, fields stri
 }
 
 // GetLastPoolNonceForSender should return last nonce for sender from observer's pool
-func (tp *TransactionProcessor) GetLastPoolNonceForSender(sender string) (uint64, error) {
-	return tp.getLastTxPoolNonceForSender(sender)
+func (tp *TransactionProcessor) GetLastPoolNonceForSender(ctx context.Context, sender string) (uint64, error) {
+	return tp.getLastTxPoolNonceForSender(ctx, sender)
 }
 
 // GetTransactionsPoolNonceGapsForSender should return nonce gaps for sender from observer's pool
-func (tp *TransactionProcessor) GetTransactionsPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
-	return tp.getTxPoolNonceGapsForSender(sender)
+func (tp *TransactionProcessor) GetTransactionsPoolNonceGapsForSender(ctx context.Context, sender string) (*data.TransactionsPoolNonceGaps, error) {
+	return tp.getTxPoolNonceGapsForSender(ctx, sender)
 }
 
 func (tp *TransactionProcessor) getShardObserversForSender(sender string, observersType requestType) ([]*data.NodeData, uint32, error) {
@@ -1293,7 +1488,7 @@ func (tp *TransactionProcessor) getShardObserversForSender(sender string, observ
 	return observers, sndShardID, nil
 }
 
-func (tp *TransactionProcessor) getTxPool(fields string) (*data.TransactionsPool, error) {
+func (tp *TransactionProcessor) getTxPool(ctx context.Context, fields string) (*data.TransactionsPool, error) {
 	shardIDs := tp.proc.GetShardIDs()
 	txs := &data.TransactionsPool{
 		RegularTransactions:  make([]data.WrappedTransaction, 0),
@@ -1301,7 +1496,7 @@ func (tp *TransactionProcessor) getTxPool(fields string) (*data.TransactionsPool
 		Rewards:              make([]data.WrappedTransaction, 0),
 	}
 	for _, shard := range shardIDs {
-		intraShardTxs, err := tp.getTxPoolForShard(shard, fields)
+		intraShardTxs, err := tp.getTxPoolForShard(ctx, shard, fields)
 		if err != nil {
 			continue
 		}
@@ -1314,7 +1509,7 @@ func (tp *TransactionProcessor) getTxPool(fields string) (*data.TransactionsPool
 	return txs, nil
 }
 
-func (tp *TransactionProcessor) getTxPoolForShard(shardID uint32, fields string) (*data.TransactionsPool, error) {
+func (tp *TransactionProcessor) getTxPoolForShard(ctx context.Context, shardID uint32, fields string) (*data.TransactionsPool, error) {
 	observers, err := tp.getNodesInShard(shardID, requestTypeObservers)
 	if err != nil {
 		log.Trace("cannot get observers for shard", "shard", shardID, "error", err)
@@ -1322,7 +1517,7 @@ func (tp *TransactionProcessor) getTxPoolForShard(shardID uint32, fields string)
 	}
 
 	for _, observer := range observers {
-		txs, ok := tp.getTxPoolFromObserver(observer, fields)
+		txs, ok := tp.getTxPoolFromObserver(ctx, observer, fields)
 		if !ok {
 			continue
 		}
@@ -1335,13 +1530,14 @@ func (tp *TransactionProcessor) getTxPoolForShard(shardID uint32, fields string)
 }
 
 func (tp *TransactionProcessor) getTxPoolFromObserver(
+	ctx context.Context,
 	observer *data.NodeData,
 	fields string,
 ) (*data.TransactionsPool, bool) {
 	txsPoolResponse := &data.TransactionsPoolApiResponse{}
 	apiPath := TransactionsPoolPath + fieldsParam + fields
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, txsPoolResponse)
+	respCode, err := tp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, apiPath, txsPoolResponse)
 	if err != nil {
 		log.Trace("cannot get tx pool", "address", observer.Address, "error", err)
 
@@ -1359,7 +1555,7 @@ func (tp *TransactionProcessor) getTxPoolFromObserver(
 	return &txsPoolResponse.Data.Transactions, true
 }
 
-func (tp *TransactionProcessor) getTxPoolForSender(sender, fields string) (*data.TransactionsPoolForSender, error) {
+func (tp *TransactionProcessor) getTxPoolForSender(ctx context.Context, sender, fields string) (*data.TransactionsPoolForSender, error) {
 	observers, _, err := tp.getShardObserversForSender(sender, requestTypeObservers)
 	if err != nil {
 		return nil, err
@@ -1370,7 +1566,7 @@ func (tp *TransactionProcessor) getTxPoolForSender(sender, fields string) (*data
 	}
 	var ok bool
 	for _, observer := range observers {
-		txsInPool, ok = tp.getTxPoolForSenderFromObserver(observer, sender, fields)
+		txsInPool, ok = tp.getTxPoolForSenderFromObserver(ctx, observer, sender, fields)
 		if ok {
 			break
 		}
@@ -1380,6 +1576,7 @@ func (tp *TransactionProcessor) getTxPoolForSender(sender, fields string) (*data
 }
 
 func (tp *TransactionProcessor) getTxPoolForSenderFromObserver(
+	ctx context.Context,
 	observer *data.NodeData,
 	sender string,
 	fields string,
@@ -1387,7 +1584,7 @@ func (tp *TransactionProcessor) getTxPoolForSenderFromObserver(
 	txsPoolResponse := &data.TransactionsPoolForSenderApiResponse{}
 	apiPath := TransactionsPoolPath + fieldsParam + fields + bySenderParam + sender
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, txsPoolResponse)
+	respCode, err := tp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, apiPath, txsPoolResponse)
 	if err != nil {
 		log.Trace("cannot get tx pool for sender", "address", observer.Address, "sender", sender, "error", err)
 
@@ -1405,14 +1602,14 @@ func (tp *TransactionProcessor) getTxPoolForSenderFromObserver(
 	return &txsPoolResponse.Data.TxPool, true
 }
 
-func (tp *TransactionProcessor) getLastTxPoolNonceForSender(sender string) (uint64, error) {
+func (tp *TransactionProcessor) getLastTxPoolNonceForSender(ctx context.Context, sender string) (uint64, error) {
 	observers, _, err := tp.getShardObserversForSender(sender, requestTypeObservers)
 	if err != nil {
 		return 0, err
 	}
 
 	for _, observer := range observers {
-		nonce, ok := tp.getLastTxPoolNonceFromObserver(observer, sender)
+		nonce, ok := tp.getLastTxPoolNonceFromObserver(ctx, observer, sender)
 		if !ok {
 			continue
 		}
@@ -1424,13 +1621,14 @@ func (tp *TransactionProcessor) getLastTxPoolNonceForSender(sender string) (uint
 }
 
 func (tp *TransactionProcessor) getLastTxPoolNonceFromObserver(
+	ctx context.Context,
 	observer *data.NodeData,
 	sender string,
 ) (uint64, bool) {
 	lastNonceResponse := &data.TransactionsPoolLastNonceForSenderApiResponse{}
 	apiPath := TransactionsPoolPath + lastNonceParam + bySenderParam + sender
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, lastNonceResponse)
+	respCode, err := tp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, apiPath, lastNonceResponse)
 	if err != nil {
 		log.Trace("cannot get last nonce from tx pool", "address", observer.Address, "sender", sender, "error", err)
 
@@ -1448,7 +1646,7 @@ func (tp *TransactionProcessor) getLastTxPoolNonceFromObserver(
 	return lastNonceResponse.Data.Nonce, true
 }
 
-func (tp *TransactionProcessor) getTxPoolNonceGapsForSender(sender string) (*data.TransactionsPoolNonceGaps, error) {
+func (tp *TransactionProcessor) getTxPoolNonceGapsForSender(ctx context.Context, sender string) (*data.TransactionsPoolNonceGaps, error) {
 	observers, _, err := tp.getShardObserversForSender(sender, requestTypeObservers)
 	if err != nil {
 		return nil, err
@@ -1459,7 +1657,7 @@ func (tp *TransactionProcessor) getTxPoolNonceGapsForSender(sender string) (*dat
 	}
 	var ok bool
 	for _, observer := range observers {
-		nonceGaps, ok = tp.getTxPoolNonceGapsFromObserver(observer, sender)
+		nonceGaps, ok = tp.getTxPoolNonceGapsFromObserver(ctx, observer, sender)
 		if ok {
 			break
 		}
@@ -1469,13 +1667,14 @@ func (tp *TransactionProcessor) getTxPoolNonceGapsForSender(sender string) (*dat
 }
 
 func (tp *TransactionProcessor) getTxPoolNonceGapsFromObserver(
+	ctx context.Context,
 	observer *data.NodeData,
 	sender string,
 ) (*data.TransactionsPoolNonceGaps, bool) {
 	nonceGapsResponse := &data.TransactionsPoolNonceGapsForSenderApiResponse{}
 	apiPath := TransactionsPoolPath + nonceGapsParam + bySenderParam + sender
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, nonceGapsResponse)
+	respCode, err := tp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, apiPath, nonceGapsResponse)
 	if err != nil {
 		log.Warn("cannot get nonce gaps from tx pool", "address", observer.Address, "sender", sender, "error", err)
 
@@ -1492,3 +1691,8 @@ func (tp *TransactionProcessor) getTxPoolNonceGapsFromObserver(
 
 	return &nonceGapsResponse.Data.NonceGaps, true
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tp *TransactionProcessor) IsInterfaceNil() bool {
+	return tp == nil
+}
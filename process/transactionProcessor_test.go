@@ -2,6 +2,7 @@ package process_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -23,6 +24,7 @@ import (
 	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
 	"github.com/multiversx/mx-chain-proxy-go/data"
 	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/disabled"
 	"github.com/multiversx/mx-chain-proxy-go/process/logsevents"
 	"github.com/multiversx/mx-chain-proxy-go/process/mock"
 	"github.com/stretchr/testify/assert"
@@ -91,6 +93,9 @@ func createTestProcessorFromScenarioData(testData *scenarioData) *process.Transa
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		false,
 	)
 
@@ -100,7 +105,7 @@ func createTestProcessorFromScenarioData(testData *scenarioData) *process.Transa
 func TestNewTransactionProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(nil, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(nil, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilCoreProcessor, err)
@@ -109,7 +114,7 @@ func TestNewTransactionProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, nil, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, nil, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilPubKeyConverter, err)
@@ -118,7 +123,7 @@ func TestNewTransactionProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilHasherShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, nil, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, nil, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilHasher, err)
@@ -127,7 +132,7 @@ func TestNewTransactionProcessor_NilHasherShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, nil, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, nil, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilMarshalizer, err)
@@ -136,16 +141,43 @@ func TestNewTransactionProcessor_NilMarshalizerShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilLogsMergerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, nil, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, nil, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilLogsMerger, err)
 }
 
+func TestNewTransactionProcessor_NilResponseCacherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, nil, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
+
+	require.Nil(t, tp)
+	require.Equal(t, process.ErrNilResponseCacher, err)
+}
+
+func TestNewTransactionProcessor_NilFinalityHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, nil, &disabled.TransactionPreflightChecker{}, true)
+
+	require.Nil(t, tp)
+	require.Equal(t, process.ErrNilFinalityHandler, err)
+}
+
+func TestNewTransactionProcessor_NilPreflightCheckerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, nil, true)
+
+	require.Nil(t, tp)
+	require.Equal(t, process.ErrNilTransactionPreflightChecker, err)
+}
+
 func TestNewTransactionProcessor_OkValuesShouldWork(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
 
 	require.NotNil(t, tp)
 	require.Nil(t, err)
@@ -156,8 +188,8 @@ func TestNewTransactionProcessor_OkValuesShouldWork(t *testing.T) {
 func TestTransactionProcessor_SendTransactionInvalidHexAdressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		Sender: "invalid hex number",
 	})
 
@@ -170,8 +202,8 @@ func TestTransactionProcessor_SendTransactionInvalidHexAdressShouldErr(t *testin
 func TestTransactionProcessor_SendTransactionNoChainIDShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{})
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{})
 
 	require.Empty(t, txHash)
 	require.NotNil(t, err)
@@ -182,8 +214,8 @@ func TestTransactionProcessor_SendTransactionNoChainIDShouldErr(t *testing.T) {
 func TestTransactionProcessor_SendTransactionNoVersionShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		ChainID: "chainID",
 	})
 
@@ -208,9 +240,12 @@ func TestTransactionProcessor_SendTransactionComputeShardIdFailsShouldErr(t *tes
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		true,
 	)
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		ChainID: "chain",
 		Version: 1,
 	})
@@ -238,10 +273,13 @@ func TestTransactionProcessor_SendTransactionGetObserversFailsShouldErr(t *testi
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		true,
 	)
 	address := "DEADBEEF"
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		Sender:  address,
 		ChainID: "chain",
 		Version: 1,
@@ -276,10 +314,13 @@ func TestTransactionProcessor_SendTransactionSendingFailsOnAllObserversShouldErr
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		true,
 	)
 	address := "DEADBEEF"
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		Sender:  address,
 		ChainID: "chain",
 		Version: 1,
@@ -317,10 +358,13 @@ func TestTransactionProcessor_SendTransactionSendingFailsOnFirstObserverShouldSt
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		true,
 	)
 	address := "DEADBEEF"
-	rc, resultedTxHash, err := tp.SendTransaction(&data.Transaction{
+	rc, resultedTxHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		Sender:  address,
 		ChainID: "chain",
 		Version: 1,
@@ -331,6 +375,65 @@ func TestTransactionProcessor_SendTransactionSendingFailsOnFirstObserverShouldSt
 	require.Equal(t, http.StatusOK, rc)
 }
 
+func TestTransactionProcessor_SendTransactionPreflightCheckFailsShouldNotSend(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := &apiErrors.ErrTxPreflightCheckFailed{Code: data.ReturnCodeNonceTooLow, Reason: "nonce too low"}
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{{Address: "address", ShardId: 0}}, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				require.Fail(t, "should have not sent the transaction")
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&preflightCheckerStub{err: expectedErr},
+		true,
+	)
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
+		Sender:  "DEADBEEF",
+		ChainID: "chain",
+		Version: 1,
+	})
+
+	require.Empty(t, txHash)
+	require.Equal(t, expectedErr, err)
+	require.Equal(t, http.StatusBadRequest, rc)
+}
+
+type preflightCheckerStub struct {
+	err error
+}
+
+func (stub *preflightCheckerStub) CheckTransaction(_ context.Context, _ *data.Transaction, _ process.PoolNonceProvider) error {
+	return stub.err
+}
+
+func (stub *preflightCheckerStub) CheckTransactions(_ context.Context, txs []*data.Transaction, _ process.PoolNonceProvider) []error {
+	errs := make([]error, len(txs))
+	for i := range errs {
+		errs[i] = stub.err
+	}
+
+	return errs
+}
+
+func (stub *preflightCheckerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
 // //------- SendMultipleTransactions
 
 func TestTransactionProcessor_SendMultipleTransactionsShouldWork(t *testing.T) {
@@ -368,10 +471,13 @@ func TestTransactionProcessor_SendMultipleTransactionsShouldWork(t *testing.T) {
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		true,
 	)
 
-	response, err := tp.SendMultipleTransactions(txsToSend)
+	response, err := tp.SendMultipleTransactions(context.Background(), txsToSend)
 	require.Nil(t, err)
 	require.Equal(t, len(response.TxsHashes), len(txsToSend))
 	require.Equal(t, uint64(len(txsToSend)), response.NumOfTxs)
@@ -441,10 +547,13 @@ func TestTransactionProcessor_SendMultipleTransactionsShouldWorkAndSendTxsByShar
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		true,
 	)
 
-	response, err := tp.SendMultipleTransactions(txsToSend)
+	response, err := tp.SendMultipleTransactions(context.Background(), txsToSend)
 	require.Nil(t, err)
 	require.Equal(t, uint64(len(txsToSend)), response.NumOfTxs)
 	require.Equal(t, uint32(2), atomic.LoadUint32(&numOfTimesPostEndpointWasCalled))
@@ -457,6 +566,148 @@ func TestTransactionProcessor_SendMultipleTransactionsShouldWorkAndSendTxsByShar
 	)
 }
 
+func TestTransactionProcessor_SendMultipleTransactionsShouldReportEachTransaction(t *testing.T) {
+	t.Parallel()
+
+	sndrShard0 := hex.EncodeToString([]byte("bbbbbb"))
+	sndrShard1 := hex.EncodeToString([]byte("cccccc"))
+	txsToSend := []*data.Transaction{
+		{Receiver: "aaaaaa", Sender: sndrShard0, ChainID: "chain", Version: 1},
+		{Receiver: "aaaaaa", Sender: sndrShard0, Version: 1},
+		{Receiver: "aaaaaa", Sender: sndrShard1, ChainID: "chain", Version: 1},
+		{Receiver: "aaaaaa", Sender: sndrShard0, ChainID: "chain", Version: 1},
+	}
+
+	postedAddresses := make([]string, 0)
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				if hex.EncodeToString(addressBuff) == sndrShard1 {
+					return 1, nil
+				}
+				return 0, nil
+			},
+			GetObserversCalled: func(shardID uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				if shardID == 1 {
+					return nil, errors.New("no observer in shard 1")
+				}
+				return []*data.NodeData{
+					{Address: "observer0", ShardId: 0},
+					{Address: "observer1", ShardId: 0},
+				}, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				postedAddresses = append(postedAddresses, address)
+				if address == "observer0" {
+					return http.StatusRequestTimeout, errors.New("timeout")
+				}
+
+				// the observer accepts only the first transaction of the group
+				resp := response.(*data.ResponseMultipleTransactions)
+				resp.Data.NumOfTxs = 1
+				resp.Data.TxsHashes = map[int]string{0: "hash0"}
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
+		true,
+	)
+
+	response, err := tp.SendMultipleTransactions(context.Background(), txsToSend)
+	require.Nil(t, err)
+	require.Equal(t, []string{"observer0", "observer1"}, postedAddresses)
+	require.Equal(t, uint64(1), response.NumOfTxs)
+	require.Equal(t, map[int]string{0: "hash0"}, response.TxsHashes)
+	require.Equal(t, 4, len(response.TxsResults))
+
+	require.Equal(t, &data.TransactionSendResult{Index: 0, TxHash: "hash0"}, response.TxsResults[0])
+	require.Equal(t, data.TxSendErrorValidation, response.TxsResults[1].Error.Type)
+	require.Equal(t, data.TxSendErrorShardUnavailable, response.TxsResults[2].Error.Type)
+	require.Contains(t, response.TxsResults[2].Error.Message, "no observer in shard 1")
+	require.Equal(t, &data.TransactionSendResult{
+		Index: 3,
+		Error: &data.TransactionSendError{
+			Type:    data.TxSendErrorObserverRejected,
+			Message: process.ErrTransactionNotAccepted.Error(),
+		},
+	}, response.TxsResults[3])
+}
+
+func TestTransactionProcessor_SendMultipleTransactionsAllObserversFailingShouldReportTheLastError(t *testing.T) {
+	t.Parallel()
+
+	txsToSend := []*data.Transaction{
+		{Receiver: "aaaaaa", Sender: hex.EncodeToString([]byte("bbbbbb")), ChainID: "chain", Version: 1},
+	}
+
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardID uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{
+					{Address: "observer0", ShardId: 0},
+					{Address: "observer1", ShardId: 0},
+				}, nil
+			},
+			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+				if address == "observer0" {
+					return http.StatusInternalServerError, errors.New("internal error")
+				}
+				return http.StatusBadRequest, errors.New("invalid transactions")
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
+		true,
+	)
+
+	response, err := tp.SendMultipleTransactions(context.Background(), txsToSend)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), response.NumOfTxs)
+	require.Equal(t, &data.TransactionSendResult{
+		Index: 0,
+		Error: &data.TransactionSendError{
+			Type:    data.TxSendErrorObserverRejected,
+			Message: "invalid transactions",
+		},
+	}, response.TxsResults[0])
+}
+
+func TestTransactionProcessor_SendMultipleTransactionsEmptyBulkShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
+		true,
+	)
+
+	_, err := tp.SendMultipleTransactions(context.Background(), nil)
+	require.Equal(t, process.ErrNoValidTransactionToSend, err)
+}
+
 func TestTransactionProcessor_SimulateTransactionShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -485,10 +736,13 @@ func TestTransactionProcessor_SimulateTransactionShouldWork(t *testing.T) {
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		true,
 	)
 
-	response, err := tp.SimulateTransaction(txsToSimulate, true)
+	response, err := tp.SimulateTransaction(context.Background(), txsToSimulate, true)
 	require.Nil(t, err)
 
 	respData := response.Data.(data.TransactionSimulationResponseData)
@@ -540,10 +794,13 @@ func TestTransactionProcessor_SimulateTransactionCrossShardOkOnSenderFailOnRecei
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		true,
 	)
 
-	response, err := tp.SimulateTransaction(txsToSimulate, true)
+	response, err := tp.SimulateTransaction(context.Background(), txsToSimulate, true)
 	require.Nil(t, err)
 
 	respData := response.Data.(data.TransactionSimulationResponseDataCrossShard)
@@ -610,10 +867,13 @@ func TestTransactionProcessor_GetTransactionStatusIntraShardTransaction(t *testi
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		true,
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), "")
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), "")
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -668,10 +928,13 @@ func TestTransactionProcessor_GetTransactionStatusCrossShardTransaction(t *testi
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		true,
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), "")
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), "")
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -739,10 +1002,13 @@ func TestTransactionProcessor_GetTransactionStatusCrossShardTransactionDestinati
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		true,
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), "")
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), "")
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -808,10 +1074,13 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderAddressCrossShard(t
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		true,
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), sndrShard0)
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), sndrShard0)
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -830,10 +1099,13 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderInvaidSender(t *test
 		hasher,
 		marshalizer, funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		true,
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), "blablabla")
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), "blablabla")
 	assert.Error(t, err)
 	assert.Equal(t, string(data.TxStatusUnknown), txStatus)
 }
@@ -886,10 +1158,13 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderAddressIntraShard(t
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		true,
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), sndrShard0)
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), sndrShard0)
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -911,7 +1186,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidTransactionValue(t *testi
 	}
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidTransactionValueField, err)
@@ -934,7 +1209,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidReceiverAddress(t *testin
 	}
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidAddress, err)
@@ -956,7 +1231,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidSenderAddress(t *testing.
 		Version:   1,
 	}
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidAddress, err)
@@ -978,7 +1253,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidSignaturesBytes(t *testin
 		Version:   1,
 	}
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidSignatureBytes, err)
@@ -1001,7 +1276,7 @@ func TestTransactionProcessor_ComputeTransactionShouldWork1(t *testing.T) {
 	}
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
 
 	txHashHex := "891694ae6307ee9f17f861816187a6729268397f8fabc055d5b334f552cd3cfb"
 	txHash, err := tp.ComputeTransactionHash(tx)
@@ -1028,7 +1303,7 @@ func TestTransactionProcessor_ComputeTransactionShouldWork2(t *testing.T) {
 	protoTxHash := hex.EncodeToString(protoTxHashBytes)
 
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
 
 	txHash, err := tp.ComputeTransactionHash(&data.Transaction{
 		Nonce:     protoTx.Nonce,
@@ -1104,14 +1379,71 @@ func TestTransactionProcessor_GetTransactionShouldWork(t *testing.T) {
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		true,
 	)
 
-	tx, err := tp.GetTransaction(string(hash0), false)
+	tx, err := tp.GetTransaction(context.Background(), string(hash0), false)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedNonce, tx.Nonce)
 }
 
+func TestTransactionProcessor_GetTransactionWithResultsShouldNotBeCached(t *testing.T) {
+	t.Parallel()
+
+	numCacheAccesses := uint32(0)
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0}
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer0", ShardId: 0}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (i int, err error) {
+				responseGetTx := value.(*data.GetTransactionResponse)
+				responseGetTx.Data.Transaction = transaction.ApiTransactionResult{
+					Status:                            transaction.TxStatusSuccess,
+					NotarizedAtDestinationInMetaNonce: 10,
+				}
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		&mock.ResponseCacherStub{
+			GetCalled: func(key string, value interface{}) bool {
+				atomic.AddUint32(&numCacheAccesses, 1)
+				return false
+			},
+			PutCalled: func(key string, value interface{}) {
+				atomic.AddUint32(&numCacheAccesses, 1)
+			},
+		},
+		&mock.FinalityHandlerStub{
+			IsHyperblockFinalCalled: func(nonce uint64) bool {
+				return true
+			},
+		},
+		&disabled.TransactionPreflightChecker{},
+		true,
+	)
+
+	tx, err := tp.GetTransaction(context.Background(), "hash", true)
+	require.NoError(t, err)
+	require.Equal(t, transaction.TxStatusSuccess, tx.Status)
+	require.Zero(t, atomic.LoadUint32(&numCacheAccesses))
+
+	_, err = tp.GetTransaction(context.Background(), "hash", false)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), atomic.LoadUint32(&numCacheAccesses))
+}
+
 func TestTransactionProcessor_GetTransactionShouldCallOtherObserverInShardIfHttpError(t *testing.T) {
 	t.Parallel()
 
@@ -1154,13 +1486,53 @@ func TestTransactionProcessor_GetTransactionShouldCallOtherObserverInShardIfHttp
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		true,
 	)
 
-	_, _ = tp.GetTransaction(string(hash0), false)
+	_, _ = tp.GetTransaction(context.Background(), string(hash0), false)
 	assert.True(t, secondObserverWasCalled)
 }
 
+func TestTransactionProcessor_GetTransactionWithCanceledContextShouldNotCallObservers(t *testing.T) {
+	t.Parallel()
+
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0, 1}
+			},
+			GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+				return []*data.NodeData{
+					{Address: "observer", ShardId: shardId},
+				}, nil
+			},
+			CallGetRestEndPointWithContextCalled: func(ctx context.Context, address string, path string, value interface{}) (int, error) {
+				require.Fail(t, "should have not been called")
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		funcNewTxCostHandler,
+		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
+		true,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tx, err := tp.GetTransaction(ctx, "hash", false)
+	require.Nil(t, tx)
+	require.Equal(t, context.Canceled, err)
+}
+
 func TestTransactionProcessor_GetTransactionShouldNotCallOtherObserverInShardIfNoHttpErrorButTxNotFound(t *testing.T) {
 	t.Parallel()
 
@@ -1200,10 +1572,13 @@ func TestTransactionProcessor_GetTransactionShouldNotCallOtherObserverInShardIfN
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		true,
 	)
 
-	_, _ = tp.GetTransaction(string(hash0), false)
+	_, _ = tp.GetTransaction(context.Background(), string(hash0), false)
 }
 
 func TestTransactionProcessor_GetTransactionWithEventsFirstFromDstShardAndAfterSource(t *testing.T) {
@@ -1313,10 +1688,13 @@ func TestTransactionProcessor_GetTransactionWithEventsFirstFromDstShardAndAfterS
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		true,
 	)
 
-	tx, err := tp.GetTransaction(string(hash0), true)
+	tx, err := tp.GetTransaction(context.Background(), string(hash0), true)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedNonce, tx.Nonce)
 	assert.Equal(t, 3, len(tx.SmartContractResults))
@@ -1329,10 +1707,10 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 	t.Run("GetTransactionsPool, flag not enabled", func(t *testing.T) {
 		t.Parallel()
 
-		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false)
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, false)
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPool("")
+		txs, err := tp.GetTransactionsPool(context.Background(), "")
 		assert.Nil(t, txs)
 		assert.Equal(t, apiErrors.ErrOperationNotAllowed, err)
 	})
@@ -1370,10 +1748,10 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPool("sender,nonce")
+		txs, err := tp.GetTransactionsPool(context.Background(), "sender,nonce")
 		require.NotNil(t, txs)
 		assert.NoError(t, err)
 	})
@@ -1483,7 +1861,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusBadGateway, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
 		require.NotNil(t, tp)
 
 		expectedResponse := &data.TransactionsPool{
@@ -1491,7 +1869,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 			SmartContractResults: []data.WrappedTransaction{scrTxSh0, scrTxSh1},
 			Rewards:              []data.WrappedTransaction{rewardsTxSh0, rewardsTxSh1},
 		}
-		txs, err := tp.GetTransactionsPool("sender,nonce")
+		txs, err := tp.GetTransactionsPool(context.Background(), "sender,nonce")
 		require.Nil(t, err)
 		assert.Equal(t, expectedResponse, txs)
 	})
@@ -1500,10 +1878,10 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 	t.Run("GetTransactionsPoolForShard, flag not enabled", func(t *testing.T) {
 		t.Parallel()
 
-		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, false)
+		tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, false)
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPoolForShard(0, "")
+		txs, err := tp.GetTransactionsPoolForShard(context.Background(), 0, "")
 		assert.Nil(t, txs)
 		assert.Equal(t, apiErrors.ErrOperationNotAllowed, err)
 	})
@@ -1533,10 +1911,10 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPoolForShard(0, "sender,nonce")
+		txs, err := tp.GetTransactionsPoolForShard(context.Background(), 0, "sender,nonce")
 		require.NotNil(t, txs)
 		assert.NoError(t, err)
 	})
@@ -1628,7 +2006,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusBadGateway, nil
 			},
-		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, &mock.PubKeyConverterMock{}, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
 		require.NotNil(t, tp)
 
 		expectedResponse := &data.TransactionsPool{
@@ -1636,7 +2014,7 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 			SmartContractResults: []data.WrappedTransaction{scrTx0, scrTx1},
 			Rewards:              []data.WrappedTransaction{rewardsTx0, rewardsTx1},
 		}
-		txs, err := tp.GetTransactionsPoolForShard(0, "sender,nonce")
+		txs, err := tp.GetTransactionsPoolForShard(context.Background(), 0, "sender,nonce")
 		require.Nil(t, err)
 		assert.Equal(t, expectedResponse, txs)
 	})
@@ -1676,18 +2054,18 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPoolForSender(providedSenderStr, "sender,nonce")
+		txs, err := tp.GetTransactionsPoolForSender(context.Background(), providedSenderStr, "sender,nonce")
 		require.NotNil(t, txs)
 		assert.NoError(t, err)
 
-		nonce, err := tp.GetLastPoolNonceForSender(providedSenderStr)
+		nonce, err := tp.GetLastPoolNonceForSender(context.Background(), providedSenderStr)
 		assert.Equal(t, uint64(0), nonce)
 		assert.Nil(t, err)
 
-		nonceGaps, err := tp.GetTransactionsPoolNonceGapsForSender(providedSenderStr)
+		nonceGaps, err := tp.GetTransactionsPoolNonceGapsForSender(context.Background(), providedSenderStr)
 		assert.NotNil(t, nonceGaps)
 		assert.NoError(t, err)
 	})
@@ -1753,18 +2131,18 @@ func TestTransactionProcessor_GetTransactionPool(t *testing.T) {
 
 				return http.StatusOK, nil
 			},
-		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, true)
+		}, providedPubKeyConverter, hasher, marshalizer, funcNewTxCostHandler, logsMerger, &mock.ResponseCacherStub{}, &mock.FinalityHandlerStub{}, &disabled.TransactionPreflightChecker{}, true)
 		require.NotNil(t, tp)
 
-		txs, err := tp.GetTransactionsPoolForSender(providedSenderStr, "sender,nonce")
+		txs, err := tp.GetTransactionsPoolForSender(context.Background(), providedSenderStr, "sender,nonce")
 		require.Nil(t, err)
 		assert.Equal(t, &providedPool, txs)
 
-		nonce, err := tp.GetLastPoolNonceForSender(providedSenderStr)
+		nonce, err := tp.GetLastPoolNonceForSender(context.Background(), providedSenderStr)
 		assert.Equal(t, lastNonce, nonce)
 		assert.Nil(t, err)
 
-		nonceGaps, err := tp.GetTransactionsPoolNonceGapsForSender(providedSenderStr)
+		nonceGaps, err := tp.GetTransactionsPoolNonceGapsForSender(context.Background(), providedSenderStr)
 		assert.Nil(t, err)
 		assert.Equal(t, providedGaps, nonceGaps.Gaps)
 	})
@@ -2060,14 +2438,97 @@ func TestTransactionProcessor_GetProcessedTransactionStatus(t *testing.T) {
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		true,
 	)
 
-	status, err := tp.GetProcessedTransactionStatus(string(hash0))
+	status, err := tp.GetProcessedTransactionStatus(context.Background(), string(hash0))
 	assert.Nil(t, err)
 	assert.Equal(t, string(transaction.TxStatusPending), status.Status) // not a move balance tx with missing finish markers
 }
 
+func TestTransactionProcessor_GetTransactionProgressStatus(t *testing.T) {
+	t.Parallel()
+
+	hash0 := []byte("hash0")
+	createProcessor := func(tx transaction.ApiTransactionResult) *process.TransactionProcessor {
+		tp, _ := process.NewTransactionProcessor(
+			&mock.ProcessorStub{
+				ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+					return 0, nil
+				},
+				GetObserversCalled: func(shardId uint32, dataAvailability data.ObserverDataAvailabilityType) ([]*data.NodeData, error) {
+					return []*data.NodeData{
+						{
+							Address: "observer address",
+							ShardId: shardId,
+						},
+					}, nil
+				},
+				GetShardIDsCalled: func() []uint32 {
+					return []uint32{0}
+				},
+				CallGetRestEndPointCalled: func(address string, path string, value interface{}) (i int, err error) {
+					txResponse := value.(*data.GetTransactionResponse)
+					txResponse.Data.Transaction = tx
+
+					return http.StatusOK, nil
+				},
+			},
+			&mock.PubKeyConverterMock{},
+			hasher,
+			marshalizer,
+			funcNewTxCostHandler,
+			logsMerger,
+			&mock.ResponseCacherStub{},
+			&mock.FinalityHandlerStub{},
+			&disabled.TransactionPreflightChecker{},
+			true,
+		)
+
+		return tp
+	}
+
+	t.Run("executed transaction with pending results should be partially executed", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createProcessor(transaction.ApiTransactionResult{Status: transaction.TxStatusSuccess})
+		status, err := tp.GetTransactionProgressStatus(context.Background(), string(hash0))
+		require.NoError(t, err)
+		require.Equal(t, string(data.TxStatusPartiallyExecuted), status.Status)
+	})
+	t.Run("cross-shard transaction notarized at source should be partially executed", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createProcessor(transaction.ApiTransactionResult{
+			Status:                       transaction.TxStatusPending,
+			DestinationShard:             1,
+			NotarizedAtSourceInMetaNonce: 10,
+		})
+		status, err := tp.GetTransactionProgressStatus(context.Background(), string(hash0))
+		require.NoError(t, err)
+		require.Equal(t, string(data.TxStatusPartiallyExecuted), status.Status)
+	})
+	t.Run("transaction not executed yet should be pending", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createProcessor(transaction.ApiTransactionResult{Status: transaction.TxStatusPending, DestinationShard: 1})
+		status, err := tp.GetTransactionProgressStatus(context.Background(), string(hash0))
+		require.NoError(t, err)
+		require.Equal(t, string(transaction.TxStatusPending), status.Status)
+	})
+	t.Run("invalid transaction should fail", func(t *testing.T) {
+		t.Parallel()
+
+		tp := createProcessor(transaction.ApiTransactionResult{Status: transaction.TxStatusInvalid})
+		status, err := tp.GetTransactionProgressStatus(context.Background(), string(hash0))
+		require.NoError(t, err)
+		require.Equal(t, string(transaction.TxStatusFail), status.Status)
+	})
+}
+
 func TestTransactionProcessor_GetProcessedStatusIntraShardTxWithPendingSCR(t *testing.T) {
 	txWithSCRs := loadJsonIntoTxAndScrs(t, "./testdata/transactionWithScrs.json")
 
@@ -2103,6 +2564,9 @@ func TestTransactionProcessor_GetProcessedStatusIntraShardTxWithPendingSCR(t *te
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		false,
 	)
 
@@ -2187,6 +2651,9 @@ func TestTransactionProcessor_GetProcessedStatusIntraShardRelayedV3WithMoveBalan
 		marshalizer,
 		funcNewTxCostHandler,
 		logsMerger,
+		&mock.ResponseCacherStub{},
+		&mock.FinalityHandlerStub{},
+		&disabled.TransactionPreflightChecker{},
 		false,
 	)
 
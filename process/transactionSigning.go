@@ -0,0 +1,64 @@
+package process
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// computeDataForSigning returns the data signed by the sender of the transaction, and by its relayer, as computed by
+// the nodes
+func computeDataForSigning(
+	tx *data.Transaction,
+	pubKeyConverter core.PubkeyConverter,
+	marshaller marshal.Marshalizer,
+	hasher hashing.Hasher,
+) ([]byte, error) {
+	value, ok := big.NewInt(0).SetString(tx.Value, 10)
+	if !ok {
+		return nil, ErrInvalidTransactionValueField
+	}
+
+	senderBytes, err := pubKeyConverter.Decode(tx.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	receiverBytes, err := pubKeyConverter.Decode(tx.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	regularTx := &transaction.Transaction{
+		Nonce:       tx.Nonce,
+		Value:       value,
+		RcvAddr:     receiverBytes,
+		RcvUserName: tx.ReceiverUsername,
+		SndAddr:     senderBytes,
+		SndUserName: tx.SenderUsername,
+		GasPrice:    tx.GasPrice,
+		GasLimit:    tx.GasLimit,
+		Data:        tx.Data,
+		ChainID:     []byte(tx.ChainID),
+		Version:     tx.Version,
+		Options:     tx.Options,
+	}
+	if len(tx.GuardianAddr) > 0 {
+		regularTx.GuardianAddr, err = pubKeyConverter.Decode(tx.GuardianAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(tx.RelayerAddr) > 0 {
+		regularTx.RelayerAddr, err = pubKeyConverter.Decode(tx.RelayerAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return regularTx.GetDataForSigning(pubKeyConverter, marshaller, hasher)
+}
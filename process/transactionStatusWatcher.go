@@ -0,0 +1,352 @@
+package process
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+)
+
+// ArgsTransactionStatusWatcher holds the arguments needed to create a new TransactionStatusWatcher
+type ArgsTransactionStatusWatcher struct {
+	StatusProvider     TransactionStatusProvider
+	PollInterval       time.Duration
+	SendAndWaitTimeout time.Duration
+	WatchTimeout       time.Duration
+	MaxWatchers        int
+}
+
+// TransactionStatusWatcher polls the process status of transactions and reports each of their status transitions,
+// until a final status is reached. The status of a transaction is polled once for all the subscriptions watching it
+type TransactionStatusWatcher struct {
+	statusProvider     TransactionStatusProvider
+	pollInterval       time.Duration
+	sendAndWaitTimeout time.Duration
+	watchTimeout       time.Duration
+	maxWatchers        int
+
+	mutPollers  sync.Mutex
+	pollers     map[string]*transactionStatusPoller
+	numWatchers int
+}
+
+// NewTransactionStatusWatcher creates a new instance of TransactionStatusWatcher
+func NewTransactionStatusWatcher(args ArgsTransactionStatusWatcher) (*TransactionStatusWatcher, error) {
+	if check.IfNil(args.StatusProvider) {
+		return nil, ErrNilTransactionStatusProvider
+	}
+	if args.PollInterval <= 0 {
+		return nil, fmt.Errorf("%w for PollInterval", ErrInvalidTransactionStatusWatcherConfig)
+	}
+	if args.SendAndWaitTimeout <= 0 {
+		return nil, fmt.Errorf("%w for SendAndWaitTimeout", ErrInvalidTransactionStatusWatcherConfig)
+	}
+	if args.WatchTimeout <= 0 {
+		return nil, fmt.Errorf("%w for WatchTimeout", ErrInvalidTransactionStatusWatcherConfig)
+	}
+	if args.MaxWatchers <= 0 {
+		return nil, fmt.Errorf("%w for MaxWatchers", ErrInvalidTransactionStatusWatcherConfig)
+	}
+
+	return &TransactionStatusWatcher{
+		statusProvider:     args.StatusProvider,
+		pollInterval:       args.PollInterval,
+		sendAndWaitTimeout: args.SendAndWaitTimeout,
+		watchTimeout:       args.WatchTimeout,
+		maxWatchers:        args.MaxWatchers,
+		pollers:            make(map[string]*transactionStatusPoller),
+	}, nil
+}
+
+// SendTransactionAndWait sends the transaction and waits for its final status. If the final status is not reached in
+// time, the last known status is returned. The returned int is the status code of the failed request. The wait counts
+// against the maximum number of watchers, so the transaction is not sent if the maximum is reached
+func (tsw *TransactionStatusWatcher) SendTransactionAndWait(ctx context.Context, tx *data.Transaction) (int, *data.TransactionStatusEvent, error) {
+	tsw.mutPollers.Lock()
+	err := tsw.reserveWatcher()
+	tsw.mutPollers.Unlock()
+	if err != nil {
+		return http.StatusServiceUnavailable, nil, err
+	}
+
+	statusCode, txHash, err := tsw.statusProvider.SendTransaction(ctx, tx)
+	if err != nil {
+		tsw.mutPollers.Lock()
+		tsw.numWatchers--
+		tsw.mutPollers.Unlock()
+
+		return statusCode, nil, err
+	}
+
+	tsw.mutPollers.Lock()
+	subscription := tsw.newSubscription(txHash, tsw.sendAndWaitTimeout, true)
+	tsw.mutPollers.Unlock()
+	defer subscription.Close()
+
+	lastEvent := &data.TransactionStatusEvent{
+		TxHash: txHash,
+		Status: string(transaction.TxStatusPending),
+	}
+	for !lastEvent.IsFinal {
+		event, errNext := subscription.Next(ctx)
+		if errNext != nil {
+			log.Debug("TransactionStatusWatcher: stopped waiting for the final status",
+				"txHash", txHash, "status", lastEvent.Status, "error", errNext.Error())
+			break
+		}
+
+		lastEvent = event
+	}
+
+	return statusCode, lastEvent, nil
+}
+
+// WatchTransaction returns a new subscription to the status transitions of the provided transaction. An error is
+// returned if the maximum number of watchers is reached
+func (tsw *TransactionStatusWatcher) WatchTransaction(txHash string) (data.TransactionStatusSubscriptionHandler, error) {
+	tsw.mutPollers.Lock()
+	defer tsw.mutPollers.Unlock()
+
+	err := tsw.reserveWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	return tsw.newSubscription(txHash, tsw.watchTimeout, true), nil
+}
+
+// reserveWatcher takes one of the watcher slots, released when the subscription of the watcher is closed. The
+// mutPollers mutex has to be held
+func (tsw *TransactionStatusWatcher) reserveWatcher() error {
+	if tsw.numWatchers >= tsw.maxWatchers {
+		return apiErrors.ErrTooManyTransactionWatchers
+	}
+
+	tsw.numWatchers++
+
+	return nil
+}
+
+// newSubscription returns a new subscription reading the status transitions recorded by the poller of the
+// transaction, which is started if the transaction is not watched already. The mutPollers mutex has to be held
+func (tsw *TransactionStatusWatcher) newSubscription(txHash string, timeout time.Duration, isWatcher bool) *transactionStatusSubscription {
+	poller, found := tsw.pollers[txHash]
+	if !found {
+		poller = newTransactionStatusPoller(txHash)
+		tsw.pollers[txHash] = poller
+
+		var ctx context.Context
+		ctx, poller.cancel = context.WithCancel(context.Background())
+		go tsw.pollStatus(ctx, poller)
+	}
+	poller.numSubscriptions++
+
+	return &transactionStatusSubscription{
+		watcher:   tsw,
+		poller:    poller,
+		deadline:  time.Now().Add(timeout),
+		isWatcher: isWatcher,
+		// a subscription joining an already watched transaction starts with its current status
+		nextEvent: poller.getLatestEventIndex(),
+	}
+}
+
+func (tsw *TransactionStatusWatcher) removeSubscription(sub *transactionStatusSubscription) {
+	tsw.mutPollers.Lock()
+	defer tsw.mutPollers.Unlock()
+
+	if sub.isWatcher {
+		tsw.numWatchers--
+	}
+
+	sub.poller.numSubscriptions--
+	if sub.poller.numSubscriptions > 0 {
+		return
+	}
+
+	sub.poller.cancel()
+	delete(tsw.pollers, sub.poller.txHash)
+}
+
+// pollStatus polls the status of the transaction until its final status is reached or until no subscription
+// watches it anymore
+func (tsw *TransactionStatusWatcher) pollStatus(ctx context.Context, poller *transactionStatusPoller) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return
+		}
+
+		event, isFinal := tsw.getStatusTransition(ctx, poller)
+		if event != nil {
+			poller.addEvent(event)
+		}
+		if isFinal {
+			return
+		}
+
+		timer.Reset(tsw.pollInterval)
+	}
+}
+
+// getStatusTransition returns the current status of the transaction if it differs from the last recorded one
+func (tsw *TransactionStatusWatcher) getStatusTransition(ctx context.Context, poller *transactionStatusPoller) (*data.TransactionStatusEvent, bool) {
+	status, err := tsw.statusProvider.GetTransactionProgressStatus(ctx, poller.txHash)
+	if err != nil {
+		// the transaction might not have reached the observers yet
+		log.Trace("TransactionStatusWatcher: cannot get the transaction status", "txHash", poller.txHash, "error", err.Error())
+		return nil, false
+	}
+	if status.Status == string(data.TxStatusUnknown) {
+		return nil, false
+	}
+
+	lastEvent := poller.getLastEvent()
+	isSameStatus := lastEvent != nil && lastEvent.Status == status.Status && lastEvent.Reason == status.Reason
+	if isSameStatus {
+		return nil, false
+	}
+
+	isFinal := isFinalTransactionStatus(status.Status)
+
+	return &data.TransactionStatusEvent{
+		TxHash:  poller.txHash,
+		Status:  status.Status,
+		Reason:  status.Reason,
+		IsFinal: isFinal,
+	}, isFinal
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tsw *TransactionStatusWatcher) IsInterfaceNil() bool {
+	return tsw == nil
+}
+
+// transactionStatusPoller records the status transitions of a transaction, shared by all the subscriptions watching it
+type transactionStatusPoller struct {
+	txHash           string
+	cancel           func()
+	numSubscriptions int
+
+	mutEvents  sync.RWMutex
+	events     []*data.TransactionStatusEvent
+	changeChan chan struct{}
+}
+
+func newTransactionStatusPoller(txHash string) *transactionStatusPoller {
+	return &transactionStatusPoller{
+		txHash:     txHash,
+		changeChan: make(chan struct{}),
+	}
+}
+
+func (poller *transactionStatusPoller) addEvent(event *data.TransactionStatusEvent) {
+	poller.mutEvents.Lock()
+	defer poller.mutEvents.Unlock()
+
+	poller.events = append(poller.events, event)
+
+	// wake up all the subscriptions waiting for a new status
+	close(poller.changeChan)
+	poller.changeChan = make(chan struct{})
+}
+
+// getEvent returns the recorded event with the provided index, if any, or the channel closed on the next status
+// transition otherwise
+func (poller *transactionStatusPoller) getEvent(index int) (*data.TransactionStatusEvent, chan struct{}) {
+	poller.mutEvents.RLock()
+	defer poller.mutEvents.RUnlock()
+
+	if index < len(poller.events) {
+		return poller.events[index], nil
+	}
+
+	return nil, poller.changeChan
+}
+
+func (poller *transactionStatusPoller) getLastEvent() *data.TransactionStatusEvent {
+	poller.mutEvents.RLock()
+	defer poller.mutEvents.RUnlock()
+
+	if len(poller.events) == 0 {
+		return nil
+	}
+
+	return poller.events[len(poller.events)-1]
+}
+
+func (poller *transactionStatusPoller) getLatestEventIndex() int {
+	poller.mutEvents.RLock()
+	defer poller.mutEvents.RUnlock()
+
+	if len(poller.events) == 0 {
+		return 0
+	}
+
+	return len(poller.events) - 1
+}
+
+type transactionStatusSubscription struct {
+	watcher   *TransactionStatusWatcher
+	poller    *transactionStatusPoller
+	deadline  time.Time
+	isWatcher bool
+	nextEvent int
+	lastEvent *data.TransactionStatusEvent
+	closeOnce sync.Once
+}
+
+// Next returns the next status transition of the transaction, waiting for it if needed
+func (sub *transactionStatusSubscription) Next(ctx context.Context) (*data.TransactionStatusEvent, error) {
+	if sub.lastEvent != nil && sub.lastEvent.IsFinal {
+		return nil, ErrTransactionWatchFinished
+	}
+
+	ctx, cancel := context.WithDeadline(ctx, sub.deadline)
+	defer cancel()
+
+	for {
+		event, changeChan := sub.poller.getEvent(sub.nextEvent)
+		if event != nil {
+			sub.nextEvent++
+			sub.lastEvent = event
+			return event, nil
+		}
+
+		select {
+		case <-changeChan:
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) && !time.Now().Before(sub.deadline) {
+				return nil, ErrTransactionWatchExpired
+			}
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Close ends the subscription, stopping the polling of the transaction status if no other subscription watches it
+func (sub *transactionStatusSubscription) Close() {
+	sub.closeOnce.Do(func() {
+		sub.watcher.removeSubscription(sub)
+	})
+}
+
+func isFinalTransactionStatus(status string) bool {
+	switch transaction.TxStatus(status) {
+	case transaction.TxStatusPending, data.TxStatusPartiallyExecuted, data.TxStatusUnknown:
+		return false
+	default:
+		return true
+	}
+}
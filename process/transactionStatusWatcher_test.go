@@ -0,0 +1,371 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	apiErrors "github.com/multiversx/mx-chain-proxy-go/api/errors"
+	"github.com/multiversx/mx-chain-proxy-go/data"
+	"github.com/multiversx/mx-chain-proxy-go/process"
+	"github.com/multiversx/mx-chain-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testWatchedTxHash = "txHash"
+
+// createStatusProviderWithStatuses returns a status provider which reports the provided statuses, one per poll, while
+// the last one is reported for all the next polls
+func createStatusProviderWithStatuses(statuses ...*data.ProcessStatusResponse) *mock.TransactionStatusProviderStub {
+	mutPolls := sync.Mutex{}
+	numPolls := 0
+
+	return &mock.TransactionStatusProviderStub{
+		SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+			return http.StatusOK, testWatchedTxHash, nil
+		},
+		GetTransactionProgressStatusCalled: func(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error) {
+			mutPolls.Lock()
+			defer mutPolls.Unlock()
+
+			index := numPolls
+			if index >= len(statuses) {
+				index = len(statuses) - 1
+			}
+			numPolls++
+
+			return statuses[index], nil
+		},
+	}
+}
+
+func createTransactionStatusWatcherArgs(statusProvider process.TransactionStatusProvider) process.ArgsTransactionStatusWatcher {
+	return process.ArgsTransactionStatusWatcher{
+		StatusProvider:     statusProvider,
+		PollInterval:       time.Millisecond,
+		SendAndWaitTimeout: time.Second,
+		WatchTimeout:       time.Second,
+		MaxWatchers:        2,
+	}
+}
+
+func TestNewTransactionStatusWatcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil status provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		tsw, err := process.NewTransactionStatusWatcher(createTransactionStatusWatcherArgs(nil))
+		require.True(t, check.IfNil(tsw))
+		require.Equal(t, process.ErrNilTransactionStatusProvider, err)
+	})
+	t.Run("invalid poll interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTransactionStatusWatcherArgs(&mock.TransactionStatusProviderStub{})
+		args.PollInterval = 0
+		tsw, err := process.NewTransactionStatusWatcher(args)
+		require.True(t, check.IfNil(tsw))
+		require.True(t, errors.Is(err, process.ErrInvalidTransactionStatusWatcherConfig))
+	})
+	t.Run("invalid send and wait timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTransactionStatusWatcherArgs(&mock.TransactionStatusProviderStub{})
+		args.SendAndWaitTimeout = 0
+		tsw, err := process.NewTransactionStatusWatcher(args)
+		require.True(t, check.IfNil(tsw))
+		require.True(t, errors.Is(err, process.ErrInvalidTransactionStatusWatcherConfig))
+	})
+	t.Run("invalid watch timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTransactionStatusWatcherArgs(&mock.TransactionStatusProviderStub{})
+		args.WatchTimeout = 0
+		tsw, err := process.NewTransactionStatusWatcher(args)
+		require.True(t, check.IfNil(tsw))
+		require.True(t, errors.Is(err, process.ErrInvalidTransactionStatusWatcherConfig))
+	})
+	t.Run("invalid max watchers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createTransactionStatusWatcherArgs(&mock.TransactionStatusProviderStub{})
+		args.MaxWatchers = 0
+		tsw, err := process.NewTransactionStatusWatcher(args)
+		require.True(t, check.IfNil(tsw))
+		require.True(t, errors.Is(err, process.ErrInvalidTransactionStatusWatcherConfig))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tsw, err := process.NewTransactionStatusWatcher(createTransactionStatusWatcherArgs(&mock.TransactionStatusProviderStub{}))
+		require.False(t, check.IfNil(tsw))
+		require.NoError(t, err)
+	})
+}
+
+func TestTransactionStatusWatcher_WatchTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should emit each status transition until the final one", func(t *testing.T) {
+		t.Parallel()
+
+		statusProvider := createStatusProviderWithStatuses(
+			&data.ProcessStatusResponse{Status: string(data.TxStatusUnknown)},
+			&data.ProcessStatusResponse{Status: string(transaction.TxStatusPending)},
+			&data.ProcessStatusResponse{Status: string(transaction.TxStatusPending)},
+			&data.ProcessStatusResponse{Status: string(data.TxStatusPartiallyExecuted)},
+			&data.ProcessStatusResponse{Status: string(transaction.TxStatusFail), Reason: "out of gas"},
+		)
+		tsw, _ := process.NewTransactionStatusWatcher(createTransactionStatusWatcherArgs(statusProvider))
+		subscription, _ := tsw.WatchTransaction(testWatchedTxHash)
+
+		event, err := subscription.Next(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, &data.TransactionStatusEvent{TxHash: testWatchedTxHash, Status: string(transaction.TxStatusPending)}, event)
+
+		event, err = subscription.Next(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, string(data.TxStatusPartiallyExecuted), event.Status)
+		require.False(t, event.IsFinal)
+
+		event, err = subscription.Next(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, &data.TransactionStatusEvent{
+			TxHash:  testWatchedTxHash,
+			Status:  string(transaction.TxStatusFail),
+			Reason:  "out of gas",
+			IsFinal: true,
+		}, event)
+
+		event, err = subscription.Next(context.Background())
+		require.Nil(t, event)
+		require.Equal(t, process.ErrTransactionWatchFinished, err)
+	})
+	t.Run("status errors should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		numPolls := 0
+		statusProvider := &mock.TransactionStatusProviderStub{
+			GetTransactionProgressStatusCalled: func(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error) {
+				numPolls++
+				if numPolls < 3 {
+					return &data.ProcessStatusResponse{Status: string(data.TxStatusUnknown)}, errors.New("transaction not found")
+				}
+
+				return &data.ProcessStatusResponse{Status: string(transaction.TxStatusSuccess)}, nil
+			},
+		}
+		tsw, _ := process.NewTransactionStatusWatcher(createTransactionStatusWatcherArgs(statusProvider))
+
+		subscription, _ := tsw.WatchTransaction(testWatchedTxHash)
+		event, err := subscription.Next(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, string(transaction.TxStatusSuccess), event.Status)
+		require.True(t, event.IsFinal)
+		require.Equal(t, 3, numPolls)
+	})
+	t.Run("should expire after the watch timeout", func(t *testing.T) {
+		t.Parallel()
+
+		statusProvider := createStatusProviderWithStatuses(&data.ProcessStatusResponse{Status: string(transaction.TxStatusPending)})
+		args := createTransactionStatusWatcherArgs(statusProvider)
+		args.WatchTimeout = 50 * time.Millisecond
+		tsw, _ := process.NewTransactionStatusWatcher(args)
+		subscription, _ := tsw.WatchTransaction(testWatchedTxHash)
+
+		_, err := subscription.Next(context.Background())
+		require.NoError(t, err)
+
+		event, err := subscription.Next(context.Background())
+		require.Nil(t, event)
+		require.Equal(t, process.ErrTransactionWatchExpired, err)
+	})
+	t.Run("cancelled context should stop waiting", func(t *testing.T) {
+		t.Parallel()
+
+		statusProvider := createStatusProviderWithStatuses(&data.ProcessStatusResponse{Status: string(data.TxStatusUnknown)})
+		tsw, _ := process.NewTransactionStatusWatcher(createTransactionStatusWatcherArgs(statusProvider))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		subscription, _ := tsw.WatchTransaction(testWatchedTxHash)
+		event, err := subscription.Next(ctx)
+		require.Nil(t, event)
+		require.Equal(t, context.DeadlineExceeded, err)
+	})
+	t.Run("watchers of the same transaction should share the status polling", func(t *testing.T) {
+		t.Parallel()
+
+		mutPolls := sync.Mutex{}
+		numPolls := 0
+		statusProvider := &mock.TransactionStatusProviderStub{
+			GetTransactionProgressStatusCalled: func(ctx context.Context, txHash string) (*data.ProcessStatusResponse, error) {
+				mutPolls.Lock()
+				numPolls++
+				mutPolls.Unlock()
+
+				return &data.ProcessStatusResponse{Status: string(transaction.TxStatusPending)}, nil
+			},
+		}
+		args := createTransactionStatusWatcherArgs(statusProvider)
+		// only the first poll, done as soon as the transaction is watched, happens during the test
+		args.PollInterval = time.Hour
+		tsw, _ := process.NewTransactionStatusWatcher(args)
+
+		first, _ := tsw.WatchTransaction(testWatchedTxHash)
+		defer first.Close()
+		second, _ := tsw.WatchTransaction(testWatchedTxHash)
+		defer second.Close()
+
+		for _, subscription := range []data.TransactionStatusSubscriptionHandler{first, second} {
+			event, err := subscription.Next(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, string(transaction.TxStatusPending), event.Status)
+		}
+
+		mutPolls.Lock()
+		defer mutPolls.Unlock()
+		require.Equal(t, 1, numPolls)
+	})
+	t.Run("should error when the maximum number of watchers is reached", func(t *testing.T) {
+		t.Parallel()
+
+		statusProvider := createStatusProviderWithStatuses(&data.ProcessStatusResponse{Status: string(transaction.TxStatusPending)})
+		tsw, _ := process.NewTransactionStatusWatcher(createTransactionStatusWatcherArgs(statusProvider))
+
+		first, err := tsw.WatchTransaction("hash1")
+		require.NoError(t, err)
+		_, err = tsw.WatchTransaction("hash2")
+		require.NoError(t, err)
+
+		subscription, err := tsw.WatchTransaction("hash3")
+		require.Nil(t, subscription)
+		require.Equal(t, apiErrors.ErrTooManyTransactionWatchers, err)
+
+		// closing a watcher frees its slot
+		first.Close()
+		first.Close()
+		subscription, err = tsw.WatchTransaction("hash3")
+		require.NoError(t, err)
+		require.NotNil(t, subscription)
+	})
+}
+
+func TestTransactionStatusWatcher_SendTransactionAndWait(t *testing.T) {
+	t.Parallel()
+
+	t.Run("send error should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		statusProvider := &mock.TransactionStatusProviderStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				return http.StatusBadRequest, "", expectedErr
+			},
+		}
+		tsw, _ := process.NewTransactionStatusWatcher(createTransactionStatusWatcherArgs(statusProvider))
+
+		statusCode, event, err := tsw.SendTransactionAndWait(context.Background(), &data.Transaction{})
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Nil(t, event)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should wait for the final status", func(t *testing.T) {
+		t.Parallel()
+
+		statusProvider := createStatusProviderWithStatuses(
+			&data.ProcessStatusResponse{Status: string(transaction.TxStatusPending)},
+			&data.ProcessStatusResponse{Status: string(data.TxStatusPartiallyExecuted)},
+			&data.ProcessStatusResponse{Status: string(transaction.TxStatusSuccess)},
+		)
+		tsw, _ := process.NewTransactionStatusWatcher(createTransactionStatusWatcherArgs(statusProvider))
+
+		statusCode, event, err := tsw.SendTransactionAndWait(context.Background(), &data.Transaction{})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, &data.TransactionStatusEvent{
+			TxHash:  testWatchedTxHash,
+			Status:  string(transaction.TxStatusSuccess),
+			IsFinal: true,
+		}, event)
+	})
+	t.Run("timeout should return the last known status", func(t *testing.T) {
+		t.Parallel()
+
+		statusProvider := createStatusProviderWithStatuses(
+			&data.ProcessStatusResponse{Status: string(transaction.TxStatusPending)},
+			&data.ProcessStatusResponse{Status: string(data.TxStatusPartiallyExecuted)},
+		)
+		args := createTransactionStatusWatcherArgs(statusProvider)
+		args.SendAndWaitTimeout = 50 * time.Millisecond
+		tsw, _ := process.NewTransactionStatusWatcher(args)
+
+		statusCode, event, err := tsw.SendTransactionAndWait(context.Background(), &data.Transaction{})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, &data.TransactionStatusEvent{
+			TxHash: testWatchedTxHash,
+			Status: string(data.TxStatusPartiallyExecuted),
+		}, event)
+	})
+	t.Run("should take a watcher slot while waiting", func(t *testing.T) {
+		t.Parallel()
+
+		numSent := 0
+		statusProvider := createStatusProviderWithStatuses(&data.ProcessStatusResponse{Status: string(transaction.TxStatusSuccess)})
+		sendTransaction := statusProvider.SendTransactionCalled
+		statusProvider.SendTransactionCalled = func(tx *data.Transaction) (int, string, error) {
+			numSent++
+			return sendTransaction(tx)
+		}
+		tsw, _ := process.NewTransactionStatusWatcher(createTransactionStatusWatcherArgs(statusProvider))
+
+		first, _ := tsw.WatchTransaction("hash1")
+		_, _ = tsw.WatchTransaction("hash2")
+
+		statusCode, event, err := tsw.SendTransactionAndWait(context.Background(), &data.Transaction{})
+		require.Equal(t, http.StatusServiceUnavailable, statusCode)
+		require.Nil(t, event)
+		require.Equal(t, apiErrors.ErrTooManyTransactionWatchers, err)
+		require.Zero(t, numSent)
+
+		first.Close()
+		statusCode, event, err = tsw.SendTransactionAndWait(context.Background(), &data.Transaction{})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, statusCode)
+		require.True(t, event.IsFinal)
+		require.Equal(t, 1, numSent)
+
+		// the slot is released once the final status is returned
+		subscription, err := tsw.WatchTransaction("hash3")
+		require.NoError(t, err)
+		require.NotNil(t, subscription)
+	})
+	t.Run("send error should release the watcher slot", func(t *testing.T) {
+		t.Parallel()
+
+		statusProvider := &mock.TransactionStatusProviderStub{
+			SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+				return http.StatusBadRequest, "", errors.New("expected error")
+			},
+		}
+		tsw, _ := process.NewTransactionStatusWatcher(createTransactionStatusWatcherArgs(statusProvider))
+
+		for i := 0; i < 3; i++ {
+			statusCode, _, _ := tsw.SendTransactionAndWait(context.Background(), &data.Transaction{})
+			require.Equal(t, http.StatusBadRequest, statusCode)
+		}
+
+		_, err := tsw.WatchTransaction("hash1")
+		require.NoError(t, err)
+		_, err = tsw.WatchTransaction("hash2")
+		require.NoError(t, err)
+	})
+}
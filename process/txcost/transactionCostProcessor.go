@@ -2,6 +2,7 @@ package txcost
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -50,13 +51,13 @@ func NewTransactionCostProcessor(
 }
 
 // ResolveCostRequest will resolve the transaction cost request
-func (tcp *transactionCostProcessor) ResolveCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
+func (tcp *transactionCostProcessor) ResolveCostRequest(ctx context.Context, tx *data.Transaction) (*data.TxCostResponseData, error) {
 	senderShardID, receiverShardID, err := tcp.computeSenderAndReceiverShardID(tx.Sender, tx.Receiver)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := tcp.doCostRequests(senderShardID, receiverShardID, tx)
+	res, err := tcp.doCostRequests(ctx, senderShardID, receiverShardID, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +88,7 @@ func (tcp *transactionCostProcessor) ResolveCostRequest(tx *data.Transaction) (*
 	return res, nil
 }
 
-func (tcp *transactionCostProcessor) doCostRequests(senderShardID, receiverShardID uint32, tx *data.Transaction) (*data.TxCostResponseData, error) {
+func (tcp *transactionCostProcessor) doCostRequests(ctx context.Context, senderShardID, receiverShardID uint32, tx *data.Transaction) (*data.TxCostResponseData, error) {
 	shouldExecuteOnSource := senderShardID != receiverShardID && len(tcp.responses) == 0
 	if shouldExecuteOnSource {
 		observers, errGet := tcp.proc.GetObservers(senderShardID, data.AvailabilityRecent)
@@ -95,7 +96,7 @@ func (tcp *transactionCostProcessor) doCostRequests(senderShardID, receiverShard
 			return nil, errGet
 		}
 
-		res, errExe := tcp.executeRequest(senderShardID, receiverShardID, observers, tx)
+		res, errExe := tcp.executeRequest(ctx, senderShardID, receiverShardID, observers, tx)
 		if errExe != nil {
 			return nil, errExe
 		}
@@ -110,10 +111,11 @@ func (tcp *transactionCostProcessor) doCostRequests(senderShardID, receiverShard
 		return nil, err
 	}
 
-	return tcp.executeRequest(senderShardID, receiverShardID, observers, tx)
+	return tcp.executeRequest(ctx, senderShardID, receiverShardID, observers, tx)
 }
 
 func (tcp *transactionCostProcessor) executeRequest(
+	ctx context.Context,
 	senderShardID uint32,
 	receiverShardID uint32,
 	observers []*data.NodeData,
@@ -121,9 +123,12 @@ func (tcp *transactionCostProcessor) executeRequest(
 ) (*data.TxCostResponseData, error) {
 	txCostResponse := data.ResponseTxCost{}
 	for _, observer := range observers {
-		respCode, errCall := tcp.proc.CallPostRestEndPoint(observer.Address, TransactionCostPath, tx, &txCostResponse)
+		respCode, errCall := tcp.proc.CallPostRestEndPointWithContext(ctx, observer.Address, TransactionCostPath, tx, &txCostResponse)
 		if respCode == http.StatusOK && errCall == nil {
-			return tcp.processResponse(senderShardID, receiverShardID, &txCostResponse, tx)
+			return tcp.processResponse(ctx, senderShardID, receiverShardID, &txCostResponse, tx)
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
 		}
 
 		// if observer was down (or didn't respond in time), skip to the next one
@@ -141,6 +146,7 @@ func (tcp *transactionCostProcessor) executeRequest(
 }
 
 func (tcp *transactionCostProcessor) processResponse(
+	ctx context.Context,
 	senderShardID uint32,
 	receiverShardID uint32,
 	response *data.ResponseTxCost,
@@ -158,7 +164,7 @@ func (tcp *transactionCostProcessor) processResponse(
 		}
 
 		scr.Used = true
-		res, err := tcp.processScResult(senderShardID, receiverShardID, scr, originalTx)
+		res, err := tcp.processScResult(ctx, senderShardID, receiverShardID, scr, originalTx)
 		if err != nil {
 			log.Warn("cannot process smart contract result", "hash", scrHash, "error", err)
 			continue
@@ -182,6 +188,7 @@ func mergeResponses(finalRes *data.ResponseTxCost, currentRes *data.TxCostRespon
 }
 
 func (tcp *transactionCostProcessor) processScResult(
+	ctx context.Context,
 	senderShardID uint32,
 	receiverShardID uint32,
 	scr *data.ExtendedApiSmartContractResult,
@@ -210,7 +217,7 @@ func (tcp *transactionCostProcessor) processScResult(
 		return nil, err
 	}
 
-	res, err := tcp.executeRequest(scrSenderShardID, scrReceiverShardID, observers, txFromScr)
+	res, err := tcp.executeRequest(ctx, scrSenderShardID, scrReceiverShardID, observers, txFromScr)
 	if err != nil {
 		return nil, err
 	}
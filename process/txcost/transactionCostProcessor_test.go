@@ -2,6 +2,7 @@ package txcost
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"net/http"
 	"testing"
@@ -129,7 +130,7 @@ func TestTransactionCostProcessor_RezolveCostRequestWith3LevelsOfAsyncCalls(t *t
 	}
 
 	expectedGas := uint64(14000)
-	res, err := newTxCostProcessor.ResolveCostRequest(tx)
+	res, err := newTxCostProcessor.ResolveCostRequest(context.Background(), tx)
 	require.Nil(t, err)
 	require.NotNil(t, res)
 	require.Equal(t, expectedGas, res.TxCost)
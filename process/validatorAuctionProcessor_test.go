@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"sync/atomic"
@@ -57,7 +58,7 @@ func TestValidatorStatisticsProcessor_GetAuctionList(t *testing.T) {
 			},
 		}
 		vsp, _ := NewValidatorStatisticsProcessor(processor, &mock.ValStatsCacherMock{}, time.Second)
-		resp, err := vsp.GetAuctionList()
+		resp, err := vsp.GetAuctionList(context.Background())
 		require.Nil(t, err)
 		require.Equal(t, expectedResp.Data, *resp)
 	})
@@ -81,7 +82,7 @@ func TestValidatorStatisticsProcessor_GetAuctionList(t *testing.T) {
 		}
 		vsp, _ := NewValidatorStatisticsProcessor(processor, &mock.ValStatsCacherMock{}, time.Second)
 
-		resp, err := vsp.GetAuctionList()
+		resp, err := vsp.GetAuctionList(context.Background())
 		require.Equal(t, errGetObservers, err)
 		require.Nil(t, resp)
 		require.Equal(t, int32(0), callGetRestEndPointCalledCt)
@@ -111,7 +112,7 @@ func TestValidatorStatisticsProcessor_GetAuctionList(t *testing.T) {
 		}
 		vsp, _ := NewValidatorStatisticsProcessor(processor, &mock.ValStatsCacherMock{}, time.Second)
 
-		resp, err := vsp.GetAuctionList()
+		resp, err := vsp.GetAuctionList(context.Background())
 		require.Equal(t, ErrAuctionListNotAvailable, err)
 		require.Nil(t, resp)
 	})
@@ -47,7 +47,7 @@ func NewValidatorStatisticsProcessor(
 }
 
 // GetValidatorStatistics will simply forward the validator statistics data from an observer
-func (vsp *ValidatorStatisticsProcessor) GetValidatorStatistics() (*data.ValidatorStatisticsResponse, error) {
+func (vsp *ValidatorStatisticsProcessor) GetValidatorStatistics(ctx context.Context) (*data.ValidatorStatisticsResponse, error) {
 	valStatsToReturn, err := vsp.cacher.LoadValStats()
 	if err == nil {
 		return &data.ValidatorStatisticsResponse{Statistics: valStatsToReturn}, nil
@@ -55,10 +55,10 @@ func (vsp *ValidatorStatisticsProcessor) GetValidatorStatistics() (*data.Validat
 
 	log.Info("validator statistics: cannot get from cache. Will fetch from API", "error", err.Error())
 
-	return vsp.getValidatorStatisticsFromApi()
+	return vsp.getValidatorStatisticsFromApi(ctx)
 }
 
-func (vsp *ValidatorStatisticsProcessor) getValidatorStatisticsFromApi() (*data.ValidatorStatisticsResponse, error) {
+func (vsp *ValidatorStatisticsProcessor) getValidatorStatisticsFromApi(ctx context.Context) (*data.ValidatorStatisticsResponse, error) {
 	observers, errFetchObs := vsp.proc.GetObservers(core.MetachainShardId, data.AvailabilityRecent)
 	if errFetchObs != nil {
 		return nil, errFetchObs
@@ -67,11 +67,15 @@ func (vsp *ValidatorStatisticsProcessor) getValidatorStatisticsFromApi() (*data.
 	var valStatsResponse data.ValidatorStatisticsApiResponse
 	var err error
 	for _, observer := range observers {
-		_, err = vsp.proc.CallGetRestEndPoint(observer.Address, validatorStatisticsPath, &valStatsResponse)
+		_, err = vsp.proc.CallGetRestEndPointWithContext(ctx, observer.Address, validatorStatisticsPath, &valStatsResponse)
 		if err == nil {
 			log.Info("validator statistics fetched from API", "observer", observer.Address)
 			return &valStatsResponse.Data, nil
 		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
 		log.Error("validator statistics", "observer", observer.Address, "error", "no response")
 	}
 	return nil, ErrValidatorStatisticsNotAvailable
@@ -91,14 +95,14 @@ func (vsp *ValidatorStatisticsProcessor) StartCacheUpdate() {
 		timer := time.NewTimer(vsp.cacheValidityDuration)
 		defer timer.Stop()
 
-		vsp.handleCacheUpdate()
+		vsp.handleCacheUpdate(ctx)
 
 		for {
 			timer.Reset(vsp.cacheValidityDuration)
 
 			select {
 			case <-timer.C:
-				vsp.handleCacheUpdate()
+				vsp.handleCacheUpdate(ctx)
 			case <-ctx.Done():
 				log.Debug("finishing ValidatorStatisticsProcessor cache update...")
 				return
@@ -107,8 +111,8 @@ func (vsp *ValidatorStatisticsProcessor) StartCacheUpdate() {
 	}(ctx)
 }
 
-func (vsp *ValidatorStatisticsProcessor) handleCacheUpdate() {
-	valStats, err := vsp.getValidatorStatisticsFromApi()
+func (vsp *ValidatorStatisticsProcessor) handleCacheUpdate(ctx context.Context) {
+	valStats, err := vsp.getValidatorStatisticsFromApi(ctx)
 	if err != nil {
 		log.Warn("validator statistics: get from API", "error", err.Error())
 	}
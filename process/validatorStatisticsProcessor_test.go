@@ -1,6 +1,7 @@
 package process_test
 
 import (
+	"context"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -54,7 +55,7 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsDataWrongValuesShoul
 	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, time.Second)
 	assert.Nil(t, err)
 
-	res, err := hp.GetValidatorStatistics()
+	res, err := hp.GetValidatorStatistics(context.Background())
 
 	assert.Nil(t, res)
 	assert.Error(t, err)
@@ -82,7 +83,7 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsDataOkValuesShouldPa
 
 	assert.Nil(t, err)
 
-	res, err := hp.GetValidatorStatistics()
+	res, err := hp.GetValidatorStatistics(context.Background())
 	assert.NotNil(t, res)
 	assert.Nil(t, err)
 }
@@ -109,7 +110,7 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsNoMetaObserverShould
 
 	assert.Nil(t, err)
 
-	res, err := hp.GetValidatorStatistics()
+	res, err := hp.GetValidatorStatistics(context.Background())
 	assert.Nil(t, res)
 	assert.Error(t, err)
 }
@@ -135,7 +136,7 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsShouldReturnDataFrom
 	)
 	assert.Nil(t, err)
 
-	_, err = hp.GetValidatorStatistics()
+	_, err = hp.GetValidatorStatistics(context.Background())
 	assert.Nil(t, err)
 	assert.True(t, httpWasCalled)
 }
@@ -150,7 +151,7 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsShouldReturnDataFrom
 	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond)
 	assert.Nil(t, err)
 
-	res, err := hp.GetValidatorStatistics()
+	res, err := hp.GetValidatorStatistics(context.Background())
 
 	assert.Nil(t, err)
 	assert.Equal(t, res.Statistics, valStatsMap)
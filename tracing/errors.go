@@ -0,0 +1,9 @@
+package tracing
+
+import "errors"
+
+// ErrUnknownExporter signals that an unknown spans exporter has been provided
+var ErrUnknownExporter = errors.New("unknown spans exporter")
+
+// ErrInvalidSampleRatio signals that an invalid sample ratio has been provided
+var ErrInvalidSampleRatio = errors.New("invalid sample ratio")
@@ -0,0 +1,85 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+)
+
+const (
+	// OtlpExporter is the exporter sending the spans to an OpenTelemetry collector over OTLP/gRPC
+	OtlpExporter = "otlp"
+	// StdoutExporter is the exporter printing the spans to the standard output
+	StdoutExporter = "stdout"
+
+	shutdownTimeout = 5 * time.Second
+)
+
+type tracerProvider struct {
+	*sdktrace.TracerProvider
+}
+
+// NewTracerProvider returns a tracer provider exporting the spans as configured
+func NewTracerProvider(cfg config.TracingConfig) (*tracerProvider, error) {
+	return newTracerProvider(cfg, os.Stdout)
+}
+
+func newTracerProvider(cfg config.TracingConfig, stdoutWriter io.Writer) (*tracerProvider, error) {
+	if cfg.SampleRatio < 0 || cfg.SampleRatio > 1 {
+		return nil, fmt.Errorf("%w %f, it should be between 0 and 1", ErrInvalidSampleRatio, cfg.SampleRatio)
+	}
+
+	exporter, err := createExporter(cfg, stdoutWriter)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(cfg.ServiceName))),
+	)
+
+	return &tracerProvider{
+		TracerProvider: provider,
+	}, nil
+}
+
+func createExporter(cfg config.TracingConfig, stdoutWriter io.Writer) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case OtlpExporter:
+		options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.OtlpEndpoint)}
+		if cfg.OtlpInsecure {
+			options = append(options, otlptracegrpc.WithInsecure())
+		}
+
+		// the connection to the collector is established in background, so this does not wait for the collector
+		return otlptracegrpc.New(context.Background(), options...)
+	case StdoutExporter:
+		return stdouttrace.New(stdouttrace.WithWriter(stdoutWriter))
+	default:
+		return nil, fmt.Errorf("%w %s", ErrUnknownExporter, cfg.Exporter)
+	}
+}
+
+// Close exports the remaining spans and stops the tracer provider
+func (tp *tracerProvider) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	return tp.Shutdown(ctx)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tp *tracerProvider) IsInterfaceNil() bool {
+	return tp == nil
+}
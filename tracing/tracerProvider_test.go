@@ -0,0 +1,96 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-proxy-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+func createTracingConfig() config.TracingConfig {
+	return config.TracingConfig{
+		Enabled:      true,
+		Exporter:     StdoutExporter,
+		OtlpEndpoint: "localhost:4317",
+		OtlpInsecure: true,
+		SampleRatio:  1,
+		ServiceName:  "proxy-test",
+	}
+}
+
+func TestNewTracerProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid sample ratio should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTracingConfig()
+		cfg.SampleRatio = 1.5
+		tp, err := NewTracerProvider(cfg)
+		require.True(t, check.IfNil(tp))
+		require.True(t, errors.Is(err, ErrInvalidSampleRatio))
+
+		cfg.SampleRatio = -0.1
+		tp, err = NewTracerProvider(cfg)
+		require.True(t, check.IfNil(tp))
+		require.True(t, errors.Is(err, ErrInvalidSampleRatio))
+	})
+	t.Run("unknown exporter should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTracingConfig()
+		cfg.Exporter = "zipkin"
+		tp, err := NewTracerProvider(cfg)
+		require.True(t, check.IfNil(tp))
+		require.True(t, errors.Is(err, ErrUnknownExporter))
+	})
+	t.Run("otlp exporter should not wait for the collector", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTracingConfig()
+		cfg.Exporter = OtlpExporter
+		cfg.OtlpEndpoint = "127.0.0.1:1"
+		tp, err := NewTracerProvider(cfg)
+		require.NoError(t, err)
+		require.False(t, check.IfNil(tp))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_ = tp.Shutdown(ctx)
+	})
+}
+
+func TestTracerProvider_StdoutExporterShouldPrintTheSpans(t *testing.T) {
+	t.Parallel()
+
+	buff := &bytes.Buffer{}
+	tp, err := newTracerProvider(createTracingConfig(), buff)
+	require.NoError(t, err)
+
+	_, span := tp.Tracer("test").Start(context.Background(), "test-span")
+	span.End()
+
+	require.NoError(t, tp.Close())
+	require.Contains(t, buff.String(), `"Name":"test-span"`)
+	require.Contains(t, buff.String(), `"Value":"proxy-test"`)
+}
+
+func TestTracerProvider_SampleRatioZeroShouldNotExportTheSpans(t *testing.T) {
+	t.Parallel()
+
+	cfg := createTracingConfig()
+	cfg.SampleRatio = 0
+	buff := &bytes.Buffer{}
+	tp, err := newTracerProvider(cfg, buff)
+	require.NoError(t, err)
+
+	_, span := tp.Tracer("test").Start(context.Background(), "test-span")
+	span.End()
+
+	require.NoError(t, tp.Close())
+	require.Empty(t, buff.String())
+}
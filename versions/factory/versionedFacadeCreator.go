@@ -12,6 +12,8 @@ import (
 	"github.com/multiversx/mx-chain-proxy-go/versions"
 )
 
+const v1_0ApiConfigName = "v1_0"
+
 // FacadeArgs holds the arguments needed for creating a base facade
 type FacadeArgs struct {
 	ActionsProcessor             facade.ActionsProcessor
@@ -29,6 +31,9 @@ type FacadeArgs struct {
 	ESDTSuppliesProcessor        facade.ESDTSupplyProcessor
 	StatusProcessor              facade.StatusProcessor
 	AboutInfoProcessor           facade.AboutInfoProcessor
+	HyperblockStreamer           facade.HyperblockStreamer
+	TransactionStatusWatcher     facade.TransactionStatusWatcher
+	RelayerProcessor             facade.RelayerProcessor
 }
 
 // CreateVersionsRegistry creates the version registry instances and populates it with the versions and their handlers
@@ -55,6 +60,14 @@ func CreateVersionsRegistry(facadeArgs FacadeArgs, apiConfigParser ApiConfigPars
 	return versionsRegistry, nil
 }
 
+// GetApiConfigNames returns the names of the API routes config files, mapped by the registered versions using them
+func GetApiConfigNames() map[string]string {
+	return map[string]string{
+		"v1.0": v1_0ApiConfigName,
+		"":     v1_0ApiConfigName,
+	}
+}
+
 func addVersionV1_0AsDefault(versionRegistry data.VersionsRegistryHandler, apiConfigParser ApiConfigParser) error {
 	versionsMap, err := versionRegistry.GetAllVersions()
 	if err != nil {
@@ -80,7 +93,7 @@ func addVersionV1_0(facadeArgs FacadeArgs, versionRegistry data.VersionsRegistry
 		return err
 	}
 
-	apiConfig, err := apiConfigParser.GetConfigForVersion("v1_0")
+	apiConfig, err := apiConfigParser.GetConfigForVersion(v1_0ApiConfigName)
 	if err != nil {
 		return err
 	}
@@ -111,6 +124,9 @@ func createVersionV1_0Facade(facadeArgs FacadeArgs) (*facadeVersions.ProxyFacade
 		ESDTSuppliesProcessor:        facadeArgs.ESDTSuppliesProcessor,
 		StatusProcessor:              facadeArgs.StatusProcessor,
 		AboutInfoProcessor:           facadeArgs.AboutInfoProcessor,
+		HyperblockStreamer:           facadeArgs.HyperblockStreamer,
+		TransactionStatusWatcher:     facadeArgs.TransactionStatusWatcher,
+		RelayerProcessor:             facadeArgs.RelayerProcessor,
 	}
 
 	commonFacade, err := createVersionedFacade(v1_0HandlerArgs)
@@ -169,6 +185,9 @@ func createVersionV_nextFacade(facadeArgs FacadeArgs) (data.FacadeHandler, error
 		PubKeyConverter:              facadeArgs.PubKeyConverter,
 		ESDTSuppliesProcessor:        facadeArgs.ESDTSuppliesProcessor,
 		StatusProcessor:              facadeArgs.StatusProcessor,
+		HyperblockStreamer:           facadeArgs.HyperblockStreamer,
+		TransactionStatusWatcher:     facadeArgs.TransactionStatusWatcher,
+		RelayerProcessor:             facadeArgs.RelayerProcessor,
 	}
 
 	commonFacade, err := createVersionedFacade(v_nextHandlerArgs)
@@ -208,5 +227,8 @@ func createVersionedFacade(args FacadeArgs) (data.FacadeHandler, error) {
 		args.ESDTSuppliesProcessor,
 		args.StatusProcessor,
 		args.AboutInfoProcessor,
+		args.HyperblockStreamer,
+		args.TransactionStatusWatcher,
+		args.RelayerProcessor,
 	)
 }